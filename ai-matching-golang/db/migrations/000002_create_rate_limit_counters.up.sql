@@ -0,0 +1,9 @@
+-- Create rate_limit_counters table (fixed-window counters used for throttling and login lockout)
+CREATE TABLE IF NOT EXISTS rate_limit_counters (
+    key VARCHAR(255) PRIMARY KEY,
+    count BIGINT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP NOT NULL
+);
+
+-- Create indexes
+CREATE INDEX idx_rate_limit_counters_expires_at ON rate_limit_counters(expires_at);
@@ -0,0 +1,5 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_organization_origins_organization_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS organization_origins;
@@ -0,0 +1,11 @@
+-- Create organization_origins table (additional frontend origins allowed by CORS)
+CREATE TABLE IF NOT EXISTS organization_origins (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    origin VARCHAR(255) UNIQUE NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Create indexes
+CREATE INDEX idx_organization_origins_organization_id ON organization_origins(organization_id);
@@ -0,0 +1,6 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_tenant_domains_verified_domain;
+DROP INDEX IF EXISTS idx_tenant_domains_tenant_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS tenant_domains;
@@ -0,0 +1,18 @@
+-- Create tenant_domains table (custom hostnames verified through a DNS TXT record)
+CREATE TABLE IF NOT EXISTS tenant_domains (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    domain VARCHAR(253) NOT NULL,
+    verification_token VARCHAR(64) NOT NULL,
+    is_primary BOOLEAN NOT NULL DEFAULT FALSE,
+    verified_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (tenant_id, domain)
+);
+
+-- Create indexes
+CREATE INDEX idx_tenant_domains_tenant_id ON tenant_domains(tenant_id);
+-- A domain belongs to the tenant that verifies it; unverified claims do not
+-- block other tenants from adding the same domain
+CREATE UNIQUE INDEX idx_tenant_domains_verified_domain ON tenant_domains(domain) WHERE verified_at IS NOT NULL;
@@ -0,0 +1,6 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_tenant_subdomain_aliases_expires_at;
+DROP INDEX IF EXISTS idx_tenant_subdomain_aliases_tenant_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS tenant_subdomain_aliases;
@@ -0,0 +1,11 @@
+-- Create tenant_subdomain_aliases table (previous subdomains kept as redirects after a rename)
+CREATE TABLE IF NOT EXISTS tenant_subdomain_aliases (
+    subdomain VARCHAR(100) PRIMARY KEY,
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Create indexes
+CREATE INDEX idx_tenant_subdomain_aliases_tenant_id ON tenant_subdomain_aliases(tenant_id);
+CREATE INDEX idx_tenant_subdomain_aliases_expires_at ON tenant_subdomain_aliases(expires_at);
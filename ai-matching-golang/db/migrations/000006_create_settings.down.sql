@@ -0,0 +1,3 @@
+-- Drop tables
+DROP TABLE IF EXISTS tenant_settings;
+DROP TABLE IF EXISTS organization_settings;
@@ -0,0 +1,7 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_feature_flag_overrides_tenant;
+DROP INDEX IF EXISTS idx_feature_flag_overrides_organization;
+
+-- Drop tables
+DROP TABLE IF EXISTS feature_flag_overrides;
+DROP TABLE IF EXISTS feature_flags;
@@ -0,0 +1,25 @@
+-- Create feature_flags table (global defaults and percentage rollouts)
+CREATE TABLE IF NOT EXISTS feature_flags (
+    key VARCHAR(100) PRIMARY KEY,
+    description TEXT,
+    enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    rollout_percentage INTEGER NOT NULL DEFAULT 100 CHECK (rollout_percentage BETWEEN 0 AND 100),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Create feature_flag_overrides table (per-organization or per-tenant on/off overrides)
+CREATE TABLE IF NOT EXISTS feature_flag_overrides (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    flag_key VARCHAR(100) NOT NULL REFERENCES feature_flags(key) ON DELETE CASCADE,
+    organization_id UUID REFERENCES organizations(id) ON DELETE CASCADE,
+    tenant_id UUID REFERENCES tenants(id) ON DELETE CASCADE,
+    enabled BOOLEAN NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK ((organization_id IS NULL) <> (tenant_id IS NULL))
+);
+
+-- Create indexes
+CREATE UNIQUE INDEX idx_feature_flag_overrides_organization ON feature_flag_overrides(flag_key, organization_id) WHERE organization_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_feature_flag_overrides_tenant ON feature_flag_overrides(flag_key, tenant_id) WHERE tenant_id IS NOT NULL;
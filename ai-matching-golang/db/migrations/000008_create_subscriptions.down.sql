@@ -0,0 +1,7 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_organization_subscriptions_plan_code;
+
+-- Drop tables
+DROP TABLE IF EXISTS organization_api_usage;
+DROP TABLE IF EXISTS organization_subscriptions;
+DROP TABLE IF EXISTS plans;
@@ -0,0 +1,38 @@
+-- Create plans table (quota limits per plan; NULL means unlimited)
+CREATE TABLE IF NOT EXISTS plans (
+    code VARCHAR(50) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    max_tenants INTEGER,
+    max_seats_per_tenant INTEGER,
+    max_api_calls_per_month BIGINT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO plans (code, name, max_tenants, max_seats_per_tenant, max_api_calls_per_month) VALUES
+    ('free', 'Free', 1, 5, 10000),
+    ('standard', 'Standard', 10, 50, 500000),
+    ('enterprise', 'Enterprise', NULL, NULL, NULL)
+ON CONFLICT (code) DO NOTHING;
+
+-- Create organization_subscriptions table (organizations without a row are on the free plan)
+CREATE TABLE IF NOT EXISTS organization_subscriptions (
+    organization_id UUID PRIMARY KEY REFERENCES organizations(id) ON DELETE CASCADE,
+    plan_code VARCHAR(50) NOT NULL REFERENCES plans(code),
+    status VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'canceled')),
+    started_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Create organization_api_usage table (API calls per organization per calendar month)
+CREATE TABLE IF NOT EXISTS organization_api_usage (
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    period_start DATE NOT NULL,
+    call_count BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (organization_id, period_start)
+);
+
+-- Create indexes
+CREATE INDEX idx_organization_subscriptions_plan_code ON organization_subscriptions(plan_code);
@@ -0,0 +1,9 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_usage_records_usage_date;
+DROP INDEX IF EXISTS idx_usage_records_organization;
+DROP INDEX IF EXISTS idx_usage_records_tenant;
+DROP INDEX IF EXISTS idx_user_daily_activity_organization_date;
+
+-- Drop tables
+DROP TABLE IF EXISTS usage_records;
+DROP TABLE IF EXISTS user_daily_activity;
@@ -0,0 +1,27 @@
+-- Create user_daily_activity table (one row per user, tenant and day with an authenticated request)
+CREATE TABLE IF NOT EXISTS user_daily_activity (
+    activity_date DATE NOT NULL,
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (activity_date, tenant_id, user_id)
+);
+
+-- Create usage_records table (daily metering snapshots; tenant_id is NULL for organization totals)
+CREATE TABLE IF NOT EXISTS usage_records (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    tenant_id UUID REFERENCES tenants(id) ON DELETE CASCADE,
+    metric VARCHAR(50) NOT NULL,
+    usage_date DATE NOT NULL,
+    quantity BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Create indexes
+CREATE INDEX idx_user_daily_activity_organization_date ON user_daily_activity(organization_id, activity_date);
+CREATE UNIQUE INDEX idx_usage_records_tenant ON usage_records(organization_id, tenant_id, metric, usage_date) WHERE tenant_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_usage_records_organization ON usage_records(organization_id, metric, usage_date) WHERE tenant_id IS NULL;
+CREATE INDEX idx_usage_records_usage_date ON usage_records(usage_date);
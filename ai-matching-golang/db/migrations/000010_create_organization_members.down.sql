@@ -0,0 +1,7 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_organization_ownership_transfers_pending;
+DROP INDEX IF EXISTS idx_organization_members_user_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS organization_ownership_transfers;
+DROP TABLE IF EXISTS organization_members;
@@ -0,0 +1,37 @@
+-- Create organization_members table (organization-level roles, separate from tenant membership)
+CREATE TABLE IF NOT EXISTS organization_members (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('owner', 'admin', 'billing')),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE(organization_id, user_id)
+);
+
+-- Create organization_ownership_transfers table (pending until the new owner accepts)
+CREATE TABLE IF NOT EXISTS organization_ownership_transfers (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    from_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    to_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'canceled')),
+    expires_at TIMESTAMP NOT NULL,
+    resolved_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Make the first member of each existing organization its owner
+INSERT INTO organization_members (organization_id, user_id, role)
+SELECT DISTINCT ON (t.organization_id) t.organization_id, tu.user_id, 'owner'
+FROM tenant_users tu
+INNER JOIN tenants t ON tu.tenant_id = t.id
+ORDER BY t.organization_id, tu.created_at
+ON CONFLICT (organization_id, user_id) DO NOTHING;
+
+-- Registration used to leave the creator without a tenant role
+UPDATE tenant_users SET role = 'admin', updated_at = NOW() WHERE role IS NULL;
+
+-- Create indexes
+CREATE INDEX idx_organization_members_user_id ON organization_members(user_id);
+CREATE UNIQUE INDEX idx_organization_ownership_transfers_pending ON organization_ownership_transfers(organization_id) WHERE status = 'pending';
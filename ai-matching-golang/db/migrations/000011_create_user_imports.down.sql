@@ -0,0 +1,6 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_user_imports_organization_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS user_import_rows;
+DROP TABLE IF EXISTS user_imports;
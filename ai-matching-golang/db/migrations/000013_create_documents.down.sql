@@ -0,0 +1,7 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_documents_deleted_at;
+DROP INDEX IF EXISTS idx_documents_tenant_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS document_versions;
+DROP TABLE IF EXISTS documents;
@@ -0,0 +1,14 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_document_versions_index_status;
+DROP INDEX IF EXISTS idx_document_chunks_tenant_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS document_chunks;
+
+-- Drop columns
+ALTER TABLE document_versions
+    DROP COLUMN IF EXISTS indexed_at,
+    DROP COLUMN IF EXISTS embedding_model,
+    DROP COLUMN IF EXISTS chunk_count,
+    DROP COLUMN IF EXISTS index_error,
+    DROP COLUMN IF EXISTS index_status;
@@ -0,0 +1,31 @@
+-- Track chunking and embedding of each document version
+ALTER TABLE document_versions
+    ADD COLUMN index_status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (index_status IN ('pending', 'indexing', 'indexed', 'failed', 'skipped')),
+    ADD COLUMN index_error TEXT,
+    ADD COLUMN chunk_count INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN embedding_model VARCHAR(255),
+    ADD COLUMN indexed_at TIMESTAMP;
+
+-- Create document_chunks table (retrieval units of a document version;
+-- tenant_id is copied from the document so every lookup can filter on it)
+CREATE TABLE IF NOT EXISTS document_chunks (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    document_id UUID NOT NULL REFERENCES documents(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL,
+    chunk_index INTEGER NOT NULL,
+    heading TEXT NOT NULL DEFAULT '',
+    content TEXT NOT NULL,
+    start_offset INTEGER NOT NULL,
+    end_offset INTEGER NOT NULL,
+    token_count INTEGER NOT NULL,
+    embedding REAL[] NOT NULL,
+    embedding_model VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE(document_id, version, chunk_index)
+);
+
+-- Create indexes
+CREATE INDEX idx_document_chunks_tenant_id ON document_chunks(tenant_id, document_id);
+CREATE INDEX idx_document_versions_index_status ON document_versions(index_status) WHERE index_status IN ('pending', 'indexing');
@@ -0,0 +1,7 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_document_chunks_model;
+DROP INDEX IF EXISTS idx_document_chunks_embedding_1536;
+DROP INDEX IF EXISTS idx_document_chunks_embedding_256;
+
+-- The vector extension is left installed; other database objects may
+-- depend on it.
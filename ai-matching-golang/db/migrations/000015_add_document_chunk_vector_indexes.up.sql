@@ -0,0 +1,27 @@
+-- Enable pgvector for document chunk search when the server provides it.
+-- Without the extension nothing is created and search falls back to
+-- comparing the stored REAL[] embeddings in the application.
+--
+-- Embeddings stay in document_chunks.embedding; the indexes are partial
+-- expression indexes per embedding size, matching the two sizes shipped by
+-- default (local hashing: 256, OpenAI text-embedding-3-small: 1536). HNSW is
+-- used rather than IVFFlat because IVFFlat derives its lists from the rows
+-- present when it is built, which is a poor fit for a table that starts empty.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_available_extensions WHERE name = 'vector') THEN
+        CREATE EXTENSION IF NOT EXISTS vector;
+
+        CREATE INDEX IF NOT EXISTS idx_document_chunks_embedding_256
+            ON document_chunks USING hnsw ((embedding::vector(256)) vector_cosine_ops)
+            WHERE cardinality(embedding) = 256;
+
+        CREATE INDEX IF NOT EXISTS idx_document_chunks_embedding_1536
+            ON document_chunks USING hnsw ((embedding::vector(1536)) vector_cosine_ops)
+            WHERE cardinality(embedding) = 1536;
+    END IF;
+END
+$$;
+
+-- Create indexes
+CREATE INDEX idx_document_chunks_model ON document_chunks(tenant_id, embedding_model, id);
@@ -0,0 +1,6 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_document_chunks_search_vector;
+
+-- Drop columns
+ALTER TABLE document_chunks
+    DROP COLUMN IF EXISTS search_vector;
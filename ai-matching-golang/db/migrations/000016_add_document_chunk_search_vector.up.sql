@@ -0,0 +1,9 @@
+-- Keyword search over document chunks. The 'simple' configuration neither
+-- stems nor drops stop words, so drug names, dosages and codes match
+-- exactly as written.
+ALTER TABLE document_chunks
+    ADD COLUMN search_vector TSVECTOR
+        GENERATED ALWAYS AS (to_tsvector('simple', heading || ' ' || content)) STORED;
+
+-- Create indexes
+CREATE INDEX idx_document_chunks_search_vector ON document_chunks USING GIN (search_vector);
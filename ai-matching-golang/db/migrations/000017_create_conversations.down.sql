@@ -0,0 +1,7 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_messages_conversation_id;
+DROP INDEX IF EXISTS idx_conversations_tenant_user;
+
+-- Drop tables
+DROP TABLE IF EXISTS messages;
+DROP TABLE IF EXISTS conversations;
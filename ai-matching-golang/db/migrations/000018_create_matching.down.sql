@@ -0,0 +1,7 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_match_openings_tenant_id;
+DROP INDEX IF EXISTS idx_match_candidates_tenant_id;
+
+-- Drop tables
+DROP TABLE IF EXISTS match_openings;
+DROP TABLE IF EXISTS match_candidates;
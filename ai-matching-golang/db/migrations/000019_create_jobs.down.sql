@@ -0,0 +1,7 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_jobs_organization_id;
+DROP INDEX IF EXISTS idx_jobs_running;
+DROP INDEX IF EXISTS idx_jobs_queued;
+
+-- Drop tables
+DROP TABLE IF EXISTS jobs;
@@ -0,0 +1,12 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_task_runs_task_name;
+DROP INDEX IF EXISTS idx_task_runs_scheduled;
+
+-- Restore ownership transfer statuses
+UPDATE organization_ownership_transfers SET status = 'canceled' WHERE status = 'expired';
+ALTER TABLE organization_ownership_transfers DROP CONSTRAINT IF EXISTS organization_ownership_transfers_status_check;
+ALTER TABLE organization_ownership_transfers ADD CONSTRAINT organization_ownership_transfers_status_check
+    CHECK (status IN ('pending', 'accepted', 'canceled'));
+
+-- Drop tables
+DROP TABLE IF EXISTS task_runs;
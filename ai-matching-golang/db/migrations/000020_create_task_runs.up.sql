@@ -0,0 +1,24 @@
+-- Create task_runs table (history of scheduled tasks; a scheduled run is
+-- recorded once per task and slot, however many replicas woke up for it)
+CREATE TABLE IF NOT EXISTS task_runs (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    task_name VARCHAR(100) NOT NULL,
+    trigger VARCHAR(20) NOT NULL CHECK (trigger IN ('schedule', 'manual')),
+    scheduled_for TIMESTAMP,
+    status VARCHAR(20) NOT NULL DEFAULT 'running' CHECK (status IN ('running', 'succeeded', 'failed')),
+    summary TEXT,
+    error_message TEXT,
+    triggered_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    worker_id VARCHAR(255) NOT NULL,
+    started_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMP
+);
+
+-- Pending ownership transfers past their deadline are marked expired
+ALTER TABLE organization_ownership_transfers DROP CONSTRAINT IF EXISTS organization_ownership_transfers_status_check;
+ALTER TABLE organization_ownership_transfers ADD CONSTRAINT organization_ownership_transfers_status_check
+    CHECK (status IN ('pending', 'accepted', 'canceled', 'expired'));
+
+-- Create indexes
+CREATE UNIQUE INDEX idx_task_runs_scheduled ON task_runs(task_name, scheduled_for) WHERE trigger = 'schedule';
+CREATE INDEX idx_task_runs_task_name ON task_runs(task_name, started_at DESC);
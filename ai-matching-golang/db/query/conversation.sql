@@ -0,0 +1,53 @@
+-- name: CreateConversation :one
+INSERT INTO conversations (
+    tenant_id, user_id, title
+) VALUES (
+    @tenant_id::uuid, @user_id::uuid, @title
+)
+RETURNING *;
+
+-- name: GetConversation :one
+SELECT * FROM conversations
+WHERE id = @id::uuid AND tenant_id = @tenant_id::uuid AND user_id = @user_id::uuid
+LIMIT 1;
+
+-- name: ListConversations :many
+SELECT * FROM conversations
+WHERE tenant_id = @tenant_id::uuid AND user_id = @user_id::uuid
+ORDER BY updated_at DESC, id
+LIMIT @row_limit OFFSET @row_offset;
+
+-- name: CountConversations :one
+SELECT COUNT(*) FROM conversations
+WHERE tenant_id = @tenant_id::uuid AND user_id = @user_id::uuid;
+
+-- name: TouchConversation :exec
+-- Also names an untitled conversation after its first question
+UPDATE conversations
+SET title = CASE WHEN title = '' THEN @title ELSE title END,
+    updated_at = NOW()
+WHERE id = @id::uuid;
+
+-- name: DeleteConversation :execrows
+DELETE FROM conversations
+WHERE id = @id::uuid AND tenant_id = @tenant_id::uuid AND user_id = @user_id::uuid;
+
+-- name: CreateMessage :one
+INSERT INTO messages (
+    conversation_id, role, content, rewritten_query, citations, model, token_count
+) VALUES (
+    @conversation_id::uuid, @role, @content, @rewritten_query, @citations, @model, @token_count
+)
+RETURNING *;
+
+-- name: ListMessages :many
+SELECT * FROM messages
+WHERE conversation_id = @conversation_id::uuid
+ORDER BY created_at, id;
+
+-- name: ListRecentMessages :many
+-- Newest first, for building the history of a follow-up question
+SELECT * FROM messages
+WHERE conversation_id = @conversation_id::uuid
+ORDER BY created_at DESC, id DESC
+LIMIT @row_limit;
@@ -0,0 +1,95 @@
+-- name: CreateDocument :one
+INSERT INTO documents (
+    tenant_id, title, metadata, created_by
+) VALUES (
+    @tenant_id::uuid, @title, @metadata, @created_by
+)
+RETURNING *;
+
+-- name: GetDocument :one
+SELECT * FROM documents
+WHERE id = @id::uuid AND tenant_id = @tenant_id::uuid AND deleted_at IS NULL
+LIMIT 1;
+
+-- name: ListDocuments :many
+SELECT * FROM documents
+WHERE tenant_id = @tenant_id::uuid AND deleted_at IS NULL
+ORDER BY created_at DESC, id
+LIMIT @row_limit OFFSET @row_offset;
+
+-- name: CountDocuments :one
+SELECT COUNT(*) FROM documents
+WHERE tenant_id = @tenant_id::uuid AND deleted_at IS NULL;
+
+-- name: UpdateDocument :one
+UPDATE documents
+SET title = @title,
+    metadata = @metadata,
+    updated_at = NOW()
+WHERE id = @id::uuid AND tenant_id = @tenant_id::uuid AND deleted_at IS NULL
+RETURNING *;
+
+-- name: SoftDeleteDocument :execrows
+UPDATE documents
+SET deleted_at = NOW(),
+    updated_at = NOW()
+WHERE id = @id::uuid AND tenant_id = @tenant_id::uuid AND deleted_at IS NULL;
+
+-- name: CreateDocumentVersion :one
+-- Bumping current_version locks the document row, so concurrent uploads get
+-- consecutive version numbers.
+WITH doc AS (
+    UPDATE documents
+    SET current_version = current_version + 1,
+        updated_at = NOW()
+    WHERE id = @document_id::uuid AND tenant_id = @tenant_id::uuid AND deleted_at IS NULL
+    RETURNING id, current_version
+)
+INSERT INTO document_versions (
+    document_id, version, filename, content_type, format, size_bytes, checksum,
+    storage_key, extraction_status, extraction_error, extracted_text, created_by
+)
+SELECT
+    doc.id, doc.current_version, @filename, @content_type, @format, @size_bytes, @checksum,
+    @storage_key, @extraction_status, @extraction_error, @extracted_text, @created_by
+FROM doc
+RETURNING *;
+
+-- name: GetDocumentVersion :one
+SELECT v.* FROM document_versions v
+INNER JOIN documents d ON v.document_id = d.id
+WHERE v.document_id = @document_id::uuid
+    AND v.version = @version
+    AND d.tenant_id = @tenant_id::uuid
+    AND d.deleted_at IS NULL
+LIMIT 1;
+
+-- name: ListDocumentVersions :many
+SELECT
+    v.id, v.document_id, v.version, v.filename, v.content_type, v.format,
+    v.size_bytes, v.checksum, v.extraction_status, v.extraction_error,
+    length(v.extracted_text)::integer AS text_length,
+    v.index_status, v.index_error, v.chunk_count, v.embedding_model, v.indexed_at,
+    v.created_by, v.created_at
+FROM document_versions v
+INNER JOIN documents d ON v.document_id = d.id
+WHERE v.document_id = @document_id::uuid
+    AND d.tenant_id = @tenant_id::uuid
+    AND d.deleted_at IS NULL
+ORDER BY v.version DESC;
+
+-- name: ListPurgeableDocuments :many
+SELECT id FROM documents
+WHERE deleted_at < @deleted_before::timestamp
+ORDER BY deleted_at, id
+LIMIT @row_limit;
+
+-- name: ListDocumentVersionStorageKeys :many
+SELECT storage_key FROM document_versions
+WHERE document_id = @document_id::uuid
+ORDER BY version;
+
+-- name: PurgeDocument :execrows
+-- Versions and chunks go with the document
+DELETE FROM documents
+WHERE id = @id::uuid AND deleted_at IS NOT NULL;
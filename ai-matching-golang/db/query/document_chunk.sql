@@ -0,0 +1,183 @@
+-- name: GetDocumentVersionForIndexing :one
+SELECT
+    v.id, v.document_id, v.version, v.format, v.extraction_status,
+    v.extracted_text, v.index_status, d.tenant_id, d.current_version
+FROM document_versions v
+INNER JOIN documents d ON v.document_id = d.id
+WHERE v.id = @id::uuid AND d.deleted_at IS NULL
+LIMIT 1;
+
+-- name: StartDocumentVersionIndexing :execrows
+-- The job queue runs one attempt of a job at a time, so a row still in
+-- progress was left by a worker that stopped and is taken over.
+UPDATE document_versions
+SET index_status = 'indexing',
+    index_error = NULL
+WHERE id = @id::uuid AND index_status IN ('pending', 'failed', 'indexing');
+
+-- name: CompleteDocumentVersionIndexing :exec
+UPDATE document_versions
+SET index_status = 'indexed',
+    index_error = NULL,
+    chunk_count = @chunk_count,
+    embedding_model = @embedding_model,
+    indexed_at = NOW()
+WHERE id = @id::uuid;
+
+-- name: SetDocumentVersionIndexStatus :exec
+UPDATE document_versions
+SET index_status = @index_status,
+    index_error = @index_error
+WHERE id = @id::uuid;
+
+-- name: CreateDocumentChunk :exec
+-- Re-indexing a version overwrites its chunks in place.
+INSERT INTO document_chunks (
+    tenant_id, document_id, version, chunk_index, heading, content,
+    start_offset, end_offset, token_count, embedding, embedding_model
+) VALUES (
+    @tenant_id::uuid, @document_id::uuid, @version, @chunk_index, @heading, @content,
+    @start_offset, @end_offset, @token_count, @embedding, @embedding_model
+)
+ON CONFLICT (document_id, version, chunk_index) DO UPDATE
+SET heading = EXCLUDED.heading,
+    content = EXCLUDED.content,
+    start_offset = EXCLUDED.start_offset,
+    end_offset = EXCLUDED.end_offset,
+    token_count = EXCLUDED.token_count,
+    embedding = EXCLUDED.embedding,
+    embedding_model = EXCLUDED.embedding_model,
+    created_at = NOW();
+
+-- name: DeleteDocumentChunksFrom :exec
+-- Removes chunks left over from a longer previous run of the same version.
+DELETE FROM document_chunks
+WHERE document_id = @document_id::uuid
+    AND version = @version
+    AND chunk_index >= @first_index;
+
+-- name: DeleteSupersededDocumentChunks :execrows
+DELETE FROM document_chunks
+WHERE document_id = @document_id::uuid AND version < @version;
+
+-- name: ListDocumentChunks :many
+SELECT
+    c.id, c.chunk_index, c.heading, c.content, c.start_offset, c.end_offset,
+    c.token_count, c.embedding_model, c.created_at
+FROM document_chunks c
+INNER JOIN documents d ON c.document_id = d.id
+WHERE c.document_id = @document_id::uuid
+    AND c.version = @version
+    AND c.tenant_id = @tenant_id::uuid
+    AND d.deleted_at IS NULL
+ORDER BY c.chunk_index;
+
+-- name: GetVectorExtensionInstalled :one
+SELECT (to_regtype('vector') IS NOT NULL)::boolean AS installed;
+
+-- name: SetHNSWSearchScope :exec
+-- Widens the HNSW scans of the current transaction. An HNSW scan applies the
+-- tenant and metadata filters after the index; with iterative scans (pgvector
+-- 0.8 and later) it keeps walking the graph until enough rows pass them
+-- instead of stopping after ef_search candidates.
+SELECT set_config('hnsw.ef_search', @ef_search::text, true),
+    CASE WHEN string_to_array(e.extversion, '.')::int[] >= ARRAY[0, 8]
+        THEN set_config('hnsw.iterative_scan', 'strict_order', true)
+    END
+FROM pg_catalog.pg_extension e
+WHERE e.extname = 'vector';
+
+-- name: SearchDocumentChunksByVector256 :many
+-- The vector queries need the pgvector extension. The sized variants match
+-- the expression of the HNSW indexes and run after SetHNSWSearchScope.
+SELECT c.id, (c.embedding::vector(256) <=> sqlc.arg(query_embedding)::real[]::vector(256))::float8 AS distance
+FROM document_chunks c
+INNER JOIN documents d ON c.document_id = d.id
+WHERE c.tenant_id = @tenant_id::uuid
+    AND cardinality(c.embedding) = 256
+    AND d.tenant_id = @tenant_id::uuid
+    AND d.deleted_at IS NULL
+    AND c.version = d.current_version
+    AND c.embedding_model = @embedding_model
+    AND d.metadata @> @metadata::jsonb
+    AND (cardinality(@document_ids::uuid[]) = 0 OR c.document_id = ANY(@document_ids::uuid[]))
+ORDER BY c.embedding::vector(256) <=> sqlc.arg(query_embedding)::real[]::vector(256)
+LIMIT @row_limit;
+
+-- name: SearchDocumentChunksByVector1536 :many
+SELECT c.id, (c.embedding::vector(1536) <=> sqlc.arg(query_embedding)::real[]::vector(1536))::float8 AS distance
+FROM document_chunks c
+INNER JOIN documents d ON c.document_id = d.id
+WHERE c.tenant_id = @tenant_id::uuid
+    AND cardinality(c.embedding) = 1536
+    AND d.tenant_id = @tenant_id::uuid
+    AND d.deleted_at IS NULL
+    AND c.version = d.current_version
+    AND c.embedding_model = @embedding_model
+    AND d.metadata @> @metadata::jsonb
+    AND (cardinality(@document_ids::uuid[]) = 0 OR c.document_id = ANY(@document_ids::uuid[]))
+ORDER BY c.embedding::vector(1536) <=> sqlc.arg(query_embedding)::real[]::vector(1536)
+LIMIT @row_limit;
+
+-- name: SearchDocumentChunksByVector :many
+-- Exact search for embedding sizes without an index
+SELECT c.id, (c.embedding::vector <=> sqlc.arg(query_embedding)::real[]::vector)::float8 AS distance
+FROM document_chunks c
+INNER JOIN documents d ON c.document_id = d.id
+WHERE c.tenant_id = @tenant_id::uuid
+    AND cardinality(c.embedding) = cardinality(sqlc.arg(query_embedding)::real[])
+    AND d.tenant_id = @tenant_id::uuid
+    AND d.deleted_at IS NULL
+    AND c.version = d.current_version
+    AND c.embedding_model = @embedding_model
+    AND d.metadata @> @metadata::jsonb
+    AND (cardinality(@document_ids::uuid[]) = 0 OR c.document_id = ANY(@document_ids::uuid[]))
+ORDER BY c.embedding::vector <=> sqlc.arg(query_embedding)::real[]::vector
+LIMIT @row_limit;
+
+-- name: ListDocumentChunkEmbeddings :many
+-- Pages through the searchable embeddings of a tenant for the in-process
+-- fallback when pgvector is not installed.
+SELECT c.id, c.embedding
+FROM document_chunks c
+INNER JOIN documents d ON c.document_id = d.id
+WHERE c.tenant_id = @tenant_id::uuid
+    AND c.id > @after_id::uuid
+    AND d.tenant_id = @tenant_id::uuid
+    AND d.deleted_at IS NULL
+    AND c.version = d.current_version
+    AND c.embedding_model = @embedding_model
+    AND d.metadata @> @metadata::jsonb
+    AND (cardinality(@document_ids::uuid[]) = 0 OR c.document_id = ANY(@document_ids::uuid[]))
+ORDER BY c.id
+LIMIT @row_limit;
+
+-- name: GetDocumentChunksByIDs :many
+SELECT
+    c.id, c.document_id, c.version, c.chunk_index, c.heading, c.content,
+    c.start_offset, c.end_offset, c.token_count,
+    d.title AS document_title, d.metadata AS document_metadata
+FROM document_chunks c
+INNER JOIN documents d ON c.document_id = d.id
+WHERE c.id = ANY(@ids::uuid[])
+    AND c.tenant_id = @tenant_id::uuid
+    AND d.tenant_id = @tenant_id::uuid
+    AND d.deleted_at IS NULL;
+
+-- name: SearchDocumentChunksByKeyword :many
+-- Ranks by ts_rank_cd, which rewards terms that occur often and close
+-- together; normalization 1 divides by the log of the chunk length so long
+-- chunks do not win by size alone.
+SELECT c.id, ts_rank_cd(c.search_vector, query, 1)::float8 AS rank
+FROM document_chunks c
+INNER JOIN documents d ON c.document_id = d.id,
+    websearch_to_tsquery('simple', @query::text) query
+WHERE c.tenant_id = @tenant_id::uuid
+    AND c.search_vector @@ query
+    AND d.tenant_id = @tenant_id::uuid
+    AND d.deleted_at IS NULL
+    AND c.version = d.current_version
+    AND d.metadata @> @metadata::jsonb
+    AND (cardinality(@document_ids::uuid[]) = 0 OR c.document_id = ANY(@document_ids::uuid[]))
+ORDER BY rank DESC, c.id
+LIMIT @row_limit;
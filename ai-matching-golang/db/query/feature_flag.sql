@@ -0,0 +1,59 @@
+-- name: ListFeatureFlags :many
+SELECT * FROM feature_flags
+ORDER BY key;
+
+-- name: GetFeatureFlag :one
+SELECT * FROM feature_flags
+WHERE key = @key
+LIMIT 1;
+
+-- name: UpsertFeatureFlag :one
+INSERT INTO feature_flags (
+    key, description, enabled, rollout_percentage
+) VALUES (
+    @key, @description, @enabled, @rollout_percentage
+)
+ON CONFLICT (key) DO UPDATE
+SET description = EXCLUDED.description,
+    enabled = EXCLUDED.enabled,
+    rollout_percentage = EXCLUDED.rollout_percentage,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteFeatureFlag :exec
+DELETE FROM feature_flags
+WHERE key = @key;
+
+-- name: ListFeatureFlagOverrides :many
+SELECT * FROM feature_flag_overrides
+ORDER BY flag_key, created_at;
+
+-- name: UpsertOrganizationFeatureFlagOverride :one
+INSERT INTO feature_flag_overrides (
+    flag_key, organization_id, enabled
+) VALUES (
+    @flag_key, @organization_id::uuid, @enabled
+)
+ON CONFLICT (flag_key, organization_id) WHERE organization_id IS NOT NULL DO UPDATE
+SET enabled = EXCLUDED.enabled,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: UpsertTenantFeatureFlagOverride :one
+INSERT INTO feature_flag_overrides (
+    flag_key, tenant_id, enabled
+) VALUES (
+    @flag_key, @tenant_id::uuid, @enabled
+)
+ON CONFLICT (flag_key, tenant_id) WHERE tenant_id IS NOT NULL DO UPDATE
+SET enabled = EXCLUDED.enabled,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteOrganizationFeatureFlagOverride :exec
+DELETE FROM feature_flag_overrides
+WHERE flag_key = @flag_key AND organization_id = @organization_id::uuid;
+
+-- name: DeleteTenantFeatureFlagOverride :exec
+DELETE FROM feature_flag_overrides
+WHERE flag_key = @flag_key AND tenant_id = @tenant_id::uuid;
@@ -0,0 +1,98 @@
+-- name: CreateJob :one
+INSERT INTO jobs (
+    kind, payload, max_attempts, run_at, organization_id, tenant_id
+) VALUES (
+    @kind, @payload, @max_attempts, COALESCE(sqlc.narg(run_at)::timestamp, NOW()), sqlc.narg(organization_id)::uuid, sqlc.narg(tenant_id)::uuid
+)
+RETURNING *;
+
+-- name: ClaimJobs :many
+-- Running jobs whose lock is older than stale_before belong to a worker that
+-- stopped, and are claimed again while they have attempts left.
+UPDATE jobs
+SET status = 'running',
+    attempts = attempts + 1,
+    locked_by = @worker_id::text,
+    locked_at = NOW(),
+    updated_at = NOW()
+WHERE id IN (
+    SELECT j.id FROM jobs j
+    WHERE (j.status = 'queued' AND j.run_at <= NOW())
+       OR (j.status = 'running' AND j.locked_at < @stale_before::timestamp AND j.attempts < j.max_attempts)
+    ORDER BY j.run_at
+    LIMIT @row_limit
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: BuryStaleJobs :execrows
+UPDATE jobs
+SET status = 'dead',
+    last_error = 'worker stopped while running the job',
+    locked_by = NULL,
+    locked_at = NULL,
+    finished_at = NOW(),
+    updated_at = NOW()
+WHERE status = 'running' AND locked_at < @stale_before::timestamp AND attempts >= max_attempts;
+
+-- name: CompleteJob :execrows
+UPDATE jobs
+SET status = 'succeeded',
+    locked_by = NULL,
+    locked_at = NULL,
+    finished_at = NOW(),
+    updated_at = NOW()
+WHERE id = @id::uuid AND status = 'running' AND locked_by = @worker_id::text;
+
+-- name: RetryJob :execrows
+UPDATE jobs
+SET status = 'queued',
+    run_at = NOW() + make_interval(secs => @delay_seconds::float8),
+    last_error = @last_error::text,
+    locked_by = NULL,
+    locked_at = NULL,
+    updated_at = NOW()
+WHERE id = @id::uuid AND status = 'running' AND locked_by = @worker_id::text;
+
+-- name: BuryJob :execrows
+UPDATE jobs
+SET status = 'dead',
+    last_error = @last_error::text,
+    locked_by = NULL,
+    locked_at = NULL,
+    finished_at = NOW(),
+    updated_at = NOW()
+WHERE id = @id::uuid AND status = 'running' AND locked_by = @worker_id::text;
+
+-- name: RequeueJob :one
+UPDATE jobs
+SET status = 'queued',
+    attempts = 0,
+    run_at = NOW(),
+    finished_at = NULL,
+    updated_at = NOW()
+WHERE id = @id::uuid AND organization_id = @organization_id::uuid AND status = 'dead'
+RETURNING *;
+
+-- name: GetOrganizationJob :one
+SELECT * FROM jobs
+WHERE id = @id::uuid AND organization_id = @organization_id::uuid
+LIMIT 1;
+
+-- name: ListOrganizationJobs :many
+SELECT * FROM jobs
+WHERE organization_id = @organization_id::uuid
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status)::text)
+  AND (sqlc.narg(kind)::text IS NULL OR kind = sqlc.narg(kind)::text)
+ORDER BY created_at DESC, id
+LIMIT @row_limit OFFSET @row_offset;
+
+-- name: CountOrganizationJobs :one
+SELECT COUNT(*) FROM jobs
+WHERE organization_id = @organization_id::uuid
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status)::text)
+  AND (sqlc.narg(kind)::text IS NULL OR kind = sqlc.narg(kind)::text);
+
+-- name: DeleteSucceededJobs :execrows
+DELETE FROM jobs
+WHERE status = 'succeeded' AND finished_at < @finished_before::timestamp;
@@ -0,0 +1,108 @@
+-- name: CreateMatchCandidate :one
+INSERT INTO match_candidates (
+    tenant_id, name, attributes, description, embedding, embedding_model, created_by
+) VALUES (
+    @tenant_id::uuid, @name, @attributes, @description, sqlc.narg(embedding)::real[], @embedding_model, @created_by
+)
+RETURNING *;
+
+-- name: GetMatchCandidate :one
+SELECT * FROM match_candidates
+WHERE id = @id::uuid AND tenant_id = @tenant_id::uuid
+LIMIT 1;
+
+-- name: ListMatchCandidates :many
+SELECT * FROM match_candidates
+WHERE tenant_id = @tenant_id::uuid
+ORDER BY created_at DESC, id
+LIMIT @row_limit OFFSET @row_offset;
+
+-- name: CountMatchCandidates :one
+SELECT COUNT(*) FROM match_candidates
+WHERE tenant_id = @tenant_id::uuid;
+
+-- name: UpdateMatchCandidate :one
+UPDATE match_candidates
+SET name = @name,
+    attributes = @attributes,
+    description = @description,
+    embedding = sqlc.narg(embedding)::real[],
+    embedding_model = @embedding_model,
+    updated_at = NOW()
+WHERE id = @id::uuid AND tenant_id = @tenant_id::uuid
+RETURNING *;
+
+-- name: SetMatchCandidateEmbedding :exec
+UPDATE match_candidates
+SET embedding = sqlc.narg(embedding)::real[],
+    embedding_model = @embedding_model
+WHERE id = @id::uuid;
+
+-- name: DeleteMatchCandidate :execrows
+DELETE FROM match_candidates
+WHERE id = @id::uuid AND tenant_id = @tenant_id::uuid;
+
+-- name: ListMatchCandidatesAfter :many
+-- Keyset pages over every candidate of a tenant for scoring
+SELECT * FROM match_candidates
+WHERE tenant_id = @tenant_id::uuid
+    AND id > @after_id::uuid
+    AND (cardinality(@candidate_ids::uuid[]) = 0 OR id = ANY(@candidate_ids::uuid[]))
+ORDER BY id
+LIMIT @row_limit;
+
+-- name: CreateMatchOpening :one
+INSERT INTO match_openings (
+    tenant_id, title, attributes, description, requirements, status, embedding, embedding_model, created_by
+) VALUES (
+    @tenant_id::uuid, @title, @attributes, @description, @requirements, @status, sqlc.narg(embedding)::real[], @embedding_model, @created_by
+)
+RETURNING *;
+
+-- name: GetMatchOpening :one
+SELECT * FROM match_openings
+WHERE id = @id::uuid AND tenant_id = @tenant_id::uuid
+LIMIT 1;
+
+-- name: ListMatchOpenings :many
+SELECT * FROM match_openings
+WHERE tenant_id = @tenant_id::uuid
+ORDER BY created_at DESC, id
+LIMIT @row_limit OFFSET @row_offset;
+
+-- name: CountMatchOpenings :one
+SELECT COUNT(*) FROM match_openings
+WHERE tenant_id = @tenant_id::uuid;
+
+-- name: UpdateMatchOpening :one
+UPDATE match_openings
+SET title = @title,
+    attributes = @attributes,
+    description = @description,
+    requirements = @requirements,
+    status = @status,
+    embedding = sqlc.narg(embedding)::real[],
+    embedding_model = @embedding_model,
+    updated_at = NOW()
+WHERE id = @id::uuid AND tenant_id = @tenant_id::uuid
+RETURNING *;
+
+-- name: SetMatchOpeningEmbedding :exec
+UPDATE match_openings
+SET embedding = sqlc.narg(embedding)::real[],
+    embedding_model = @embedding_model
+WHERE id = @id::uuid;
+
+-- name: DeleteMatchOpening :execrows
+DELETE FROM match_openings
+WHERE id = @id::uuid AND tenant_id = @tenant_id::uuid;
+
+-- name: ListOpenMatchOpeningsAfter :many
+-- Keyset pages over the open openings of a tenant for scoring
+SELECT * FROM match_openings
+WHERE tenant_id = @tenant_id::uuid
+    AND status = 'open'
+    AND id > @after_id::uuid
+    AND (cardinality(@opening_ids::uuid[]) = 0 OR id = ANY(@opening_ids::uuid[]))
+ORDER BY id
+LIMIT @row_limit;
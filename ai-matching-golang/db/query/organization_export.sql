@@ -0,0 +1,143 @@
+-- name: CreateOrganizationExport :one
+INSERT INTO organization_exports (
+    organization_id, requested_by
+) VALUES (
+    @organization_id::uuid, @requested_by
+)
+RETURNING *;
+
+-- name: GetOrganizationExport :one
+SELECT * FROM organization_exports
+WHERE id = @id::uuid AND organization_id = @organization_id::uuid
+LIMIT 1;
+
+-- name: GetOrganizationExportByID :one
+SELECT * FROM organization_exports
+WHERE id = @id::uuid
+LIMIT 1;
+
+-- name: ListOrganizationExports :many
+SELECT * FROM organization_exports
+WHERE organization_id = @organization_id::uuid
+ORDER BY created_at DESC
+LIMIT @row_limit;
+
+-- name: StartOrganizationExport :execrows
+-- The job queue runs one attempt of a job at a time, so a row still in
+-- progress was left by a worker that stopped and is taken over.
+UPDATE organization_exports
+SET status = 'processing',
+    error_message = NULL,
+    started_at = NOW(),
+    completed_at = NULL,
+    updated_at = NOW()
+WHERE id = @id::uuid AND status IN ('pending', 'failed', 'processing');
+
+-- name: CompleteOrganizationExport :exec
+UPDATE organization_exports
+SET status = 'completed',
+    storage_key = @storage_key,
+    size_bytes = @size_bytes,
+    expires_at = @expires_at,
+    completed_at = NOW(),
+    updated_at = NOW()
+WHERE id = @id::uuid;
+
+-- name: FailOrganizationExport :exec
+UPDATE organization_exports
+SET status = 'failed',
+    error_message = @error_message,
+    completed_at = NOW(),
+    updated_at = NOW()
+WHERE id = @id::uuid;
+
+-- name: ListOrganizationExportTenants :many
+SELECT * FROM tenants
+WHERE organization_id = @organization_id::uuid
+ORDER BY subdomain;
+
+-- name: ListOrganizationExportUsers :many
+SELECT * FROM users
+WHERE id IN (
+    SELECT tu.user_id FROM tenant_users tu
+    INNER JOIN tenants t ON tu.tenant_id = t.id
+    WHERE t.organization_id = @organization_id::uuid
+    UNION
+    SELECT om.user_id FROM organization_members om
+    WHERE om.organization_id = @organization_id::uuid
+)
+ORDER BY email;
+
+-- name: ListOrganizationExportTenantMemberships :many
+SELECT
+    tu.*,
+    t.subdomain AS tenant_subdomain,
+    u.email
+FROM tenant_users tu
+INNER JOIN tenants t ON tu.tenant_id = t.id
+INNER JOIN users u ON tu.user_id = u.id
+WHERE t.organization_id = @organization_id::uuid
+ORDER BY t.subdomain, u.email;
+
+-- name: ListOrganizationAuditEvents :many
+-- The service keeps no separate audit log; events are reconstructed from the
+-- tables that record who did what.
+SELECT occurred_at, event_type, actor_id, subject_id, detail FROM (
+    SELECT
+        ot.created_at AS occurred_at,
+        'ownership_transfer.requested'::text AS event_type,
+        ot.from_user_id::text AS actor_id,
+        ot.to_user_id::text AS subject_id,
+        ''::text AS detail
+    FROM organization_ownership_transfers ot
+    WHERE ot.organization_id = @organization_id::uuid
+    UNION ALL
+    SELECT
+        ot.resolved_at,
+        'ownership_transfer.' || ot.status,
+        CASE WHEN ot.status = 'accepted' THEN ot.to_user_id ELSE ot.from_user_id END::text,
+        ot.to_user_id::text,
+        ''
+    FROM organization_ownership_transfers ot
+    WHERE ot.organization_id = @organization_id::uuid AND ot.resolved_at IS NOT NULL
+    UNION ALL
+    SELECT
+        om.created_at,
+        'organization_member.added',
+        '',
+        om.user_id::text,
+        'role=' || om.role
+    FROM organization_members om
+    WHERE om.organization_id = @organization_id::uuid
+    UNION ALL
+    SELECT
+        ui.created_at,
+        'user_import.' || ui.status,
+        COALESCE(ui.created_by::text, ''),
+        ui.id::text,
+        format('rows=%s succeeded=%s failed=%s', ui.total_rows, ui.succeeded_rows, ui.failed_rows)
+    FROM user_imports ui
+    WHERE ui.organization_id = @organization_id::uuid
+    UNION ALL
+    SELECT
+        oe.created_at,
+        'data_export.requested',
+        COALESCE(oe.requested_by::text, ''),
+        oe.id::text,
+        ''
+    FROM organization_exports oe
+    WHERE oe.organization_id = @organization_id::uuid
+) events
+ORDER BY occurred_at, event_type;
+
+-- name: ListExpiredOrganizationExports :many
+SELECT * FROM organization_exports
+WHERE status = 'completed' AND expires_at <= NOW() AND storage_key IS NOT NULL
+ORDER BY expires_at
+LIMIT @row_limit;
+
+-- name: ClearOrganizationExportStorage :exec
+UPDATE organization_exports
+SET storage_key = NULL,
+    updated_at = NOW()
+WHERE id = @id::uuid;
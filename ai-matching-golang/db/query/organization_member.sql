@@ -0,0 +1,111 @@
+-- name: GetOrganizationMember :one
+SELECT * FROM organization_members
+WHERE organization_id = @organization_id::uuid AND user_id = @user_id::uuid
+LIMIT 1;
+
+-- name: ListOrganizationMembers :many
+SELECT
+    om.*,
+    u.email,
+    u.first_name,
+    u.last_name
+FROM organization_members om
+INNER JOIN users u ON om.user_id = u.id
+WHERE om.organization_id = @organization_id::uuid
+ORDER BY
+    CASE om.role WHEN 'owner' THEN 0 WHEN 'admin' THEN 1 ELSE 2 END,
+    u.email;
+
+-- name: AddOrganizationMember :one
+INSERT INTO organization_members (
+    organization_id, user_id, role
+) VALUES (
+    @organization_id::uuid, @user_id::uuid, @role
+)
+RETURNING *;
+
+-- name: LockOrganizationOwners :exec
+-- Locks the owner rows until the transaction ends, so concurrent demotions
+-- and removals re-count the owners after one another.
+SELECT user_id FROM organization_members
+WHERE organization_id = @organization_id::uuid AND role = 'owner'
+FOR UPDATE;
+
+-- name: UpdateOrganizationMemberRole :execrows
+-- Refuses to demote the last owner.
+UPDATE organization_members
+SET role = @role,
+    updated_at = NOW()
+WHERE organization_id = @organization_id::uuid AND user_id = @user_id::uuid
+  AND (
+    role <> 'owner' OR @role = 'owner'
+    OR (SELECT COUNT(*) FROM organization_members o WHERE o.organization_id = @organization_id::uuid AND o.role = 'owner') > 1
+  );
+
+-- name: DeleteOrganizationMember :execrows
+-- Refuses to remove the last owner.
+DELETE FROM organization_members
+WHERE organization_id = @organization_id::uuid AND user_id = @user_id::uuid
+  AND (
+    role <> 'owner'
+    OR (SELECT COUNT(*) FROM organization_members o WHERE o.organization_id = @organization_id::uuid AND o.role = 'owner') > 1
+  );
+
+-- name: GetOwnershipTransfer :one
+SELECT * FROM organization_ownership_transfers
+WHERE id = @id::uuid AND organization_id = @organization_id::uuid
+LIMIT 1;
+
+-- name: GetPendingOwnershipTransfer :one
+SELECT * FROM organization_ownership_transfers
+WHERE organization_id = @organization_id::uuid AND status = 'pending' AND expires_at > NOW()
+LIMIT 1;
+
+-- name: CancelPendingOwnershipTransfers :execrows
+-- Run in the same transaction as CreateOwnershipTransfer; a data-modifying
+-- CTE would run after the INSERT and trip the pending-transfer unique index.
+UPDATE organization_ownership_transfers
+SET status = 'canceled', resolved_at = NOW()
+WHERE organization_id = @organization_id::uuid AND status = 'pending';
+
+-- name: CancelMemberOwnershipTransfers :execrows
+-- Cancels pending transfers from or to a member who is leaving.
+UPDATE organization_ownership_transfers
+SET status = 'canceled', resolved_at = NOW()
+WHERE organization_id = @organization_id::uuid AND status = 'pending'
+  AND (from_user_id = @user_id::uuid OR to_user_id = @user_id::uuid);
+
+-- name: CreateOwnershipTransfer :one
+INSERT INTO organization_ownership_transfers (
+    organization_id, from_user_id, to_user_id, expires_at
+) VALUES (
+    @organization_id::uuid, @from_user_id::uuid, @to_user_id::uuid, @expires_at
+)
+RETURNING *;
+
+-- name: CancelOwnershipTransfer :execrows
+UPDATE organization_ownership_transfers
+SET status = 'canceled', resolved_at = NOW()
+WHERE id = @id::uuid AND organization_id = @organization_id::uuid AND status = 'pending';
+
+-- name: AcceptOwnershipTransfer :one
+-- Marks the transfer accepted. Run together with SwapOwnerRoles in one
+-- transaction, which is rolled back unless both members were updated.
+UPDATE organization_ownership_transfers
+SET status = 'accepted', resolved_at = NOW()
+WHERE id = @id::uuid AND organization_id = @organization_id::uuid
+  AND status = 'pending' AND expires_at > NOW()
+RETURNING *;
+
+-- name: SwapOwnerRoles :execrows
+-- The new owner becomes owner and the previous owner stays on as admin.
+UPDATE organization_members
+SET role = CASE WHEN user_id = @to_user_id::uuid THEN 'owner' ELSE 'admin' END,
+    updated_at = NOW()
+WHERE organization_id = @organization_id::uuid
+  AND user_id IN (@from_user_id::uuid, @to_user_id::uuid);
+
+-- name: ExpireOwnershipTransfers :execrows
+UPDATE organization_ownership_transfers
+SET status = 'expired', resolved_at = expires_at
+WHERE status = 'pending' AND expires_at <= NOW();
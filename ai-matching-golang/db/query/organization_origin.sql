@@ -0,0 +1,28 @@
+-- name: GetOrganizationOrigin :one
+SELECT * FROM organization_origins
+WHERE id = @id::uuid AND organization_id = @organization_id::uuid
+LIMIT 1;
+
+-- name: ListOrganizationOrigins :many
+SELECT * FROM organization_origins
+WHERE organization_id = @organization_id::uuid
+ORDER BY created_at;
+
+-- name: CreateOrganizationOrigin :one
+INSERT INTO organization_origins (
+    organization_id, origin
+) VALUES (
+    @organization_id::uuid, @origin
+)
+RETURNING *;
+
+-- name: DeleteOrganizationOrigin :exec
+DELETE FROM organization_origins
+WHERE id = @id::uuid AND organization_id = @organization_id::uuid;
+
+-- name: IsOriginRegistered :one
+SELECT EXISTS(
+    SELECT 1 FROM organization_origins o
+    INNER JOIN organizations org ON org.id = o.organization_id
+    WHERE o.origin = @origin AND org.is_active = true
+) AS registered;
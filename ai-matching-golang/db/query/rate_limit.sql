@@ -0,0 +1,29 @@
+-- name: IncrementRateLimitCounter :one
+INSERT INTO rate_limit_counters (
+    key, count, expires_at
+) VALUES (
+    @key, 1, NOW() + make_interval(secs => @window_seconds::float8)
+)
+ON CONFLICT (key) DO UPDATE
+SET count = CASE
+        WHEN rate_limit_counters.expires_at <= NOW() THEN 1
+        ELSE rate_limit_counters.count + 1
+    END,
+    expires_at = CASE
+        WHEN rate_limit_counters.expires_at <= NOW() THEN EXCLUDED.expires_at
+        ELSE rate_limit_counters.expires_at
+    END
+RETURNING count, expires_at;
+
+-- name: GetRateLimitCounter :one
+SELECT count, expires_at FROM rate_limit_counters
+WHERE key = @key AND expires_at > NOW()
+LIMIT 1;
+
+-- name: DeleteRateLimitCounter :exec
+DELETE FROM rate_limit_counters
+WHERE key = @key;
+
+-- name: DeleteExpiredRateLimitCounters :execrows
+DELETE FROM rate_limit_counters
+WHERE expires_at <= NOW();
@@ -0,0 +1,43 @@
+-- name: ListOrganizationSettings :many
+SELECT * FROM organization_settings
+WHERE organization_id = @organization_id::uuid
+ORDER BY key;
+
+-- name: UpsertOrganizationSetting :one
+INSERT INTO organization_settings (
+    organization_id, key, value, updated_by
+) VALUES (
+    @organization_id::uuid, @key, @value, @updated_by
+)
+ON CONFLICT (organization_id, key) DO UPDATE
+SET value = EXCLUDED.value,
+    version = organization_settings.version + 1,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteOrganizationSetting :exec
+DELETE FROM organization_settings
+WHERE organization_id = @organization_id::uuid AND key = @key;
+
+-- name: ListTenantSettings :many
+SELECT * FROM tenant_settings
+WHERE tenant_id = @tenant_id::uuid
+ORDER BY key;
+
+-- name: UpsertTenantSetting :one
+INSERT INTO tenant_settings (
+    tenant_id, key, value, updated_by
+) VALUES (
+    @tenant_id::uuid, @key, @value, @updated_by
+)
+ON CONFLICT (tenant_id, key) DO UPDATE
+SET value = EXCLUDED.value,
+    version = tenant_settings.version + 1,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteTenantSetting :exec
+DELETE FROM tenant_settings
+WHERE tenant_id = @tenant_id::uuid AND key = @key;
@@ -0,0 +1,55 @@
+-- name: ListPlans :many
+SELECT * FROM plans
+ORDER BY max_tenants NULLS LAST, code;
+
+-- name: GetPlan :one
+SELECT * FROM plans
+WHERE code = @code
+LIMIT 1;
+
+-- name: GetOrganizationSubscription :one
+SELECT * FROM organization_subscriptions
+WHERE organization_id = @organization_id::uuid
+LIMIT 1;
+
+-- name: UpsertOrganizationSubscription :one
+INSERT INTO organization_subscriptions (
+    organization_id, plan_code, status
+) VALUES (
+    @organization_id::uuid, @plan_code, @status
+)
+ON CONFLICT (organization_id) DO UPDATE
+SET plan_code = EXCLUDED.plan_code,
+    status = EXCLUDED.status,
+    started_at = CASE
+        WHEN organization_subscriptions.plan_code = EXCLUDED.plan_code THEN organization_subscriptions.started_at
+        ELSE NOW()
+    END,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: IncrementOrganizationApiUsage :one
+INSERT INTO organization_api_usage (
+    organization_id, period_start, call_count
+) VALUES (
+    @organization_id::uuid, @period_start::date, 1
+)
+ON CONFLICT (organization_id, period_start) DO UPDATE
+SET call_count = organization_api_usage.call_count + 1,
+    updated_at = NOW()
+RETURNING call_count;
+
+-- name: GetOrganizationApiUsage :one
+SELECT COALESCE(SUM(call_count), 0)::bigint FROM organization_api_usage
+WHERE organization_id = @organization_id::uuid AND period_start = @period_start::date;
+
+-- name: ListTenantSeatCounts :many
+SELECT
+    t.id,
+    t.name,
+    COUNT(tu.id) AS seat_count
+FROM tenants t
+LEFT JOIN tenant_users tu ON t.id = tu.tenant_id
+WHERE t.organization_id = @organization_id::uuid
+GROUP BY t.id, t.name
+ORDER BY t.name;
@@ -0,0 +1,51 @@
+-- name: StartScheduledTaskRun :one
+-- Returns no row when another replica already ran the task for this slot.
+INSERT INTO task_runs (
+    task_name, trigger, scheduled_for, worker_id
+) VALUES (
+    @task_name, 'schedule', @scheduled_for::timestamp, @worker_id
+)
+ON CONFLICT (task_name, scheduled_for) WHERE trigger = 'schedule' DO NOTHING
+RETURNING *;
+
+-- name: StartManualTaskRun :one
+INSERT INTO task_runs (
+    task_name, trigger, triggered_by, worker_id
+) VALUES (
+    @task_name, 'manual', sqlc.narg(triggered_by)::uuid, @worker_id
+)
+RETURNING *;
+
+-- name: FinishTaskRun :exec
+UPDATE task_runs
+SET status = @status,
+    summary = sqlc.narg(summary)::text,
+    error_message = sqlc.narg(error_message)::text,
+    finished_at = NOW()
+WHERE id = @id::uuid;
+
+-- name: ListLatestTaskRuns :many
+SELECT DISTINCT ON (task_name) * FROM task_runs
+ORDER BY task_name, started_at DESC;
+
+-- name: ListTaskRuns :many
+SELECT * FROM task_runs
+WHERE task_name = @task_name
+ORDER BY started_at DESC
+LIMIT @row_limit OFFSET @row_offset;
+
+-- name: CountTaskRuns :one
+SELECT COUNT(*) FROM task_runs
+WHERE task_name = @task_name;
+
+-- name: DeleteTaskRunsBefore :execrows
+DELETE FROM task_runs
+WHERE started_at < @before::timestamp AND status <> 'running';
+
+-- name: TryTaskLock :one
+-- Session-level advisory lock held by the scheduler while a task runs; take
+-- and release it on the same dedicated connection.
+SELECT pg_try_advisory_lock(hashtext(@lock_key::text)::bigint)::boolean AS locked;
+
+-- name: ReleaseTaskLock :exec
+SELECT pg_advisory_unlock(hashtext(@lock_key::text)::bigint);
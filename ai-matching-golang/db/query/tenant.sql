@@ -58,4 +58,37 @@ SELECT EXISTS(
 
 -- name: CountTenantsByOrganization :one
 SELECT COUNT(*) FROM tenants
-WHERE organization_id = @organization_id::uuid AND is_active = true;
\ No newline at end of file
+WHERE organization_id = @organization_id::uuid AND is_active = true;
+
+-- name: ListTakenSubdomains :many
+-- Returns the candidates already used by another tenant, either as its
+-- current subdomain or as an unexpired alias.
+SELECT t.subdomain::text AS subdomain FROM tenants t
+WHERE t.subdomain = ANY(@subdomains::text[]) AND t.id <> @tenant_id::uuid
+UNION
+SELECT a.subdomain::text AS subdomain FROM tenant_subdomain_aliases a
+WHERE a.subdomain = ANY(@subdomains::text[]) AND a.expires_at > NOW() AND a.tenant_id <> @tenant_id::uuid;
+
+-- name: UpsertTenantSubdomainAlias :exec
+INSERT INTO tenant_subdomain_aliases (
+    subdomain, tenant_id, expires_at
+) VALUES (
+    @subdomain, @tenant_id::uuid, @expires_at
+)
+ON CONFLICT (subdomain) DO UPDATE
+SET tenant_id = EXCLUDED.tenant_id,
+    expires_at = EXCLUDED.expires_at;
+
+-- name: GetTenantBySubdomainAlias :one
+SELECT t.* FROM tenants t
+INNER JOIN tenant_subdomain_aliases a ON a.tenant_id = t.id
+WHERE a.subdomain = @subdomain AND a.expires_at > NOW() AND t.is_active = true
+LIMIT 1;
+
+-- name: DeleteTenantSubdomainAlias :exec
+DELETE FROM tenant_subdomain_aliases
+WHERE subdomain = @subdomain;
+
+-- name: DeleteExpiredTenantSubdomainAliases :execrows
+DELETE FROM tenant_subdomain_aliases
+WHERE expires_at <= NOW();
@@ -0,0 +1,41 @@
+-- name: GetTenantDomain :one
+SELECT * FROM tenant_domains
+WHERE id = @id::uuid AND tenant_id = @tenant_id::uuid
+LIMIT 1;
+
+-- name: ListTenantDomains :many
+SELECT * FROM tenant_domains
+WHERE tenant_id = @tenant_id::uuid
+ORDER BY is_primary DESC, created_at;
+
+-- name: CreateTenantDomain :one
+INSERT INTO tenant_domains (
+    tenant_id, domain, verification_token
+) VALUES (
+    @tenant_id::uuid, @domain, @verification_token
+)
+RETURNING *;
+
+-- name: MarkTenantDomainVerified :one
+UPDATE tenant_domains
+SET verified_at = NOW(),
+    updated_at = NOW()
+WHERE id = @id::uuid AND tenant_id = @tenant_id::uuid
+RETURNING *;
+
+-- name: SetPrimaryTenantDomain :exec
+UPDATE tenant_domains
+SET is_primary = (id = @id::uuid),
+    updated_at = NOW()
+WHERE tenant_id = @tenant_id::uuid
+  AND (is_primary = true OR id = @id::uuid);
+
+-- name: DeleteTenantDomain :exec
+DELETE FROM tenant_domains
+WHERE id = @id::uuid AND tenant_id = @tenant_id::uuid;
+
+-- name: GetTenantByVerifiedDomain :one
+SELECT t.* FROM tenants t
+INNER JOIN tenant_domains d ON d.tenant_id = t.id
+WHERE d.domain = @domain AND d.verified_at IS NOT NULL AND t.is_active = true
+LIMIT 1;
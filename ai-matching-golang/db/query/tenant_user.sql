@@ -43,4 +43,8 @@ SELECT
 FROM tenant_users tu
 INNER JOIN users u ON tu.user_id = u.id
 WHERE tu.tenant_id = @tenant_id::uuid
-ORDER BY u.email;
\ No newline at end of file
+ORDER BY u.email;
+
+-- name: CountTenantUsers :one
+SELECT COUNT(*) FROM tenant_users
+WHERE tenant_id = @tenant_id::uuid;
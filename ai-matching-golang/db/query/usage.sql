@@ -0,0 +1,84 @@
+-- name: RecordUserActivity :exec
+INSERT INTO user_daily_activity (
+    activity_date, tenant_id, user_id, organization_id
+) VALUES (
+    @activity_date::date, @tenant_id::uuid, @user_id::uuid, @organization_id::uuid
+)
+ON CONFLICT (activity_date, tenant_id, user_id) DO NOTHING;
+
+-- name: SnapshotTenantActiveUsers :execrows
+INSERT INTO usage_records (organization_id, tenant_id, metric, usage_date, quantity)
+SELECT organization_id, tenant_id, 'active_users', @usage_date::date, COUNT(*)
+FROM user_daily_activity
+WHERE activity_date = @usage_date::date
+GROUP BY organization_id, tenant_id
+ON CONFLICT (organization_id, tenant_id, metric, usage_date) WHERE tenant_id IS NOT NULL
+DO UPDATE SET quantity = EXCLUDED.quantity, updated_at = NOW();
+
+-- name: SnapshotOrganizationActiveUsers :execrows
+INSERT INTO usage_records (organization_id, metric, usage_date, quantity)
+SELECT organization_id, 'active_users', @usage_date::date, COUNT(DISTINCT user_id)
+FROM user_daily_activity
+WHERE activity_date = @usage_date::date
+GROUP BY organization_id
+ON CONFLICT (organization_id, metric, usage_date) WHERE tenant_id IS NULL
+DO UPDATE SET quantity = EXCLUDED.quantity, updated_at = NOW();
+
+-- name: SnapshotTenantSeats :execrows
+INSERT INTO usage_records (organization_id, tenant_id, metric, usage_date, quantity)
+SELECT t.organization_id, t.id, 'seats', @usage_date::date, COUNT(tu.id)
+FROM tenants t
+LEFT JOIN tenant_users tu ON t.id = tu.tenant_id
+GROUP BY t.organization_id, t.id
+ON CONFLICT (organization_id, tenant_id, metric, usage_date) WHERE tenant_id IS NOT NULL
+DO UPDATE SET quantity = EXCLUDED.quantity, updated_at = NOW();
+
+-- name: SnapshotOrganizationSeats :execrows
+INSERT INTO usage_records (organization_id, metric, usage_date, quantity)
+SELECT o.id, 'seats', @usage_date::date, COUNT(DISTINCT tu.user_id)
+FROM organizations o
+LEFT JOIN tenants t ON o.id = t.organization_id
+LEFT JOIN tenant_users tu ON t.id = tu.tenant_id
+GROUP BY o.id
+ON CONFLICT (organization_id, metric, usage_date) WHERE tenant_id IS NULL
+DO UPDATE SET quantity = EXCLUDED.quantity, updated_at = NOW();
+
+-- name: SnapshotOrganizationApiCalls :execrows
+INSERT INTO usage_records (organization_id, metric, usage_date, quantity)
+SELECT organization_id, 'api_calls', @usage_date::date, call_count
+FROM organization_api_usage
+WHERE period_start = date_trunc('month', @usage_date::date)::date
+ON CONFLICT (organization_id, metric, usage_date) WHERE tenant_id IS NULL
+DO UPDATE SET quantity = EXCLUDED.quantity, updated_at = NOW();
+
+-- name: ListUsageRecordsByPeriod :many
+SELECT
+    ur.organization_id,
+    o.name AS organization_name,
+    ur.tenant_id,
+    t.name AS tenant_name,
+    ur.metric,
+    ur.usage_date,
+    ur.quantity
+FROM usage_records ur
+INNER JOIN organizations o ON ur.organization_id = o.id
+LEFT JOIN tenants t ON ur.tenant_id = t.id
+WHERE ur.usage_date >= @period_start::date AND ur.usage_date < @period_end::date
+ORDER BY o.name, ur.organization_id, t.name NULLS FIRST, ur.usage_date;
+
+-- name: CountTenantMonthlyActiveUsers :many
+SELECT
+    organization_id,
+    tenant_id,
+    COUNT(DISTINCT user_id) AS active_users
+FROM user_daily_activity
+WHERE activity_date >= @period_start::date AND activity_date < @period_end::date
+GROUP BY organization_id, tenant_id;
+
+-- name: CountOrganizationMonthlyActiveUsers :many
+SELECT
+    organization_id,
+    COUNT(DISTINCT user_id) AS active_users
+FROM user_daily_activity
+WHERE activity_date >= @period_start::date AND activity_date < @period_end::date
+GROUP BY organization_id;
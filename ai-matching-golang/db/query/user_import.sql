@@ -0,0 +1,62 @@
+-- name: CreateUserImport :one
+INSERT INTO user_imports (
+    organization_id, created_by, total_rows
+) VALUES (
+    @organization_id::uuid, @created_by, @total_rows
+)
+RETURNING *;
+
+-- name: CreateUserImportRow :exec
+INSERT INTO user_import_rows (
+    import_id, row_number, email, first_name, last_name, tenant_id, role
+) VALUES (
+    @import_id::uuid, @row_number, @email, @first_name, @last_name, @tenant_id::uuid, @role
+);
+
+-- name: GetUserImport :one
+SELECT * FROM user_imports
+WHERE id = @id::uuid AND organization_id = @organization_id::uuid
+LIMIT 1;
+
+-- name: ListUserImports :many
+SELECT * FROM user_imports
+WHERE organization_id = @organization_id::uuid
+ORDER BY created_at DESC
+LIMIT @row_limit;
+
+-- name: ListUserImportRows :many
+SELECT
+    r.*,
+    t.subdomain AS tenant_subdomain,
+    t.organization_id
+FROM user_import_rows r
+INNER JOIN tenants t ON r.tenant_id = t.id
+WHERE r.import_id = @import_id::uuid
+ORDER BY r.row_number;
+
+-- name: StartUserImport :execrows
+-- The job queue runs one attempt of a job at a time, so a row still in
+-- progress was left by a worker that stopped and is taken over.
+UPDATE user_imports
+SET status = 'processing',
+    started_at = NOW(),
+    completed_at = NULL,
+    updated_at = NOW()
+WHERE id = @id::uuid AND status IN ('pending', 'failed', 'processing');
+
+-- name: UpdateUserImportRow :exec
+UPDATE user_import_rows
+SET status = @status,
+    message = @message,
+    user_id = @user_id,
+    updated_at = NOW()
+WHERE id = @id::uuid;
+
+-- name: FinishUserImport :exec
+UPDATE user_imports
+SET status = @status,
+    succeeded_rows = (SELECT COUNT(*) FROM user_import_rows r WHERE r.import_id = @id::uuid AND r.status IN ('created', 'added', 'skipped')),
+    failed_rows = (SELECT COUNT(*) FROM user_import_rows r WHERE r.import_id = @id::uuid AND r.status = 'failed'),
+    completed_at = NOW(),
+    updated_at = NOW()
+WHERE id = @id::uuid;
@@ -0,0 +1,112 @@
+-- name: CreateWebhookEndpoint :one
+INSERT INTO webhook_endpoints (
+    organization_id, url, description, event_types, secret, created_by
+) VALUES (
+    @organization_id::uuid, @url, @description, @event_types::text[], @secret, @created_by
+)
+RETURNING *;
+
+-- name: GetWebhookEndpoint :one
+SELECT * FROM webhook_endpoints
+WHERE id = @id::uuid AND organization_id = @organization_id::uuid
+LIMIT 1;
+
+-- name: ListWebhookEndpoints :many
+SELECT * FROM webhook_endpoints
+WHERE organization_id = @organization_id::uuid
+ORDER BY created_at;
+
+-- name: ListSubscribedWebhookEndpoints :many
+SELECT * FROM webhook_endpoints
+WHERE organization_id = @organization_id::uuid
+  AND is_active = true
+  AND @event_type::text = ANY(event_types)
+ORDER BY created_at;
+
+-- name: UpdateWebhookEndpoint :one
+UPDATE webhook_endpoints
+SET url = @url,
+    description = @description,
+    event_types = @event_types::text[],
+    is_active = @is_active,
+    updated_at = NOW()
+WHERE id = @id::uuid AND organization_id = @organization_id::uuid
+RETURNING *;
+
+-- name: RotateWebhookEndpointSecret :one
+-- The current secret keeps signing alongside the new one until
+-- previous_secret_expires_at, so receivers can switch without dropping events.
+UPDATE webhook_endpoints
+SET previous_secret = secret,
+    previous_secret_expires_at = @previous_secret_expires_at::timestamp,
+    secret = @secret,
+    updated_at = NOW()
+WHERE id = @id::uuid AND organization_id = @organization_id::uuid
+RETURNING *;
+
+-- name: DeleteWebhookEndpoint :execrows
+DELETE FROM webhook_endpoints
+WHERE id = @id::uuid AND organization_id = @organization_id::uuid;
+
+-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_deliveries (
+    endpoint_id, event_id, event_type, payload, redelivery_of
+) VALUES (
+    @endpoint_id::uuid, @event_id::uuid, @event_type, @payload, @redelivery_of
+)
+RETURNING *;
+
+-- name: GetWebhookDelivery :one
+SELECT * FROM webhook_deliveries
+WHERE id = @id::uuid AND endpoint_id = @endpoint_id::uuid
+LIMIT 1;
+
+-- name: GetWebhookDeliveryTarget :one
+-- Loads a delivery with what is needed to send it
+SELECT
+    d.*,
+    e.url,
+    e.secret,
+    e.previous_secret,
+    e.previous_secret_expires_at,
+    e.is_active
+FROM webhook_deliveries d
+INNER JOIN webhook_endpoints e ON d.endpoint_id = e.id
+WHERE d.id = @id::uuid
+LIMIT 1;
+
+-- name: ListWebhookDeliveries :many
+SELECT * FROM webhook_deliveries
+WHERE endpoint_id = @endpoint_id::uuid
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status)::text)
+ORDER BY created_at DESC, id
+LIMIT @row_limit OFFSET @row_offset;
+
+-- name: CountWebhookDeliveries :one
+SELECT COUNT(*) FROM webhook_deliveries
+WHERE endpoint_id = @endpoint_id::uuid
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status)::text);
+
+-- name: RecordWebhookDeliveryAttempt :exec
+UPDATE webhook_deliveries
+SET status = @status,
+    attempts = attempts + 1,
+    response_status = sqlc.narg(response_status)::integer,
+    response_body = sqlc.narg(response_body)::text,
+    error_message = sqlc.narg(error_message)::text,
+    duration_ms = @duration_ms::integer,
+    last_attempt_at = NOW(),
+    delivered_at = CASE WHEN @status = 'succeeded' THEN NOW() ELSE NULL END,
+    updated_at = NOW()
+WHERE id = @id::uuid AND status = 'pending';
+
+-- name: FailWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET status = 'failed',
+    error_message = @error_message::text,
+    updated_at = NOW()
+WHERE id = @id::uuid AND status = 'pending';
+
+-- name: DeleteWebhookDeliveriesBefore :execrows
+DELETE FROM webhook_deliveries
+WHERE created_at < @before::timestamp AND status <> 'pending';
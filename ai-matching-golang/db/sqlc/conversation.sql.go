@@ -0,0 +1,301 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: conversation.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+const countConversations = `-- name: CountConversations :one
+SELECT COUNT(*) FROM conversations
+WHERE tenant_id = $1::uuid AND user_id = $2::uuid
+`
+
+type CountConversationsParams struct {
+	TenantID uuid.UUID `json:"tenant_id"`
+	UserID   uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) CountConversations(ctx context.Context, arg CountConversationsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countConversations, arg.TenantID, arg.UserID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createConversation = `-- name: CreateConversation :one
+INSERT INTO conversations (
+    tenant_id, user_id, title
+) VALUES (
+    $1::uuid, $2::uuid, $3
+)
+RETURNING id, tenant_id, user_id, title, created_at, updated_at
+`
+
+type CreateConversationParams struct {
+	TenantID uuid.UUID `json:"tenant_id"`
+	UserID   uuid.UUID `json:"user_id"`
+	Title    string    `json:"title"`
+}
+
+func (q *Queries) CreateConversation(ctx context.Context, arg CreateConversationParams) (Conversation, error) {
+	row := q.db.QueryRowContext(ctx, createConversation, arg.TenantID, arg.UserID, arg.Title)
+	var i Conversation
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.UserID,
+		&i.Title,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createMessage = `-- name: CreateMessage :one
+INSERT INTO messages (
+    conversation_id, role, content, rewritten_query, citations, model, token_count
+) VALUES (
+    $1::uuid, $2, $3, $4, $5, $6, $7
+)
+RETURNING id, conversation_id, role, content, rewritten_query, citations, model, token_count, created_at
+`
+
+type CreateMessageParams struct {
+	ConversationID uuid.UUID       `json:"conversation_id"`
+	Role           string          `json:"role"`
+	Content        string          `json:"content"`
+	RewrittenQuery sql.NullString  `json:"rewritten_query"`
+	Citations      json.RawMessage `json:"citations"`
+	Model          sql.NullString  `json:"model"`
+	TokenCount     int32           `json:"token_count"`
+}
+
+func (q *Queries) CreateMessage(ctx context.Context, arg CreateMessageParams) (Message, error) {
+	row := q.db.QueryRowContext(ctx, createMessage,
+		arg.ConversationID,
+		arg.Role,
+		arg.Content,
+		arg.RewrittenQuery,
+		arg.Citations,
+		arg.Model,
+		arg.TokenCount,
+	)
+	var i Message
+	err := row.Scan(
+		&i.ID,
+		&i.ConversationID,
+		&i.Role,
+		&i.Content,
+		&i.RewrittenQuery,
+		&i.Citations,
+		&i.Model,
+		&i.TokenCount,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteConversation = `-- name: DeleteConversation :execrows
+DELETE FROM conversations
+WHERE id = $1::uuid AND tenant_id = $2::uuid AND user_id = $3::uuid
+`
+
+type DeleteConversationParams struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID uuid.UUID `json:"tenant_id"`
+	UserID   uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) DeleteConversation(ctx context.Context, arg DeleteConversationParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteConversation, arg.ID, arg.TenantID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getConversation = `-- name: GetConversation :one
+SELECT id, tenant_id, user_id, title, created_at, updated_at FROM conversations
+WHERE id = $1::uuid AND tenant_id = $2::uuid AND user_id = $3::uuid
+LIMIT 1
+`
+
+type GetConversationParams struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID uuid.UUID `json:"tenant_id"`
+	UserID   uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) GetConversation(ctx context.Context, arg GetConversationParams) (Conversation, error) {
+	row := q.db.QueryRowContext(ctx, getConversation, arg.ID, arg.TenantID, arg.UserID)
+	var i Conversation
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.UserID,
+		&i.Title,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listConversations = `-- name: ListConversations :many
+SELECT id, tenant_id, user_id, title, created_at, updated_at FROM conversations
+WHERE tenant_id = $1::uuid AND user_id = $2::uuid
+ORDER BY updated_at DESC, id
+LIMIT $4 OFFSET $3
+`
+
+type ListConversationsParams struct {
+	TenantID  uuid.UUID `json:"tenant_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	RowOffset int32     `json:"row_offset"`
+	RowLimit  int32     `json:"row_limit"`
+}
+
+func (q *Queries) ListConversations(ctx context.Context, arg ListConversationsParams) ([]Conversation, error) {
+	rows, err := q.db.QueryContext(ctx, listConversations,
+		arg.TenantID,
+		arg.UserID,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Conversation{}
+	for rows.Next() {
+		var i Conversation
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.UserID,
+			&i.Title,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMessages = `-- name: ListMessages :many
+SELECT id, conversation_id, role, content, rewritten_query, citations, model, token_count, created_at FROM messages
+WHERE conversation_id = $1::uuid
+ORDER BY created_at, id
+`
+
+func (q *Queries) ListMessages(ctx context.Context, conversationID uuid.UUID) ([]Message, error) {
+	rows, err := q.db.QueryContext(ctx, listMessages, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Message{}
+	for rows.Next() {
+		var i Message
+		if err := rows.Scan(
+			&i.ID,
+			&i.ConversationID,
+			&i.Role,
+			&i.Content,
+			&i.RewrittenQuery,
+			&i.Citations,
+			&i.Model,
+			&i.TokenCount,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecentMessages = `-- name: ListRecentMessages :many
+SELECT id, conversation_id, role, content, rewritten_query, citations, model, token_count, created_at FROM messages
+WHERE conversation_id = $1::uuid
+ORDER BY created_at DESC, id DESC
+LIMIT $2
+`
+
+type ListRecentMessagesParams struct {
+	ConversationID uuid.UUID `json:"conversation_id"`
+	RowLimit       int32     `json:"row_limit"`
+}
+
+// Newest first, for building the history of a follow-up question
+func (q *Queries) ListRecentMessages(ctx context.Context, arg ListRecentMessagesParams) ([]Message, error) {
+	rows, err := q.db.QueryContext(ctx, listRecentMessages, arg.ConversationID, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Message{}
+	for rows.Next() {
+		var i Message
+		if err := rows.Scan(
+			&i.ID,
+			&i.ConversationID,
+			&i.Role,
+			&i.Content,
+			&i.RewrittenQuery,
+			&i.Citations,
+			&i.Model,
+			&i.TokenCount,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const touchConversation = `-- name: TouchConversation :exec
+UPDATE conversations
+SET title = CASE WHEN title = '' THEN $1 ELSE title END,
+    updated_at = NOW()
+WHERE id = $2::uuid
+`
+
+type TouchConversationParams struct {
+	Title string    `json:"title"`
+	ID    uuid.UUID `json:"id"`
+}
+
+// Also names an untitled conversation after its first question
+func (q *Queries) TouchConversation(ctx context.Context, arg TouchConversationParams) error {
+	_, err := q.db.ExecContext(ctx, touchConversation, arg.Title, arg.ID)
+	return err
+}
@@ -0,0 +1,477 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: document.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const countDocuments = `-- name: CountDocuments :one
+SELECT COUNT(*) FROM documents
+WHERE tenant_id = $1::uuid AND deleted_at IS NULL
+`
+
+func (q *Queries) CountDocuments(ctx context.Context, tenantID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countDocuments, tenantID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createDocument = `-- name: CreateDocument :one
+INSERT INTO documents (
+    tenant_id, title, metadata, created_by
+) VALUES (
+    $1::uuid, $2, $3, $4
+)
+RETURNING id, tenant_id, title, metadata, current_version, created_by, created_at, updated_at, deleted_at
+`
+
+type CreateDocumentParams struct {
+	TenantID  uuid.UUID       `json:"tenant_id"`
+	Title     string          `json:"title"`
+	Metadata  json.RawMessage `json:"metadata"`
+	CreatedBy uuid.NullUUID   `json:"created_by"`
+}
+
+func (q *Queries) CreateDocument(ctx context.Context, arg CreateDocumentParams) (Document, error) {
+	row := q.db.QueryRowContext(ctx, createDocument,
+		arg.TenantID,
+		arg.Title,
+		arg.Metadata,
+		arg.CreatedBy,
+	)
+	var i Document
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Title,
+		&i.Metadata,
+		&i.CurrentVersion,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const createDocumentVersion = `-- name: CreateDocumentVersion :one
+WITH doc AS (
+    UPDATE documents
+    SET current_version = current_version + 1,
+        updated_at = NOW()
+    WHERE id = $11::uuid AND tenant_id = $12::uuid AND deleted_at IS NULL
+    RETURNING id, current_version
+)
+INSERT INTO document_versions (
+    document_id, version, filename, content_type, format, size_bytes, checksum,
+    storage_key, extraction_status, extraction_error, extracted_text, created_by
+)
+SELECT
+    doc.id, doc.current_version, $1, $2, $3, $4, $5,
+    $6, $7, $8, $9, $10
+FROM doc
+RETURNING id, document_id, version, filename, content_type, format, size_bytes, checksum, storage_key, extraction_status, extraction_error, extracted_text, created_by, created_at, index_status, index_error, chunk_count, embedding_model, indexed_at
+`
+
+type CreateDocumentVersionParams struct {
+	Filename         string         `json:"filename"`
+	ContentType      string         `json:"content_type"`
+	Format           string         `json:"format"`
+	SizeBytes        int64          `json:"size_bytes"`
+	Checksum         string         `json:"checksum"`
+	StorageKey       string         `json:"storage_key"`
+	ExtractionStatus string         `json:"extraction_status"`
+	ExtractionError  sql.NullString `json:"extraction_error"`
+	ExtractedText    string         `json:"extracted_text"`
+	CreatedBy        uuid.NullUUID  `json:"created_by"`
+	DocumentID       uuid.UUID      `json:"document_id"`
+	TenantID         uuid.UUID      `json:"tenant_id"`
+}
+
+// Bumping current_version locks the document row, so concurrent uploads get
+// consecutive version numbers.
+func (q *Queries) CreateDocumentVersion(ctx context.Context, arg CreateDocumentVersionParams) (DocumentVersion, error) {
+	row := q.db.QueryRowContext(ctx, createDocumentVersion,
+		arg.Filename,
+		arg.ContentType,
+		arg.Format,
+		arg.SizeBytes,
+		arg.Checksum,
+		arg.StorageKey,
+		arg.ExtractionStatus,
+		arg.ExtractionError,
+		arg.ExtractedText,
+		arg.CreatedBy,
+		arg.DocumentID,
+		arg.TenantID,
+	)
+	var i DocumentVersion
+	err := row.Scan(
+		&i.ID,
+		&i.DocumentID,
+		&i.Version,
+		&i.Filename,
+		&i.ContentType,
+		&i.Format,
+		&i.SizeBytes,
+		&i.Checksum,
+		&i.StorageKey,
+		&i.ExtractionStatus,
+		&i.ExtractionError,
+		&i.ExtractedText,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.IndexStatus,
+		&i.IndexError,
+		&i.ChunkCount,
+		&i.EmbeddingModel,
+		&i.IndexedAt,
+	)
+	return i, err
+}
+
+const getDocument = `-- name: GetDocument :one
+SELECT id, tenant_id, title, metadata, current_version, created_by, created_at, updated_at, deleted_at FROM documents
+WHERE id = $1::uuid AND tenant_id = $2::uuid AND deleted_at IS NULL
+LIMIT 1
+`
+
+type GetDocumentParams struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+func (q *Queries) GetDocument(ctx context.Context, arg GetDocumentParams) (Document, error) {
+	row := q.db.QueryRowContext(ctx, getDocument, arg.ID, arg.TenantID)
+	var i Document
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Title,
+		&i.Metadata,
+		&i.CurrentVersion,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getDocumentVersion = `-- name: GetDocumentVersion :one
+SELECT v.id, v.document_id, v.version, v.filename, v.content_type, v.format, v.size_bytes, v.checksum, v.storage_key, v.extraction_status, v.extraction_error, v.extracted_text, v.created_by, v.created_at, v.index_status, v.index_error, v.chunk_count, v.embedding_model, v.indexed_at FROM document_versions v
+INNER JOIN documents d ON v.document_id = d.id
+WHERE v.document_id = $1::uuid
+    AND v.version = $2
+    AND d.tenant_id = $3::uuid
+    AND d.deleted_at IS NULL
+LIMIT 1
+`
+
+type GetDocumentVersionParams struct {
+	DocumentID uuid.UUID `json:"document_id"`
+	Version    int32     `json:"version"`
+	TenantID   uuid.UUID `json:"tenant_id"`
+}
+
+func (q *Queries) GetDocumentVersion(ctx context.Context, arg GetDocumentVersionParams) (DocumentVersion, error) {
+	row := q.db.QueryRowContext(ctx, getDocumentVersion, arg.DocumentID, arg.Version, arg.TenantID)
+	var i DocumentVersion
+	err := row.Scan(
+		&i.ID,
+		&i.DocumentID,
+		&i.Version,
+		&i.Filename,
+		&i.ContentType,
+		&i.Format,
+		&i.SizeBytes,
+		&i.Checksum,
+		&i.StorageKey,
+		&i.ExtractionStatus,
+		&i.ExtractionError,
+		&i.ExtractedText,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.IndexStatus,
+		&i.IndexError,
+		&i.ChunkCount,
+		&i.EmbeddingModel,
+		&i.IndexedAt,
+	)
+	return i, err
+}
+
+const listDocumentVersionStorageKeys = `-- name: ListDocumentVersionStorageKeys :many
+SELECT storage_key FROM document_versions
+WHERE document_id = $1::uuid
+ORDER BY version
+`
+
+func (q *Queries) ListDocumentVersionStorageKeys(ctx context.Context, documentID uuid.UUID) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listDocumentVersionStorageKeys, documentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var storage_key string
+		if err := rows.Scan(&storage_key); err != nil {
+			return nil, err
+		}
+		items = append(items, storage_key)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDocumentVersions = `-- name: ListDocumentVersions :many
+SELECT
+    v.id, v.document_id, v.version, v.filename, v.content_type, v.format,
+    v.size_bytes, v.checksum, v.extraction_status, v.extraction_error,
+    length(v.extracted_text)::integer AS text_length,
+    v.index_status, v.index_error, v.chunk_count, v.embedding_model, v.indexed_at,
+    v.created_by, v.created_at
+FROM document_versions v
+INNER JOIN documents d ON v.document_id = d.id
+WHERE v.document_id = $1::uuid
+    AND d.tenant_id = $2::uuid
+    AND d.deleted_at IS NULL
+ORDER BY v.version DESC
+`
+
+type ListDocumentVersionsParams struct {
+	DocumentID uuid.UUID `json:"document_id"`
+	TenantID   uuid.UUID `json:"tenant_id"`
+}
+
+type ListDocumentVersionsRow struct {
+	ID               uuid.UUID      `json:"id"`
+	DocumentID       uuid.UUID      `json:"document_id"`
+	Version          int32          `json:"version"`
+	Filename         string         `json:"filename"`
+	ContentType      string         `json:"content_type"`
+	Format           string         `json:"format"`
+	SizeBytes        int64          `json:"size_bytes"`
+	Checksum         string         `json:"checksum"`
+	ExtractionStatus string         `json:"extraction_status"`
+	ExtractionError  sql.NullString `json:"extraction_error"`
+	TextLength       int32          `json:"text_length"`
+	IndexStatus      string         `json:"index_status"`
+	IndexError       sql.NullString `json:"index_error"`
+	ChunkCount       int32          `json:"chunk_count"`
+	EmbeddingModel   sql.NullString `json:"embedding_model"`
+	IndexedAt        sql.NullTime   `json:"indexed_at"`
+	CreatedBy        uuid.NullUUID  `json:"created_by"`
+	CreatedAt        time.Time      `json:"created_at"`
+}
+
+func (q *Queries) ListDocumentVersions(ctx context.Context, arg ListDocumentVersionsParams) ([]ListDocumentVersionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDocumentVersions, arg.DocumentID, arg.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListDocumentVersionsRow{}
+	for rows.Next() {
+		var i ListDocumentVersionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.DocumentID,
+			&i.Version,
+			&i.Filename,
+			&i.ContentType,
+			&i.Format,
+			&i.SizeBytes,
+			&i.Checksum,
+			&i.ExtractionStatus,
+			&i.ExtractionError,
+			&i.TextLength,
+			&i.IndexStatus,
+			&i.IndexError,
+			&i.ChunkCount,
+			&i.EmbeddingModel,
+			&i.IndexedAt,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDocuments = `-- name: ListDocuments :many
+SELECT id, tenant_id, title, metadata, current_version, created_by, created_at, updated_at, deleted_at FROM documents
+WHERE tenant_id = $1::uuid AND deleted_at IS NULL
+ORDER BY created_at DESC, id
+LIMIT $3 OFFSET $2
+`
+
+type ListDocumentsParams struct {
+	TenantID  uuid.UUID `json:"tenant_id"`
+	RowOffset int32     `json:"row_offset"`
+	RowLimit  int32     `json:"row_limit"`
+}
+
+func (q *Queries) ListDocuments(ctx context.Context, arg ListDocumentsParams) ([]Document, error) {
+	rows, err := q.db.QueryContext(ctx, listDocuments, arg.TenantID, arg.RowOffset, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Document{}
+	for rows.Next() {
+		var i Document
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Title,
+			&i.Metadata,
+			&i.CurrentVersion,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPurgeableDocuments = `-- name: ListPurgeableDocuments :many
+SELECT id FROM documents
+WHERE deleted_at < $1::timestamp
+ORDER BY deleted_at, id
+LIMIT $2
+`
+
+type ListPurgeableDocumentsParams struct {
+	DeletedBefore time.Time `json:"deleted_before"`
+	RowLimit      int32     `json:"row_limit"`
+}
+
+func (q *Queries) ListPurgeableDocuments(ctx context.Context, arg ListPurgeableDocumentsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, listPurgeableDocuments, arg.DeletedBefore, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeDocument = `-- name: PurgeDocument :execrows
+DELETE FROM documents
+WHERE id = $1::uuid AND deleted_at IS NOT NULL
+`
+
+// Versions and chunks go with the document
+func (q *Queries) PurgeDocument(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, purgeDocument, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const softDeleteDocument = `-- name: SoftDeleteDocument :execrows
+UPDATE documents
+SET deleted_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1::uuid AND tenant_id = $2::uuid AND deleted_at IS NULL
+`
+
+type SoftDeleteDocumentParams struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+func (q *Queries) SoftDeleteDocument(ctx context.Context, arg SoftDeleteDocumentParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, softDeleteDocument, arg.ID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateDocument = `-- name: UpdateDocument :one
+UPDATE documents
+SET title = $1,
+    metadata = $2,
+    updated_at = NOW()
+WHERE id = $3::uuid AND tenant_id = $4::uuid AND deleted_at IS NULL
+RETURNING id, tenant_id, title, metadata, current_version, created_by, created_at, updated_at, deleted_at
+`
+
+type UpdateDocumentParams struct {
+	Title    string          `json:"title"`
+	Metadata json.RawMessage `json:"metadata"`
+	ID       uuid.UUID       `json:"id"`
+	TenantID uuid.UUID       `json:"tenant_id"`
+}
+
+func (q *Queries) UpdateDocument(ctx context.Context, arg UpdateDocumentParams) (Document, error) {
+	row := q.db.QueryRowContext(ctx, updateDocument,
+		arg.Title,
+		arg.Metadata,
+		arg.ID,
+		arg.TenantID,
+	)
+	var i Document
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Title,
+		&i.Metadata,
+		&i.CurrentVersion,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
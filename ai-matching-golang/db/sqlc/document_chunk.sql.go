@@ -0,0 +1,665 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: document_chunk.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const completeDocumentVersionIndexing = `-- name: CompleteDocumentVersionIndexing :exec
+UPDATE document_versions
+SET index_status = 'indexed',
+    index_error = NULL,
+    chunk_count = $1,
+    embedding_model = $2,
+    indexed_at = NOW()
+WHERE id = $3::uuid
+`
+
+type CompleteDocumentVersionIndexingParams struct {
+	ChunkCount     int32          `json:"chunk_count"`
+	EmbeddingModel sql.NullString `json:"embedding_model"`
+	ID             uuid.UUID      `json:"id"`
+}
+
+func (q *Queries) CompleteDocumentVersionIndexing(ctx context.Context, arg CompleteDocumentVersionIndexingParams) error {
+	_, err := q.db.ExecContext(ctx, completeDocumentVersionIndexing, arg.ChunkCount, arg.EmbeddingModel, arg.ID)
+	return err
+}
+
+const createDocumentChunk = `-- name: CreateDocumentChunk :exec
+INSERT INTO document_chunks (
+    tenant_id, document_id, version, chunk_index, heading, content,
+    start_offset, end_offset, token_count, embedding, embedding_model
+) VALUES (
+    $1::uuid, $2::uuid, $3, $4, $5, $6,
+    $7, $8, $9, $10, $11
+)
+ON CONFLICT (document_id, version, chunk_index) DO UPDATE
+SET heading = EXCLUDED.heading,
+    content = EXCLUDED.content,
+    start_offset = EXCLUDED.start_offset,
+    end_offset = EXCLUDED.end_offset,
+    token_count = EXCLUDED.token_count,
+    embedding = EXCLUDED.embedding,
+    embedding_model = EXCLUDED.embedding_model,
+    created_at = NOW()
+`
+
+type CreateDocumentChunkParams struct {
+	TenantID       uuid.UUID `json:"tenant_id"`
+	DocumentID     uuid.UUID `json:"document_id"`
+	Version        int32     `json:"version"`
+	ChunkIndex     int32     `json:"chunk_index"`
+	Heading        string    `json:"heading"`
+	Content        string    `json:"content"`
+	StartOffset    int32     `json:"start_offset"`
+	EndOffset      int32     `json:"end_offset"`
+	TokenCount     int32     `json:"token_count"`
+	Embedding      []float32 `json:"embedding"`
+	EmbeddingModel string    `json:"embedding_model"`
+}
+
+// Re-indexing a version overwrites its chunks in place.
+func (q *Queries) CreateDocumentChunk(ctx context.Context, arg CreateDocumentChunkParams) error {
+	_, err := q.db.ExecContext(ctx, createDocumentChunk,
+		arg.TenantID,
+		arg.DocumentID,
+		arg.Version,
+		arg.ChunkIndex,
+		arg.Heading,
+		arg.Content,
+		arg.StartOffset,
+		arg.EndOffset,
+		arg.TokenCount,
+		pq.Array(arg.Embedding),
+		arg.EmbeddingModel,
+	)
+	return err
+}
+
+const deleteDocumentChunksFrom = `-- name: DeleteDocumentChunksFrom :exec
+DELETE FROM document_chunks
+WHERE document_id = $1::uuid
+    AND version = $2
+    AND chunk_index >= $3
+`
+
+type DeleteDocumentChunksFromParams struct {
+	DocumentID uuid.UUID `json:"document_id"`
+	Version    int32     `json:"version"`
+	FirstIndex int32     `json:"first_index"`
+}
+
+// Removes chunks left over from a longer previous run of the same version.
+func (q *Queries) DeleteDocumentChunksFrom(ctx context.Context, arg DeleteDocumentChunksFromParams) error {
+	_, err := q.db.ExecContext(ctx, deleteDocumentChunksFrom, arg.DocumentID, arg.Version, arg.FirstIndex)
+	return err
+}
+
+const deleteSupersededDocumentChunks = `-- name: DeleteSupersededDocumentChunks :execrows
+DELETE FROM document_chunks
+WHERE document_id = $1::uuid AND version < $2
+`
+
+type DeleteSupersededDocumentChunksParams struct {
+	DocumentID uuid.UUID `json:"document_id"`
+	Version    int32     `json:"version"`
+}
+
+func (q *Queries) DeleteSupersededDocumentChunks(ctx context.Context, arg DeleteSupersededDocumentChunksParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteSupersededDocumentChunks, arg.DocumentID, arg.Version)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getDocumentChunksByIDs = `-- name: GetDocumentChunksByIDs :many
+SELECT
+    c.id, c.document_id, c.version, c.chunk_index, c.heading, c.content,
+    c.start_offset, c.end_offset, c.token_count,
+    d.title AS document_title, d.metadata AS document_metadata
+FROM document_chunks c
+INNER JOIN documents d ON c.document_id = d.id
+WHERE c.id = ANY($1::uuid[])
+    AND c.tenant_id = $2::uuid
+    AND d.tenant_id = $2::uuid
+    AND d.deleted_at IS NULL
+`
+
+type GetDocumentChunksByIDsParams struct {
+	Ids      []uuid.UUID `json:"ids"`
+	TenantID uuid.UUID   `json:"tenant_id"`
+}
+
+type GetDocumentChunksByIDsRow struct {
+	ID               uuid.UUID       `json:"id"`
+	DocumentID       uuid.UUID       `json:"document_id"`
+	Version          int32           `json:"version"`
+	ChunkIndex       int32           `json:"chunk_index"`
+	Heading          string          `json:"heading"`
+	Content          string          `json:"content"`
+	StartOffset      int32           `json:"start_offset"`
+	EndOffset        int32           `json:"end_offset"`
+	TokenCount       int32           `json:"token_count"`
+	DocumentTitle    string          `json:"document_title"`
+	DocumentMetadata json.RawMessage `json:"document_metadata"`
+}
+
+func (q *Queries) GetDocumentChunksByIDs(ctx context.Context, arg GetDocumentChunksByIDsParams) ([]GetDocumentChunksByIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getDocumentChunksByIDs, pq.Array(arg.Ids), arg.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetDocumentChunksByIDsRow{}
+	for rows.Next() {
+		var i GetDocumentChunksByIDsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.DocumentID,
+			&i.Version,
+			&i.ChunkIndex,
+			&i.Heading,
+			&i.Content,
+			&i.StartOffset,
+			&i.EndOffset,
+			&i.TokenCount,
+			&i.DocumentTitle,
+			&i.DocumentMetadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDocumentVersionForIndexing = `-- name: GetDocumentVersionForIndexing :one
+SELECT
+    v.id, v.document_id, v.version, v.format, v.extraction_status,
+    v.extracted_text, v.index_status, d.tenant_id, d.current_version
+FROM document_versions v
+INNER JOIN documents d ON v.document_id = d.id
+WHERE v.id = $1::uuid AND d.deleted_at IS NULL
+LIMIT 1
+`
+
+type GetDocumentVersionForIndexingRow struct {
+	ID               uuid.UUID `json:"id"`
+	DocumentID       uuid.UUID `json:"document_id"`
+	Version          int32     `json:"version"`
+	Format           string    `json:"format"`
+	ExtractionStatus string    `json:"extraction_status"`
+	ExtractedText    string    `json:"extracted_text"`
+	IndexStatus      string    `json:"index_status"`
+	TenantID         uuid.UUID `json:"tenant_id"`
+	CurrentVersion   int32     `json:"current_version"`
+}
+
+func (q *Queries) GetDocumentVersionForIndexing(ctx context.Context, id uuid.UUID) (GetDocumentVersionForIndexingRow, error) {
+	row := q.db.QueryRowContext(ctx, getDocumentVersionForIndexing, id)
+	var i GetDocumentVersionForIndexingRow
+	err := row.Scan(
+		&i.ID,
+		&i.DocumentID,
+		&i.Version,
+		&i.Format,
+		&i.ExtractionStatus,
+		&i.ExtractedText,
+		&i.IndexStatus,
+		&i.TenantID,
+		&i.CurrentVersion,
+	)
+	return i, err
+}
+
+const getVectorExtensionInstalled = `-- name: GetVectorExtensionInstalled :one
+SELECT (to_regtype('vector') IS NOT NULL)::boolean AS installed
+`
+
+func (q *Queries) GetVectorExtensionInstalled(ctx context.Context) (bool, error) {
+	row := q.db.QueryRowContext(ctx, getVectorExtensionInstalled)
+	var installed bool
+	err := row.Scan(&installed)
+	return installed, err
+}
+
+const listDocumentChunkEmbeddings = `-- name: ListDocumentChunkEmbeddings :many
+SELECT c.id, c.embedding
+FROM document_chunks c
+INNER JOIN documents d ON c.document_id = d.id
+WHERE c.tenant_id = $1::uuid
+    AND c.id > $2::uuid
+    AND d.tenant_id = $1::uuid
+    AND d.deleted_at IS NULL
+    AND c.version = d.current_version
+    AND c.embedding_model = $3
+    AND d.metadata @> $4::jsonb
+    AND (cardinality($5::uuid[]) = 0 OR c.document_id = ANY($5::uuid[]))
+ORDER BY c.id
+LIMIT $6
+`
+
+type ListDocumentChunkEmbeddingsParams struct {
+	TenantID       uuid.UUID       `json:"tenant_id"`
+	AfterID        uuid.UUID       `json:"after_id"`
+	EmbeddingModel string          `json:"embedding_model"`
+	Metadata       json.RawMessage `json:"metadata"`
+	DocumentIds    []uuid.UUID     `json:"document_ids"`
+	RowLimit       int32           `json:"row_limit"`
+}
+
+type ListDocumentChunkEmbeddingsRow struct {
+	ID        uuid.UUID `json:"id"`
+	Embedding []float32 `json:"embedding"`
+}
+
+// Pages through the searchable embeddings of a tenant for the in-process
+// fallback when pgvector is not installed.
+func (q *Queries) ListDocumentChunkEmbeddings(ctx context.Context, arg ListDocumentChunkEmbeddingsParams) ([]ListDocumentChunkEmbeddingsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDocumentChunkEmbeddings,
+		arg.TenantID,
+		arg.AfterID,
+		arg.EmbeddingModel,
+		arg.Metadata,
+		pq.Array(arg.DocumentIds),
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListDocumentChunkEmbeddingsRow{}
+	for rows.Next() {
+		var i ListDocumentChunkEmbeddingsRow
+		if err := rows.Scan(&i.ID, pq.Array(&i.Embedding)); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDocumentChunks = `-- name: ListDocumentChunks :many
+SELECT
+    c.id, c.chunk_index, c.heading, c.content, c.start_offset, c.end_offset,
+    c.token_count, c.embedding_model, c.created_at
+FROM document_chunks c
+INNER JOIN documents d ON c.document_id = d.id
+WHERE c.document_id = $1::uuid
+    AND c.version = $2
+    AND c.tenant_id = $3::uuid
+    AND d.deleted_at IS NULL
+ORDER BY c.chunk_index
+`
+
+type ListDocumentChunksParams struct {
+	DocumentID uuid.UUID `json:"document_id"`
+	Version    int32     `json:"version"`
+	TenantID   uuid.UUID `json:"tenant_id"`
+}
+
+type ListDocumentChunksRow struct {
+	ID             uuid.UUID `json:"id"`
+	ChunkIndex     int32     `json:"chunk_index"`
+	Heading        string    `json:"heading"`
+	Content        string    `json:"content"`
+	StartOffset    int32     `json:"start_offset"`
+	EndOffset      int32     `json:"end_offset"`
+	TokenCount     int32     `json:"token_count"`
+	EmbeddingModel string    `json:"embedding_model"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+func (q *Queries) ListDocumentChunks(ctx context.Context, arg ListDocumentChunksParams) ([]ListDocumentChunksRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDocumentChunks, arg.DocumentID, arg.Version, arg.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListDocumentChunksRow{}
+	for rows.Next() {
+		var i ListDocumentChunksRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChunkIndex,
+			&i.Heading,
+			&i.Content,
+			&i.StartOffset,
+			&i.EndOffset,
+			&i.TokenCount,
+			&i.EmbeddingModel,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchDocumentChunksByKeyword = `-- name: SearchDocumentChunksByKeyword :many
+SELECT c.id, ts_rank_cd(c.search_vector, query, 1)::float8 AS rank
+FROM document_chunks c
+INNER JOIN documents d ON c.document_id = d.id,
+    websearch_to_tsquery('simple', $1::text) query
+WHERE c.tenant_id = $2::uuid
+    AND c.search_vector @@ query
+    AND d.tenant_id = $2::uuid
+    AND d.deleted_at IS NULL
+    AND c.version = d.current_version
+    AND d.metadata @> $3::jsonb
+    AND (cardinality($4::uuid[]) = 0 OR c.document_id = ANY($4::uuid[]))
+ORDER BY rank DESC, c.id
+LIMIT $5
+`
+
+type SearchDocumentChunksByKeywordParams struct {
+	Query       string          `json:"query"`
+	TenantID    uuid.UUID       `json:"tenant_id"`
+	Metadata    json.RawMessage `json:"metadata"`
+	DocumentIds []uuid.UUID     `json:"document_ids"`
+	RowLimit    int32           `json:"row_limit"`
+}
+
+type SearchDocumentChunksByKeywordRow struct {
+	ID   uuid.UUID `json:"id"`
+	Rank float64   `json:"rank"`
+}
+
+// Ranks by ts_rank_cd, which rewards terms that occur often and close
+// together; normalization 1 divides by the log of the chunk length so long
+// chunks do not win by size alone.
+func (q *Queries) SearchDocumentChunksByKeyword(ctx context.Context, arg SearchDocumentChunksByKeywordParams) ([]SearchDocumentChunksByKeywordRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchDocumentChunksByKeyword,
+		arg.Query,
+		arg.TenantID,
+		arg.Metadata,
+		pq.Array(arg.DocumentIds),
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchDocumentChunksByKeywordRow{}
+	for rows.Next() {
+		var i SearchDocumentChunksByKeywordRow
+		if err := rows.Scan(&i.ID, &i.Rank); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchDocumentChunksByVector = `-- name: SearchDocumentChunksByVector :many
+SELECT c.id, (c.embedding::vector <=> $1::real[]::vector)::float8 AS distance
+FROM document_chunks c
+INNER JOIN documents d ON c.document_id = d.id
+WHERE c.tenant_id = $2::uuid
+    AND cardinality(c.embedding) = cardinality($1::real[])
+    AND d.tenant_id = $2::uuid
+    AND d.deleted_at IS NULL
+    AND c.version = d.current_version
+    AND c.embedding_model = $3
+    AND d.metadata @> $4::jsonb
+    AND (cardinality($5::uuid[]) = 0 OR c.document_id = ANY($5::uuid[]))
+ORDER BY c.embedding::vector <=> $1::real[]::vector
+LIMIT $6
+`
+
+type SearchDocumentChunksByVectorParams struct {
+	QueryEmbedding []float32       `json:"query_embedding"`
+	TenantID       uuid.UUID       `json:"tenant_id"`
+	EmbeddingModel string          `json:"embedding_model"`
+	Metadata       json.RawMessage `json:"metadata"`
+	DocumentIds    []uuid.UUID     `json:"document_ids"`
+	RowLimit       int32           `json:"row_limit"`
+}
+
+type SearchDocumentChunksByVectorRow struct {
+	ID       uuid.UUID `json:"id"`
+	Distance float64   `json:"distance"`
+}
+
+// Exact search for embedding sizes without an index
+func (q *Queries) SearchDocumentChunksByVector(ctx context.Context, arg SearchDocumentChunksByVectorParams) ([]SearchDocumentChunksByVectorRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchDocumentChunksByVector,
+		pq.Array(arg.QueryEmbedding),
+		arg.TenantID,
+		arg.EmbeddingModel,
+		arg.Metadata,
+		pq.Array(arg.DocumentIds),
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchDocumentChunksByVectorRow{}
+	for rows.Next() {
+		var i SearchDocumentChunksByVectorRow
+		if err := rows.Scan(&i.ID, &i.Distance); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchDocumentChunksByVector1536 = `-- name: SearchDocumentChunksByVector1536 :many
+SELECT c.id, (c.embedding::vector(1536) <=> $1::real[]::vector(1536))::float8 AS distance
+FROM document_chunks c
+INNER JOIN documents d ON c.document_id = d.id
+WHERE c.tenant_id = $2::uuid
+    AND cardinality(c.embedding) = 1536
+    AND d.tenant_id = $2::uuid
+    AND d.deleted_at IS NULL
+    AND c.version = d.current_version
+    AND c.embedding_model = $3
+    AND d.metadata @> $4::jsonb
+    AND (cardinality($5::uuid[]) = 0 OR c.document_id = ANY($5::uuid[]))
+ORDER BY c.embedding::vector(1536) <=> $1::real[]::vector(1536)
+LIMIT $6
+`
+
+type SearchDocumentChunksByVector1536Params struct {
+	QueryEmbedding []float32       `json:"query_embedding"`
+	TenantID       uuid.UUID       `json:"tenant_id"`
+	EmbeddingModel string          `json:"embedding_model"`
+	Metadata       json.RawMessage `json:"metadata"`
+	DocumentIds    []uuid.UUID     `json:"document_ids"`
+	RowLimit       int32           `json:"row_limit"`
+}
+
+type SearchDocumentChunksByVector1536Row struct {
+	ID       uuid.UUID `json:"id"`
+	Distance float64   `json:"distance"`
+}
+
+func (q *Queries) SearchDocumentChunksByVector1536(ctx context.Context, arg SearchDocumentChunksByVector1536Params) ([]SearchDocumentChunksByVector1536Row, error) {
+	rows, err := q.db.QueryContext(ctx, searchDocumentChunksByVector1536,
+		pq.Array(arg.QueryEmbedding),
+		arg.TenantID,
+		arg.EmbeddingModel,
+		arg.Metadata,
+		pq.Array(arg.DocumentIds),
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchDocumentChunksByVector1536Row{}
+	for rows.Next() {
+		var i SearchDocumentChunksByVector1536Row
+		if err := rows.Scan(&i.ID, &i.Distance); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchDocumentChunksByVector256 = `-- name: SearchDocumentChunksByVector256 :many
+SELECT c.id, (c.embedding::vector(256) <=> $1::real[]::vector(256))::float8 AS distance
+FROM document_chunks c
+INNER JOIN documents d ON c.document_id = d.id
+WHERE c.tenant_id = $2::uuid
+    AND cardinality(c.embedding) = 256
+    AND d.tenant_id = $2::uuid
+    AND d.deleted_at IS NULL
+    AND c.version = d.current_version
+    AND c.embedding_model = $3
+    AND d.metadata @> $4::jsonb
+    AND (cardinality($5::uuid[]) = 0 OR c.document_id = ANY($5::uuid[]))
+ORDER BY c.embedding::vector(256) <=> $1::real[]::vector(256)
+LIMIT $6
+`
+
+type SearchDocumentChunksByVector256Params struct {
+	QueryEmbedding []float32       `json:"query_embedding"`
+	TenantID       uuid.UUID       `json:"tenant_id"`
+	EmbeddingModel string          `json:"embedding_model"`
+	Metadata       json.RawMessage `json:"metadata"`
+	DocumentIds    []uuid.UUID     `json:"document_ids"`
+	RowLimit       int32           `json:"row_limit"`
+}
+
+type SearchDocumentChunksByVector256Row struct {
+	ID       uuid.UUID `json:"id"`
+	Distance float64   `json:"distance"`
+}
+
+// The vector queries need the pgvector extension. The sized variants match
+// the expression of the HNSW indexes and run after SetHNSWSearchScope.
+func (q *Queries) SearchDocumentChunksByVector256(ctx context.Context, arg SearchDocumentChunksByVector256Params) ([]SearchDocumentChunksByVector256Row, error) {
+	rows, err := q.db.QueryContext(ctx, searchDocumentChunksByVector256,
+		pq.Array(arg.QueryEmbedding),
+		arg.TenantID,
+		arg.EmbeddingModel,
+		arg.Metadata,
+		pq.Array(arg.DocumentIds),
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchDocumentChunksByVector256Row{}
+	for rows.Next() {
+		var i SearchDocumentChunksByVector256Row
+		if err := rows.Scan(&i.ID, &i.Distance); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setDocumentVersionIndexStatus = `-- name: SetDocumentVersionIndexStatus :exec
+UPDATE document_versions
+SET index_status = $1,
+    index_error = $2
+WHERE id = $3::uuid
+`
+
+type SetDocumentVersionIndexStatusParams struct {
+	IndexStatus string         `json:"index_status"`
+	IndexError  sql.NullString `json:"index_error"`
+	ID          uuid.UUID      `json:"id"`
+}
+
+func (q *Queries) SetDocumentVersionIndexStatus(ctx context.Context, arg SetDocumentVersionIndexStatusParams) error {
+	_, err := q.db.ExecContext(ctx, setDocumentVersionIndexStatus, arg.IndexStatus, arg.IndexError, arg.ID)
+	return err
+}
+
+const setHNSWSearchScope = `-- name: SetHNSWSearchScope :exec
+SELECT set_config('hnsw.ef_search', $1::text, true),
+    CASE WHEN string_to_array(e.extversion, '.')::int[] >= ARRAY[0, 8]
+        THEN set_config('hnsw.iterative_scan', 'strict_order', true)
+    END
+FROM pg_catalog.pg_extension e
+WHERE e.extname = 'vector'
+`
+
+// Widens the HNSW scans of the current transaction. An HNSW scan applies the
+// tenant and metadata filters after the index; with iterative scans (pgvector
+// 0.8 and later) it keeps walking the graph until enough rows pass them
+// instead of stopping after ef_search candidates.
+func (q *Queries) SetHNSWSearchScope(ctx context.Context, efSearch string) error {
+	_, err := q.db.ExecContext(ctx, setHNSWSearchScope, efSearch)
+	return err
+}
+
+const startDocumentVersionIndexing = `-- name: StartDocumentVersionIndexing :execrows
+UPDATE document_versions
+SET index_status = 'indexing',
+    index_error = NULL
+WHERE id = $1::uuid AND index_status IN ('pending', 'failed', 'indexing')
+`
+
+// The job queue runs one attempt of a job at a time, so a row still in
+// progress was left by a worker that stopped and is taken over.
+func (q *Queries) StartDocumentVersionIndexing(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, startDocumentVersionIndexing, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,250 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: feature_flag.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+const deleteFeatureFlag = `-- name: DeleteFeatureFlag :exec
+DELETE FROM feature_flags
+WHERE key = $1
+`
+
+func (q *Queries) DeleteFeatureFlag(ctx context.Context, key string) error {
+	_, err := q.db.ExecContext(ctx, deleteFeatureFlag, key)
+	return err
+}
+
+const deleteOrganizationFeatureFlagOverride = `-- name: DeleteOrganizationFeatureFlagOverride :exec
+DELETE FROM feature_flag_overrides
+WHERE flag_key = $1 AND organization_id = $2::uuid
+`
+
+type DeleteOrganizationFeatureFlagOverrideParams struct {
+	FlagKey        string    `json:"flag_key"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+}
+
+func (q *Queries) DeleteOrganizationFeatureFlagOverride(ctx context.Context, arg DeleteOrganizationFeatureFlagOverrideParams) error {
+	_, err := q.db.ExecContext(ctx, deleteOrganizationFeatureFlagOverride, arg.FlagKey, arg.OrganizationID)
+	return err
+}
+
+const deleteTenantFeatureFlagOverride = `-- name: DeleteTenantFeatureFlagOverride :exec
+DELETE FROM feature_flag_overrides
+WHERE flag_key = $1 AND tenant_id = $2::uuid
+`
+
+type DeleteTenantFeatureFlagOverrideParams struct {
+	FlagKey  string    `json:"flag_key"`
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+func (q *Queries) DeleteTenantFeatureFlagOverride(ctx context.Context, arg DeleteTenantFeatureFlagOverrideParams) error {
+	_, err := q.db.ExecContext(ctx, deleteTenantFeatureFlagOverride, arg.FlagKey, arg.TenantID)
+	return err
+}
+
+const getFeatureFlag = `-- name: GetFeatureFlag :one
+SELECT key, description, enabled, rollout_percentage, created_at, updated_at FROM feature_flags
+WHERE key = $1
+LIMIT 1
+`
+
+func (q *Queries) GetFeatureFlag(ctx context.Context, key string) (FeatureFlag, error) {
+	row := q.db.QueryRowContext(ctx, getFeatureFlag, key)
+	var i FeatureFlag
+	err := row.Scan(
+		&i.Key,
+		&i.Description,
+		&i.Enabled,
+		&i.RolloutPercentage,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listFeatureFlagOverrides = `-- name: ListFeatureFlagOverrides :many
+SELECT id, flag_key, organization_id, tenant_id, enabled, created_at, updated_at FROM feature_flag_overrides
+ORDER BY flag_key, created_at
+`
+
+func (q *Queries) ListFeatureFlagOverrides(ctx context.Context) ([]FeatureFlagOverride, error) {
+	rows, err := q.db.QueryContext(ctx, listFeatureFlagOverrides)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FeatureFlagOverride{}
+	for rows.Next() {
+		var i FeatureFlagOverride
+		if err := rows.Scan(
+			&i.ID,
+			&i.FlagKey,
+			&i.OrganizationID,
+			&i.TenantID,
+			&i.Enabled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFeatureFlags = `-- name: ListFeatureFlags :many
+SELECT key, description, enabled, rollout_percentage, created_at, updated_at FROM feature_flags
+ORDER BY key
+`
+
+func (q *Queries) ListFeatureFlags(ctx context.Context) ([]FeatureFlag, error) {
+	rows, err := q.db.QueryContext(ctx, listFeatureFlags)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FeatureFlag{}
+	for rows.Next() {
+		var i FeatureFlag
+		if err := rows.Scan(
+			&i.Key,
+			&i.Description,
+			&i.Enabled,
+			&i.RolloutPercentage,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertFeatureFlag = `-- name: UpsertFeatureFlag :one
+INSERT INTO feature_flags (
+    key, description, enabled, rollout_percentage
+) VALUES (
+    $1, $2, $3, $4
+)
+ON CONFLICT (key) DO UPDATE
+SET description = EXCLUDED.description,
+    enabled = EXCLUDED.enabled,
+    rollout_percentage = EXCLUDED.rollout_percentage,
+    updated_at = NOW()
+RETURNING key, description, enabled, rollout_percentage, created_at, updated_at
+`
+
+type UpsertFeatureFlagParams struct {
+	Key               string         `json:"key"`
+	Description       sql.NullString `json:"description"`
+	Enabled           bool           `json:"enabled"`
+	RolloutPercentage int32          `json:"rollout_percentage"`
+}
+
+func (q *Queries) UpsertFeatureFlag(ctx context.Context, arg UpsertFeatureFlagParams) (FeatureFlag, error) {
+	row := q.db.QueryRowContext(ctx, upsertFeatureFlag,
+		arg.Key,
+		arg.Description,
+		arg.Enabled,
+		arg.RolloutPercentage,
+	)
+	var i FeatureFlag
+	err := row.Scan(
+		&i.Key,
+		&i.Description,
+		&i.Enabled,
+		&i.RolloutPercentage,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertOrganizationFeatureFlagOverride = `-- name: UpsertOrganizationFeatureFlagOverride :one
+INSERT INTO feature_flag_overrides (
+    flag_key, organization_id, enabled
+) VALUES (
+    $1, $2::uuid, $3
+)
+ON CONFLICT (flag_key, organization_id) WHERE organization_id IS NOT NULL DO UPDATE
+SET enabled = EXCLUDED.enabled,
+    updated_at = NOW()
+RETURNING id, flag_key, organization_id, tenant_id, enabled, created_at, updated_at
+`
+
+type UpsertOrganizationFeatureFlagOverrideParams struct {
+	FlagKey        string    `json:"flag_key"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Enabled        bool      `json:"enabled"`
+}
+
+func (q *Queries) UpsertOrganizationFeatureFlagOverride(ctx context.Context, arg UpsertOrganizationFeatureFlagOverrideParams) (FeatureFlagOverride, error) {
+	row := q.db.QueryRowContext(ctx, upsertOrganizationFeatureFlagOverride, arg.FlagKey, arg.OrganizationID, arg.Enabled)
+	var i FeatureFlagOverride
+	err := row.Scan(
+		&i.ID,
+		&i.FlagKey,
+		&i.OrganizationID,
+		&i.TenantID,
+		&i.Enabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertTenantFeatureFlagOverride = `-- name: UpsertTenantFeatureFlagOverride :one
+INSERT INTO feature_flag_overrides (
+    flag_key, tenant_id, enabled
+) VALUES (
+    $1, $2::uuid, $3
+)
+ON CONFLICT (flag_key, tenant_id) WHERE tenant_id IS NOT NULL DO UPDATE
+SET enabled = EXCLUDED.enabled,
+    updated_at = NOW()
+RETURNING id, flag_key, organization_id, tenant_id, enabled, created_at, updated_at
+`
+
+type UpsertTenantFeatureFlagOverrideParams struct {
+	FlagKey  string    `json:"flag_key"`
+	TenantID uuid.UUID `json:"tenant_id"`
+	Enabled  bool      `json:"enabled"`
+}
+
+func (q *Queries) UpsertTenantFeatureFlagOverride(ctx context.Context, arg UpsertTenantFeatureFlagOverrideParams) (FeatureFlagOverride, error) {
+	row := q.db.QueryRowContext(ctx, upsertTenantFeatureFlagOverride, arg.FlagKey, arg.TenantID, arg.Enabled)
+	var i FeatureFlagOverride
+	err := row.Scan(
+		&i.ID,
+		&i.FlagKey,
+		&i.OrganizationID,
+		&i.TenantID,
+		&i.Enabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,394 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: job.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const buryJob = `-- name: BuryJob :execrows
+UPDATE jobs
+SET status = 'dead',
+    last_error = $1::text,
+    locked_by = NULL,
+    locked_at = NULL,
+    finished_at = NOW(),
+    updated_at = NOW()
+WHERE id = $2::uuid AND status = 'running' AND locked_by = $3::text
+`
+
+type BuryJobParams struct {
+	LastError string    `json:"last_error"`
+	ID        uuid.UUID `json:"id"`
+	WorkerID  string    `json:"worker_id"`
+}
+
+func (q *Queries) BuryJob(ctx context.Context, arg BuryJobParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, buryJob, arg.LastError, arg.ID, arg.WorkerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const buryStaleJobs = `-- name: BuryStaleJobs :execrows
+UPDATE jobs
+SET status = 'dead',
+    last_error = 'worker stopped while running the job',
+    locked_by = NULL,
+    locked_at = NULL,
+    finished_at = NOW(),
+    updated_at = NOW()
+WHERE status = 'running' AND locked_at < $1::timestamp AND attempts >= max_attempts
+`
+
+func (q *Queries) BuryStaleJobs(ctx context.Context, staleBefore time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, buryStaleJobs, staleBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const claimJobs = `-- name: ClaimJobs :many
+UPDATE jobs
+SET status = 'running',
+    attempts = attempts + 1,
+    locked_by = $1::text,
+    locked_at = NOW(),
+    updated_at = NOW()
+WHERE id IN (
+    SELECT j.id FROM jobs j
+    WHERE (j.status = 'queued' AND j.run_at <= NOW())
+       OR (j.status = 'running' AND j.locked_at < $2::timestamp AND j.attempts < j.max_attempts)
+    ORDER BY j.run_at
+    LIMIT $3
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, kind, payload, status, attempts, max_attempts, run_at, locked_by, locked_at, last_error, organization_id, tenant_id, created_at, updated_at, finished_at
+`
+
+type ClaimJobsParams struct {
+	WorkerID    string    `json:"worker_id"`
+	StaleBefore time.Time `json:"stale_before"`
+	RowLimit    int32     `json:"row_limit"`
+}
+
+// Running jobs whose lock is older than stale_before belong to a worker that
+// stopped, and are claimed again while they have attempts left.
+func (q *Queries) ClaimJobs(ctx context.Context, arg ClaimJobsParams) ([]Job, error) {
+	rows, err := q.db.QueryContext(ctx, claimJobs, arg.WorkerID, arg.StaleBefore, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Job{}
+	for rows.Next() {
+		var i Job
+		if err := rows.Scan(
+			&i.ID,
+			&i.Kind,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.MaxAttempts,
+			&i.RunAt,
+			&i.LockedBy,
+			&i.LockedAt,
+			&i.LastError,
+			&i.OrganizationID,
+			&i.TenantID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.FinishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const completeJob = `-- name: CompleteJob :execrows
+UPDATE jobs
+SET status = 'succeeded',
+    locked_by = NULL,
+    locked_at = NULL,
+    finished_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1::uuid AND status = 'running' AND locked_by = $2::text
+`
+
+type CompleteJobParams struct {
+	ID       uuid.UUID `json:"id"`
+	WorkerID string    `json:"worker_id"`
+}
+
+func (q *Queries) CompleteJob(ctx context.Context, arg CompleteJobParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, completeJob, arg.ID, arg.WorkerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const countOrganizationJobs = `-- name: CountOrganizationJobs :one
+SELECT COUNT(*) FROM jobs
+WHERE organization_id = $1::uuid
+  AND ($2::text IS NULL OR status = $2::text)
+  AND ($3::text IS NULL OR kind = $3::text)
+`
+
+type CountOrganizationJobsParams struct {
+	OrganizationID uuid.UUID      `json:"organization_id"`
+	Status         sql.NullString `json:"status"`
+	Kind           sql.NullString `json:"kind"`
+}
+
+func (q *Queries) CountOrganizationJobs(ctx context.Context, arg CountOrganizationJobsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countOrganizationJobs, arg.OrganizationID, arg.Status, arg.Kind)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createJob = `-- name: CreateJob :one
+INSERT INTO jobs (
+    kind, payload, max_attempts, run_at, organization_id, tenant_id
+) VALUES (
+    $1, $2, $3, COALESCE($4::timestamp, NOW()), $5::uuid, $6::uuid
+)
+RETURNING id, kind, payload, status, attempts, max_attempts, run_at, locked_by, locked_at, last_error, organization_id, tenant_id, created_at, updated_at, finished_at
+`
+
+type CreateJobParams struct {
+	Kind           string          `json:"kind"`
+	Payload        json.RawMessage `json:"payload"`
+	MaxAttempts    int32           `json:"max_attempts"`
+	RunAt          sql.NullTime    `json:"run_at"`
+	OrganizationID uuid.NullUUID   `json:"organization_id"`
+	TenantID       uuid.NullUUID   `json:"tenant_id"`
+}
+
+func (q *Queries) CreateJob(ctx context.Context, arg CreateJobParams) (Job, error) {
+	row := q.db.QueryRowContext(ctx, createJob,
+		arg.Kind,
+		arg.Payload,
+		arg.MaxAttempts,
+		arg.RunAt,
+		arg.OrganizationID,
+		arg.TenantID,
+	)
+	var i Job
+	err := row.Scan(
+		&i.ID,
+		&i.Kind,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.RunAt,
+		&i.LockedBy,
+		&i.LockedAt,
+		&i.LastError,
+		&i.OrganizationID,
+		&i.TenantID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const deleteSucceededJobs = `-- name: DeleteSucceededJobs :execrows
+DELETE FROM jobs
+WHERE status = 'succeeded' AND finished_at < $1::timestamp
+`
+
+func (q *Queries) DeleteSucceededJobs(ctx context.Context, finishedBefore time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteSucceededJobs, finishedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getOrganizationJob = `-- name: GetOrganizationJob :one
+SELECT id, kind, payload, status, attempts, max_attempts, run_at, locked_by, locked_at, last_error, organization_id, tenant_id, created_at, updated_at, finished_at FROM jobs
+WHERE id = $1::uuid AND organization_id = $2::uuid
+LIMIT 1
+`
+
+type GetOrganizationJobParams struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+}
+
+func (q *Queries) GetOrganizationJob(ctx context.Context, arg GetOrganizationJobParams) (Job, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationJob, arg.ID, arg.OrganizationID)
+	var i Job
+	err := row.Scan(
+		&i.ID,
+		&i.Kind,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.RunAt,
+		&i.LockedBy,
+		&i.LockedAt,
+		&i.LastError,
+		&i.OrganizationID,
+		&i.TenantID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const listOrganizationJobs = `-- name: ListOrganizationJobs :many
+SELECT id, kind, payload, status, attempts, max_attempts, run_at, locked_by, locked_at, last_error, organization_id, tenant_id, created_at, updated_at, finished_at FROM jobs
+WHERE organization_id = $1::uuid
+  AND ($2::text IS NULL OR status = $2::text)
+  AND ($3::text IS NULL OR kind = $3::text)
+ORDER BY created_at DESC, id
+LIMIT $5 OFFSET $4
+`
+
+type ListOrganizationJobsParams struct {
+	OrganizationID uuid.UUID      `json:"organization_id"`
+	Status         sql.NullString `json:"status"`
+	Kind           sql.NullString `json:"kind"`
+	RowOffset      int32          `json:"row_offset"`
+	RowLimit       int32          `json:"row_limit"`
+}
+
+func (q *Queries) ListOrganizationJobs(ctx context.Context, arg ListOrganizationJobsParams) ([]Job, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizationJobs,
+		arg.OrganizationID,
+		arg.Status,
+		arg.Kind,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Job{}
+	for rows.Next() {
+		var i Job
+		if err := rows.Scan(
+			&i.ID,
+			&i.Kind,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.MaxAttempts,
+			&i.RunAt,
+			&i.LockedBy,
+			&i.LockedAt,
+			&i.LastError,
+			&i.OrganizationID,
+			&i.TenantID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.FinishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const requeueJob = `-- name: RequeueJob :one
+UPDATE jobs
+SET status = 'queued',
+    attempts = 0,
+    run_at = NOW(),
+    finished_at = NULL,
+    updated_at = NOW()
+WHERE id = $1::uuid AND organization_id = $2::uuid AND status = 'dead'
+RETURNING id, kind, payload, status, attempts, max_attempts, run_at, locked_by, locked_at, last_error, organization_id, tenant_id, created_at, updated_at, finished_at
+`
+
+type RequeueJobParams struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+}
+
+func (q *Queries) RequeueJob(ctx context.Context, arg RequeueJobParams) (Job, error) {
+	row := q.db.QueryRowContext(ctx, requeueJob, arg.ID, arg.OrganizationID)
+	var i Job
+	err := row.Scan(
+		&i.ID,
+		&i.Kind,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.RunAt,
+		&i.LockedBy,
+		&i.LockedAt,
+		&i.LastError,
+		&i.OrganizationID,
+		&i.TenantID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const retryJob = `-- name: RetryJob :execrows
+UPDATE jobs
+SET status = 'queued',
+    run_at = NOW() + make_interval(secs => $1::float8),
+    last_error = $2::text,
+    locked_by = NULL,
+    locked_at = NULL,
+    updated_at = NOW()
+WHERE id = $3::uuid AND status = 'running' AND locked_by = $4::text
+`
+
+type RetryJobParams struct {
+	DelaySeconds float64   `json:"delay_seconds"`
+	LastError    string    `json:"last_error"`
+	ID           uuid.UUID `json:"id"`
+	WorkerID     string    `json:"worker_id"`
+}
+
+func (q *Queries) RetryJob(ctx context.Context, arg RetryJobParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, retryJob,
+		arg.DelaySeconds,
+		arg.LastError,
+		arg.ID,
+		arg.WorkerID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,582 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: match.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const countMatchCandidates = `-- name: CountMatchCandidates :one
+SELECT COUNT(*) FROM match_candidates
+WHERE tenant_id = $1::uuid
+`
+
+func (q *Queries) CountMatchCandidates(ctx context.Context, tenantID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countMatchCandidates, tenantID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countMatchOpenings = `-- name: CountMatchOpenings :one
+SELECT COUNT(*) FROM match_openings
+WHERE tenant_id = $1::uuid
+`
+
+func (q *Queries) CountMatchOpenings(ctx context.Context, tenantID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countMatchOpenings, tenantID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createMatchCandidate = `-- name: CreateMatchCandidate :one
+INSERT INTO match_candidates (
+    tenant_id, name, attributes, description, embedding, embedding_model, created_by
+) VALUES (
+    $1::uuid, $2, $3, $4, $5::real[], $6, $7
+)
+RETURNING id, tenant_id, name, attributes, description, embedding, embedding_model, created_by, created_at, updated_at
+`
+
+type CreateMatchCandidateParams struct {
+	TenantID       uuid.UUID       `json:"tenant_id"`
+	Name           string          `json:"name"`
+	Attributes     json.RawMessage `json:"attributes"`
+	Description    string          `json:"description"`
+	Embedding      []float32       `json:"embedding"`
+	EmbeddingModel sql.NullString  `json:"embedding_model"`
+	CreatedBy      uuid.NullUUID   `json:"created_by"`
+}
+
+func (q *Queries) CreateMatchCandidate(ctx context.Context, arg CreateMatchCandidateParams) (MatchCandidate, error) {
+	row := q.db.QueryRowContext(ctx, createMatchCandidate,
+		arg.TenantID,
+		arg.Name,
+		arg.Attributes,
+		arg.Description,
+		pq.Array(arg.Embedding),
+		arg.EmbeddingModel,
+		arg.CreatedBy,
+	)
+	var i MatchCandidate
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Name,
+		&i.Attributes,
+		&i.Description,
+		pq.Array(&i.Embedding),
+		&i.EmbeddingModel,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createMatchOpening = `-- name: CreateMatchOpening :one
+INSERT INTO match_openings (
+    tenant_id, title, attributes, description, requirements, status, embedding, embedding_model, created_by
+) VALUES (
+    $1::uuid, $2, $3, $4, $5, $6, $7::real[], $8, $9
+)
+RETURNING id, tenant_id, title, attributes, description, requirements, status, embedding, embedding_model, created_by, created_at, updated_at
+`
+
+type CreateMatchOpeningParams struct {
+	TenantID       uuid.UUID       `json:"tenant_id"`
+	Title          string          `json:"title"`
+	Attributes     json.RawMessage `json:"attributes"`
+	Description    string          `json:"description"`
+	Requirements   json.RawMessage `json:"requirements"`
+	Status         string          `json:"status"`
+	Embedding      []float32       `json:"embedding"`
+	EmbeddingModel sql.NullString  `json:"embedding_model"`
+	CreatedBy      uuid.NullUUID   `json:"created_by"`
+}
+
+func (q *Queries) CreateMatchOpening(ctx context.Context, arg CreateMatchOpeningParams) (MatchOpening, error) {
+	row := q.db.QueryRowContext(ctx, createMatchOpening,
+		arg.TenantID,
+		arg.Title,
+		arg.Attributes,
+		arg.Description,
+		arg.Requirements,
+		arg.Status,
+		pq.Array(arg.Embedding),
+		arg.EmbeddingModel,
+		arg.CreatedBy,
+	)
+	var i MatchOpening
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Title,
+		&i.Attributes,
+		&i.Description,
+		&i.Requirements,
+		&i.Status,
+		pq.Array(&i.Embedding),
+		&i.EmbeddingModel,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteMatchCandidate = `-- name: DeleteMatchCandidate :execrows
+DELETE FROM match_candidates
+WHERE id = $1::uuid AND tenant_id = $2::uuid
+`
+
+type DeleteMatchCandidateParams struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+func (q *Queries) DeleteMatchCandidate(ctx context.Context, arg DeleteMatchCandidateParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteMatchCandidate, arg.ID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteMatchOpening = `-- name: DeleteMatchOpening :execrows
+DELETE FROM match_openings
+WHERE id = $1::uuid AND tenant_id = $2::uuid
+`
+
+type DeleteMatchOpeningParams struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+func (q *Queries) DeleteMatchOpening(ctx context.Context, arg DeleteMatchOpeningParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteMatchOpening, arg.ID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getMatchCandidate = `-- name: GetMatchCandidate :one
+SELECT id, tenant_id, name, attributes, description, embedding, embedding_model, created_by, created_at, updated_at FROM match_candidates
+WHERE id = $1::uuid AND tenant_id = $2::uuid
+LIMIT 1
+`
+
+type GetMatchCandidateParams struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+func (q *Queries) GetMatchCandidate(ctx context.Context, arg GetMatchCandidateParams) (MatchCandidate, error) {
+	row := q.db.QueryRowContext(ctx, getMatchCandidate, arg.ID, arg.TenantID)
+	var i MatchCandidate
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Name,
+		&i.Attributes,
+		&i.Description,
+		pq.Array(&i.Embedding),
+		&i.EmbeddingModel,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getMatchOpening = `-- name: GetMatchOpening :one
+SELECT id, tenant_id, title, attributes, description, requirements, status, embedding, embedding_model, created_by, created_at, updated_at FROM match_openings
+WHERE id = $1::uuid AND tenant_id = $2::uuid
+LIMIT 1
+`
+
+type GetMatchOpeningParams struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+func (q *Queries) GetMatchOpening(ctx context.Context, arg GetMatchOpeningParams) (MatchOpening, error) {
+	row := q.db.QueryRowContext(ctx, getMatchOpening, arg.ID, arg.TenantID)
+	var i MatchOpening
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Title,
+		&i.Attributes,
+		&i.Description,
+		&i.Requirements,
+		&i.Status,
+		pq.Array(&i.Embedding),
+		&i.EmbeddingModel,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listMatchCandidates = `-- name: ListMatchCandidates :many
+SELECT id, tenant_id, name, attributes, description, embedding, embedding_model, created_by, created_at, updated_at FROM match_candidates
+WHERE tenant_id = $1::uuid
+ORDER BY created_at DESC, id
+LIMIT $3 OFFSET $2
+`
+
+type ListMatchCandidatesParams struct {
+	TenantID  uuid.UUID `json:"tenant_id"`
+	RowOffset int32     `json:"row_offset"`
+	RowLimit  int32     `json:"row_limit"`
+}
+
+func (q *Queries) ListMatchCandidates(ctx context.Context, arg ListMatchCandidatesParams) ([]MatchCandidate, error) {
+	rows, err := q.db.QueryContext(ctx, listMatchCandidates, arg.TenantID, arg.RowOffset, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MatchCandidate{}
+	for rows.Next() {
+		var i MatchCandidate
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Name,
+			&i.Attributes,
+			&i.Description,
+			pq.Array(&i.Embedding),
+			&i.EmbeddingModel,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMatchCandidatesAfter = `-- name: ListMatchCandidatesAfter :many
+SELECT id, tenant_id, name, attributes, description, embedding, embedding_model, created_by, created_at, updated_at FROM match_candidates
+WHERE tenant_id = $1::uuid
+    AND id > $2::uuid
+    AND (cardinality($3::uuid[]) = 0 OR id = ANY($3::uuid[]))
+ORDER BY id
+LIMIT $4
+`
+
+type ListMatchCandidatesAfterParams struct {
+	TenantID     uuid.UUID   `json:"tenant_id"`
+	AfterID      uuid.UUID   `json:"after_id"`
+	CandidateIds []uuid.UUID `json:"candidate_ids"`
+	RowLimit     int32       `json:"row_limit"`
+}
+
+// Keyset pages over every candidate of a tenant for scoring
+func (q *Queries) ListMatchCandidatesAfter(ctx context.Context, arg ListMatchCandidatesAfterParams) ([]MatchCandidate, error) {
+	rows, err := q.db.QueryContext(ctx, listMatchCandidatesAfter,
+		arg.TenantID,
+		arg.AfterID,
+		pq.Array(arg.CandidateIds),
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MatchCandidate{}
+	for rows.Next() {
+		var i MatchCandidate
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Name,
+			&i.Attributes,
+			&i.Description,
+			pq.Array(&i.Embedding),
+			&i.EmbeddingModel,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMatchOpenings = `-- name: ListMatchOpenings :many
+SELECT id, tenant_id, title, attributes, description, requirements, status, embedding, embedding_model, created_by, created_at, updated_at FROM match_openings
+WHERE tenant_id = $1::uuid
+ORDER BY created_at DESC, id
+LIMIT $3 OFFSET $2
+`
+
+type ListMatchOpeningsParams struct {
+	TenantID  uuid.UUID `json:"tenant_id"`
+	RowOffset int32     `json:"row_offset"`
+	RowLimit  int32     `json:"row_limit"`
+}
+
+func (q *Queries) ListMatchOpenings(ctx context.Context, arg ListMatchOpeningsParams) ([]MatchOpening, error) {
+	rows, err := q.db.QueryContext(ctx, listMatchOpenings, arg.TenantID, arg.RowOffset, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MatchOpening{}
+	for rows.Next() {
+		var i MatchOpening
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Title,
+			&i.Attributes,
+			&i.Description,
+			&i.Requirements,
+			&i.Status,
+			pq.Array(&i.Embedding),
+			&i.EmbeddingModel,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOpenMatchOpeningsAfter = `-- name: ListOpenMatchOpeningsAfter :many
+SELECT id, tenant_id, title, attributes, description, requirements, status, embedding, embedding_model, created_by, created_at, updated_at FROM match_openings
+WHERE tenant_id = $1::uuid
+    AND status = 'open'
+    AND id > $2::uuid
+    AND (cardinality($3::uuid[]) = 0 OR id = ANY($3::uuid[]))
+ORDER BY id
+LIMIT $4
+`
+
+type ListOpenMatchOpeningsAfterParams struct {
+	TenantID   uuid.UUID   `json:"tenant_id"`
+	AfterID    uuid.UUID   `json:"after_id"`
+	OpeningIds []uuid.UUID `json:"opening_ids"`
+	RowLimit   int32       `json:"row_limit"`
+}
+
+// Keyset pages over the open openings of a tenant for scoring
+func (q *Queries) ListOpenMatchOpeningsAfter(ctx context.Context, arg ListOpenMatchOpeningsAfterParams) ([]MatchOpening, error) {
+	rows, err := q.db.QueryContext(ctx, listOpenMatchOpeningsAfter,
+		arg.TenantID,
+		arg.AfterID,
+		pq.Array(arg.OpeningIds),
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []MatchOpening{}
+	for rows.Next() {
+		var i MatchOpening
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Title,
+			&i.Attributes,
+			&i.Description,
+			&i.Requirements,
+			&i.Status,
+			pq.Array(&i.Embedding),
+			&i.EmbeddingModel,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setMatchCandidateEmbedding = `-- name: SetMatchCandidateEmbedding :exec
+UPDATE match_candidates
+SET embedding = $1::real[],
+    embedding_model = $2
+WHERE id = $3::uuid
+`
+
+type SetMatchCandidateEmbeddingParams struct {
+	Embedding      []float32      `json:"embedding"`
+	EmbeddingModel sql.NullString `json:"embedding_model"`
+	ID             uuid.UUID      `json:"id"`
+}
+
+func (q *Queries) SetMatchCandidateEmbedding(ctx context.Context, arg SetMatchCandidateEmbeddingParams) error {
+	_, err := q.db.ExecContext(ctx, setMatchCandidateEmbedding, pq.Array(arg.Embedding), arg.EmbeddingModel, arg.ID)
+	return err
+}
+
+const setMatchOpeningEmbedding = `-- name: SetMatchOpeningEmbedding :exec
+UPDATE match_openings
+SET embedding = $1::real[],
+    embedding_model = $2
+WHERE id = $3::uuid
+`
+
+type SetMatchOpeningEmbeddingParams struct {
+	Embedding      []float32      `json:"embedding"`
+	EmbeddingModel sql.NullString `json:"embedding_model"`
+	ID             uuid.UUID      `json:"id"`
+}
+
+func (q *Queries) SetMatchOpeningEmbedding(ctx context.Context, arg SetMatchOpeningEmbeddingParams) error {
+	_, err := q.db.ExecContext(ctx, setMatchOpeningEmbedding, pq.Array(arg.Embedding), arg.EmbeddingModel, arg.ID)
+	return err
+}
+
+const updateMatchCandidate = `-- name: UpdateMatchCandidate :one
+UPDATE match_candidates
+SET name = $1,
+    attributes = $2,
+    description = $3,
+    embedding = $4::real[],
+    embedding_model = $5,
+    updated_at = NOW()
+WHERE id = $6::uuid AND tenant_id = $7::uuid
+RETURNING id, tenant_id, name, attributes, description, embedding, embedding_model, created_by, created_at, updated_at
+`
+
+type UpdateMatchCandidateParams struct {
+	Name           string          `json:"name"`
+	Attributes     json.RawMessage `json:"attributes"`
+	Description    string          `json:"description"`
+	Embedding      []float32       `json:"embedding"`
+	EmbeddingModel sql.NullString  `json:"embedding_model"`
+	ID             uuid.UUID       `json:"id"`
+	TenantID       uuid.UUID       `json:"tenant_id"`
+}
+
+func (q *Queries) UpdateMatchCandidate(ctx context.Context, arg UpdateMatchCandidateParams) (MatchCandidate, error) {
+	row := q.db.QueryRowContext(ctx, updateMatchCandidate,
+		arg.Name,
+		arg.Attributes,
+		arg.Description,
+		pq.Array(arg.Embedding),
+		arg.EmbeddingModel,
+		arg.ID,
+		arg.TenantID,
+	)
+	var i MatchCandidate
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Name,
+		&i.Attributes,
+		&i.Description,
+		pq.Array(&i.Embedding),
+		&i.EmbeddingModel,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateMatchOpening = `-- name: UpdateMatchOpening :one
+UPDATE match_openings
+SET title = $1,
+    attributes = $2,
+    description = $3,
+    requirements = $4,
+    status = $5,
+    embedding = $6::real[],
+    embedding_model = $7,
+    updated_at = NOW()
+WHERE id = $8::uuid AND tenant_id = $9::uuid
+RETURNING id, tenant_id, title, attributes, description, requirements, status, embedding, embedding_model, created_by, created_at, updated_at
+`
+
+type UpdateMatchOpeningParams struct {
+	Title          string          `json:"title"`
+	Attributes     json.RawMessage `json:"attributes"`
+	Description    string          `json:"description"`
+	Requirements   json.RawMessage `json:"requirements"`
+	Status         string          `json:"status"`
+	Embedding      []float32       `json:"embedding"`
+	EmbeddingModel sql.NullString  `json:"embedding_model"`
+	ID             uuid.UUID       `json:"id"`
+	TenantID       uuid.UUID       `json:"tenant_id"`
+}
+
+func (q *Queries) UpdateMatchOpening(ctx context.Context, arg UpdateMatchOpeningParams) (MatchOpening, error) {
+	row := q.db.QueryRowContext(ctx, updateMatchOpening,
+		arg.Title,
+		arg.Attributes,
+		arg.Description,
+		arg.Requirements,
+		arg.Status,
+		pq.Array(arg.Embedding),
+		arg.EmbeddingModel,
+		arg.ID,
+		arg.TenantID,
+	)
+	var i MatchOpening
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Title,
+		&i.Attributes,
+		&i.Description,
+		&i.Requirements,
+		&i.Status,
+		pq.Array(&i.Embedding),
+		&i.EmbeddingModel,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -6,11 +6,149 @@ package db
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+type Conversation struct {
+	ID        uuid.UUID `json:"id"`
+	TenantID  uuid.UUID `json:"tenant_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Title     string    `json:"title"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Document struct {
+	ID             uuid.UUID       `json:"id"`
+	TenantID       uuid.UUID       `json:"tenant_id"`
+	Title          string          `json:"title"`
+	Metadata       json.RawMessage `json:"metadata"`
+	CurrentVersion int32           `json:"current_version"`
+	CreatedBy      uuid.NullUUID   `json:"created_by"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+	DeletedAt      sql.NullTime    `json:"deleted_at"`
+}
+
+type DocumentChunk struct {
+	ID             uuid.UUID   `json:"id"`
+	TenantID       uuid.UUID   `json:"tenant_id"`
+	DocumentID     uuid.UUID   `json:"document_id"`
+	Version        int32       `json:"version"`
+	ChunkIndex     int32       `json:"chunk_index"`
+	Heading        string      `json:"heading"`
+	Content        string      `json:"content"`
+	StartOffset    int32       `json:"start_offset"`
+	EndOffset      int32       `json:"end_offset"`
+	TokenCount     int32       `json:"token_count"`
+	Embedding      []float32   `json:"embedding"`
+	EmbeddingModel string      `json:"embedding_model"`
+	CreatedAt      time.Time   `json:"created_at"`
+	SearchVector   interface{} `json:"search_vector"`
+}
+
+type DocumentVersion struct {
+	ID               uuid.UUID      `json:"id"`
+	DocumentID       uuid.UUID      `json:"document_id"`
+	Version          int32          `json:"version"`
+	Filename         string         `json:"filename"`
+	ContentType      string         `json:"content_type"`
+	Format           string         `json:"format"`
+	SizeBytes        int64          `json:"size_bytes"`
+	Checksum         string         `json:"checksum"`
+	StorageKey       string         `json:"storage_key"`
+	ExtractionStatus string         `json:"extraction_status"`
+	ExtractionError  sql.NullString `json:"extraction_error"`
+	ExtractedText    string         `json:"extracted_text"`
+	CreatedBy        uuid.NullUUID  `json:"created_by"`
+	CreatedAt        time.Time      `json:"created_at"`
+	IndexStatus      string         `json:"index_status"`
+	IndexError       sql.NullString `json:"index_error"`
+	ChunkCount       int32          `json:"chunk_count"`
+	EmbeddingModel   sql.NullString `json:"embedding_model"`
+	IndexedAt        sql.NullTime   `json:"indexed_at"`
+}
+
+type FeatureFlag struct {
+	Key               string         `json:"key"`
+	Description       sql.NullString `json:"description"`
+	Enabled           bool           `json:"enabled"`
+	RolloutPercentage int32          `json:"rollout_percentage"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+}
+
+type FeatureFlagOverride struct {
+	ID             uuid.UUID     `json:"id"`
+	FlagKey        string        `json:"flag_key"`
+	OrganizationID uuid.NullUUID `json:"organization_id"`
+	TenantID       uuid.NullUUID `json:"tenant_id"`
+	Enabled        bool          `json:"enabled"`
+	CreatedAt      time.Time     `json:"created_at"`
+	UpdatedAt      time.Time     `json:"updated_at"`
+}
+
+type Job struct {
+	ID             uuid.UUID       `json:"id"`
+	Kind           string          `json:"kind"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int32           `json:"attempts"`
+	MaxAttempts    int32           `json:"max_attempts"`
+	RunAt          time.Time       `json:"run_at"`
+	LockedBy       sql.NullString  `json:"locked_by"`
+	LockedAt       sql.NullTime    `json:"locked_at"`
+	LastError      sql.NullString  `json:"last_error"`
+	OrganizationID uuid.NullUUID   `json:"organization_id"`
+	TenantID       uuid.NullUUID   `json:"tenant_id"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+	FinishedAt     sql.NullTime    `json:"finished_at"`
+}
+
+type MatchCandidate struct {
+	ID             uuid.UUID       `json:"id"`
+	TenantID       uuid.UUID       `json:"tenant_id"`
+	Name           string          `json:"name"`
+	Attributes     json.RawMessage `json:"attributes"`
+	Description    string          `json:"description"`
+	Embedding      []float32       `json:"embedding"`
+	EmbeddingModel sql.NullString  `json:"embedding_model"`
+	CreatedBy      uuid.NullUUID   `json:"created_by"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+type MatchOpening struct {
+	ID             uuid.UUID       `json:"id"`
+	TenantID       uuid.UUID       `json:"tenant_id"`
+	Title          string          `json:"title"`
+	Attributes     json.RawMessage `json:"attributes"`
+	Description    string          `json:"description"`
+	Requirements   json.RawMessage `json:"requirements"`
+	Status         string          `json:"status"`
+	Embedding      []float32       `json:"embedding"`
+	EmbeddingModel sql.NullString  `json:"embedding_model"`
+	CreatedBy      uuid.NullUUID   `json:"created_by"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+type Message struct {
+	ID             uuid.UUID       `json:"id"`
+	ConversationID uuid.UUID       `json:"conversation_id"`
+	Role           string          `json:"role"`
+	Content        string          `json:"content"`
+	RewrittenQuery sql.NullString  `json:"rewritten_query"`
+	Citations      json.RawMessage `json:"citations"`
+	Model          sql.NullString  `json:"model"`
+	TokenCount     int32           `json:"token_count"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
 type Organization struct {
 	ID          uuid.UUID      `json:"id"`
 	Name        string         `json:"name"`
@@ -20,6 +158,105 @@ type Organization struct {
 	UpdatedAt   time.Time      `json:"updated_at"`
 }
 
+type OrganizationApiUsage struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	PeriodStart    time.Time `json:"period_start"`
+	CallCount      int64     `json:"call_count"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type OrganizationExport struct {
+	ID             uuid.UUID      `json:"id"`
+	OrganizationID uuid.UUID      `json:"organization_id"`
+	RequestedBy    uuid.NullUUID  `json:"requested_by"`
+	Status         string         `json:"status"`
+	StorageKey     sql.NullString `json:"storage_key"`
+	SizeBytes      sql.NullInt64  `json:"size_bytes"`
+	ErrorMessage   sql.NullString `json:"error_message"`
+	ExpiresAt      sql.NullTime   `json:"expires_at"`
+	StartedAt      sql.NullTime   `json:"started_at"`
+	CompletedAt    sql.NullTime   `json:"completed_at"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+}
+
+type OrganizationMember struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	Role           string    `json:"role"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type OrganizationOrigin struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Origin         string    `json:"origin"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type OrganizationOwnershipTransfer struct {
+	ID             uuid.UUID    `json:"id"`
+	OrganizationID uuid.UUID    `json:"organization_id"`
+	FromUserID     uuid.UUID    `json:"from_user_id"`
+	ToUserID       uuid.UUID    `json:"to_user_id"`
+	Status         string       `json:"status"`
+	ExpiresAt      time.Time    `json:"expires_at"`
+	ResolvedAt     sql.NullTime `json:"resolved_at"`
+	CreatedAt      time.Time    `json:"created_at"`
+}
+
+type OrganizationSetting struct {
+	OrganizationID uuid.UUID       `json:"organization_id"`
+	Key            string          `json:"key"`
+	Value          json.RawMessage `json:"value"`
+	Version        int32           `json:"version"`
+	UpdatedBy      uuid.NullUUID   `json:"updated_by"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+type OrganizationSubscription struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	PlanCode       string    `json:"plan_code"`
+	Status         string    `json:"status"`
+	StartedAt      time.Time `json:"started_at"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type Plan struct {
+	Code                string        `json:"code"`
+	Name                string        `json:"name"`
+	MaxTenants          sql.NullInt32 `json:"max_tenants"`
+	MaxSeatsPerTenant   sql.NullInt32 `json:"max_seats_per_tenant"`
+	MaxApiCallsPerMonth sql.NullInt64 `json:"max_api_calls_per_month"`
+	CreatedAt           time.Time     `json:"created_at"`
+	UpdatedAt           time.Time     `json:"updated_at"`
+}
+
+type RateLimitCounter struct {
+	Key       string    `json:"key"`
+	Count     int64     `json:"count"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type TaskRun struct {
+	ID           uuid.UUID      `json:"id"`
+	TaskName     string         `json:"task_name"`
+	Trigger      string         `json:"trigger"`
+	ScheduledFor sql.NullTime   `json:"scheduled_for"`
+	Status       string         `json:"status"`
+	Summary      sql.NullString `json:"summary"`
+	ErrorMessage sql.NullString `json:"error_message"`
+	TriggeredBy  uuid.NullUUID  `json:"triggered_by"`
+	WorkerID     string         `json:"worker_id"`
+	StartedAt    time.Time      `json:"started_at"`
+	FinishedAt   sql.NullTime   `json:"finished_at"`
+}
+
 type Tenant struct {
 	ID             uuid.UUID `json:"id"`
 	OrganizationID uuid.UUID `json:"organization_id"`
@@ -30,6 +267,34 @@ type Tenant struct {
 	UpdatedAt      time.Time `json:"updated_at"`
 }
 
+type TenantDomain struct {
+	ID                uuid.UUID    `json:"id"`
+	TenantID          uuid.UUID    `json:"tenant_id"`
+	Domain            string       `json:"domain"`
+	VerificationToken string       `json:"verification_token"`
+	IsPrimary         bool         `json:"is_primary"`
+	VerifiedAt        sql.NullTime `json:"verified_at"`
+	CreatedAt         time.Time    `json:"created_at"`
+	UpdatedAt         time.Time    `json:"updated_at"`
+}
+
+type TenantSetting struct {
+	TenantID  uuid.UUID       `json:"tenant_id"`
+	Key       string          `json:"key"`
+	Value     json.RawMessage `json:"value"`
+	Version   int32           `json:"version"`
+	UpdatedBy uuid.NullUUID   `json:"updated_by"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+type TenantSubdomainAlias struct {
+	Subdomain string    `json:"subdomain"`
+	TenantID  uuid.UUID `json:"tenant_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
 type TenantUser struct {
 	ID        uuid.UUID      `json:"id"`
 	TenantID  uuid.UUID      `json:"tenant_id"`
@@ -39,6 +304,17 @@ type TenantUser struct {
 	UpdatedAt time.Time      `json:"updated_at"`
 }
 
+type UsageRecord struct {
+	ID             uuid.UUID     `json:"id"`
+	OrganizationID uuid.UUID     `json:"organization_id"`
+	TenantID       uuid.NullUUID `json:"tenant_id"`
+	Metric         string        `json:"metric"`
+	UsageDate      time.Time     `json:"usage_date"`
+	Quantity       int64         `json:"quantity"`
+	CreatedAt      time.Time     `json:"created_at"`
+	UpdatedAt      time.Time     `json:"updated_at"`
+}
+
 type User struct {
 	ID            uuid.UUID      `json:"id"`
 	CognitoID     string         `json:"cognito_id"`
@@ -49,3 +325,74 @@ type User struct {
 	CreatedAt     time.Time      `json:"created_at"`
 	UpdatedAt     time.Time      `json:"updated_at"`
 }
+
+type UserDailyActivity struct {
+	ActivityDate   time.Time `json:"activity_date"`
+	TenantID       uuid.UUID `json:"tenant_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type UserImport struct {
+	ID             uuid.UUID     `json:"id"`
+	OrganizationID uuid.UUID     `json:"organization_id"`
+	CreatedBy      uuid.NullUUID `json:"created_by"`
+	Status         string        `json:"status"`
+	TotalRows      int32         `json:"total_rows"`
+	SucceededRows  int32         `json:"succeeded_rows"`
+	FailedRows     int32         `json:"failed_rows"`
+	StartedAt      sql.NullTime  `json:"started_at"`
+	CompletedAt    sql.NullTime  `json:"completed_at"`
+	CreatedAt      time.Time     `json:"created_at"`
+	UpdatedAt      time.Time     `json:"updated_at"`
+}
+
+type UserImportRow struct {
+	ID        uuid.UUID      `json:"id"`
+	ImportID  uuid.UUID      `json:"import_id"`
+	RowNumber int32          `json:"row_number"`
+	Email     string         `json:"email"`
+	FirstName string         `json:"first_name"`
+	LastName  string         `json:"last_name"`
+	TenantID  uuid.UUID      `json:"tenant_id"`
+	Role      string         `json:"role"`
+	Status    string         `json:"status"`
+	Message   sql.NullString `json:"message"`
+	UserID    uuid.NullUUID  `json:"user_id"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+type WebhookDelivery struct {
+	ID             uuid.UUID       `json:"id"`
+	EndpointID     uuid.UUID       `json:"endpoint_id"`
+	EventID        uuid.UUID       `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int32           `json:"attempts"`
+	ResponseStatus sql.NullInt32   `json:"response_status"`
+	ResponseBody   sql.NullString  `json:"response_body"`
+	ErrorMessage   sql.NullString  `json:"error_message"`
+	DurationMs     sql.NullInt32   `json:"duration_ms"`
+	RedeliveryOf   uuid.NullUUID   `json:"redelivery_of"`
+	LastAttemptAt  sql.NullTime    `json:"last_attempt_at"`
+	DeliveredAt    sql.NullTime    `json:"delivered_at"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+type WebhookEndpoint struct {
+	ID                      uuid.UUID      `json:"id"`
+	OrganizationID          uuid.UUID      `json:"organization_id"`
+	Url                     string         `json:"url"`
+	Description             string         `json:"description"`
+	EventTypes              []string       `json:"event_types"`
+	Secret                  string         `json:"secret"`
+	PreviousSecret          sql.NullString `json:"previous_secret"`
+	PreviousSecretExpiresAt sql.NullTime   `json:"previous_secret_expires_at"`
+	IsActive                bool           `json:"is_active"`
+	CreatedBy               uuid.NullUUID  `json:"created_by"`
+	CreatedAt               time.Time      `json:"created_at"`
+	UpdatedAt               time.Time      `json:"updated_at"`
+}
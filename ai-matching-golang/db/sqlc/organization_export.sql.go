@@ -0,0 +1,501 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: organization_export.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const clearOrganizationExportStorage = `-- name: ClearOrganizationExportStorage :exec
+UPDATE organization_exports
+SET storage_key = NULL,
+    updated_at = NOW()
+WHERE id = $1::uuid
+`
+
+func (q *Queries) ClearOrganizationExportStorage(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, clearOrganizationExportStorage, id)
+	return err
+}
+
+const completeOrganizationExport = `-- name: CompleteOrganizationExport :exec
+UPDATE organization_exports
+SET status = 'completed',
+    storage_key = $1,
+    size_bytes = $2,
+    expires_at = $3,
+    completed_at = NOW(),
+    updated_at = NOW()
+WHERE id = $4::uuid
+`
+
+type CompleteOrganizationExportParams struct {
+	StorageKey sql.NullString `json:"storage_key"`
+	SizeBytes  sql.NullInt64  `json:"size_bytes"`
+	ExpiresAt  sql.NullTime   `json:"expires_at"`
+	ID         uuid.UUID      `json:"id"`
+}
+
+func (q *Queries) CompleteOrganizationExport(ctx context.Context, arg CompleteOrganizationExportParams) error {
+	_, err := q.db.ExecContext(ctx, completeOrganizationExport,
+		arg.StorageKey,
+		arg.SizeBytes,
+		arg.ExpiresAt,
+		arg.ID,
+	)
+	return err
+}
+
+const createOrganizationExport = `-- name: CreateOrganizationExport :one
+INSERT INTO organization_exports (
+    organization_id, requested_by
+) VALUES (
+    $1::uuid, $2
+)
+RETURNING id, organization_id, requested_by, status, storage_key, size_bytes, error_message, expires_at, started_at, completed_at, created_at, updated_at
+`
+
+type CreateOrganizationExportParams struct {
+	OrganizationID uuid.UUID     `json:"organization_id"`
+	RequestedBy    uuid.NullUUID `json:"requested_by"`
+}
+
+func (q *Queries) CreateOrganizationExport(ctx context.Context, arg CreateOrganizationExportParams) (OrganizationExport, error) {
+	row := q.db.QueryRowContext(ctx, createOrganizationExport, arg.OrganizationID, arg.RequestedBy)
+	var i OrganizationExport
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.RequestedBy,
+		&i.Status,
+		&i.StorageKey,
+		&i.SizeBytes,
+		&i.ErrorMessage,
+		&i.ExpiresAt,
+		&i.StartedAt,
+		&i.CompletedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const failOrganizationExport = `-- name: FailOrganizationExport :exec
+UPDATE organization_exports
+SET status = 'failed',
+    error_message = $1,
+    completed_at = NOW(),
+    updated_at = NOW()
+WHERE id = $2::uuid
+`
+
+type FailOrganizationExportParams struct {
+	ErrorMessage sql.NullString `json:"error_message"`
+	ID           uuid.UUID      `json:"id"`
+}
+
+func (q *Queries) FailOrganizationExport(ctx context.Context, arg FailOrganizationExportParams) error {
+	_, err := q.db.ExecContext(ctx, failOrganizationExport, arg.ErrorMessage, arg.ID)
+	return err
+}
+
+const getOrganizationExport = `-- name: GetOrganizationExport :one
+SELECT id, organization_id, requested_by, status, storage_key, size_bytes, error_message, expires_at, started_at, completed_at, created_at, updated_at FROM organization_exports
+WHERE id = $1::uuid AND organization_id = $2::uuid
+LIMIT 1
+`
+
+type GetOrganizationExportParams struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+}
+
+func (q *Queries) GetOrganizationExport(ctx context.Context, arg GetOrganizationExportParams) (OrganizationExport, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationExport, arg.ID, arg.OrganizationID)
+	var i OrganizationExport
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.RequestedBy,
+		&i.Status,
+		&i.StorageKey,
+		&i.SizeBytes,
+		&i.ErrorMessage,
+		&i.ExpiresAt,
+		&i.StartedAt,
+		&i.CompletedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getOrganizationExportByID = `-- name: GetOrganizationExportByID :one
+SELECT id, organization_id, requested_by, status, storage_key, size_bytes, error_message, expires_at, started_at, completed_at, created_at, updated_at FROM organization_exports
+WHERE id = $1::uuid
+LIMIT 1
+`
+
+func (q *Queries) GetOrganizationExportByID(ctx context.Context, id uuid.UUID) (OrganizationExport, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationExportByID, id)
+	var i OrganizationExport
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.RequestedBy,
+		&i.Status,
+		&i.StorageKey,
+		&i.SizeBytes,
+		&i.ErrorMessage,
+		&i.ExpiresAt,
+		&i.StartedAt,
+		&i.CompletedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listExpiredOrganizationExports = `-- name: ListExpiredOrganizationExports :many
+SELECT id, organization_id, requested_by, status, storage_key, size_bytes, error_message, expires_at, started_at, completed_at, created_at, updated_at FROM organization_exports
+WHERE status = 'completed' AND expires_at <= NOW() AND storage_key IS NOT NULL
+ORDER BY expires_at
+LIMIT $1
+`
+
+func (q *Queries) ListExpiredOrganizationExports(ctx context.Context, rowLimit int32) ([]OrganizationExport, error) {
+	rows, err := q.db.QueryContext(ctx, listExpiredOrganizationExports, rowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OrganizationExport{}
+	for rows.Next() {
+		var i OrganizationExport
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.RequestedBy,
+			&i.Status,
+			&i.StorageKey,
+			&i.SizeBytes,
+			&i.ErrorMessage,
+			&i.ExpiresAt,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrganizationAuditEvents = `-- name: ListOrganizationAuditEvents :many
+SELECT occurred_at, event_type, actor_id, subject_id, detail FROM (
+    SELECT
+        ot.created_at AS occurred_at,
+        'ownership_transfer.requested'::text AS event_type,
+        ot.from_user_id::text AS actor_id,
+        ot.to_user_id::text AS subject_id,
+        ''::text AS detail
+    FROM organization_ownership_transfers ot
+    WHERE ot.organization_id = $1::uuid
+    UNION ALL
+    SELECT
+        ot.resolved_at,
+        'ownership_transfer.' || ot.status,
+        CASE WHEN ot.status = 'accepted' THEN ot.to_user_id ELSE ot.from_user_id END::text,
+        ot.to_user_id::text,
+        ''
+    FROM organization_ownership_transfers ot
+    WHERE ot.organization_id = $1::uuid AND ot.resolved_at IS NOT NULL
+    UNION ALL
+    SELECT
+        om.created_at,
+        'organization_member.added',
+        '',
+        om.user_id::text,
+        'role=' || om.role
+    FROM organization_members om
+    WHERE om.organization_id = $1::uuid
+    UNION ALL
+    SELECT
+        ui.created_at,
+        'user_import.' || ui.status,
+        COALESCE(ui.created_by::text, ''),
+        ui.id::text,
+        format('rows=%s succeeded=%s failed=%s', ui.total_rows, ui.succeeded_rows, ui.failed_rows)
+    FROM user_imports ui
+    WHERE ui.organization_id = $1::uuid
+    UNION ALL
+    SELECT
+        oe.created_at,
+        'data_export.requested',
+        COALESCE(oe.requested_by::text, ''),
+        oe.id::text,
+        ''
+    FROM organization_exports oe
+    WHERE oe.organization_id = $1::uuid
+) events
+ORDER BY occurred_at, event_type
+`
+
+type ListOrganizationAuditEventsRow struct {
+	OccurredAt time.Time `json:"occurred_at"`
+	EventType  string    `json:"event_type"`
+	ActorID    string    `json:"actor_id"`
+	SubjectID  string    `json:"subject_id"`
+	Detail     string    `json:"detail"`
+}
+
+// The service keeps no separate audit log; events are reconstructed from the
+// tables that record who did what.
+func (q *Queries) ListOrganizationAuditEvents(ctx context.Context, organizationID uuid.UUID) ([]ListOrganizationAuditEventsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizationAuditEvents, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListOrganizationAuditEventsRow{}
+	for rows.Next() {
+		var i ListOrganizationAuditEventsRow
+		if err := rows.Scan(
+			&i.OccurredAt,
+			&i.EventType,
+			&i.ActorID,
+			&i.SubjectID,
+			&i.Detail,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrganizationExportTenantMemberships = `-- name: ListOrganizationExportTenantMemberships :many
+SELECT
+    tu.id, tu.tenant_id, tu.user_id, tu.role, tu.created_at, tu.updated_at,
+    t.subdomain AS tenant_subdomain,
+    u.email
+FROM tenant_users tu
+INNER JOIN tenants t ON tu.tenant_id = t.id
+INNER JOIN users u ON tu.user_id = u.id
+WHERE t.organization_id = $1::uuid
+ORDER BY t.subdomain, u.email
+`
+
+type ListOrganizationExportTenantMembershipsRow struct {
+	ID              uuid.UUID      `json:"id"`
+	TenantID        uuid.UUID      `json:"tenant_id"`
+	UserID          uuid.UUID      `json:"user_id"`
+	Role            sql.NullString `json:"role"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	TenantSubdomain string         `json:"tenant_subdomain"`
+	Email           string         `json:"email"`
+}
+
+func (q *Queries) ListOrganizationExportTenantMemberships(ctx context.Context, organizationID uuid.UUID) ([]ListOrganizationExportTenantMembershipsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizationExportTenantMemberships, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListOrganizationExportTenantMembershipsRow{}
+	for rows.Next() {
+		var i ListOrganizationExportTenantMembershipsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.UserID,
+			&i.Role,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TenantSubdomain,
+			&i.Email,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrganizationExportTenants = `-- name: ListOrganizationExportTenants :many
+SELECT id, organization_id, name, subdomain, is_active, created_at, updated_at FROM tenants
+WHERE organization_id = $1::uuid
+ORDER BY subdomain
+`
+
+func (q *Queries) ListOrganizationExportTenants(ctx context.Context, organizationID uuid.UUID) ([]Tenant, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizationExportTenants, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Tenant{}
+	for rows.Next() {
+		var i Tenant
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Name,
+			&i.Subdomain,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrganizationExportUsers = `-- name: ListOrganizationExportUsers :many
+SELECT id, cognito_id, email, is_system_admin, first_name, last_name, created_at, updated_at FROM users
+WHERE id IN (
+    SELECT tu.user_id FROM tenant_users tu
+    INNER JOIN tenants t ON tu.tenant_id = t.id
+    WHERE t.organization_id = $1::uuid
+    UNION
+    SELECT om.user_id FROM organization_members om
+    WHERE om.organization_id = $1::uuid
+)
+ORDER BY email
+`
+
+func (q *Queries) ListOrganizationExportUsers(ctx context.Context, organizationID uuid.UUID) ([]User, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizationExportUsers, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.CognitoID,
+			&i.Email,
+			&i.IsSystemAdmin,
+			&i.FirstName,
+			&i.LastName,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrganizationExports = `-- name: ListOrganizationExports :many
+SELECT id, organization_id, requested_by, status, storage_key, size_bytes, error_message, expires_at, started_at, completed_at, created_at, updated_at FROM organization_exports
+WHERE organization_id = $1::uuid
+ORDER BY created_at DESC
+LIMIT $2
+`
+
+type ListOrganizationExportsParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	RowLimit       int32     `json:"row_limit"`
+}
+
+func (q *Queries) ListOrganizationExports(ctx context.Context, arg ListOrganizationExportsParams) ([]OrganizationExport, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizationExports, arg.OrganizationID, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OrganizationExport{}
+	for rows.Next() {
+		var i OrganizationExport
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.RequestedBy,
+			&i.Status,
+			&i.StorageKey,
+			&i.SizeBytes,
+			&i.ErrorMessage,
+			&i.ExpiresAt,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const startOrganizationExport = `-- name: StartOrganizationExport :execrows
+UPDATE organization_exports
+SET status = 'processing',
+    error_message = NULL,
+    started_at = NOW(),
+    completed_at = NULL,
+    updated_at = NOW()
+WHERE id = $1::uuid AND status IN ('pending', 'failed', 'processing')
+`
+
+// The job queue runs one attempt of a job at a time, so a row still in
+// progress was left by a worker that stopped and is taken over.
+func (q *Queries) StartOrganizationExport(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, startOrganizationExport, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,399 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: organization_member.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const acceptOwnershipTransfer = `-- name: AcceptOwnershipTransfer :one
+UPDATE organization_ownership_transfers
+SET status = 'accepted', resolved_at = NOW()
+WHERE id = $1::uuid AND organization_id = $2::uuid
+  AND status = 'pending' AND expires_at > NOW()
+RETURNING id, organization_id, from_user_id, to_user_id, status, expires_at, resolved_at, created_at
+`
+
+type AcceptOwnershipTransferParams struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+}
+
+// Marks the transfer accepted. Run together with SwapOwnerRoles in one
+// transaction, which is rolled back unless both members were updated.
+func (q *Queries) AcceptOwnershipTransfer(ctx context.Context, arg AcceptOwnershipTransferParams) (OrganizationOwnershipTransfer, error) {
+	row := q.db.QueryRowContext(ctx, acceptOwnershipTransfer, arg.ID, arg.OrganizationID)
+	var i OrganizationOwnershipTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.FromUserID,
+		&i.ToUserID,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.ResolvedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const addOrganizationMember = `-- name: AddOrganizationMember :one
+INSERT INTO organization_members (
+    organization_id, user_id, role
+) VALUES (
+    $1::uuid, $2::uuid, $3
+)
+RETURNING id, organization_id, user_id, role, created_at, updated_at
+`
+
+type AddOrganizationMemberParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	Role           string    `json:"role"`
+}
+
+func (q *Queries) AddOrganizationMember(ctx context.Context, arg AddOrganizationMemberParams) (OrganizationMember, error) {
+	row := q.db.QueryRowContext(ctx, addOrganizationMember, arg.OrganizationID, arg.UserID, arg.Role)
+	var i OrganizationMember
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.UserID,
+		&i.Role,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const cancelMemberOwnershipTransfers = `-- name: CancelMemberOwnershipTransfers :execrows
+UPDATE organization_ownership_transfers
+SET status = 'canceled', resolved_at = NOW()
+WHERE organization_id = $1::uuid AND status = 'pending'
+  AND (from_user_id = $2::uuid OR to_user_id = $2::uuid)
+`
+
+type CancelMemberOwnershipTransfersParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+}
+
+// Cancels pending transfers from or to a member who is leaving.
+func (q *Queries) CancelMemberOwnershipTransfers(ctx context.Context, arg CancelMemberOwnershipTransfersParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, cancelMemberOwnershipTransfers, arg.OrganizationID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const cancelOwnershipTransfer = `-- name: CancelOwnershipTransfer :execrows
+UPDATE organization_ownership_transfers
+SET status = 'canceled', resolved_at = NOW()
+WHERE id = $1::uuid AND organization_id = $2::uuid AND status = 'pending'
+`
+
+type CancelOwnershipTransferParams struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+}
+
+func (q *Queries) CancelOwnershipTransfer(ctx context.Context, arg CancelOwnershipTransferParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, cancelOwnershipTransfer, arg.ID, arg.OrganizationID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const cancelPendingOwnershipTransfers = `-- name: CancelPendingOwnershipTransfers :execrows
+UPDATE organization_ownership_transfers
+SET status = 'canceled', resolved_at = NOW()
+WHERE organization_id = $1::uuid AND status = 'pending'
+`
+
+// Run in the same transaction as CreateOwnershipTransfer; a data-modifying
+// CTE would run after the INSERT and trip the pending-transfer unique index.
+func (q *Queries) CancelPendingOwnershipTransfers(ctx context.Context, organizationID uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, cancelPendingOwnershipTransfers, organizationID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createOwnershipTransfer = `-- name: CreateOwnershipTransfer :one
+INSERT INTO organization_ownership_transfers (
+    organization_id, from_user_id, to_user_id, expires_at
+) VALUES (
+    $1::uuid, $2::uuid, $3::uuid, $4
+)
+RETURNING id, organization_id, from_user_id, to_user_id, status, expires_at, resolved_at, created_at
+`
+
+type CreateOwnershipTransferParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	FromUserID     uuid.UUID `json:"from_user_id"`
+	ToUserID       uuid.UUID `json:"to_user_id"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+func (q *Queries) CreateOwnershipTransfer(ctx context.Context, arg CreateOwnershipTransferParams) (OrganizationOwnershipTransfer, error) {
+	row := q.db.QueryRowContext(ctx, createOwnershipTransfer,
+		arg.OrganizationID,
+		arg.FromUserID,
+		arg.ToUserID,
+		arg.ExpiresAt,
+	)
+	var i OrganizationOwnershipTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.FromUserID,
+		&i.ToUserID,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.ResolvedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteOrganizationMember = `-- name: DeleteOrganizationMember :execrows
+DELETE FROM organization_members
+WHERE organization_id = $1::uuid AND user_id = $2::uuid
+  AND (
+    role <> 'owner'
+    OR (SELECT COUNT(*) FROM organization_members o WHERE o.organization_id = $1::uuid AND o.role = 'owner') > 1
+  )
+`
+
+type DeleteOrganizationMemberParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+}
+
+// Refuses to remove the last owner.
+func (q *Queries) DeleteOrganizationMember(ctx context.Context, arg DeleteOrganizationMemberParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteOrganizationMember, arg.OrganizationID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const expireOwnershipTransfers = `-- name: ExpireOwnershipTransfers :execrows
+UPDATE organization_ownership_transfers
+SET status = 'expired', resolved_at = expires_at
+WHERE status = 'pending' AND expires_at <= NOW()
+`
+
+func (q *Queries) ExpireOwnershipTransfers(ctx context.Context) (int64, error) {
+	result, err := q.db.ExecContext(ctx, expireOwnershipTransfers)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getOrganizationMember = `-- name: GetOrganizationMember :one
+SELECT id, organization_id, user_id, role, created_at, updated_at FROM organization_members
+WHERE organization_id = $1::uuid AND user_id = $2::uuid
+LIMIT 1
+`
+
+type GetOrganizationMemberParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) GetOrganizationMember(ctx context.Context, arg GetOrganizationMemberParams) (OrganizationMember, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationMember, arg.OrganizationID, arg.UserID)
+	var i OrganizationMember
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.UserID,
+		&i.Role,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getOwnershipTransfer = `-- name: GetOwnershipTransfer :one
+SELECT id, organization_id, from_user_id, to_user_id, status, expires_at, resolved_at, created_at FROM organization_ownership_transfers
+WHERE id = $1::uuid AND organization_id = $2::uuid
+LIMIT 1
+`
+
+type GetOwnershipTransferParams struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+}
+
+func (q *Queries) GetOwnershipTransfer(ctx context.Context, arg GetOwnershipTransferParams) (OrganizationOwnershipTransfer, error) {
+	row := q.db.QueryRowContext(ctx, getOwnershipTransfer, arg.ID, arg.OrganizationID)
+	var i OrganizationOwnershipTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.FromUserID,
+		&i.ToUserID,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.ResolvedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getPendingOwnershipTransfer = `-- name: GetPendingOwnershipTransfer :one
+SELECT id, organization_id, from_user_id, to_user_id, status, expires_at, resolved_at, created_at FROM organization_ownership_transfers
+WHERE organization_id = $1::uuid AND status = 'pending' AND expires_at > NOW()
+LIMIT 1
+`
+
+func (q *Queries) GetPendingOwnershipTransfer(ctx context.Context, organizationID uuid.UUID) (OrganizationOwnershipTransfer, error) {
+	row := q.db.QueryRowContext(ctx, getPendingOwnershipTransfer, organizationID)
+	var i OrganizationOwnershipTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.FromUserID,
+		&i.ToUserID,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.ResolvedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listOrganizationMembers = `-- name: ListOrganizationMembers :many
+SELECT
+    om.id, om.organization_id, om.user_id, om.role, om.created_at, om.updated_at,
+    u.email,
+    u.first_name,
+    u.last_name
+FROM organization_members om
+INNER JOIN users u ON om.user_id = u.id
+WHERE om.organization_id = $1::uuid
+ORDER BY
+    CASE om.role WHEN 'owner' THEN 0 WHEN 'admin' THEN 1 ELSE 2 END,
+    u.email
+`
+
+type ListOrganizationMembersRow struct {
+	ID             uuid.UUID      `json:"id"`
+	OrganizationID uuid.UUID      `json:"organization_id"`
+	UserID         uuid.UUID      `json:"user_id"`
+	Role           string         `json:"role"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+	Email          string         `json:"email"`
+	FirstName      sql.NullString `json:"first_name"`
+	LastName       sql.NullString `json:"last_name"`
+}
+
+func (q *Queries) ListOrganizationMembers(ctx context.Context, organizationID uuid.UUID) ([]ListOrganizationMembersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizationMembers, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListOrganizationMembersRow{}
+	for rows.Next() {
+		var i ListOrganizationMembersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.UserID,
+			&i.Role,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Email,
+			&i.FirstName,
+			&i.LastName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockOrganizationOwners = `-- name: LockOrganizationOwners :exec
+SELECT user_id FROM organization_members
+WHERE organization_id = $1::uuid AND role = 'owner'
+FOR UPDATE
+`
+
+// Locks the owner rows until the transaction ends, so concurrent demotions
+// and removals re-count the owners after one another.
+func (q *Queries) LockOrganizationOwners(ctx context.Context, organizationID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, lockOrganizationOwners, organizationID)
+	return err
+}
+
+const swapOwnerRoles = `-- name: SwapOwnerRoles :execrows
+UPDATE organization_members
+SET role = CASE WHEN user_id = $1::uuid THEN 'owner' ELSE 'admin' END,
+    updated_at = NOW()
+WHERE organization_id = $2::uuid
+  AND user_id IN ($3::uuid, $1::uuid)
+`
+
+type SwapOwnerRolesParams struct {
+	ToUserID       uuid.UUID `json:"to_user_id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	FromUserID     uuid.UUID `json:"from_user_id"`
+}
+
+// The new owner becomes owner and the previous owner stays on as admin.
+func (q *Queries) SwapOwnerRoles(ctx context.Context, arg SwapOwnerRolesParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, swapOwnerRoles, arg.ToUserID, arg.OrganizationID, arg.FromUserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateOrganizationMemberRole = `-- name: UpdateOrganizationMemberRole :execrows
+UPDATE organization_members
+SET role = $1,
+    updated_at = NOW()
+WHERE organization_id = $2::uuid AND user_id = $3::uuid
+  AND (
+    role <> 'owner' OR $1 = 'owner'
+    OR (SELECT COUNT(*) FROM organization_members o WHERE o.organization_id = $2::uuid AND o.role = 'owner') > 1
+  )
+`
+
+type UpdateOrganizationMemberRoleParams struct {
+	Role           string    `json:"role"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	UserID         uuid.UUID `json:"user_id"`
+}
+
+// Refuses to demote the last owner.
+func (q *Queries) UpdateOrganizationMemberRole(ctx context.Context, arg UpdateOrganizationMemberRoleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateOrganizationMemberRole, arg.Role, arg.OrganizationID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
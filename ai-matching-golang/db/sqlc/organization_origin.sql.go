@@ -0,0 +1,128 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: organization_origin.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const createOrganizationOrigin = `-- name: CreateOrganizationOrigin :one
+INSERT INTO organization_origins (
+    organization_id, origin
+) VALUES (
+    $1::uuid, $2
+)
+RETURNING id, organization_id, origin, created_at, updated_at
+`
+
+type CreateOrganizationOriginParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Origin         string    `json:"origin"`
+}
+
+func (q *Queries) CreateOrganizationOrigin(ctx context.Context, arg CreateOrganizationOriginParams) (OrganizationOrigin, error) {
+	row := q.db.QueryRowContext(ctx, createOrganizationOrigin, arg.OrganizationID, arg.Origin)
+	var i OrganizationOrigin
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Origin,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteOrganizationOrigin = `-- name: DeleteOrganizationOrigin :exec
+DELETE FROM organization_origins
+WHERE id = $1::uuid AND organization_id = $2::uuid
+`
+
+type DeleteOrganizationOriginParams struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+}
+
+func (q *Queries) DeleteOrganizationOrigin(ctx context.Context, arg DeleteOrganizationOriginParams) error {
+	_, err := q.db.ExecContext(ctx, deleteOrganizationOrigin, arg.ID, arg.OrganizationID)
+	return err
+}
+
+const getOrganizationOrigin = `-- name: GetOrganizationOrigin :one
+SELECT id, organization_id, origin, created_at, updated_at FROM organization_origins
+WHERE id = $1::uuid AND organization_id = $2::uuid
+LIMIT 1
+`
+
+type GetOrganizationOriginParams struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+}
+
+func (q *Queries) GetOrganizationOrigin(ctx context.Context, arg GetOrganizationOriginParams) (OrganizationOrigin, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationOrigin, arg.ID, arg.OrganizationID)
+	var i OrganizationOrigin
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Origin,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const isOriginRegistered = `-- name: IsOriginRegistered :one
+SELECT EXISTS(
+    SELECT 1 FROM organization_origins o
+    INNER JOIN organizations org ON org.id = o.organization_id
+    WHERE o.origin = $1 AND org.is_active = true
+) AS registered
+`
+
+func (q *Queries) IsOriginRegistered(ctx context.Context, origin string) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isOriginRegistered, origin)
+	var registered bool
+	err := row.Scan(&registered)
+	return registered, err
+}
+
+const listOrganizationOrigins = `-- name: ListOrganizationOrigins :many
+SELECT id, organization_id, origin, created_at, updated_at FROM organization_origins
+WHERE organization_id = $1::uuid
+ORDER BY created_at
+`
+
+func (q *Queries) ListOrganizationOrigins(ctx context.Context, organizationID uuid.UUID) ([]OrganizationOrigin, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizationOrigins, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OrganizationOrigin{}
+	for rows.Next() {
+		var i OrganizationOrigin
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Origin,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
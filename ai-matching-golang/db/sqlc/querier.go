@@ -6,28 +6,127 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
+	// Marks the transfer accepted. Run together with SwapOwnerRoles in one
+	// transaction, which is rolled back unless both members were updated.
+	AcceptOwnershipTransfer(ctx context.Context, arg AcceptOwnershipTransferParams) (OrganizationOwnershipTransfer, error)
+	AddOrganizationMember(ctx context.Context, arg AddOrganizationMemberParams) (OrganizationMember, error)
 	AddUserToTenant(ctx context.Context, arg AddUserToTenantParams) (TenantUser, error)
+	BuryJob(ctx context.Context, arg BuryJobParams) (int64, error)
+	BuryStaleJobs(ctx context.Context, staleBefore time.Time) (int64, error)
+	// Cancels pending transfers from or to a member who is leaving.
+	CancelMemberOwnershipTransfers(ctx context.Context, arg CancelMemberOwnershipTransfersParams) (int64, error)
+	CancelOwnershipTransfer(ctx context.Context, arg CancelOwnershipTransferParams) (int64, error)
+	// Run in the same transaction as CreateOwnershipTransfer; a data-modifying
+	// CTE would run after the INSERT and trip the pending-transfer unique index.
+	CancelPendingOwnershipTransfers(ctx context.Context, organizationID uuid.UUID) (int64, error)
 	CheckUserBelongsToTenant(ctx context.Context, arg CheckUserBelongsToTenantParams) (bool, error)
+	// Running jobs whose lock is older than stale_before belong to a worker that
+	// stopped, and are claimed again while they have attempts left.
+	ClaimJobs(ctx context.Context, arg ClaimJobsParams) ([]Job, error)
+	ClearOrganizationExportStorage(ctx context.Context, id uuid.UUID) error
+	CompleteDocumentVersionIndexing(ctx context.Context, arg CompleteDocumentVersionIndexingParams) error
+	CompleteJob(ctx context.Context, arg CompleteJobParams) (int64, error)
+	CompleteOrganizationExport(ctx context.Context, arg CompleteOrganizationExportParams) error
+	CountConversations(ctx context.Context, arg CountConversationsParams) (int64, error)
+	CountDocuments(ctx context.Context, tenantID uuid.UUID) (int64, error)
+	CountMatchCandidates(ctx context.Context, tenantID uuid.UUID) (int64, error)
+	CountMatchOpenings(ctx context.Context, tenantID uuid.UUID) (int64, error)
+	CountOrganizationJobs(ctx context.Context, arg CountOrganizationJobsParams) (int64, error)
+	CountOrganizationMonthlyActiveUsers(ctx context.Context, arg CountOrganizationMonthlyActiveUsersParams) ([]CountOrganizationMonthlyActiveUsersRow, error)
 	CountOrganizations(ctx context.Context) (int64, error)
+	CountTaskRuns(ctx context.Context, taskName string) (int64, error)
+	CountTenantMonthlyActiveUsers(ctx context.Context, arg CountTenantMonthlyActiveUsersParams) ([]CountTenantMonthlyActiveUsersRow, error)
+	CountTenantUsers(ctx context.Context, tenantID uuid.UUID) (int64, error)
 	CountTenantsByOrganization(ctx context.Context, organizationID uuid.UUID) (int64, error)
 	CountUsers(ctx context.Context) (int64, error)
 	CountUsersNotInTenant(ctx context.Context, tenantID uuid.UUID) (int64, error)
+	CountWebhookDeliveries(ctx context.Context, arg CountWebhookDeliveriesParams) (int64, error)
+	CreateConversation(ctx context.Context, arg CreateConversationParams) (Conversation, error)
+	CreateDocument(ctx context.Context, arg CreateDocumentParams) (Document, error)
+	// Re-indexing a version overwrites its chunks in place.
+	CreateDocumentChunk(ctx context.Context, arg CreateDocumentChunkParams) error
+	// Bumping current_version locks the document row, so concurrent uploads get
+	// consecutive version numbers.
+	CreateDocumentVersion(ctx context.Context, arg CreateDocumentVersionParams) (DocumentVersion, error)
+	CreateJob(ctx context.Context, arg CreateJobParams) (Job, error)
+	CreateMatchCandidate(ctx context.Context, arg CreateMatchCandidateParams) (MatchCandidate, error)
+	CreateMatchOpening(ctx context.Context, arg CreateMatchOpeningParams) (MatchOpening, error)
+	CreateMessage(ctx context.Context, arg CreateMessageParams) (Message, error)
 	CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (Organization, error)
+	CreateOrganizationExport(ctx context.Context, arg CreateOrganizationExportParams) (OrganizationExport, error)
+	CreateOrganizationOrigin(ctx context.Context, arg CreateOrganizationOriginParams) (OrganizationOrigin, error)
+	CreateOwnershipTransfer(ctx context.Context, arg CreateOwnershipTransferParams) (OrganizationOwnershipTransfer, error)
 	CreateTenant(ctx context.Context, arg CreateTenantParams) (Tenant, error)
+	CreateTenantDomain(ctx context.Context, arg CreateTenantDomainParams) (TenantDomain, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreateUserImport(ctx context.Context, arg CreateUserImportParams) (UserImport, error)
+	CreateUserImportRow(ctx context.Context, arg CreateUserImportRowParams) error
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error)
+	CreateWebhookEndpoint(ctx context.Context, arg CreateWebhookEndpointParams) (WebhookEndpoint, error)
+	DeleteConversation(ctx context.Context, arg DeleteConversationParams) (int64, error)
+	// Removes chunks left over from a longer previous run of the same version.
+	DeleteDocumentChunksFrom(ctx context.Context, arg DeleteDocumentChunksFromParams) error
+	DeleteExpiredRateLimitCounters(ctx context.Context) (int64, error)
+	DeleteExpiredTenantSubdomainAliases(ctx context.Context) (int64, error)
+	DeleteFeatureFlag(ctx context.Context, key string) error
+	DeleteMatchCandidate(ctx context.Context, arg DeleteMatchCandidateParams) (int64, error)
+	DeleteMatchOpening(ctx context.Context, arg DeleteMatchOpeningParams) (int64, error)
 	DeleteOrganization(ctx context.Context, id uuid.UUID) error
+	DeleteOrganizationFeatureFlagOverride(ctx context.Context, arg DeleteOrganizationFeatureFlagOverrideParams) error
+	// Refuses to remove the last owner.
+	DeleteOrganizationMember(ctx context.Context, arg DeleteOrganizationMemberParams) (int64, error)
+	DeleteOrganizationOrigin(ctx context.Context, arg DeleteOrganizationOriginParams) error
+	DeleteOrganizationSetting(ctx context.Context, arg DeleteOrganizationSettingParams) error
+	DeleteRateLimitCounter(ctx context.Context, key string) error
+	DeleteSucceededJobs(ctx context.Context, finishedBefore time.Time) (int64, error)
+	DeleteSupersededDocumentChunks(ctx context.Context, arg DeleteSupersededDocumentChunksParams) (int64, error)
+	DeleteTaskRunsBefore(ctx context.Context, before time.Time) (int64, error)
 	DeleteTenant(ctx context.Context, id uuid.UUID) error
+	DeleteTenantDomain(ctx context.Context, arg DeleteTenantDomainParams) error
+	DeleteTenantFeatureFlagOverride(ctx context.Context, arg DeleteTenantFeatureFlagOverrideParams) error
+	DeleteTenantSetting(ctx context.Context, arg DeleteTenantSettingParams) error
+	DeleteTenantSubdomainAlias(ctx context.Context, subdomain string) error
 	DeleteUser(ctx context.Context, id uuid.UUID) error
+	DeleteWebhookDeliveriesBefore(ctx context.Context, before time.Time) (int64, error)
+	DeleteWebhookEndpoint(ctx context.Context, arg DeleteWebhookEndpointParams) (int64, error)
+	ExpireOwnershipTransfers(ctx context.Context) (int64, error)
+	FailOrganizationExport(ctx context.Context, arg FailOrganizationExportParams) error
+	FailWebhookDelivery(ctx context.Context, arg FailWebhookDeliveryParams) error
+	FinishTaskRun(ctx context.Context, arg FinishTaskRunParams) error
+	FinishUserImport(ctx context.Context, arg FinishUserImportParams) error
+	GetConversation(ctx context.Context, arg GetConversationParams) (Conversation, error)
+	GetDocument(ctx context.Context, arg GetDocumentParams) (Document, error)
+	GetDocumentChunksByIDs(ctx context.Context, arg GetDocumentChunksByIDsParams) ([]GetDocumentChunksByIDsRow, error)
+	GetDocumentVersion(ctx context.Context, arg GetDocumentVersionParams) (DocumentVersion, error)
+	GetDocumentVersionForIndexing(ctx context.Context, id uuid.UUID) (GetDocumentVersionForIndexingRow, error)
+	GetFeatureFlag(ctx context.Context, key string) (FeatureFlag, error)
+	GetMatchCandidate(ctx context.Context, arg GetMatchCandidateParams) (MatchCandidate, error)
+	GetMatchOpening(ctx context.Context, arg GetMatchOpeningParams) (MatchOpening, error)
 	GetOrganization(ctx context.Context, id uuid.UUID) (Organization, error)
+	GetOrganizationApiUsage(ctx context.Context, arg GetOrganizationApiUsageParams) (int64, error)
 	GetOrganizationByTenant(ctx context.Context, tenantID uuid.UUID) (Organization, error)
+	GetOrganizationExport(ctx context.Context, arg GetOrganizationExportParams) (OrganizationExport, error)
+	GetOrganizationExportByID(ctx context.Context, id uuid.UUID) (OrganizationExport, error)
+	GetOrganizationJob(ctx context.Context, arg GetOrganizationJobParams) (Job, error)
+	GetOrganizationMember(ctx context.Context, arg GetOrganizationMemberParams) (OrganizationMember, error)
+	GetOrganizationOrigin(ctx context.Context, arg GetOrganizationOriginParams) (OrganizationOrigin, error)
+	GetOrganizationSubscription(ctx context.Context, organizationID uuid.UUID) (OrganizationSubscription, error)
 	GetOrganizationWithTenants(ctx context.Context, id uuid.UUID) (GetOrganizationWithTenantsRow, error)
+	GetOwnershipTransfer(ctx context.Context, arg GetOwnershipTransferParams) (OrganizationOwnershipTransfer, error)
+	GetPendingOwnershipTransfer(ctx context.Context, organizationID uuid.UUID) (OrganizationOwnershipTransfer, error)
+	GetPlan(ctx context.Context, code string) (Plan, error)
+	GetRateLimitCounter(ctx context.Context, key string) (GetRateLimitCounterRow, error)
 	GetTenant(ctx context.Context, id uuid.UUID) (Tenant, error)
 	GetTenantBySubdomain(ctx context.Context, subdomain string) (Tenant, error)
+	GetTenantBySubdomainAlias(ctx context.Context, subdomain string) (Tenant, error)
+	GetTenantByVerifiedDomain(ctx context.Context, domain string) (Tenant, error)
+	GetTenantDomain(ctx context.Context, arg GetTenantDomainParams) (TenantDomain, error)
 	GetTenantUser(ctx context.Context, arg GetTenantUserParams) (TenantUser, error)
 	GetTenantWithUserCount(ctx context.Context, id uuid.UUID) (GetTenantWithUserCountRow, error)
 	GetTenantsByOrganization(ctx context.Context, organizationID uuid.UUID) ([]Tenant, error)
@@ -36,18 +135,146 @@ type Querier interface {
 	GetUser(ctx context.Context, id uuid.UUID) (User, error)
 	GetUserByCognitoID(ctx context.Context, cognitoID string) (User, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
+	GetUserImport(ctx context.Context, arg GetUserImportParams) (UserImport, error)
 	GetUserWithTenants(ctx context.Context, id uuid.UUID) (GetUserWithTenantsRow, error)
 	GetUsersByTenant(ctx context.Context, tenantID uuid.UUID) ([]User, error)
 	GetUsersNotInTenant(ctx context.Context, arg GetUsersNotInTenantParams) ([]User, error)
+	GetVectorExtensionInstalled(ctx context.Context) (bool, error)
+	GetWebhookDelivery(ctx context.Context, arg GetWebhookDeliveryParams) (WebhookDelivery, error)
+	// Loads a delivery with what is needed to send it
+	GetWebhookDeliveryTarget(ctx context.Context, id uuid.UUID) (GetWebhookDeliveryTargetRow, error)
+	GetWebhookEndpoint(ctx context.Context, arg GetWebhookEndpointParams) (WebhookEndpoint, error)
+	IncrementOrganizationApiUsage(ctx context.Context, arg IncrementOrganizationApiUsageParams) (int64, error)
+	IncrementRateLimitCounter(ctx context.Context, arg IncrementRateLimitCounterParams) (IncrementRateLimitCounterRow, error)
+	IsOriginRegistered(ctx context.Context, origin string) (bool, error)
+	ListConversations(ctx context.Context, arg ListConversationsParams) ([]Conversation, error)
+	// Pages through the searchable embeddings of a tenant for the in-process
+	// fallback when pgvector is not installed.
+	ListDocumentChunkEmbeddings(ctx context.Context, arg ListDocumentChunkEmbeddingsParams) ([]ListDocumentChunkEmbeddingsRow, error)
+	ListDocumentChunks(ctx context.Context, arg ListDocumentChunksParams) ([]ListDocumentChunksRow, error)
+	ListDocumentVersionStorageKeys(ctx context.Context, documentID uuid.UUID) ([]string, error)
+	ListDocumentVersions(ctx context.Context, arg ListDocumentVersionsParams) ([]ListDocumentVersionsRow, error)
+	ListDocuments(ctx context.Context, arg ListDocumentsParams) ([]Document, error)
+	ListExpiredOrganizationExports(ctx context.Context, rowLimit int32) ([]OrganizationExport, error)
+	ListFeatureFlagOverrides(ctx context.Context) ([]FeatureFlagOverride, error)
+	ListFeatureFlags(ctx context.Context) ([]FeatureFlag, error)
+	ListLatestTaskRuns(ctx context.Context) ([]TaskRun, error)
+	ListMatchCandidates(ctx context.Context, arg ListMatchCandidatesParams) ([]MatchCandidate, error)
+	// Keyset pages over every candidate of a tenant for scoring
+	ListMatchCandidatesAfter(ctx context.Context, arg ListMatchCandidatesAfterParams) ([]MatchCandidate, error)
+	ListMatchOpenings(ctx context.Context, arg ListMatchOpeningsParams) ([]MatchOpening, error)
+	ListMessages(ctx context.Context, conversationID uuid.UUID) ([]Message, error)
+	// Keyset pages over the open openings of a tenant for scoring
+	ListOpenMatchOpeningsAfter(ctx context.Context, arg ListOpenMatchOpeningsAfterParams) ([]MatchOpening, error)
+	// The service keeps no separate audit log; events are reconstructed from the
+	// tables that record who did what.
+	ListOrganizationAuditEvents(ctx context.Context, organizationID uuid.UUID) ([]ListOrganizationAuditEventsRow, error)
+	ListOrganizationExportTenantMemberships(ctx context.Context, organizationID uuid.UUID) ([]ListOrganizationExportTenantMembershipsRow, error)
+	ListOrganizationExportTenants(ctx context.Context, organizationID uuid.UUID) ([]Tenant, error)
+	ListOrganizationExportUsers(ctx context.Context, organizationID uuid.UUID) ([]User, error)
+	ListOrganizationExports(ctx context.Context, arg ListOrganizationExportsParams) ([]OrganizationExport, error)
+	ListOrganizationJobs(ctx context.Context, arg ListOrganizationJobsParams) ([]Job, error)
+	ListOrganizationMembers(ctx context.Context, organizationID uuid.UUID) ([]ListOrganizationMembersRow, error)
+	ListOrganizationOrigins(ctx context.Context, organizationID uuid.UUID) ([]OrganizationOrigin, error)
+	ListOrganizationSettings(ctx context.Context, organizationID uuid.UUID) ([]OrganizationSetting, error)
 	ListOrganizations(ctx context.Context, arg ListOrganizationsParams) ([]Organization, error)
+	ListPlans(ctx context.Context) ([]Plan, error)
+	ListPurgeableDocuments(ctx context.Context, arg ListPurgeableDocumentsParams) ([]uuid.UUID, error)
+	// Newest first, for building the history of a follow-up question
+	ListRecentMessages(ctx context.Context, arg ListRecentMessagesParams) ([]Message, error)
+	ListSubscribedWebhookEndpoints(ctx context.Context, arg ListSubscribedWebhookEndpointsParams) ([]WebhookEndpoint, error)
+	// Returns the candidates already used by another tenant, either as its
+	// current subdomain or as an unexpired alias.
+	ListTakenSubdomains(ctx context.Context, arg ListTakenSubdomainsParams) ([]string, error)
+	ListTaskRuns(ctx context.Context, arg ListTaskRunsParams) ([]TaskRun, error)
+	ListTenantDomains(ctx context.Context, tenantID uuid.UUID) ([]TenantDomain, error)
+	ListTenantSeatCounts(ctx context.Context, organizationID uuid.UUID) ([]ListTenantSeatCountsRow, error)
+	ListTenantSettings(ctx context.Context, tenantID uuid.UUID) ([]TenantSetting, error)
 	ListTenantUsers(ctx context.Context, tenantID uuid.UUID) ([]ListTenantUsersRow, error)
 	ListTenantsByOrganization(ctx context.Context, arg ListTenantsByOrganizationParams) ([]Tenant, error)
+	ListUsageRecordsByPeriod(ctx context.Context, arg ListUsageRecordsByPeriodParams) ([]ListUsageRecordsByPeriodRow, error)
+	ListUserImportRows(ctx context.Context, importID uuid.UUID) ([]ListUserImportRowsRow, error)
+	ListUserImports(ctx context.Context, arg ListUserImportsParams) ([]UserImport, error)
 	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	ListWebhookEndpoints(ctx context.Context, organizationID uuid.UUID) ([]WebhookEndpoint, error)
+	// Locks the owner rows until the transaction ends, so concurrent demotions
+	// and removals re-count the owners after one another.
+	LockOrganizationOwners(ctx context.Context, organizationID uuid.UUID) error
+	MarkTenantDomainVerified(ctx context.Context, arg MarkTenantDomainVerifiedParams) (TenantDomain, error)
+	// Versions and chunks go with the document
+	PurgeDocument(ctx context.Context, id uuid.UUID) (int64, error)
+	RecordUserActivity(ctx context.Context, arg RecordUserActivityParams) error
+	RecordWebhookDeliveryAttempt(ctx context.Context, arg RecordWebhookDeliveryAttemptParams) error
+	ReleaseTaskLock(ctx context.Context, lockKey string) error
 	RemoveUserFromTenant(ctx context.Context, arg RemoveUserFromTenantParams) error
+	RequeueJob(ctx context.Context, arg RequeueJobParams) (Job, error)
+	RetryJob(ctx context.Context, arg RetryJobParams) (int64, error)
+	// The current secret keeps signing alongside the new one until
+	// previous_secret_expires_at, so receivers can switch without dropping events.
+	RotateWebhookEndpointSecret(ctx context.Context, arg RotateWebhookEndpointSecretParams) (WebhookEndpoint, error)
+	// Ranks by ts_rank_cd, which rewards terms that occur often and close
+	// together; normalization 1 divides by the log of the chunk length so long
+	// chunks do not win by size alone.
+	SearchDocumentChunksByKeyword(ctx context.Context, arg SearchDocumentChunksByKeywordParams) ([]SearchDocumentChunksByKeywordRow, error)
+	// Exact search for embedding sizes without an index
+	SearchDocumentChunksByVector(ctx context.Context, arg SearchDocumentChunksByVectorParams) ([]SearchDocumentChunksByVectorRow, error)
+	SearchDocumentChunksByVector1536(ctx context.Context, arg SearchDocumentChunksByVector1536Params) ([]SearchDocumentChunksByVector1536Row, error)
+	// The vector queries need the pgvector extension. The sized variants match
+	// the expression of the HNSW indexes and run after SetHNSWSearchScope.
+	SearchDocumentChunksByVector256(ctx context.Context, arg SearchDocumentChunksByVector256Params) ([]SearchDocumentChunksByVector256Row, error)
+	SetDocumentVersionIndexStatus(ctx context.Context, arg SetDocumentVersionIndexStatusParams) error
+	// Widens the HNSW scans of the current transaction. An HNSW scan applies the
+	// tenant and metadata filters after the index; with iterative scans (pgvector
+	// 0.8 and later) it keeps walking the graph until enough rows pass them
+	// instead of stopping after ef_search candidates.
+	SetHNSWSearchScope(ctx context.Context, efSearch string) error
+	SetMatchCandidateEmbedding(ctx context.Context, arg SetMatchCandidateEmbeddingParams) error
+	SetMatchOpeningEmbedding(ctx context.Context, arg SetMatchOpeningEmbeddingParams) error
+	SetPrimaryTenantDomain(ctx context.Context, arg SetPrimaryTenantDomainParams) error
+	SnapshotOrganizationActiveUsers(ctx context.Context, usageDate time.Time) (int64, error)
+	SnapshotOrganizationApiCalls(ctx context.Context, usageDate time.Time) (int64, error)
+	SnapshotOrganizationSeats(ctx context.Context, usageDate time.Time) (int64, error)
+	SnapshotTenantActiveUsers(ctx context.Context, usageDate time.Time) (int64, error)
+	SnapshotTenantSeats(ctx context.Context, usageDate time.Time) (int64, error)
+	SoftDeleteDocument(ctx context.Context, arg SoftDeleteDocumentParams) (int64, error)
+	// The job queue runs one attempt of a job at a time, so a row still in
+	// progress was left by a worker that stopped and is taken over.
+	StartDocumentVersionIndexing(ctx context.Context, id uuid.UUID) (int64, error)
+	StartManualTaskRun(ctx context.Context, arg StartManualTaskRunParams) (TaskRun, error)
+	// The job queue runs one attempt of a job at a time, so a row still in
+	// progress was left by a worker that stopped and is taken over.
+	StartOrganizationExport(ctx context.Context, id uuid.UUID) (int64, error)
+	// Returns no row when another replica already ran the task for this slot.
+	StartScheduledTaskRun(ctx context.Context, arg StartScheduledTaskRunParams) (TaskRun, error)
+	// The job queue runs one attempt of a job at a time, so a row still in
+	// progress was left by a worker that stopped and is taken over.
+	StartUserImport(ctx context.Context, id uuid.UUID) (int64, error)
+	// The new owner becomes owner and the previous owner stays on as admin.
+	SwapOwnerRoles(ctx context.Context, arg SwapOwnerRolesParams) (int64, error)
+	// Also names an untitled conversation after its first question
+	TouchConversation(ctx context.Context, arg TouchConversationParams) error
+	// Session-level advisory lock held by the scheduler while a task runs; take
+	// and release it on the same dedicated connection.
+	TryTaskLock(ctx context.Context, lockKey string) (bool, error)
+	UpdateDocument(ctx context.Context, arg UpdateDocumentParams) (Document, error)
+	UpdateMatchCandidate(ctx context.Context, arg UpdateMatchCandidateParams) (MatchCandidate, error)
+	UpdateMatchOpening(ctx context.Context, arg UpdateMatchOpeningParams) (MatchOpening, error)
 	UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) (Organization, error)
+	// Refuses to demote the last owner.
+	UpdateOrganizationMemberRole(ctx context.Context, arg UpdateOrganizationMemberRoleParams) (int64, error)
 	UpdateTenant(ctx context.Context, arg UpdateTenantParams) (Tenant, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpdateUserImportRow(ctx context.Context, arg UpdateUserImportRowParams) error
 	UpdateUserRoleInTenant(ctx context.Context, arg UpdateUserRoleInTenantParams) (TenantUser, error)
+	UpdateWebhookEndpoint(ctx context.Context, arg UpdateWebhookEndpointParams) (WebhookEndpoint, error)
+	UpsertFeatureFlag(ctx context.Context, arg UpsertFeatureFlagParams) (FeatureFlag, error)
+	UpsertOrganizationFeatureFlagOverride(ctx context.Context, arg UpsertOrganizationFeatureFlagOverrideParams) (FeatureFlagOverride, error)
+	UpsertOrganizationSetting(ctx context.Context, arg UpsertOrganizationSettingParams) (OrganizationSetting, error)
+	UpsertOrganizationSubscription(ctx context.Context, arg UpsertOrganizationSubscriptionParams) (OrganizationSubscription, error)
+	UpsertTenantFeatureFlagOverride(ctx context.Context, arg UpsertTenantFeatureFlagOverrideParams) (FeatureFlagOverride, error)
+	UpsertTenantSetting(ctx context.Context, arg UpsertTenantSettingParams) (TenantSetting, error)
+	UpsertTenantSubdomainAlias(ctx context.Context, arg UpsertTenantSubdomainAliasParams) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,87 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: rate_limit.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const deleteExpiredRateLimitCounters = `-- name: DeleteExpiredRateLimitCounters :execrows
+DELETE FROM rate_limit_counters
+WHERE expires_at <= NOW()
+`
+
+func (q *Queries) DeleteExpiredRateLimitCounters(ctx context.Context) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteExpiredRateLimitCounters)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteRateLimitCounter = `-- name: DeleteRateLimitCounter :exec
+DELETE FROM rate_limit_counters
+WHERE key = $1
+`
+
+func (q *Queries) DeleteRateLimitCounter(ctx context.Context, key string) error {
+	_, err := q.db.ExecContext(ctx, deleteRateLimitCounter, key)
+	return err
+}
+
+const getRateLimitCounter = `-- name: GetRateLimitCounter :one
+SELECT count, expires_at FROM rate_limit_counters
+WHERE key = $1 AND expires_at > NOW()
+LIMIT 1
+`
+
+type GetRateLimitCounterRow struct {
+	Count     int64     `json:"count"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (q *Queries) GetRateLimitCounter(ctx context.Context, key string) (GetRateLimitCounterRow, error) {
+	row := q.db.QueryRowContext(ctx, getRateLimitCounter, key)
+	var i GetRateLimitCounterRow
+	err := row.Scan(&i.Count, &i.ExpiresAt)
+	return i, err
+}
+
+const incrementRateLimitCounter = `-- name: IncrementRateLimitCounter :one
+INSERT INTO rate_limit_counters (
+    key, count, expires_at
+) VALUES (
+    $1, 1, NOW() + make_interval(secs => $2::float8)
+)
+ON CONFLICT (key) DO UPDATE
+SET count = CASE
+        WHEN rate_limit_counters.expires_at <= NOW() THEN 1
+        ELSE rate_limit_counters.count + 1
+    END,
+    expires_at = CASE
+        WHEN rate_limit_counters.expires_at <= NOW() THEN EXCLUDED.expires_at
+        ELSE rate_limit_counters.expires_at
+    END
+RETURNING count, expires_at
+`
+
+type IncrementRateLimitCounterParams struct {
+	Key           string  `json:"key"`
+	WindowSeconds float64 `json:"window_seconds"`
+}
+
+type IncrementRateLimitCounterRow struct {
+	Count     int64     `json:"count"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (q *Queries) IncrementRateLimitCounter(ctx context.Context, arg IncrementRateLimitCounterParams) (IncrementRateLimitCounterRow, error) {
+	row := q.db.QueryRowContext(ctx, incrementRateLimitCounter, arg.Key, arg.WindowSeconds)
+	var i IncrementRateLimitCounterRow
+	err := row.Scan(&i.Count, &i.ExpiresAt)
+	return i, err
+}
@@ -0,0 +1,199 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: settings.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+const deleteOrganizationSetting = `-- name: DeleteOrganizationSetting :exec
+DELETE FROM organization_settings
+WHERE organization_id = $1::uuid AND key = $2
+`
+
+type DeleteOrganizationSettingParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Key            string    `json:"key"`
+}
+
+func (q *Queries) DeleteOrganizationSetting(ctx context.Context, arg DeleteOrganizationSettingParams) error {
+	_, err := q.db.ExecContext(ctx, deleteOrganizationSetting, arg.OrganizationID, arg.Key)
+	return err
+}
+
+const deleteTenantSetting = `-- name: DeleteTenantSetting :exec
+DELETE FROM tenant_settings
+WHERE tenant_id = $1::uuid AND key = $2
+`
+
+type DeleteTenantSettingParams struct {
+	TenantID uuid.UUID `json:"tenant_id"`
+	Key      string    `json:"key"`
+}
+
+func (q *Queries) DeleteTenantSetting(ctx context.Context, arg DeleteTenantSettingParams) error {
+	_, err := q.db.ExecContext(ctx, deleteTenantSetting, arg.TenantID, arg.Key)
+	return err
+}
+
+const listOrganizationSettings = `-- name: ListOrganizationSettings :many
+SELECT organization_id, key, value, version, updated_by, created_at, updated_at FROM organization_settings
+WHERE organization_id = $1::uuid
+ORDER BY key
+`
+
+func (q *Queries) ListOrganizationSettings(ctx context.Context, organizationID uuid.UUID) ([]OrganizationSetting, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizationSettings, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OrganizationSetting{}
+	for rows.Next() {
+		var i OrganizationSetting
+		if err := rows.Scan(
+			&i.OrganizationID,
+			&i.Key,
+			&i.Value,
+			&i.Version,
+			&i.UpdatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTenantSettings = `-- name: ListTenantSettings :many
+SELECT tenant_id, key, value, version, updated_by, created_at, updated_at FROM tenant_settings
+WHERE tenant_id = $1::uuid
+ORDER BY key
+`
+
+func (q *Queries) ListTenantSettings(ctx context.Context, tenantID uuid.UUID) ([]TenantSetting, error) {
+	rows, err := q.db.QueryContext(ctx, listTenantSettings, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TenantSetting{}
+	for rows.Next() {
+		var i TenantSetting
+		if err := rows.Scan(
+			&i.TenantID,
+			&i.Key,
+			&i.Value,
+			&i.Version,
+			&i.UpdatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertOrganizationSetting = `-- name: UpsertOrganizationSetting :one
+INSERT INTO organization_settings (
+    organization_id, key, value, updated_by
+) VALUES (
+    $1::uuid, $2, $3, $4
+)
+ON CONFLICT (organization_id, key) DO UPDATE
+SET value = EXCLUDED.value,
+    version = organization_settings.version + 1,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = NOW()
+RETURNING organization_id, key, value, version, updated_by, created_at, updated_at
+`
+
+type UpsertOrganizationSettingParams struct {
+	OrganizationID uuid.UUID       `json:"organization_id"`
+	Key            string          `json:"key"`
+	Value          json.RawMessage `json:"value"`
+	UpdatedBy      uuid.NullUUID   `json:"updated_by"`
+}
+
+func (q *Queries) UpsertOrganizationSetting(ctx context.Context, arg UpsertOrganizationSettingParams) (OrganizationSetting, error) {
+	row := q.db.QueryRowContext(ctx, upsertOrganizationSetting,
+		arg.OrganizationID,
+		arg.Key,
+		arg.Value,
+		arg.UpdatedBy,
+	)
+	var i OrganizationSetting
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.Key,
+		&i.Value,
+		&i.Version,
+		&i.UpdatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertTenantSetting = `-- name: UpsertTenantSetting :one
+INSERT INTO tenant_settings (
+    tenant_id, key, value, updated_by
+) VALUES (
+    $1::uuid, $2, $3, $4
+)
+ON CONFLICT (tenant_id, key) DO UPDATE
+SET value = EXCLUDED.value,
+    version = tenant_settings.version + 1,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = NOW()
+RETURNING tenant_id, key, value, version, updated_by, created_at, updated_at
+`
+
+type UpsertTenantSettingParams struct {
+	TenantID  uuid.UUID       `json:"tenant_id"`
+	Key       string          `json:"key"`
+	Value     json.RawMessage `json:"value"`
+	UpdatedBy uuid.NullUUID   `json:"updated_by"`
+}
+
+func (q *Queries) UpsertTenantSetting(ctx context.Context, arg UpsertTenantSettingParams) (TenantSetting, error) {
+	row := q.db.QueryRowContext(ctx, upsertTenantSetting,
+		arg.TenantID,
+		arg.Key,
+		arg.Value,
+		arg.UpdatedBy,
+	)
+	var i TenantSetting
+	err := row.Scan(
+		&i.TenantID,
+		&i.Key,
+		&i.Value,
+		&i.Version,
+		&i.UpdatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
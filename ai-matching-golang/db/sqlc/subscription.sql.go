@@ -0,0 +1,209 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: subscription.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const getOrganizationApiUsage = `-- name: GetOrganizationApiUsage :one
+SELECT COALESCE(SUM(call_count), 0)::bigint FROM organization_api_usage
+WHERE organization_id = $1::uuid AND period_start = $2::date
+`
+
+type GetOrganizationApiUsageParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	PeriodStart    time.Time `json:"period_start"`
+}
+
+func (q *Queries) GetOrganizationApiUsage(ctx context.Context, arg GetOrganizationApiUsageParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationApiUsage, arg.OrganizationID, arg.PeriodStart)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const getOrganizationSubscription = `-- name: GetOrganizationSubscription :one
+SELECT organization_id, plan_code, status, started_at, created_at, updated_at FROM organization_subscriptions
+WHERE organization_id = $1::uuid
+LIMIT 1
+`
+
+func (q *Queries) GetOrganizationSubscription(ctx context.Context, organizationID uuid.UUID) (OrganizationSubscription, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationSubscription, organizationID)
+	var i OrganizationSubscription
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.PlanCode,
+		&i.Status,
+		&i.StartedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getPlan = `-- name: GetPlan :one
+SELECT code, name, max_tenants, max_seats_per_tenant, max_api_calls_per_month, created_at, updated_at FROM plans
+WHERE code = $1
+LIMIT 1
+`
+
+func (q *Queries) GetPlan(ctx context.Context, code string) (Plan, error) {
+	row := q.db.QueryRowContext(ctx, getPlan, code)
+	var i Plan
+	err := row.Scan(
+		&i.Code,
+		&i.Name,
+		&i.MaxTenants,
+		&i.MaxSeatsPerTenant,
+		&i.MaxApiCallsPerMonth,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const incrementOrganizationApiUsage = `-- name: IncrementOrganizationApiUsage :one
+INSERT INTO organization_api_usage (
+    organization_id, period_start, call_count
+) VALUES (
+    $1::uuid, $2::date, 1
+)
+ON CONFLICT (organization_id, period_start) DO UPDATE
+SET call_count = organization_api_usage.call_count + 1,
+    updated_at = NOW()
+RETURNING call_count
+`
+
+type IncrementOrganizationApiUsageParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	PeriodStart    time.Time `json:"period_start"`
+}
+
+func (q *Queries) IncrementOrganizationApiUsage(ctx context.Context, arg IncrementOrganizationApiUsageParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, incrementOrganizationApiUsage, arg.OrganizationID, arg.PeriodStart)
+	var call_count int64
+	err := row.Scan(&call_count)
+	return call_count, err
+}
+
+const listPlans = `-- name: ListPlans :many
+SELECT code, name, max_tenants, max_seats_per_tenant, max_api_calls_per_month, created_at, updated_at FROM plans
+ORDER BY max_tenants NULLS LAST, code
+`
+
+func (q *Queries) ListPlans(ctx context.Context) ([]Plan, error) {
+	rows, err := q.db.QueryContext(ctx, listPlans)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Plan{}
+	for rows.Next() {
+		var i Plan
+		if err := rows.Scan(
+			&i.Code,
+			&i.Name,
+			&i.MaxTenants,
+			&i.MaxSeatsPerTenant,
+			&i.MaxApiCallsPerMonth,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTenantSeatCounts = `-- name: ListTenantSeatCounts :many
+SELECT
+    t.id,
+    t.name,
+    COUNT(tu.id) AS seat_count
+FROM tenants t
+LEFT JOIN tenant_users tu ON t.id = tu.tenant_id
+WHERE t.organization_id = $1::uuid
+GROUP BY t.id, t.name
+ORDER BY t.name
+`
+
+type ListTenantSeatCountsRow struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	SeatCount int64     `json:"seat_count"`
+}
+
+func (q *Queries) ListTenantSeatCounts(ctx context.Context, organizationID uuid.UUID) ([]ListTenantSeatCountsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTenantSeatCounts, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTenantSeatCountsRow{}
+	for rows.Next() {
+		var i ListTenantSeatCountsRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.SeatCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertOrganizationSubscription = `-- name: UpsertOrganizationSubscription :one
+INSERT INTO organization_subscriptions (
+    organization_id, plan_code, status
+) VALUES (
+    $1::uuid, $2, $3
+)
+ON CONFLICT (organization_id) DO UPDATE
+SET plan_code = EXCLUDED.plan_code,
+    status = EXCLUDED.status,
+    started_at = CASE
+        WHEN organization_subscriptions.plan_code = EXCLUDED.plan_code THEN organization_subscriptions.started_at
+        ELSE NOW()
+    END,
+    updated_at = NOW()
+RETURNING organization_id, plan_code, status, started_at, created_at, updated_at
+`
+
+type UpsertOrganizationSubscriptionParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	PlanCode       string    `json:"plan_code"`
+	Status         string    `json:"status"`
+}
+
+func (q *Queries) UpsertOrganizationSubscription(ctx context.Context, arg UpsertOrganizationSubscriptionParams) (OrganizationSubscription, error) {
+	row := q.db.QueryRowContext(ctx, upsertOrganizationSubscription, arg.OrganizationID, arg.PlanCode, arg.Status)
+	var i OrganizationSubscription
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.PlanCode,
+		&i.Status,
+		&i.StartedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
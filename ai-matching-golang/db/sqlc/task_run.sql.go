@@ -0,0 +1,245 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: task_run.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const countTaskRuns = `-- name: CountTaskRuns :one
+SELECT COUNT(*) FROM task_runs
+WHERE task_name = $1
+`
+
+func (q *Queries) CountTaskRuns(ctx context.Context, taskName string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countTaskRuns, taskName)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteTaskRunsBefore = `-- name: DeleteTaskRunsBefore :execrows
+DELETE FROM task_runs
+WHERE started_at < $1::timestamp AND status <> 'running'
+`
+
+func (q *Queries) DeleteTaskRunsBefore(ctx context.Context, before time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteTaskRunsBefore, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const finishTaskRun = `-- name: FinishTaskRun :exec
+UPDATE task_runs
+SET status = $1,
+    summary = $2::text,
+    error_message = $3::text,
+    finished_at = NOW()
+WHERE id = $4::uuid
+`
+
+type FinishTaskRunParams struct {
+	Status       string         `json:"status"`
+	Summary      sql.NullString `json:"summary"`
+	ErrorMessage sql.NullString `json:"error_message"`
+	ID           uuid.UUID      `json:"id"`
+}
+
+func (q *Queries) FinishTaskRun(ctx context.Context, arg FinishTaskRunParams) error {
+	_, err := q.db.ExecContext(ctx, finishTaskRun,
+		arg.Status,
+		arg.Summary,
+		arg.ErrorMessage,
+		arg.ID,
+	)
+	return err
+}
+
+const listLatestTaskRuns = `-- name: ListLatestTaskRuns :many
+SELECT DISTINCT ON (task_name) id, task_name, trigger, scheduled_for, status, summary, error_message, triggered_by, worker_id, started_at, finished_at FROM task_runs
+ORDER BY task_name, started_at DESC
+`
+
+func (q *Queries) ListLatestTaskRuns(ctx context.Context) ([]TaskRun, error) {
+	rows, err := q.db.QueryContext(ctx, listLatestTaskRuns)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TaskRun{}
+	for rows.Next() {
+		var i TaskRun
+		if err := rows.Scan(
+			&i.ID,
+			&i.TaskName,
+			&i.Trigger,
+			&i.ScheduledFor,
+			&i.Status,
+			&i.Summary,
+			&i.ErrorMessage,
+			&i.TriggeredBy,
+			&i.WorkerID,
+			&i.StartedAt,
+			&i.FinishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTaskRuns = `-- name: ListTaskRuns :many
+SELECT id, task_name, trigger, scheduled_for, status, summary, error_message, triggered_by, worker_id, started_at, finished_at FROM task_runs
+WHERE task_name = $1
+ORDER BY started_at DESC
+LIMIT $3 OFFSET $2
+`
+
+type ListTaskRunsParams struct {
+	TaskName  string `json:"task_name"`
+	RowOffset int32  `json:"row_offset"`
+	RowLimit  int32  `json:"row_limit"`
+}
+
+func (q *Queries) ListTaskRuns(ctx context.Context, arg ListTaskRunsParams) ([]TaskRun, error) {
+	rows, err := q.db.QueryContext(ctx, listTaskRuns, arg.TaskName, arg.RowOffset, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TaskRun{}
+	for rows.Next() {
+		var i TaskRun
+		if err := rows.Scan(
+			&i.ID,
+			&i.TaskName,
+			&i.Trigger,
+			&i.ScheduledFor,
+			&i.Status,
+			&i.Summary,
+			&i.ErrorMessage,
+			&i.TriggeredBy,
+			&i.WorkerID,
+			&i.StartedAt,
+			&i.FinishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const releaseTaskLock = `-- name: ReleaseTaskLock :exec
+SELECT pg_advisory_unlock(hashtext($1::text)::bigint)
+`
+
+func (q *Queries) ReleaseTaskLock(ctx context.Context, lockKey string) error {
+	_, err := q.db.ExecContext(ctx, releaseTaskLock, lockKey)
+	return err
+}
+
+const startManualTaskRun = `-- name: StartManualTaskRun :one
+INSERT INTO task_runs (
+    task_name, trigger, triggered_by, worker_id
+) VALUES (
+    $1, 'manual', $2::uuid, $3
+)
+RETURNING id, task_name, trigger, scheduled_for, status, summary, error_message, triggered_by, worker_id, started_at, finished_at
+`
+
+type StartManualTaskRunParams struct {
+	TaskName    string        `json:"task_name"`
+	TriggeredBy uuid.NullUUID `json:"triggered_by"`
+	WorkerID    string        `json:"worker_id"`
+}
+
+func (q *Queries) StartManualTaskRun(ctx context.Context, arg StartManualTaskRunParams) (TaskRun, error) {
+	row := q.db.QueryRowContext(ctx, startManualTaskRun, arg.TaskName, arg.TriggeredBy, arg.WorkerID)
+	var i TaskRun
+	err := row.Scan(
+		&i.ID,
+		&i.TaskName,
+		&i.Trigger,
+		&i.ScheduledFor,
+		&i.Status,
+		&i.Summary,
+		&i.ErrorMessage,
+		&i.TriggeredBy,
+		&i.WorkerID,
+		&i.StartedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const startScheduledTaskRun = `-- name: StartScheduledTaskRun :one
+INSERT INTO task_runs (
+    task_name, trigger, scheduled_for, worker_id
+) VALUES (
+    $1, 'schedule', $2::timestamp, $3
+)
+ON CONFLICT (task_name, scheduled_for) WHERE trigger = 'schedule' DO NOTHING
+RETURNING id, task_name, trigger, scheduled_for, status, summary, error_message, triggered_by, worker_id, started_at, finished_at
+`
+
+type StartScheduledTaskRunParams struct {
+	TaskName     string    `json:"task_name"`
+	ScheduledFor time.Time `json:"scheduled_for"`
+	WorkerID     string    `json:"worker_id"`
+}
+
+// Returns no row when another replica already ran the task for this slot.
+func (q *Queries) StartScheduledTaskRun(ctx context.Context, arg StartScheduledTaskRunParams) (TaskRun, error) {
+	row := q.db.QueryRowContext(ctx, startScheduledTaskRun, arg.TaskName, arg.ScheduledFor, arg.WorkerID)
+	var i TaskRun
+	err := row.Scan(
+		&i.ID,
+		&i.TaskName,
+		&i.Trigger,
+		&i.ScheduledFor,
+		&i.Status,
+		&i.Summary,
+		&i.ErrorMessage,
+		&i.TriggeredBy,
+		&i.WorkerID,
+		&i.StartedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const tryTaskLock = `-- name: TryTaskLock :one
+SELECT pg_try_advisory_lock(hashtext($1::text)::bigint)::boolean AS locked
+`
+
+// Session-level advisory lock held by the scheduler while a task runs; take
+// and release it on the same dedicated connection.
+func (q *Queries) TryTaskLock(ctx context.Context, lockKey string) (bool, error) {
+	row := q.db.QueryRowContext(ctx, tryTaskLock, lockKey)
+	var locked bool
+	err := row.Scan(&locked)
+	return locked, err
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 const checkUserBelongsToTenant = `-- name: CheckUserBelongsToTenant :one
@@ -80,6 +81,19 @@ func (q *Queries) CreateTenant(ctx context.Context, arg CreateTenantParams) (Ten
 	return i, err
 }
 
+const deleteExpiredTenantSubdomainAliases = `-- name: DeleteExpiredTenantSubdomainAliases :execrows
+DELETE FROM tenant_subdomain_aliases
+WHERE expires_at <= NOW()
+`
+
+func (q *Queries) DeleteExpiredTenantSubdomainAliases(ctx context.Context) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteExpiredTenantSubdomainAliases)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteTenant = `-- name: DeleteTenant :exec
 DELETE FROM tenants
 WHERE id = $1::uuid
@@ -90,6 +104,16 @@ func (q *Queries) DeleteTenant(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const deleteTenantSubdomainAlias = `-- name: DeleteTenantSubdomainAlias :exec
+DELETE FROM tenant_subdomain_aliases
+WHERE subdomain = $1
+`
+
+func (q *Queries) DeleteTenantSubdomainAlias(ctx context.Context, subdomain string) error {
+	_, err := q.db.ExecContext(ctx, deleteTenantSubdomainAlias, subdomain)
+	return err
+}
+
 const getTenant = `-- name: GetTenant :one
 SELECT id, organization_id, name, subdomain, is_active, created_at, updated_at FROM tenants
 WHERE id = $1::uuid LIMIT 1
@@ -131,6 +155,28 @@ func (q *Queries) GetTenantBySubdomain(ctx context.Context, subdomain string) (T
 	return i, err
 }
 
+const getTenantBySubdomainAlias = `-- name: GetTenantBySubdomainAlias :one
+SELECT t.id, t.organization_id, t.name, t.subdomain, t.is_active, t.created_at, t.updated_at FROM tenants t
+INNER JOIN tenant_subdomain_aliases a ON a.tenant_id = t.id
+WHERE a.subdomain = $1 AND a.expires_at > NOW() AND t.is_active = true
+LIMIT 1
+`
+
+func (q *Queries) GetTenantBySubdomainAlias(ctx context.Context, subdomain string) (Tenant, error) {
+	row := q.db.QueryRowContext(ctx, getTenantBySubdomainAlias, subdomain)
+	var i Tenant
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Subdomain,
+		&i.IsActive,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getTenantWithUserCount = `-- name: GetTenantWithUserCount :one
 SELECT 
     t.id, t.organization_id, t.name, t.subdomain, t.is_active, t.created_at, t.updated_at,
@@ -219,6 +265,44 @@ func (q *Queries) GetTenantsByUserID(ctx context.Context, userID uuid.UUID) ([]G
 	return items, nil
 }
 
+const listTakenSubdomains = `-- name: ListTakenSubdomains :many
+SELECT t.subdomain::text AS subdomain FROM tenants t
+WHERE t.subdomain = ANY($1::text[]) AND t.id <> $2::uuid
+UNION
+SELECT a.subdomain::text AS subdomain FROM tenant_subdomain_aliases a
+WHERE a.subdomain = ANY($1::text[]) AND a.expires_at > NOW() AND a.tenant_id <> $2::uuid
+`
+
+type ListTakenSubdomainsParams struct {
+	Subdomains []string  `json:"subdomains"`
+	TenantID   uuid.UUID `json:"tenant_id"`
+}
+
+// Returns the candidates already used by another tenant, either as its
+// current subdomain or as an unexpired alias.
+func (q *Queries) ListTakenSubdomains(ctx context.Context, arg ListTakenSubdomainsParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listTakenSubdomains, pq.Array(arg.Subdomains), arg.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var subdomain string
+		if err := rows.Scan(&subdomain); err != nil {
+			return nil, err
+		}
+		items = append(items, subdomain)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTenantsByOrganization = `-- name: ListTenantsByOrganization :many
 SELECT id, organization_id, name, subdomain, is_active, created_at, updated_at FROM tenants
 WHERE organization_id = $3::uuid AND is_active = true
@@ -299,3 +383,25 @@ func (q *Queries) UpdateTenant(ctx context.Context, arg UpdateTenantParams) (Ten
 	)
 	return i, err
 }
+
+const upsertTenantSubdomainAlias = `-- name: UpsertTenantSubdomainAlias :exec
+INSERT INTO tenant_subdomain_aliases (
+    subdomain, tenant_id, expires_at
+) VALUES (
+    $1, $2::uuid, $3
+)
+ON CONFLICT (subdomain) DO UPDATE
+SET tenant_id = EXCLUDED.tenant_id,
+    expires_at = EXCLUDED.expires_at
+`
+
+type UpsertTenantSubdomainAliasParams struct {
+	Subdomain string    `json:"subdomain"`
+	TenantID  uuid.UUID `json:"tenant_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (q *Queries) UpsertTenantSubdomainAlias(ctx context.Context, arg UpsertTenantSubdomainAliasParams) error {
+	_, err := q.db.ExecContext(ctx, upsertTenantSubdomainAlias, arg.Subdomain, arg.TenantID, arg.ExpiresAt)
+	return err
+}
@@ -0,0 +1,192 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: tenant_domain.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const createTenantDomain = `-- name: CreateTenantDomain :one
+INSERT INTO tenant_domains (
+    tenant_id, domain, verification_token
+) VALUES (
+    $1::uuid, $2, $3
+)
+RETURNING id, tenant_id, domain, verification_token, is_primary, verified_at, created_at, updated_at
+`
+
+type CreateTenantDomainParams struct {
+	TenantID          uuid.UUID `json:"tenant_id"`
+	Domain            string    `json:"domain"`
+	VerificationToken string    `json:"verification_token"`
+}
+
+func (q *Queries) CreateTenantDomain(ctx context.Context, arg CreateTenantDomainParams) (TenantDomain, error) {
+	row := q.db.QueryRowContext(ctx, createTenantDomain, arg.TenantID, arg.Domain, arg.VerificationToken)
+	var i TenantDomain
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Domain,
+		&i.VerificationToken,
+		&i.IsPrimary,
+		&i.VerifiedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteTenantDomain = `-- name: DeleteTenantDomain :exec
+DELETE FROM tenant_domains
+WHERE id = $1::uuid AND tenant_id = $2::uuid
+`
+
+type DeleteTenantDomainParams struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+func (q *Queries) DeleteTenantDomain(ctx context.Context, arg DeleteTenantDomainParams) error {
+	_, err := q.db.ExecContext(ctx, deleteTenantDomain, arg.ID, arg.TenantID)
+	return err
+}
+
+const getTenantByVerifiedDomain = `-- name: GetTenantByVerifiedDomain :one
+SELECT t.id, t.organization_id, t.name, t.subdomain, t.is_active, t.created_at, t.updated_at FROM tenants t
+INNER JOIN tenant_domains d ON d.tenant_id = t.id
+WHERE d.domain = $1 AND d.verified_at IS NOT NULL AND t.is_active = true
+LIMIT 1
+`
+
+func (q *Queries) GetTenantByVerifiedDomain(ctx context.Context, domain string) (Tenant, error) {
+	row := q.db.QueryRowContext(ctx, getTenantByVerifiedDomain, domain)
+	var i Tenant
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.Subdomain,
+		&i.IsActive,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getTenantDomain = `-- name: GetTenantDomain :one
+SELECT id, tenant_id, domain, verification_token, is_primary, verified_at, created_at, updated_at FROM tenant_domains
+WHERE id = $1::uuid AND tenant_id = $2::uuid
+LIMIT 1
+`
+
+type GetTenantDomainParams struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+func (q *Queries) GetTenantDomain(ctx context.Context, arg GetTenantDomainParams) (TenantDomain, error) {
+	row := q.db.QueryRowContext(ctx, getTenantDomain, arg.ID, arg.TenantID)
+	var i TenantDomain
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Domain,
+		&i.VerificationToken,
+		&i.IsPrimary,
+		&i.VerifiedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listTenantDomains = `-- name: ListTenantDomains :many
+SELECT id, tenant_id, domain, verification_token, is_primary, verified_at, created_at, updated_at FROM tenant_domains
+WHERE tenant_id = $1::uuid
+ORDER BY is_primary DESC, created_at
+`
+
+func (q *Queries) ListTenantDomains(ctx context.Context, tenantID uuid.UUID) ([]TenantDomain, error) {
+	rows, err := q.db.QueryContext(ctx, listTenantDomains, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TenantDomain{}
+	for rows.Next() {
+		var i TenantDomain
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Domain,
+			&i.VerificationToken,
+			&i.IsPrimary,
+			&i.VerifiedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markTenantDomainVerified = `-- name: MarkTenantDomainVerified :one
+UPDATE tenant_domains
+SET verified_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1::uuid AND tenant_id = $2::uuid
+RETURNING id, tenant_id, domain, verification_token, is_primary, verified_at, created_at, updated_at
+`
+
+type MarkTenantDomainVerifiedParams struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+func (q *Queries) MarkTenantDomainVerified(ctx context.Context, arg MarkTenantDomainVerifiedParams) (TenantDomain, error) {
+	row := q.db.QueryRowContext(ctx, markTenantDomainVerified, arg.ID, arg.TenantID)
+	var i TenantDomain
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.Domain,
+		&i.VerificationToken,
+		&i.IsPrimary,
+		&i.VerifiedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const setPrimaryTenantDomain = `-- name: SetPrimaryTenantDomain :exec
+UPDATE tenant_domains
+SET is_primary = (id = $1::uuid),
+    updated_at = NOW()
+WHERE tenant_id = $2::uuid
+  AND (is_primary = true OR id = $1::uuid)
+`
+
+type SetPrimaryTenantDomainParams struct {
+	ID       uuid.UUID `json:"id"`
+	TenantID uuid.UUID `json:"tenant_id"`
+}
+
+func (q *Queries) SetPrimaryTenantDomain(ctx context.Context, arg SetPrimaryTenantDomainParams) error {
+	_, err := q.db.ExecContext(ctx, setPrimaryTenantDomain, arg.ID, arg.TenantID)
+	return err
+}
@@ -42,6 +42,18 @@ func (q *Queries) AddUserToTenant(ctx context.Context, arg AddUserToTenantParams
 	return i, err
 }
 
+const countTenantUsers = `-- name: CountTenantUsers :one
+SELECT COUNT(*) FROM tenant_users
+WHERE tenant_id = $1::uuid
+`
+
+func (q *Queries) CountTenantUsers(ctx context.Context, tenantID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countTenantUsers, tenantID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const getTenantUser = `-- name: GetTenantUser :one
 SELECT id, tenant_id, user_id, role, created_at, updated_at FROM tenant_users
 WHERE tenant_id = $1::uuid AND user_id = $2::uuid
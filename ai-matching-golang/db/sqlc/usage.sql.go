@@ -0,0 +1,278 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: usage.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const countOrganizationMonthlyActiveUsers = `-- name: CountOrganizationMonthlyActiveUsers :many
+SELECT
+    organization_id,
+    COUNT(DISTINCT user_id) AS active_users
+FROM user_daily_activity
+WHERE activity_date >= $1::date AND activity_date < $2::date
+GROUP BY organization_id
+`
+
+type CountOrganizationMonthlyActiveUsersParams struct {
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+}
+
+type CountOrganizationMonthlyActiveUsersRow struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	ActiveUsers    int64     `json:"active_users"`
+}
+
+func (q *Queries) CountOrganizationMonthlyActiveUsers(ctx context.Context, arg CountOrganizationMonthlyActiveUsersParams) ([]CountOrganizationMonthlyActiveUsersRow, error) {
+	rows, err := q.db.QueryContext(ctx, countOrganizationMonthlyActiveUsers, arg.PeriodStart, arg.PeriodEnd)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountOrganizationMonthlyActiveUsersRow{}
+	for rows.Next() {
+		var i CountOrganizationMonthlyActiveUsersRow
+		if err := rows.Scan(&i.OrganizationID, &i.ActiveUsers); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countTenantMonthlyActiveUsers = `-- name: CountTenantMonthlyActiveUsers :many
+SELECT
+    organization_id,
+    tenant_id,
+    COUNT(DISTINCT user_id) AS active_users
+FROM user_daily_activity
+WHERE activity_date >= $1::date AND activity_date < $2::date
+GROUP BY organization_id, tenant_id
+`
+
+type CountTenantMonthlyActiveUsersParams struct {
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+}
+
+type CountTenantMonthlyActiveUsersRow struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	TenantID       uuid.UUID `json:"tenant_id"`
+	ActiveUsers    int64     `json:"active_users"`
+}
+
+func (q *Queries) CountTenantMonthlyActiveUsers(ctx context.Context, arg CountTenantMonthlyActiveUsersParams) ([]CountTenantMonthlyActiveUsersRow, error) {
+	rows, err := q.db.QueryContext(ctx, countTenantMonthlyActiveUsers, arg.PeriodStart, arg.PeriodEnd)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountTenantMonthlyActiveUsersRow{}
+	for rows.Next() {
+		var i CountTenantMonthlyActiveUsersRow
+		if err := rows.Scan(&i.OrganizationID, &i.TenantID, &i.ActiveUsers); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsageRecordsByPeriod = `-- name: ListUsageRecordsByPeriod :many
+SELECT
+    ur.organization_id,
+    o.name AS organization_name,
+    ur.tenant_id,
+    t.name AS tenant_name,
+    ur.metric,
+    ur.usage_date,
+    ur.quantity
+FROM usage_records ur
+INNER JOIN organizations o ON ur.organization_id = o.id
+LEFT JOIN tenants t ON ur.tenant_id = t.id
+WHERE ur.usage_date >= $1::date AND ur.usage_date < $2::date
+ORDER BY o.name, ur.organization_id, t.name NULLS FIRST, ur.usage_date
+`
+
+type ListUsageRecordsByPeriodParams struct {
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+}
+
+type ListUsageRecordsByPeriodRow struct {
+	OrganizationID   uuid.UUID      `json:"organization_id"`
+	OrganizationName string         `json:"organization_name"`
+	TenantID         uuid.NullUUID  `json:"tenant_id"`
+	TenantName       sql.NullString `json:"tenant_name"`
+	Metric           string         `json:"metric"`
+	UsageDate        time.Time      `json:"usage_date"`
+	Quantity         int64          `json:"quantity"`
+}
+
+func (q *Queries) ListUsageRecordsByPeriod(ctx context.Context, arg ListUsageRecordsByPeriodParams) ([]ListUsageRecordsByPeriodRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUsageRecordsByPeriod, arg.PeriodStart, arg.PeriodEnd)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUsageRecordsByPeriodRow{}
+	for rows.Next() {
+		var i ListUsageRecordsByPeriodRow
+		if err := rows.Scan(
+			&i.OrganizationID,
+			&i.OrganizationName,
+			&i.TenantID,
+			&i.TenantName,
+			&i.Metric,
+			&i.UsageDate,
+			&i.Quantity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recordUserActivity = `-- name: RecordUserActivity :exec
+INSERT INTO user_daily_activity (
+    activity_date, tenant_id, user_id, organization_id
+) VALUES (
+    $1::date, $2::uuid, $3::uuid, $4::uuid
+)
+ON CONFLICT (activity_date, tenant_id, user_id) DO NOTHING
+`
+
+type RecordUserActivityParams struct {
+	ActivityDate   time.Time `json:"activity_date"`
+	TenantID       uuid.UUID `json:"tenant_id"`
+	UserID         uuid.UUID `json:"user_id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+}
+
+func (q *Queries) RecordUserActivity(ctx context.Context, arg RecordUserActivityParams) error {
+	_, err := q.db.ExecContext(ctx, recordUserActivity,
+		arg.ActivityDate,
+		arg.TenantID,
+		arg.UserID,
+		arg.OrganizationID,
+	)
+	return err
+}
+
+const snapshotOrganizationActiveUsers = `-- name: SnapshotOrganizationActiveUsers :execrows
+INSERT INTO usage_records (organization_id, metric, usage_date, quantity)
+SELECT organization_id, 'active_users', $1::date, COUNT(DISTINCT user_id)
+FROM user_daily_activity
+WHERE activity_date = $1::date
+GROUP BY organization_id
+ON CONFLICT (organization_id, metric, usage_date) WHERE tenant_id IS NULL
+DO UPDATE SET quantity = EXCLUDED.quantity, updated_at = NOW()
+`
+
+func (q *Queries) SnapshotOrganizationActiveUsers(ctx context.Context, usageDate time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, snapshotOrganizationActiveUsers, usageDate)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const snapshotOrganizationApiCalls = `-- name: SnapshotOrganizationApiCalls :execrows
+INSERT INTO usage_records (organization_id, metric, usage_date, quantity)
+SELECT organization_id, 'api_calls', $1::date, call_count
+FROM organization_api_usage
+WHERE period_start = date_trunc('month', $1::date)::date
+ON CONFLICT (organization_id, metric, usage_date) WHERE tenant_id IS NULL
+DO UPDATE SET quantity = EXCLUDED.quantity, updated_at = NOW()
+`
+
+func (q *Queries) SnapshotOrganizationApiCalls(ctx context.Context, usageDate time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, snapshotOrganizationApiCalls, usageDate)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const snapshotOrganizationSeats = `-- name: SnapshotOrganizationSeats :execrows
+INSERT INTO usage_records (organization_id, metric, usage_date, quantity)
+SELECT o.id, 'seats', $1::date, COUNT(DISTINCT tu.user_id)
+FROM organizations o
+LEFT JOIN tenants t ON o.id = t.organization_id
+LEFT JOIN tenant_users tu ON t.id = tu.tenant_id
+GROUP BY o.id
+ON CONFLICT (organization_id, metric, usage_date) WHERE tenant_id IS NULL
+DO UPDATE SET quantity = EXCLUDED.quantity, updated_at = NOW()
+`
+
+func (q *Queries) SnapshotOrganizationSeats(ctx context.Context, usageDate time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, snapshotOrganizationSeats, usageDate)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const snapshotTenantActiveUsers = `-- name: SnapshotTenantActiveUsers :execrows
+INSERT INTO usage_records (organization_id, tenant_id, metric, usage_date, quantity)
+SELECT organization_id, tenant_id, 'active_users', $1::date, COUNT(*)
+FROM user_daily_activity
+WHERE activity_date = $1::date
+GROUP BY organization_id, tenant_id
+ON CONFLICT (organization_id, tenant_id, metric, usage_date) WHERE tenant_id IS NOT NULL
+DO UPDATE SET quantity = EXCLUDED.quantity, updated_at = NOW()
+`
+
+func (q *Queries) SnapshotTenantActiveUsers(ctx context.Context, usageDate time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, snapshotTenantActiveUsers, usageDate)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const snapshotTenantSeats = `-- name: SnapshotTenantSeats :execrows
+INSERT INTO usage_records (organization_id, tenant_id, metric, usage_date, quantity)
+SELECT t.organization_id, t.id, 'seats', $1::date, COUNT(tu.id)
+FROM tenants t
+LEFT JOIN tenant_users tu ON t.id = tu.tenant_id
+GROUP BY t.organization_id, t.id
+ON CONFLICT (organization_id, tenant_id, metric, usage_date) WHERE tenant_id IS NOT NULL
+DO UPDATE SET quantity = EXCLUDED.quantity, updated_at = NOW()
+`
+
+func (q *Queries) SnapshotTenantSeats(ctx context.Context, usageDate time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, snapshotTenantSeats, usageDate)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,287 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: user_import.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createUserImport = `-- name: CreateUserImport :one
+INSERT INTO user_imports (
+    organization_id, created_by, total_rows
+) VALUES (
+    $1::uuid, $2, $3
+)
+RETURNING id, organization_id, created_by, status, total_rows, succeeded_rows, failed_rows, started_at, completed_at, created_at, updated_at
+`
+
+type CreateUserImportParams struct {
+	OrganizationID uuid.UUID     `json:"organization_id"`
+	CreatedBy      uuid.NullUUID `json:"created_by"`
+	TotalRows      int32         `json:"total_rows"`
+}
+
+func (q *Queries) CreateUserImport(ctx context.Context, arg CreateUserImportParams) (UserImport, error) {
+	row := q.db.QueryRowContext(ctx, createUserImport, arg.OrganizationID, arg.CreatedBy, arg.TotalRows)
+	var i UserImport
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.CreatedBy,
+		&i.Status,
+		&i.TotalRows,
+		&i.SucceededRows,
+		&i.FailedRows,
+		&i.StartedAt,
+		&i.CompletedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createUserImportRow = `-- name: CreateUserImportRow :exec
+INSERT INTO user_import_rows (
+    import_id, row_number, email, first_name, last_name, tenant_id, role
+) VALUES (
+    $1::uuid, $2, $3, $4, $5, $6::uuid, $7
+)
+`
+
+type CreateUserImportRowParams struct {
+	ImportID  uuid.UUID `json:"import_id"`
+	RowNumber int32     `json:"row_number"`
+	Email     string    `json:"email"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+	TenantID  uuid.UUID `json:"tenant_id"`
+	Role      string    `json:"role"`
+}
+
+func (q *Queries) CreateUserImportRow(ctx context.Context, arg CreateUserImportRowParams) error {
+	_, err := q.db.ExecContext(ctx, createUserImportRow,
+		arg.ImportID,
+		arg.RowNumber,
+		arg.Email,
+		arg.FirstName,
+		arg.LastName,
+		arg.TenantID,
+		arg.Role,
+	)
+	return err
+}
+
+const finishUserImport = `-- name: FinishUserImport :exec
+UPDATE user_imports
+SET status = $1,
+    succeeded_rows = (SELECT COUNT(*) FROM user_import_rows r WHERE r.import_id = $2::uuid AND r.status IN ('created', 'added', 'skipped')),
+    failed_rows = (SELECT COUNT(*) FROM user_import_rows r WHERE r.import_id = $2::uuid AND r.status = 'failed'),
+    completed_at = NOW(),
+    updated_at = NOW()
+WHERE id = $2::uuid
+`
+
+type FinishUserImportParams struct {
+	Status string    `json:"status"`
+	ID     uuid.UUID `json:"id"`
+}
+
+func (q *Queries) FinishUserImport(ctx context.Context, arg FinishUserImportParams) error {
+	_, err := q.db.ExecContext(ctx, finishUserImport, arg.Status, arg.ID)
+	return err
+}
+
+const getUserImport = `-- name: GetUserImport :one
+SELECT id, organization_id, created_by, status, total_rows, succeeded_rows, failed_rows, started_at, completed_at, created_at, updated_at FROM user_imports
+WHERE id = $1::uuid AND organization_id = $2::uuid
+LIMIT 1
+`
+
+type GetUserImportParams struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+}
+
+func (q *Queries) GetUserImport(ctx context.Context, arg GetUserImportParams) (UserImport, error) {
+	row := q.db.QueryRowContext(ctx, getUserImport, arg.ID, arg.OrganizationID)
+	var i UserImport
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.CreatedBy,
+		&i.Status,
+		&i.TotalRows,
+		&i.SucceededRows,
+		&i.FailedRows,
+		&i.StartedAt,
+		&i.CompletedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listUserImportRows = `-- name: ListUserImportRows :many
+SELECT
+    r.id, r.import_id, r.row_number, r.email, r.first_name, r.last_name, r.tenant_id, r.role, r.status, r.message, r.user_id, r.updated_at,
+    t.subdomain AS tenant_subdomain,
+    t.organization_id
+FROM user_import_rows r
+INNER JOIN tenants t ON r.tenant_id = t.id
+WHERE r.import_id = $1::uuid
+ORDER BY r.row_number
+`
+
+type ListUserImportRowsRow struct {
+	ID              uuid.UUID      `json:"id"`
+	ImportID        uuid.UUID      `json:"import_id"`
+	RowNumber       int32          `json:"row_number"`
+	Email           string         `json:"email"`
+	FirstName       string         `json:"first_name"`
+	LastName        string         `json:"last_name"`
+	TenantID        uuid.UUID      `json:"tenant_id"`
+	Role            string         `json:"role"`
+	Status          string         `json:"status"`
+	Message         sql.NullString `json:"message"`
+	UserID          uuid.NullUUID  `json:"user_id"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	TenantSubdomain string         `json:"tenant_subdomain"`
+	OrganizationID  uuid.UUID      `json:"organization_id"`
+}
+
+func (q *Queries) ListUserImportRows(ctx context.Context, importID uuid.UUID) ([]ListUserImportRowsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUserImportRows, importID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUserImportRowsRow{}
+	for rows.Next() {
+		var i ListUserImportRowsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ImportID,
+			&i.RowNumber,
+			&i.Email,
+			&i.FirstName,
+			&i.LastName,
+			&i.TenantID,
+			&i.Role,
+			&i.Status,
+			&i.Message,
+			&i.UserID,
+			&i.UpdatedAt,
+			&i.TenantSubdomain,
+			&i.OrganizationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserImports = `-- name: ListUserImports :many
+SELECT id, organization_id, created_by, status, total_rows, succeeded_rows, failed_rows, started_at, completed_at, created_at, updated_at FROM user_imports
+WHERE organization_id = $1::uuid
+ORDER BY created_at DESC
+LIMIT $2
+`
+
+type ListUserImportsParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	RowLimit       int32     `json:"row_limit"`
+}
+
+func (q *Queries) ListUserImports(ctx context.Context, arg ListUserImportsParams) ([]UserImport, error) {
+	rows, err := q.db.QueryContext(ctx, listUserImports, arg.OrganizationID, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []UserImport{}
+	for rows.Next() {
+		var i UserImport
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.CreatedBy,
+			&i.Status,
+			&i.TotalRows,
+			&i.SucceededRows,
+			&i.FailedRows,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const startUserImport = `-- name: StartUserImport :execrows
+UPDATE user_imports
+SET status = 'processing',
+    started_at = NOW(),
+    completed_at = NULL,
+    updated_at = NOW()
+WHERE id = $1::uuid AND status IN ('pending', 'failed', 'processing')
+`
+
+// The job queue runs one attempt of a job at a time, so a row still in
+// progress was left by a worker that stopped and is taken over.
+func (q *Queries) StartUserImport(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, startUserImport, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateUserImportRow = `-- name: UpdateUserImportRow :exec
+UPDATE user_import_rows
+SET status = $1,
+    message = $2,
+    user_id = $3,
+    updated_at = NOW()
+WHERE id = $4::uuid
+`
+
+type UpdateUserImportRowParams struct {
+	Status  string         `json:"status"`
+	Message sql.NullString `json:"message"`
+	UserID  uuid.NullUUID  `json:"user_id"`
+	ID      uuid.UUID      `json:"id"`
+}
+
+func (q *Queries) UpdateUserImportRow(ctx context.Context, arg UpdateUserImportRowParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserImportRow,
+		arg.Status,
+		arg.Message,
+		arg.UserID,
+		arg.ID,
+	)
+	return err
+}
@@ -0,0 +1,586 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: webhook.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const countWebhookDeliveries = `-- name: CountWebhookDeliveries :one
+SELECT COUNT(*) FROM webhook_deliveries
+WHERE endpoint_id = $1::uuid
+  AND ($2::text IS NULL OR status = $2::text)
+`
+
+type CountWebhookDeliveriesParams struct {
+	EndpointID uuid.UUID      `json:"endpoint_id"`
+	Status     sql.NullString `json:"status"`
+}
+
+func (q *Queries) CountWebhookDeliveries(ctx context.Context, arg CountWebhookDeliveriesParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countWebhookDeliveries, arg.EndpointID, arg.Status)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createWebhookDelivery = `-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_deliveries (
+    endpoint_id, event_id, event_type, payload, redelivery_of
+) VALUES (
+    $1::uuid, $2::uuid, $3, $4, $5
+)
+RETURNING id, endpoint_id, event_id, event_type, payload, status, attempts, response_status, response_body, error_message, duration_ms, redelivery_of, last_attempt_at, delivered_at, created_at, updated_at
+`
+
+type CreateWebhookDeliveryParams struct {
+	EndpointID   uuid.UUID       `json:"endpoint_id"`
+	EventID      uuid.UUID       `json:"event_id"`
+	EventType    string          `json:"event_type"`
+	Payload      json.RawMessage `json:"payload"`
+	RedeliveryOf uuid.NullUUID   `json:"redelivery_of"`
+}
+
+func (q *Queries) CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error) {
+	row := q.db.QueryRowContext(ctx, createWebhookDelivery,
+		arg.EndpointID,
+		arg.EventID,
+		arg.EventType,
+		arg.Payload,
+		arg.RedeliveryOf,
+	)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.EndpointID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseStatus,
+		&i.ResponseBody,
+		&i.ErrorMessage,
+		&i.DurationMs,
+		&i.RedeliveryOf,
+		&i.LastAttemptAt,
+		&i.DeliveredAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createWebhookEndpoint = `-- name: CreateWebhookEndpoint :one
+INSERT INTO webhook_endpoints (
+    organization_id, url, description, event_types, secret, created_by
+) VALUES (
+    $1::uuid, $2, $3, $4::text[], $5, $6
+)
+RETURNING id, organization_id, url, description, event_types, secret, previous_secret, previous_secret_expires_at, is_active, created_by, created_at, updated_at
+`
+
+type CreateWebhookEndpointParams struct {
+	OrganizationID uuid.UUID     `json:"organization_id"`
+	Url            string        `json:"url"`
+	Description    string        `json:"description"`
+	EventTypes     []string      `json:"event_types"`
+	Secret         string        `json:"secret"`
+	CreatedBy      uuid.NullUUID `json:"created_by"`
+}
+
+func (q *Queries) CreateWebhookEndpoint(ctx context.Context, arg CreateWebhookEndpointParams) (WebhookEndpoint, error) {
+	row := q.db.QueryRowContext(ctx, createWebhookEndpoint,
+		arg.OrganizationID,
+		arg.Url,
+		arg.Description,
+		pq.Array(arg.EventTypes),
+		arg.Secret,
+		arg.CreatedBy,
+	)
+	var i WebhookEndpoint
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Url,
+		&i.Description,
+		pq.Array(&i.EventTypes),
+		&i.Secret,
+		&i.PreviousSecret,
+		&i.PreviousSecretExpiresAt,
+		&i.IsActive,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteWebhookDeliveriesBefore = `-- name: DeleteWebhookDeliveriesBefore :execrows
+DELETE FROM webhook_deliveries
+WHERE created_at < $1::timestamp AND status <> 'pending'
+`
+
+func (q *Queries) DeleteWebhookDeliveriesBefore(ctx context.Context, before time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteWebhookDeliveriesBefore, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteWebhookEndpoint = `-- name: DeleteWebhookEndpoint :execrows
+DELETE FROM webhook_endpoints
+WHERE id = $1::uuid AND organization_id = $2::uuid
+`
+
+type DeleteWebhookEndpointParams struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+}
+
+func (q *Queries) DeleteWebhookEndpoint(ctx context.Context, arg DeleteWebhookEndpointParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteWebhookEndpoint, arg.ID, arg.OrganizationID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const failWebhookDelivery = `-- name: FailWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET status = 'failed',
+    error_message = $1::text,
+    updated_at = NOW()
+WHERE id = $2::uuid AND status = 'pending'
+`
+
+type FailWebhookDeliveryParams struct {
+	ErrorMessage string    `json:"error_message"`
+	ID           uuid.UUID `json:"id"`
+}
+
+func (q *Queries) FailWebhookDelivery(ctx context.Context, arg FailWebhookDeliveryParams) error {
+	_, err := q.db.ExecContext(ctx, failWebhookDelivery, arg.ErrorMessage, arg.ID)
+	return err
+}
+
+const getWebhookDelivery = `-- name: GetWebhookDelivery :one
+SELECT id, endpoint_id, event_id, event_type, payload, status, attempts, response_status, response_body, error_message, duration_ms, redelivery_of, last_attempt_at, delivered_at, created_at, updated_at FROM webhook_deliveries
+WHERE id = $1::uuid AND endpoint_id = $2::uuid
+LIMIT 1
+`
+
+type GetWebhookDeliveryParams struct {
+	ID         uuid.UUID `json:"id"`
+	EndpointID uuid.UUID `json:"endpoint_id"`
+}
+
+func (q *Queries) GetWebhookDelivery(ctx context.Context, arg GetWebhookDeliveryParams) (WebhookDelivery, error) {
+	row := q.db.QueryRowContext(ctx, getWebhookDelivery, arg.ID, arg.EndpointID)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.EndpointID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseStatus,
+		&i.ResponseBody,
+		&i.ErrorMessage,
+		&i.DurationMs,
+		&i.RedeliveryOf,
+		&i.LastAttemptAt,
+		&i.DeliveredAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getWebhookDeliveryTarget = `-- name: GetWebhookDeliveryTarget :one
+SELECT
+    d.id, d.endpoint_id, d.event_id, d.event_type, d.payload, d.status, d.attempts, d.response_status, d.response_body, d.error_message, d.duration_ms, d.redelivery_of, d.last_attempt_at, d.delivered_at, d.created_at, d.updated_at,
+    e.url,
+    e.secret,
+    e.previous_secret,
+    e.previous_secret_expires_at,
+    e.is_active
+FROM webhook_deliveries d
+INNER JOIN webhook_endpoints e ON d.endpoint_id = e.id
+WHERE d.id = $1::uuid
+LIMIT 1
+`
+
+type GetWebhookDeliveryTargetRow struct {
+	ID                      uuid.UUID       `json:"id"`
+	EndpointID              uuid.UUID       `json:"endpoint_id"`
+	EventID                 uuid.UUID       `json:"event_id"`
+	EventType               string          `json:"event_type"`
+	Payload                 json.RawMessage `json:"payload"`
+	Status                  string          `json:"status"`
+	Attempts                int32           `json:"attempts"`
+	ResponseStatus          sql.NullInt32   `json:"response_status"`
+	ResponseBody            sql.NullString  `json:"response_body"`
+	ErrorMessage            sql.NullString  `json:"error_message"`
+	DurationMs              sql.NullInt32   `json:"duration_ms"`
+	RedeliveryOf            uuid.NullUUID   `json:"redelivery_of"`
+	LastAttemptAt           sql.NullTime    `json:"last_attempt_at"`
+	DeliveredAt             sql.NullTime    `json:"delivered_at"`
+	CreatedAt               time.Time       `json:"created_at"`
+	UpdatedAt               time.Time       `json:"updated_at"`
+	Url                     string          `json:"url"`
+	Secret                  string          `json:"secret"`
+	PreviousSecret          sql.NullString  `json:"previous_secret"`
+	PreviousSecretExpiresAt sql.NullTime    `json:"previous_secret_expires_at"`
+	IsActive                bool            `json:"is_active"`
+}
+
+// Loads a delivery with what is needed to send it
+func (q *Queries) GetWebhookDeliveryTarget(ctx context.Context, id uuid.UUID) (GetWebhookDeliveryTargetRow, error) {
+	row := q.db.QueryRowContext(ctx, getWebhookDeliveryTarget, id)
+	var i GetWebhookDeliveryTargetRow
+	err := row.Scan(
+		&i.ID,
+		&i.EndpointID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.ResponseStatus,
+		&i.ResponseBody,
+		&i.ErrorMessage,
+		&i.DurationMs,
+		&i.RedeliveryOf,
+		&i.LastAttemptAt,
+		&i.DeliveredAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Url,
+		&i.Secret,
+		&i.PreviousSecret,
+		&i.PreviousSecretExpiresAt,
+		&i.IsActive,
+	)
+	return i, err
+}
+
+const getWebhookEndpoint = `-- name: GetWebhookEndpoint :one
+SELECT id, organization_id, url, description, event_types, secret, previous_secret, previous_secret_expires_at, is_active, created_by, created_at, updated_at FROM webhook_endpoints
+WHERE id = $1::uuid AND organization_id = $2::uuid
+LIMIT 1
+`
+
+type GetWebhookEndpointParams struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+}
+
+func (q *Queries) GetWebhookEndpoint(ctx context.Context, arg GetWebhookEndpointParams) (WebhookEndpoint, error) {
+	row := q.db.QueryRowContext(ctx, getWebhookEndpoint, arg.ID, arg.OrganizationID)
+	var i WebhookEndpoint
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Url,
+		&i.Description,
+		pq.Array(&i.EventTypes),
+		&i.Secret,
+		&i.PreviousSecret,
+		&i.PreviousSecretExpiresAt,
+		&i.IsActive,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listSubscribedWebhookEndpoints = `-- name: ListSubscribedWebhookEndpoints :many
+SELECT id, organization_id, url, description, event_types, secret, previous_secret, previous_secret_expires_at, is_active, created_by, created_at, updated_at FROM webhook_endpoints
+WHERE organization_id = $1::uuid
+  AND is_active = true
+  AND $2::text = ANY(event_types)
+ORDER BY created_at
+`
+
+type ListSubscribedWebhookEndpointsParams struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	EventType      string    `json:"event_type"`
+}
+
+func (q *Queries) ListSubscribedWebhookEndpoints(ctx context.Context, arg ListSubscribedWebhookEndpointsParams) ([]WebhookEndpoint, error) {
+	rows, err := q.db.QueryContext(ctx, listSubscribedWebhookEndpoints, arg.OrganizationID, arg.EventType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WebhookEndpoint{}
+	for rows.Next() {
+		var i WebhookEndpoint
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Url,
+			&i.Description,
+			pq.Array(&i.EventTypes),
+			&i.Secret,
+			&i.PreviousSecret,
+			&i.PreviousSecretExpiresAt,
+			&i.IsActive,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT id, endpoint_id, event_id, event_type, payload, status, attempts, response_status, response_body, error_message, duration_ms, redelivery_of, last_attempt_at, delivered_at, created_at, updated_at FROM webhook_deliveries
+WHERE endpoint_id = $1::uuid
+  AND ($2::text IS NULL OR status = $2::text)
+ORDER BY created_at DESC, id
+LIMIT $4 OFFSET $3
+`
+
+type ListWebhookDeliveriesParams struct {
+	EndpointID uuid.UUID      `json:"endpoint_id"`
+	Status     sql.NullString `json:"status"`
+	RowOffset  int32          `json:"row_offset"`
+	RowLimit   int32          `json:"row_limit"`
+}
+
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error) {
+	rows, err := q.db.QueryContext(ctx, listWebhookDeliveries,
+		arg.EndpointID,
+		arg.Status,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WebhookDelivery{}
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.EndpointID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.ResponseStatus,
+			&i.ResponseBody,
+			&i.ErrorMessage,
+			&i.DurationMs,
+			&i.RedeliveryOf,
+			&i.LastAttemptAt,
+			&i.DeliveredAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookEndpoints = `-- name: ListWebhookEndpoints :many
+SELECT id, organization_id, url, description, event_types, secret, previous_secret, previous_secret_expires_at, is_active, created_by, created_at, updated_at FROM webhook_endpoints
+WHERE organization_id = $1::uuid
+ORDER BY created_at
+`
+
+func (q *Queries) ListWebhookEndpoints(ctx context.Context, organizationID uuid.UUID) ([]WebhookEndpoint, error) {
+	rows, err := q.db.QueryContext(ctx, listWebhookEndpoints, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []WebhookEndpoint{}
+	for rows.Next() {
+		var i WebhookEndpoint
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Url,
+			&i.Description,
+			pq.Array(&i.EventTypes),
+			&i.Secret,
+			&i.PreviousSecret,
+			&i.PreviousSecretExpiresAt,
+			&i.IsActive,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recordWebhookDeliveryAttempt = `-- name: RecordWebhookDeliveryAttempt :exec
+UPDATE webhook_deliveries
+SET status = $1,
+    attempts = attempts + 1,
+    response_status = $2::integer,
+    response_body = $3::text,
+    error_message = $4::text,
+    duration_ms = $5::integer,
+    last_attempt_at = NOW(),
+    delivered_at = CASE WHEN $1 = 'succeeded' THEN NOW() ELSE NULL END,
+    updated_at = NOW()
+WHERE id = $6::uuid AND status = 'pending'
+`
+
+type RecordWebhookDeliveryAttemptParams struct {
+	Status         string         `json:"status"`
+	ResponseStatus sql.NullInt32  `json:"response_status"`
+	ResponseBody   sql.NullString `json:"response_body"`
+	ErrorMessage   sql.NullString `json:"error_message"`
+	DurationMs     int32          `json:"duration_ms"`
+	ID             uuid.UUID      `json:"id"`
+}
+
+func (q *Queries) RecordWebhookDeliveryAttempt(ctx context.Context, arg RecordWebhookDeliveryAttemptParams) error {
+	_, err := q.db.ExecContext(ctx, recordWebhookDeliveryAttempt,
+		arg.Status,
+		arg.ResponseStatus,
+		arg.ResponseBody,
+		arg.ErrorMessage,
+		arg.DurationMs,
+		arg.ID,
+	)
+	return err
+}
+
+const rotateWebhookEndpointSecret = `-- name: RotateWebhookEndpointSecret :one
+UPDATE webhook_endpoints
+SET previous_secret = secret,
+    previous_secret_expires_at = $1::timestamp,
+    secret = $2,
+    updated_at = NOW()
+WHERE id = $3::uuid AND organization_id = $4::uuid
+RETURNING id, organization_id, url, description, event_types, secret, previous_secret, previous_secret_expires_at, is_active, created_by, created_at, updated_at
+`
+
+type RotateWebhookEndpointSecretParams struct {
+	PreviousSecretExpiresAt time.Time `json:"previous_secret_expires_at"`
+	Secret                  string    `json:"secret"`
+	ID                      uuid.UUID `json:"id"`
+	OrganizationID          uuid.UUID `json:"organization_id"`
+}
+
+// The current secret keeps signing alongside the new one until
+// previous_secret_expires_at, so receivers can switch without dropping events.
+func (q *Queries) RotateWebhookEndpointSecret(ctx context.Context, arg RotateWebhookEndpointSecretParams) (WebhookEndpoint, error) {
+	row := q.db.QueryRowContext(ctx, rotateWebhookEndpointSecret,
+		arg.PreviousSecretExpiresAt,
+		arg.Secret,
+		arg.ID,
+		arg.OrganizationID,
+	)
+	var i WebhookEndpoint
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Url,
+		&i.Description,
+		pq.Array(&i.EventTypes),
+		&i.Secret,
+		&i.PreviousSecret,
+		&i.PreviousSecretExpiresAt,
+		&i.IsActive,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateWebhookEndpoint = `-- name: UpdateWebhookEndpoint :one
+UPDATE webhook_endpoints
+SET url = $1,
+    description = $2,
+    event_types = $3::text[],
+    is_active = $4,
+    updated_at = NOW()
+WHERE id = $5::uuid AND organization_id = $6::uuid
+RETURNING id, organization_id, url, description, event_types, secret, previous_secret, previous_secret_expires_at, is_active, created_by, created_at, updated_at
+`
+
+type UpdateWebhookEndpointParams struct {
+	Url            string    `json:"url"`
+	Description    string    `json:"description"`
+	EventTypes     []string  `json:"event_types"`
+	IsActive       bool      `json:"is_active"`
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+}
+
+func (q *Queries) UpdateWebhookEndpoint(ctx context.Context, arg UpdateWebhookEndpointParams) (WebhookEndpoint, error) {
+	row := q.db.QueryRowContext(ctx, updateWebhookEndpoint,
+		arg.Url,
+		arg.Description,
+		pq.Array(arg.EventTypes),
+		arg.IsActive,
+		arg.ID,
+		arg.OrganizationID,
+	)
+	var i WebhookEndpoint
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Url,
+		&i.Description,
+		pq.Array(&i.EventTypes),
+		&i.Secret,
+		&i.PreviousSecret,
+		&i.PreviousSecretExpiresAt,
+		&i.IsActive,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -2,18 +2,82 @@ package main
 
 import (
 	"ai-matching/src/di"
-	"log"
+	"ai-matching/src/infrastructure/buildinfo"
+	"ai-matching/src/infrastructure/logging"
+	"ai-matching/src/infrastructure/metering"
+	"ai-matching/src/infrastructure/tracing"
+	"context"
+	"errors"
+	"log/slog"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
+// shutdownTimeout is how long in-flight requests get to finish once the
+// server is asked to stop
+const shutdownTimeout = 30 * time.Second
+
 func main() {
-	if err := godotenv.Load(); err != nil {
-		log.Println("No .env file found")
+	envErr := godotenv.Load()
+
+	logger := logging.NewLogger()
+	slog.SetDefault(logger)
+	if envErr != nil {
+		logger.Info("No .env file found")
+	}
+
+	shutdownTracing, err := tracing.Setup(context.Background())
+	if err != nil {
+		logger.Error("Failed to set up tracing", slog.Any("error", err))
+		os.Exit(1)
+	}
+
+	// os.Exit skips deferred calls, so spans are flushed before it
+	code := run(logger)
+	if err := shutdownTracing(context.Background()); err != nil {
+		logger.Error("Failed to flush traces", slog.Any("error", err))
+	}
+	os.Exit(code)
+}
+
+// run starts the command given on the command line and returns the exit code
+func run(logger *slog.Logger) int {
+	container := di.NewContainer(logger)
+
+	if len(os.Args) > 1 && os.Args[1] == "usage" {
+		if err := metering.RunCommand(context.Background(), container.Meter, os.Args[2:], os.Stdout); err != nil {
+			logger.Error("Usage command failed", slog.Any("error", err))
+			return 1
+		}
+		return 0
 	}
 
-	container := di.NewContainer()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if len(os.Args) > 1 && os.Args[1] == "worker" {
+		logger.Info("Worker starting", slog.String("version", buildinfo.Version))
+		if err := runBackground(ctx, container); err != nil {
+			logger.Error("Worker stopped", slog.Any("error", err))
+			return 1
+		}
+		return 0
+	}
+
+	// WORKER_EMBEDDED=false leaves background jobs and scheduled tasks to
+	// separate worker processes; by default the server runs them too.
+	backgroundDone := make(chan error, 1)
+	if os.Getenv("WORKER_EMBEDDED") != "false" {
+		go func() {
+			backgroundDone <- runBackground(ctx, container)
+		}()
+	} else {
+		backgroundDone <- nil
+	}
 
 	app := di.SetupRouter(container)
 
@@ -22,8 +86,39 @@ func main() {
 		port = "8080"
 	}
 
-	log.Printf("Server starting on port %s", port)
-	if err := app.Listen(":" + port); err != nil {
-		log.Fatal(err)
+	logger.Info("Server starting", slog.String("port", port), slog.String("version", buildinfo.Version))
+	listenDone := make(chan error, 1)
+	go func() {
+		listenDone <- app.Listen(":" + port)
+	}()
+
+	var serverErr error
+	select {
+	case serverErr = <-listenDone:
+	case <-ctx.Done():
+		logger.Info("Server shutting down")
+		serverErr = app.ShutdownWithTimeout(shutdownTimeout)
+		if listenErr := <-listenDone; serverErr == nil {
+			serverErr = listenErr
+		}
 	}
+
+	// Stop the embedded worker too when Listen failed on its own
+	stop()
+	if err := errors.Join(serverErr, <-backgroundDone); err != nil {
+		logger.Error("Server stopped", slog.Any("error", err))
+		return 1
+	}
+	return 0
+}
+
+// runBackground runs the job worker and, alongside it, the scheduled tasks
+// until ctx is cancelled
+func runBackground(ctx context.Context, container *di.Container) error {
+	schedulerDone := make(chan error, 1)
+	go func() {
+		schedulerDone <- container.Scheduler.Run(ctx)
+	}()
+	workerErr := container.Worker.Run(ctx)
+	return errors.Join(workerErr, <-schedulerDone)
 }
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"ai-matching/src/api/auth/ask/requests"
+	"ai-matching/src/api/auth/ask/response"
+	"ai-matching/src/api/auth/ask/usecase"
+	"ai-matching/src/infrastructure/eventstream"
+	"ai-matching/src/infrastructure/middleware"
+	"ai-matching/src/infrastructure/retrieval"
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+type AskController struct {
+	usecase *usecase.AskUsecase
+}
+
+func NewAskController(askUsecase *usecase.AskUsecase) *AskController {
+	return &AskController{
+		usecase: askUsecase,
+	}
+}
+
+type AskInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	Body           requests.AskRequest
+}
+
+// Ask streams the answer as token events followed by a done event carrying
+// the citations, or an error event if generation fails midway
+func (c *AskController) Ask(ctx context.Context, input *AskInput) (*huma.StreamResponse, error) {
+	if _, err := middleware.RequireTenant(ctx, input.TenantID); err != nil {
+		return nil, err
+	}
+
+	answer, err := c.usecase.Prepare(ctx, input.OrganizationID, input.TenantID, &input.Body)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return eventstream.Response(ctx, func(ctx context.Context, send eventstream.Sender) {
+		done, err := c.usecase.Answer(ctx, answer, func(token string) error {
+			return send(response.EventToken, response.AskTokenEvent{Text: token})
+		})
+		if err != nil {
+			// A cancelled context means the client went away
+			if ctx.Err() == nil {
+				slog.ErrorContext(ctx, "failed to answer question", slog.String("tenant_id", input.TenantID.String()), slog.Any("error", err))
+				_ = send(response.EventError, response.AskErrorEvent{Message: "failed to generate an answer"})
+			}
+			return
+		}
+		_ = send(response.EventDone, done)
+	}), nil
+}
+
+func toHTTPError(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrTenantNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, usecase.ErrEmptyQuestion), errors.Is(err, retrieval.ErrEmptyQuery):
+		return huma.Error400BadRequest(err.Error())
+	}
+	return err
+}
@@ -0,0 +1,15 @@
+package requests
+
+import "github.com/google/uuid"
+
+type AskFilter struct {
+	Metadata    map[string]any `json:"metadata,omitempty" doc:"Only use documents whose metadata contains these key/value pairs"`
+	DocumentIDs []uuid.UUID    `json:"documentIds,omitempty" maxItems:"100" doc:"Only use these documents"`
+}
+
+type AskRequest struct {
+	Question string     `json:"question" required:"true" minLength:"1" maxLength:"2000" doc:"Question to answer from the tenant's documents"`
+	Mode     string     `json:"mode,omitempty" enum:"hybrid,vector,keyword" default:"hybrid" doc:"How sources are retrieved"`
+	TopK     int        `json:"topK,omitempty" minimum:"1" maximum:"20" default:"5" doc:"Number of chunks to retrieve as sources"`
+	Filter   *AskFilter `json:"filter,omitempty" doc:"Restrict the sources to matching documents"`
+}
@@ -0,0 +1,38 @@
+package response
+
+import "github.com/google/uuid"
+
+// Names of the events an answer streams
+const (
+	EventToken = "token"
+	EventDone  = "done"
+	EventError = "error"
+)
+
+type AskSourceResponse struct {
+	Number        int       `json:"number" doc:"Number the answer cites the source by, as in [1]"`
+	ChunkID       uuid.UUID `json:"chunkId" doc:"Chunk ID"`
+	DocumentID    uuid.UUID `json:"documentId" doc:"Document ID"`
+	DocumentTitle string    `json:"documentTitle" doc:"Document title"`
+	Version       int       `json:"version" doc:"Document version the chunk belongs to"`
+	ChunkIndex    int       `json:"chunkIndex" doc:"Position of the chunk in the version"`
+	Heading       string    `json:"heading,omitempty" doc:"Markdown heading path the chunk falls under"`
+	StartOffset   int       `json:"startOffset" doc:"Byte offset of the chunk in the extracted text"`
+	EndOffset     int       `json:"endOffset" doc:"Byte offset of the end of the chunk"`
+	Score         float64   `json:"score" doc:"Retrieval score"`
+}
+
+type AskTokenEvent struct {
+	Text string `json:"text" doc:"Next piece of the answer"`
+}
+
+type AskDoneEvent struct {
+	Answer    string              `json:"answer" doc:"Whole answer"`
+	Model     string              `json:"model" doc:"Model that generated the answer"`
+	Citations []AskSourceResponse `json:"citations" doc:"Sources the answer cites, in order of first citation"`
+	Sources   []AskSourceResponse `json:"sources" doc:"All sources given to the model"`
+}
+
+type AskErrorEvent struct {
+	Message string `json:"message" doc:"What went wrong"`
+}
@@ -0,0 +1,28 @@
+package router
+
+import (
+	"ai-matching/src/api/auth/ask/controller"
+	"ai-matching/src/api/auth/ask/response"
+	"ai-matching/src/infrastructure/eventstream"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterAskRoutes(api huma.API, router fiber.Router, askController *controller.AskController) {
+	// Tenant question answering endpoints
+	huma.Register(api, huma.Operation{
+		OperationID: "ask-question",
+		Method:      "POST",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/ask",
+		Summary:     "Ask a question",
+		Description: "Answer a question from the tenant's documents. The answer streams as Server-Sent Events: token events with the text as it is generated, then a done event with the whole answer and the chunks and documents it cites.",
+		Tags:        []string{"Ask"},
+		Security:    []map[string][]string{{"bearer": {}}},
+		Responses: eventstream.Responses(api, map[string]any{
+			response.EventToken: response.AskTokenEvent{},
+			response.EventDone:  response.AskDoneEvent{},
+			response.EventError: response.AskErrorEvent{},
+		}),
+	}, askController.Ask)
+}
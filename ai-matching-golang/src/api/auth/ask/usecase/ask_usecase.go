@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"ai-matching/src/api/auth/ask/requests"
+	"ai-matching/src/api/auth/ask/response"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/rag"
+	"ai-matching/src/infrastructure/retrieval"
+	"ai-matching/src/infrastructure/settings"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const defaultTopK = 5
+
+var (
+	ErrTenantNotFound = errors.New("tenant not found")
+	ErrEmptyQuestion  = errors.New("question must not be blank")
+)
+
+type AskUsecase struct {
+	tenantRepo repository.TenantRepository
+	answerer   *rag.Answerer
+	settings   *settings.Resolver
+}
+
+func NewAskUsecase(tenantRepo repository.TenantRepository, answerer *rag.Answerer, settingsResolver *settings.Resolver) *AskUsecase {
+	return &AskUsecase{
+		tenantRepo: tenantRepo,
+		answerer:   answerer,
+		settings:   settingsResolver,
+	}
+}
+
+// Prepare retrieves the sources for a question. It runs before the response
+// starts streaming so that its failures get a proper status code.
+func (u *AskUsecase) Prepare(ctx context.Context, organizationID, tenantID uuid.UUID, req *requests.AskRequest) (*rag.Answer, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	question := strings.TrimSpace(req.Question)
+	if question == "" {
+		return nil, ErrEmptyQuestion
+	}
+
+	q := rag.Question{
+		Text: question,
+		TopK: req.TopK,
+		Mode: req.Mode,
+	}
+	if q.TopK <= 0 {
+		q.TopK = defaultTopK
+	}
+	if q.Mode == "" {
+		q.Mode = retrieval.ModeHybrid
+	}
+	if req.Filter != nil {
+		q.Filter.Metadata = req.Filter.Metadata
+		q.Filter.DocumentIDs = req.Filter.DocumentIDs
+	}
+
+	values, err := u.settings.ForTenant(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve search settings: %w", err)
+	}
+	q.Weights = retrieval.Weights{
+		Vector:  settings.Get(values, settings.SearchVectorWeight),
+		Keyword: settings.Get(values, settings.SearchKeywordWeight),
+		K:       settings.Get(values, settings.SearchRRFK),
+	}
+
+	answer, err := u.answerer.Prepare(ctx, tenantID, q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve sources: %w", err)
+	}
+	return answer, nil
+}
+
+// Answer generates a prepared answer, passing each token to onToken, and
+// returns it with the sources it cites
+func (u *AskUsecase) Answer(ctx context.Context, answer *rag.Answer, onToken func(string) error) (*response.AskDoneEvent, error) {
+	text, err := answer.Stream(ctx, onToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.AskDoneEvent{
+		Answer:    text,
+		Model:     u.answerer.Model(),
+		Citations: toSourceResponses(answer.Cited(text)),
+		Sources:   toSourceResponses(answer.Sources),
+	}, nil
+}
+
+// ensureTenant checks that the tenant belongs to the organization in the path
+func (u *AskUsecase) ensureTenant(ctx context.Context, organizationID, tenantID uuid.UUID) error {
+	tenant, err := u.tenantRepo.GetTenant(ctx, tenantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTenantNotFound
+		}
+		return fmt.Errorf("failed to get tenant: %w", err)
+	}
+	if tenant.OrganizationID != organizationID {
+		return ErrTenantNotFound
+	}
+	return nil
+}
+
+func toSourceResponses(sources []rag.Source) []response.AskSourceResponse {
+	resp := make([]response.AskSourceResponse, len(sources))
+	for i, source := range sources {
+		chunk := source.Chunk
+		resp[i] = response.AskSourceResponse{
+			Number:        source.Number,
+			ChunkID:       chunk.ID,
+			DocumentID:    chunk.DocumentID,
+			DocumentTitle: chunk.DocumentTitle,
+			Version:       int(chunk.Version),
+			ChunkIndex:    int(chunk.ChunkIndex),
+			Heading:       chunk.Heading,
+			StartOffset:   int(chunk.StartOffset),
+			EndOffset:     int(chunk.EndOffset),
+			Score:         source.Score,
+		}
+	}
+	return resp
+}
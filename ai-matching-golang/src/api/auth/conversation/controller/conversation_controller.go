@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"ai-matching/src/api/auth/conversation/requests"
+	"ai-matching/src/api/auth/conversation/response"
+	"ai-matching/src/api/auth/conversation/usecase"
+	"ai-matching/src/infrastructure/eventstream"
+	"ai-matching/src/infrastructure/middleware"
+	"ai-matching/src/infrastructure/retrieval"
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+type ConversationController struct {
+	usecase *usecase.ConversationUsecase
+}
+
+func NewConversationController(conversationUsecase *usecase.ConversationUsecase) *ConversationController {
+	return &ConversationController{
+		usecase: conversationUsecase,
+	}
+}
+
+type CreateConversationInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	Body           requests.CreateConversationRequest
+}
+
+type ConversationOutput struct {
+	Body response.ConversationResponse
+}
+
+func (c *ConversationController) CreateConversation(ctx context.Context, input *CreateConversationInput) (*ConversationOutput, error) {
+	userCtx, err := middleware.RequireTenant(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.CreateConversation(ctx, input.OrganizationID, input.TenantID, userCtx.UserID, &input.Body)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &ConversationOutput{Body: *resp}, nil
+}
+
+type ListConversationsInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	Page           int       `query:"page" minimum:"1" default:"1" doc:"Page number"`
+	PageSize       int       `query:"pageSize" minimum:"1" maximum:"100" default:"20" doc:"Conversations per page"`
+}
+
+type ListConversationsOutput struct {
+	Body response.ConversationListResponse
+}
+
+func (c *ConversationController) ListConversations(ctx context.Context, input *ListConversationsInput) (*ListConversationsOutput, error) {
+	userCtx, err := middleware.RequireTenant(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.ListConversations(ctx, input.OrganizationID, input.TenantID, userCtx.UserID, input.Page, input.PageSize)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &ListConversationsOutput{Body: *resp}, nil
+}
+
+type ConversationInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	ConversationID uuid.UUID `path:"conversationId" doc:"Conversation ID"`
+}
+
+type ConversationDetailOutput struct {
+	Body response.ConversationDetailResponse
+}
+
+func (c *ConversationController) GetConversation(ctx context.Context, input *ConversationInput) (*ConversationDetailOutput, error) {
+	userCtx, err := middleware.RequireTenant(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.GetConversation(ctx, input.OrganizationID, input.TenantID, userCtx.UserID, input.ConversationID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &ConversationDetailOutput{Body: *resp}, nil
+}
+
+type DeleteConversationOutput struct {
+	Body struct {
+		Message string `json:"message" doc:"Result message"`
+	}
+}
+
+func (c *ConversationController) DeleteConversation(ctx context.Context, input *ConversationInput) (*DeleteConversationOutput, error) {
+	userCtx, err := middleware.RequireTenant(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.usecase.DeleteConversation(ctx, input.OrganizationID, input.TenantID, userCtx.UserID, input.ConversationID); err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	out := &DeleteConversationOutput{}
+	out.Body.Message = "Conversation deleted successfully"
+	return out, nil
+}
+
+type SendMessageInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	ConversationID uuid.UUID `path:"conversationId" doc:"Conversation ID"`
+	Body           requests.SendMessageRequest
+}
+
+// SendMessage streams the answer as token events followed by a done event
+// carrying the stored question and answer, or an error event if generation
+// fails midway
+func (c *ConversationController) SendMessage(ctx context.Context, input *SendMessageInput) (*huma.StreamResponse, error) {
+	userCtx, err := middleware.RequireTenant(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := c.usecase.SendMessage(ctx, input.OrganizationID, input.TenantID, userCtx.UserID, input.ConversationID, &input.Body)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return eventstream.Response(ctx, func(ctx context.Context, send eventstream.Sender) {
+		done, err := c.usecase.Reply(ctx, pending, func(token string) error {
+			return send(response.EventToken, response.MessageTokenEvent{Text: token})
+		})
+		if err != nil {
+			// A cancelled context means the client went away
+			if ctx.Err() == nil {
+				slog.ErrorContext(ctx, "failed to answer message", slog.String("conversation_id", input.ConversationID.String()), slog.Any("error", err))
+				_ = send(response.EventError, response.MessageErrorEvent{Message: "failed to generate an answer"})
+			}
+			return
+		}
+		_ = send(response.EventDone, done)
+	}), nil
+}
+
+func toHTTPError(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrTenantNotFound), errors.Is(err, usecase.ErrConversationNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, usecase.ErrEmptyMessage), errors.Is(err, retrieval.ErrEmptyQuery):
+		return huma.Error400BadRequest(err.Error())
+	}
+	return err
+}
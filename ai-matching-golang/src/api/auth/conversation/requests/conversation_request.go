@@ -0,0 +1,19 @@
+package requests
+
+import "github.com/google/uuid"
+
+type CreateConversationRequest struct {
+	Title string `json:"title,omitempty" maxLength:"255" doc:"Conversation title; untitled conversations are named after their first question"`
+}
+
+type MessageFilter struct {
+	Metadata    map[string]any `json:"metadata,omitempty" doc:"Only use documents whose metadata contains these key/value pairs"`
+	DocumentIDs []uuid.UUID    `json:"documentIds,omitempty" maxItems:"100" doc:"Only use these documents"`
+}
+
+type SendMessageRequest struct {
+	Content string         `json:"content" required:"true" minLength:"1" maxLength:"2000" doc:"Question to answer, which may refer back to earlier turns"`
+	Mode    string         `json:"mode,omitempty" enum:"hybrid,vector,keyword" default:"hybrid" doc:"How sources are retrieved"`
+	TopK    int            `json:"topK,omitempty" minimum:"1" maximum:"20" default:"5" doc:"Number of chunks to retrieve as sources"`
+	Filter  *MessageFilter `json:"filter,omitempty" doc:"Restrict the sources to matching documents"`
+}
@@ -0,0 +1,65 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Names of the events a reply streams
+const (
+	EventToken = "token"
+	EventDone  = "done"
+	EventError = "error"
+)
+
+type ConversationResponse struct {
+	ID        uuid.UUID `json:"id" doc:"Conversation ID"`
+	Title     string    `json:"title" doc:"Conversation title"`
+	CreatedAt time.Time `json:"createdAt" doc:"Creation time"`
+	UpdatedAt time.Time `json:"updatedAt" doc:"Time of the latest message"`
+}
+
+type ConversationListResponse struct {
+	Conversations []ConversationResponse `json:"conversations" doc:"Conversations, most recently active first"`
+	Total         int                    `json:"total" doc:"Total number of conversations"`
+	Page          int                    `json:"page" doc:"Page number"`
+	PageSize      int                    `json:"pageSize" doc:"Page size"`
+}
+
+type ConversationDetailResponse struct {
+	ConversationResponse
+	Messages []MessageResponse `json:"messages" doc:"Messages, oldest first"`
+}
+
+type CitationResponse struct {
+	Number        int       `json:"number" doc:"Number the answer cites the source by, as in [1]"`
+	ChunkID       uuid.UUID `json:"chunkId" doc:"Chunk ID"`
+	DocumentID    uuid.UUID `json:"documentId" doc:"Document ID"`
+	DocumentTitle string    `json:"documentTitle" doc:"Document title"`
+	Version       int       `json:"version" doc:"Document version the chunk belongs to"`
+	Heading       string    `json:"heading,omitempty" doc:"Markdown heading path the chunk falls under"`
+}
+
+type MessageResponse struct {
+	ID             uuid.UUID          `json:"id" doc:"Message ID"`
+	Role           string             `json:"role" enum:"user,assistant" doc:"Who wrote the message"`
+	Content        string             `json:"content" doc:"Message text"`
+	RewrittenQuery string             `json:"rewrittenQuery,omitempty" doc:"Standalone query a follow-up question was searched as"`
+	Citations      []CitationResponse `json:"citations" doc:"Sources an answer cites"`
+	Model          string             `json:"model,omitempty" doc:"Model that generated an answer"`
+	CreatedAt      time.Time          `json:"createdAt" doc:"Creation time"`
+}
+
+type MessageTokenEvent struct {
+	Text string `json:"text" doc:"Next piece of the answer"`
+}
+
+type MessageDoneEvent struct {
+	Question MessageResponse `json:"question" doc:"Stored question"`
+	Answer   MessageResponse `json:"answer" doc:"Stored answer with its citations"`
+}
+
+type MessageErrorEvent struct {
+	Message string `json:"message" doc:"What went wrong"`
+}
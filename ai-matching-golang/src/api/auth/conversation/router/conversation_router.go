@@ -0,0 +1,68 @@
+package router
+
+import (
+	"ai-matching/src/api/auth/conversation/controller"
+	"ai-matching/src/api/auth/conversation/response"
+	"ai-matching/src/infrastructure/eventstream"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterConversationRoutes(api huma.API, router fiber.Router, conversationController *controller.ConversationController) {
+	// Conversation endpoints; every conversation belongs to the signed-in user
+	huma.Register(api, huma.Operation{
+		OperationID: "create-conversation",
+		Method:      "POST",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/conversations",
+		Summary:     "Create conversation",
+		Description: "Start a conversation for asking follow-up questions about the tenant's documents",
+		Tags:        []string{"Conversations"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, conversationController.CreateConversation)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-conversations",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/conversations",
+		Summary:     "List conversations",
+		Description: "List the current user's conversations in a tenant, most recently active first",
+		Tags:        []string{"Conversations"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, conversationController.ListConversations)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-conversation",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/conversations/{conversationId}",
+		Summary:     "Get conversation",
+		Description: "Get a conversation with all of its messages",
+		Tags:        []string{"Conversations"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, conversationController.GetConversation)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-conversation",
+		Method:      "DELETE",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/conversations/{conversationId}",
+		Summary:     "Delete conversation",
+		Description: "Delete a conversation and all of its messages",
+		Tags:        []string{"Conversations"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, conversationController.DeleteConversation)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "send-conversation-message",
+		Method:      "POST",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/conversations/{conversationId}/messages",
+		Summary:     "Send message",
+		Description: "Ask a question in a conversation. Follow-ups are rewritten into standalone queries from the earlier turns before retrieval. The answer streams as Server-Sent Events: token events, then a done event with the stored question and answer.",
+		Tags:        []string{"Conversations"},
+		Security:    []map[string][]string{{"bearer": {}}},
+		Responses: eventstream.Responses(api, map[string]any{
+			response.EventToken: response.MessageTokenEvent{},
+			response.EventDone:  response.MessageDoneEvent{},
+			response.EventError: response.MessageErrorEvent{},
+		}),
+	}, conversationController.SendMessage)
+}
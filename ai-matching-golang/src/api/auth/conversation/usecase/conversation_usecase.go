@@ -0,0 +1,378 @@
+package usecase
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/api/auth/conversation/requests"
+	"ai-matching/src/api/auth/conversation/response"
+	"ai-matching/src/domain/interface/external"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/chunking"
+	"ai-matching/src/infrastructure/rag"
+	"ai-matching/src/infrastructure/retrieval"
+	"ai-matching/src/infrastructure/settings"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	defaultTopK     = 5
+
+	// historyMessages is how many earlier messages are loaded for a
+	// follow-up; the answerer trims them further to its token budget
+	historyMessages = 20
+	// titleRunes is how much of the first question names a conversation
+	titleRunes = 80
+)
+
+var (
+	ErrTenantNotFound       = errors.New("tenant not found")
+	ErrConversationNotFound = errors.New("conversation not found")
+	ErrEmptyMessage         = errors.New("message must not be blank")
+)
+
+type ConversationUsecase struct {
+	conversationRepo repository.ConversationRepository
+	tenantRepo       repository.TenantRepository
+	answerer         *rag.Answerer
+	settings         *settings.Resolver
+}
+
+func NewConversationUsecase(conversationRepo repository.ConversationRepository, tenantRepo repository.TenantRepository, answerer *rag.Answerer, settingsResolver *settings.Resolver) *ConversationUsecase {
+	return &ConversationUsecase{
+		conversationRepo: conversationRepo,
+		tenantRepo:       tenantRepo,
+		answerer:         answerer,
+		settings:         settingsResolver,
+	}
+}
+
+// CreateConversation starts an empty conversation for the user
+func (u *ConversationUsecase) CreateConversation(ctx context.Context, organizationID, tenantID, userID uuid.UUID, req *requests.CreateConversationRequest) (*response.ConversationResponse, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	conversation, err := u.conversationRepo.CreateConversation(ctx, db.CreateConversationParams{
+		TenantID: tenantID,
+		UserID:   userID,
+		Title:    strings.TrimSpace(req.Title),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create conversation: %w", err)
+	}
+	return toConversationResponse(conversation), nil
+}
+
+// ListConversations returns the user's conversations, most recently active
+// first
+func (u *ConversationUsecase) ListConversations(ctx context.Context, organizationID, tenantID, userID uuid.UUID, page, pageSize int) (*response.ConversationListResponse, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	conversations, err := u.conversationRepo.ListConversations(ctx, tenantID, userID, int32(pageSize), int32((page-1)*pageSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list conversations: %w", err)
+	}
+
+	total, err := u.conversationRepo.CountConversations(ctx, tenantID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count conversations: %w", err)
+	}
+
+	resp := &response.ConversationListResponse{
+		Conversations: make([]response.ConversationResponse, 0, len(conversations)),
+		Total:         int(total),
+		Page:          page,
+		PageSize:      pageSize,
+	}
+	for _, conversation := range conversations {
+		resp.Conversations = append(resp.Conversations, *toConversationResponse(conversation))
+	}
+	return resp, nil
+}
+
+// GetConversation returns a conversation with all its messages
+func (u *ConversationUsecase) GetConversation(ctx context.Context, organizationID, tenantID, userID, conversationID uuid.UUID) (*response.ConversationDetailResponse, error) {
+	conversation, err := u.getConversation(ctx, organizationID, tenantID, userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := u.conversationRepo.ListMessages(ctx, conversation.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list messages: %w", err)
+	}
+
+	resp := &response.ConversationDetailResponse{
+		ConversationResponse: *toConversationResponse(conversation),
+		Messages:             make([]response.MessageResponse, 0, len(messages)),
+	}
+	for _, message := range messages {
+		resp.Messages = append(resp.Messages, toMessageResponse(message))
+	}
+	return resp, nil
+}
+
+// DeleteConversation deletes a conversation and its messages
+func (u *ConversationUsecase) DeleteConversation(ctx context.Context, organizationID, tenantID, userID, conversationID uuid.UUID) error {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return err
+	}
+
+	deleted, err := u.conversationRepo.DeleteConversation(ctx, tenantID, userID, conversationID)
+	if err != nil {
+		return fmt.Errorf("failed to delete conversation: %w", err)
+	}
+	if !deleted {
+		return ErrConversationNotFound
+	}
+	return nil
+}
+
+// PendingReply is a stored question whose answer is ready to be generated
+type PendingReply struct {
+	conversationID uuid.UUID
+	question       db.Message
+	answer         *rag.Answer
+}
+
+// SendMessage stores a question and retrieves its sources. A follow-up is
+// first rewritten into a standalone query using the earlier turns, which
+// are also passed to the model. It runs before the response starts
+// streaming so that its failures get a proper status code.
+func (u *ConversationUsecase) SendMessage(ctx context.Context, organizationID, tenantID, userID, conversationID uuid.UUID, req *requests.SendMessageRequest) (*PendingReply, error) {
+	conversation, err := u.getConversation(ctx, organizationID, tenantID, userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	content := strings.TrimSpace(req.Content)
+	if content == "" {
+		return nil, ErrEmptyMessage
+	}
+
+	history, err := u.history(ctx, conversation.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := u.answerer.Rewrite(ctx, history, content)
+	if err != nil {
+		return nil, err
+	}
+
+	q := rag.Question{
+		Text:    content,
+		Query:   query,
+		History: history,
+		TopK:    req.TopK,
+		Mode:    req.Mode,
+	}
+	if q.TopK <= 0 {
+		q.TopK = defaultTopK
+	}
+	if q.Mode == "" {
+		q.Mode = retrieval.ModeHybrid
+	}
+	if req.Filter != nil {
+		q.Filter.Metadata = req.Filter.Metadata
+		q.Filter.DocumentIDs = req.Filter.DocumentIDs
+	}
+
+	values, err := u.settings.ForTenant(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve search settings: %w", err)
+	}
+	q.Weights = retrieval.Weights{
+		Vector:  settings.Get(values, settings.SearchVectorWeight),
+		Keyword: settings.Get(values, settings.SearchKeywordWeight),
+		K:       settings.Get(values, settings.SearchRRFK),
+	}
+
+	answer, err := u.answerer.Prepare(ctx, tenantID, q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve sources: %w", err)
+	}
+
+	var rewritten sql.NullString
+	if query != content {
+		rewritten = sql.NullString{String: query, Valid: true}
+	}
+	question, err := u.conversationRepo.CreateMessage(ctx, db.CreateMessageParams{
+		ConversationID: conversation.ID,
+		Role:           external.RoleUser,
+		Content:        content,
+		RewrittenQuery: rewritten,
+		Citations:      json.RawMessage("[]"),
+		TokenCount:     int32(chunking.CountTokens(content)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to store message: %w", err)
+	}
+
+	if err := u.conversationRepo.TouchConversation(ctx, conversation.ID, title(content)); err != nil {
+		return nil, fmt.Errorf("failed to update conversation: %w", err)
+	}
+
+	return &PendingReply{
+		conversationID: conversation.ID,
+		question:       question,
+		answer:         answer,
+	}, nil
+}
+
+// Reply generates the answer to a pending question, passing each token to
+// onToken, and stores it with the sources it cites
+func (u *ConversationUsecase) Reply(ctx context.Context, pending *PendingReply, onToken func(string) error) (*response.MessageDoneEvent, error) {
+	text, err := pending.answer.Stream(ctx, onToken)
+	if err != nil {
+		return nil, err
+	}
+
+	citations, err := json.Marshal(toCitationResponses(pending.answer.Cited(text)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode citations: %w", err)
+	}
+
+	answer, err := u.conversationRepo.CreateMessage(ctx, db.CreateMessageParams{
+		ConversationID: pending.conversationID,
+		Role:           external.RoleAssistant,
+		Content:        text,
+		Citations:      citations,
+		Model:          sql.NullString{String: u.answerer.Model(), Valid: true},
+		TokenCount:     int32(chunking.CountTokens(text)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to store answer: %w", err)
+	}
+
+	if err := u.conversationRepo.TouchConversation(ctx, pending.conversationID, ""); err != nil {
+		return nil, fmt.Errorf("failed to update conversation: %w", err)
+	}
+
+	return &response.MessageDoneEvent{
+		Question: toMessageResponse(pending.question),
+		Answer:   toMessageResponse(answer),
+	}, nil
+}
+
+// history returns the latest messages of a conversation, oldest first.
+// Questions are stored before their answer streams, so one whose answer
+// failed has no assistant message after it; it is left out to keep the
+// turns alternating.
+func (u *ConversationUsecase) history(ctx context.Context, conversationID uuid.UUID) ([]external.ChatMessage, error) {
+	messages, err := u.conversationRepo.ListRecentMessages(ctx, conversationID, historyMessages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list messages: %w", err)
+	}
+
+	history := make([]external.ChatMessage, 0, len(messages))
+	for i := len(messages) - 1; i >= 0; i-- {
+		message := messages[i]
+		if message.Role == external.RoleUser && (i == 0 || messages[i-1].Role != external.RoleAssistant) {
+			continue
+		}
+		history = append(history, external.ChatMessage{Role: message.Role, Content: message.Content})
+	}
+	return history, nil
+}
+
+func (u *ConversationUsecase) getConversation(ctx context.Context, organizationID, tenantID, userID, conversationID uuid.UUID) (db.Conversation, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return db.Conversation{}, err
+	}
+
+	conversation, err := u.conversationRepo.GetConversation(ctx, tenantID, userID, conversationID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.Conversation{}, ErrConversationNotFound
+		}
+		return db.Conversation{}, fmt.Errorf("failed to get conversation: %w", err)
+	}
+	return conversation, nil
+}
+
+// ensureTenant checks that the tenant belongs to the organization in the path
+func (u *ConversationUsecase) ensureTenant(ctx context.Context, organizationID, tenantID uuid.UUID) error {
+	tenant, err := u.tenantRepo.GetTenant(ctx, tenantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTenantNotFound
+		}
+		return fmt.Errorf("failed to get tenant: %w", err)
+	}
+	if tenant.OrganizationID != organizationID {
+		return ErrTenantNotFound
+	}
+	return nil
+}
+
+// title cuts a question down to a conversation title
+func title(content string) string {
+	content = strings.Join(strings.Fields(content), " ")
+	if runes := []rune(content); len(runes) > titleRunes {
+		return strings.TrimSpace(string(runes[:titleRunes])) + "…"
+	}
+	return content
+}
+
+func toConversationResponse(conversation db.Conversation) *response.ConversationResponse {
+	return &response.ConversationResponse{
+		ID:        conversation.ID,
+		Title:     conversation.Title,
+		CreatedAt: conversation.CreatedAt,
+		UpdatedAt: conversation.UpdatedAt,
+	}
+}
+
+func toMessageResponse(message db.Message) response.MessageResponse {
+	citations := []response.CitationResponse{}
+	if len(message.Citations) > 0 {
+		_ = json.Unmarshal(message.Citations, &citations)
+	}
+
+	return response.MessageResponse{
+		ID:             message.ID,
+		Role:           message.Role,
+		Content:        message.Content,
+		RewrittenQuery: message.RewrittenQuery.String,
+		Citations:      citations,
+		Model:          message.Model.String,
+		CreatedAt:      message.CreatedAt,
+	}
+}
+
+func toCitationResponses(sources []rag.Source) []response.CitationResponse {
+	resp := make([]response.CitationResponse, len(sources))
+	for i, source := range sources {
+		chunk := source.Chunk
+		resp[i] = response.CitationResponse{
+			Number:        source.Number,
+			ChunkID:       chunk.ID,
+			DocumentID:    chunk.DocumentID,
+			DocumentTitle: chunk.DocumentTitle,
+			Version:       int(chunk.Version),
+			Heading:       chunk.Heading,
+		}
+	}
+	return resp
+}
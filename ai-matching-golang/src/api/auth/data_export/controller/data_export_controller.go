@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"ai-matching/src/api/auth/data_export/response"
+	"ai-matching/src/api/auth/data_export/usecase"
+	"ai-matching/src/infrastructure/middleware"
+	"context"
+	"errors"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+type DataExportController struct {
+	usecase *usecase.DataExportUsecase
+}
+
+func NewDataExportController(exportUsecase *usecase.DataExportUsecase) *DataExportController {
+	return &DataExportController{
+		usecase: exportUsecase,
+	}
+}
+
+type CreateExportInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+}
+
+type DataExportOutput struct {
+	Body response.DataExportResponse
+}
+
+func (c *DataExportController) CreateExport(ctx context.Context, input *CreateExportInput) (*DataExportOutput, error) {
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.CreateExport(ctx, input.OrganizationID, actorID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &DataExportOutput{Body: *resp}, nil
+}
+
+type ListExportsInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+}
+
+type ListExportsOutput struct {
+	Body response.DataExportListResponse
+}
+
+func (c *DataExportController) ListExports(ctx context.Context, input *ListExportsInput) (*ListExportsOutput, error) {
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.ListExports(ctx, input.OrganizationID, actorID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &ListExportsOutput{Body: *resp}, nil
+}
+
+type GetExportInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	ExportID       uuid.UUID `path:"exportId" doc:"Export ID"`
+}
+
+func (c *DataExportController) GetExport(ctx context.Context, input *GetExportInput) (*DataExportOutput, error) {
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.GetExport(ctx, input.OrganizationID, actorID, input.ExportID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &DataExportOutput{Body: *resp}, nil
+}
+
+// currentUserID returns the authenticated user; exports are always
+// attributed to someone.
+func currentUserID(ctx context.Context) (uuid.UUID, error) {
+	userCtx, err := middleware.GetUserFromContext(ctx)
+	if err != nil {
+		return uuid.Nil, huma.Error401Unauthorized("Authentication required")
+	}
+	return userCtx.UserID, nil
+}
+
+func toHTTPError(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrOrganizationNotFound), errors.Is(err, usecase.ErrExportNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, usecase.ErrForbidden):
+		return huma.Error403Forbidden(err.Error())
+	}
+	return err
+}
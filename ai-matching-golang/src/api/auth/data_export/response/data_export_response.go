@@ -0,0 +1,24 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DataExportResponse struct {
+	ID                uuid.UUID  `json:"id" doc:"Export ID"`
+	OrganizationID    uuid.UUID  `json:"organizationId" doc:"Organization ID"`
+	Status            string     `json:"status" doc:"pending, processing, completed or failed"`
+	SizeBytes         *int64     `json:"sizeBytes,omitempty" doc:"Archive size"`
+	Error             string     `json:"error,omitempty" doc:"Why the export failed"`
+	DownloadURL       string     `json:"downloadUrl,omitempty" doc:"Signed download link, relative to the API host"`
+	DownloadExpiresAt *time.Time `json:"downloadExpiresAt,omitempty" doc:"When the download link stops working"`
+	ExpiresAt         *time.Time `json:"expiresAt,omitempty" doc:"When the archive is no longer available"`
+	CreatedAt         time.Time  `json:"createdAt" doc:"Creation timestamp"`
+	CompletedAt       *time.Time `json:"completedAt,omitempty" doc:"When the export finished"`
+}
+
+type DataExportListResponse struct {
+	Exports []DataExportResponse `json:"exports" doc:"Recent exports, newest first"`
+}
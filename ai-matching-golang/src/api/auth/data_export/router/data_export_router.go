@@ -0,0 +1,41 @@
+package router
+
+import (
+	"ai-matching/src/api/auth/data_export/controller"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterDataExportRoutes(api huma.API, router fiber.Router, exportController *controller.DataExportController) {
+	// Organization data export endpoints
+	huma.Register(api, huma.Operation{
+		OperationID: "create-data-export",
+		Method:      "POST",
+		Path:        "/api/v1/organizations/{organizationId}/exports",
+		Summary:     "Export organization data",
+		Description: "Start building a ZIP archive of the organization, its tenants, users, memberships and audit events (owners and admins only)",
+		Tags:        []string{"Data Exports"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, exportController.CreateExport)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-data-exports",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/exports",
+		Summary:     "List data exports",
+		Description: "List the most recent data exports of an organization",
+		Tags:        []string{"Data Exports"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, exportController.ListExports)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-data-export",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/exports/{exportId}",
+		Summary:     "Get data export",
+		Description: "Get the status of a data export with a short-lived signed download link once it is complete",
+		Tags:        []string{"Data Exports"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, exportController.GetExport)
+}
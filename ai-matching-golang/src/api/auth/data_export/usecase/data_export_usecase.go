@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/api/auth/data_export/response"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/dataexport"
+	"ai-matching/src/infrastructure/jobs"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const listExportsLimit = 50
+
+var (
+	ErrOrganizationNotFound = errors.New("organization not found")
+	ErrExportNotFound       = errors.New("export not found")
+	ErrForbidden            = errors.New("only organization owners and admins can export data")
+)
+
+type DataExportUsecase struct {
+	exportRepo repository.OrganizationExportRepository
+	orgRepo    repository.OrganizationRepository
+	memberRepo repository.OrganizationMemberRepository
+	exporter   *dataexport.Exporter
+	queue      *jobs.Queue
+}
+
+func NewDataExportUsecase(exportRepo repository.OrganizationExportRepository, orgRepo repository.OrganizationRepository, memberRepo repository.OrganizationMemberRepository, exporter *dataexport.Exporter, queue *jobs.Queue) *DataExportUsecase {
+	return &DataExportUsecase{
+		exportRepo: exportRepo,
+		orgRepo:    orgRepo,
+		memberRepo: memberRepo,
+		exporter:   exporter,
+		queue:      queue,
+	}
+}
+
+// CreateExport starts building an archive of the organization's data in the
+// background
+func (u *DataExportUsecase) CreateExport(ctx context.Context, organizationID, actorID uuid.UUID) (*response.DataExportResponse, error) {
+	if err := u.authorize(ctx, organizationID, actorID); err != nil {
+		return nil, err
+	}
+
+	export, err := u.exportRepo.CreateOrganizationExport(ctx, organizationID, uuid.NullUUID{UUID: actorID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create export: %w", err)
+	}
+
+	if _, err := u.queue.Enqueue(ctx, dataexport.JobKind, dataexport.Job{ExportID: export.ID}, jobs.Options{OrganizationID: organizationID}); err != nil {
+		_ = u.exportRepo.FailOrganizationExport(ctx, export.ID, "failed to queue export")
+		return nil, err
+	}
+
+	return u.toResponse(export), nil
+}
+
+// GetExport returns an export with a fresh download link once it is complete
+func (u *DataExportUsecase) GetExport(ctx context.Context, organizationID, actorID, exportID uuid.UUID) (*response.DataExportResponse, error) {
+	if err := u.authorize(ctx, organizationID, actorID); err != nil {
+		return nil, err
+	}
+
+	export, err := u.exportRepo.GetOrganizationExport(ctx, organizationID, exportID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrExportNotFound
+		}
+		return nil, fmt.Errorf("failed to get export: %w", err)
+	}
+
+	return u.toResponse(export), nil
+}
+
+// ListExports returns the most recent exports of an organization
+func (u *DataExportUsecase) ListExports(ctx context.Context, organizationID, actorID uuid.UUID) (*response.DataExportListResponse, error) {
+	if err := u.authorize(ctx, organizationID, actorID); err != nil {
+		return nil, err
+	}
+
+	exports, err := u.exportRepo.ListOrganizationExports(ctx, organizationID, listExportsLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list exports: %w", err)
+	}
+
+	items := make([]response.DataExportResponse, len(exports))
+	for i, export := range exports {
+		items[i] = *u.toResponse(export)
+	}
+	return &response.DataExportListResponse{Exports: items}, nil
+}
+
+// authorize limits exports to organization owners and admins
+func (u *DataExportUsecase) authorize(ctx context.Context, organizationID, actorID uuid.UUID) error {
+	if _, err := u.orgRepo.GetOrganization(ctx, organizationID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOrganizationNotFound
+		}
+		return fmt.Errorf("failed to get organization: %w", err)
+	}
+
+	actor, err := u.memberRepo.GetOrganizationMember(ctx, organizationID, actorID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrForbidden
+		}
+		return fmt.Errorf("failed to get organization member: %w", err)
+	}
+	if actor.Role != repository.OrganizationRoleOwner && actor.Role != repository.OrganizationRoleAdmin {
+		return ErrForbidden
+	}
+	return nil
+}
+
+func (u *DataExportUsecase) toResponse(export db.OrganizationExport) *response.DataExportResponse {
+	resp := &response.DataExportResponse{
+		ID:             export.ID,
+		OrganizationID: export.OrganizationID,
+		Status:         export.Status,
+		Error:          export.ErrorMessage.String,
+		ExpiresAt:      nullTime(export.ExpiresAt),
+		CreatedAt:      export.CreatedAt,
+		CompletedAt:    nullTime(export.CompletedAt),
+	}
+	if export.SizeBytes.Valid {
+		resp.SizeBytes = &export.SizeBytes.Int64
+	}
+	if url, expiresAt, ok := u.exporter.DownloadURL(export); ok {
+		resp.DownloadURL = url
+		resp.DownloadExpiresAt = &expiresAt
+	}
+	return resp
+}
+
+func nullTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
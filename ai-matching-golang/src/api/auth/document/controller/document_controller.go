@@ -0,0 +1,291 @@
+package controller
+
+import (
+	"ai-matching/src/api/auth/document/requests"
+	"ai-matching/src/api/auth/document/response"
+	"ai-matching/src/api/auth/document/usecase"
+	"ai-matching/src/infrastructure/middleware"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+type DocumentController struct {
+	usecase *usecase.DocumentUsecase
+}
+
+func NewDocumentController(documentUsecase *usecase.DocumentUsecase) *DocumentController {
+	return &DocumentController{
+		usecase: documentUsecase,
+	}
+}
+
+type CreateDocumentInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	RawBody        huma.MultipartFormFiles[requests.UploadDocumentForm]
+}
+
+type DocumentOutput struct {
+	Body response.DocumentResponse
+}
+
+func (c *DocumentController) CreateDocument(ctx context.Context, input *CreateDocumentInput) (*DocumentOutput, error) {
+	userCtx, err := middleware.RequireTenant(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	form := input.RawBody.Data()
+	file, err := readUpload(form.File)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.CreateDocument(ctx, input.OrganizationID, input.TenantID, userCtx.UserID, form.Title, form.Metadata, file)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &DocumentOutput{Body: *resp}, nil
+}
+
+type ListDocumentsInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	Page           int       `query:"page" minimum:"1" default:"1" doc:"Page number"`
+	PageSize       int       `query:"pageSize" minimum:"1" maximum:"100" default:"20" doc:"Documents per page"`
+}
+
+type ListDocumentsOutput struct {
+	Body response.DocumentListResponse
+}
+
+func (c *DocumentController) ListDocuments(ctx context.Context, input *ListDocumentsInput) (*ListDocumentsOutput, error) {
+	if _, err := middleware.RequireTenant(ctx, input.TenantID); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.ListDocuments(ctx, input.OrganizationID, input.TenantID, input.Page, input.PageSize)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &ListDocumentsOutput{Body: *resp}, nil
+}
+
+type DocumentInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	DocumentID     uuid.UUID `path:"documentId" doc:"Document ID"`
+}
+
+func (c *DocumentController) GetDocument(ctx context.Context, input *DocumentInput) (*DocumentOutput, error) {
+	if _, err := middleware.RequireTenant(ctx, input.TenantID); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.GetDocument(ctx, input.OrganizationID, input.TenantID, input.DocumentID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &DocumentOutput{Body: *resp}, nil
+}
+
+type UpdateDocumentInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	DocumentID     uuid.UUID `path:"documentId" doc:"Document ID"`
+	Body           requests.UpdateDocumentRequest
+}
+
+func (c *DocumentController) UpdateDocument(ctx context.Context, input *UpdateDocumentInput) (*DocumentOutput, error) {
+	if _, err := middleware.RequireTenant(ctx, input.TenantID); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.UpdateDocument(ctx, input.OrganizationID, input.TenantID, input.DocumentID, input.Body.Title, input.Body.Metadata)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &DocumentOutput{Body: *resp}, nil
+}
+
+type DeleteDocumentOutput struct {
+	Body struct {
+		Message string `json:"message" doc:"Result message"`
+	}
+}
+
+func (c *DocumentController) DeleteDocument(ctx context.Context, input *DocumentInput) (*DeleteDocumentOutput, error) {
+	if _, err := middleware.RequireTenant(ctx, input.TenantID); err != nil {
+		return nil, err
+	}
+
+	if err := c.usecase.DeleteDocument(ctx, input.OrganizationID, input.TenantID, input.DocumentID); err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	out := &DeleteDocumentOutput{}
+	out.Body.Message = "Document deleted successfully"
+	return out, nil
+}
+
+type CreateVersionInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	DocumentID     uuid.UUID `path:"documentId" doc:"Document ID"`
+	RawBody        huma.MultipartFormFiles[requests.UploadDocumentVersionForm]
+}
+
+func (c *DocumentController) CreateVersion(ctx context.Context, input *CreateVersionInput) (*DocumentOutput, error) {
+	userCtx, err := middleware.RequireTenant(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := readUpload(input.RawBody.Data().File)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.AddVersion(ctx, input.OrganizationID, input.TenantID, input.DocumentID, userCtx.UserID, file)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &DocumentOutput{Body: *resp}, nil
+}
+
+type ListVersionsOutput struct {
+	Body response.DocumentVersionListResponse
+}
+
+func (c *DocumentController) ListVersions(ctx context.Context, input *DocumentInput) (*ListVersionsOutput, error) {
+	if _, err := middleware.RequireTenant(ctx, input.TenantID); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.ListVersions(ctx, input.OrganizationID, input.TenantID, input.DocumentID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &ListVersionsOutput{Body: *resp}, nil
+}
+
+type VersionInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	DocumentID     uuid.UUID `path:"documentId" doc:"Document ID"`
+	Version        int       `path:"version" minimum:"1" doc:"Version number"`
+}
+
+type DocumentTextOutput struct {
+	Body response.DocumentTextResponse
+}
+
+func (c *DocumentController) GetText(ctx context.Context, input *VersionInput) (*DocumentTextOutput, error) {
+	if _, err := middleware.RequireTenant(ctx, input.TenantID); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.GetText(ctx, input.OrganizationID, input.TenantID, input.DocumentID, input.Version)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &DocumentTextOutput{Body: *resp}, nil
+}
+
+type DocumentChunksOutput struct {
+	Body response.DocumentChunkListResponse
+}
+
+func (c *DocumentController) ListChunks(ctx context.Context, input *VersionInput) (*DocumentChunksOutput, error) {
+	if _, err := middleware.RequireTenant(ctx, input.TenantID); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.ListChunks(ctx, input.OrganizationID, input.TenantID, input.DocumentID, input.Version)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &DocumentChunksOutput{Body: *resp}, nil
+}
+
+func (c *DocumentController) DownloadFile(ctx context.Context, input *VersionInput) (*huma.StreamResponse, error) {
+	if _, err := middleware.RequireTenant(ctx, input.TenantID); err != nil {
+		return nil, err
+	}
+
+	file, err := c.usecase.OpenFile(ctx, input.OrganizationID, input.TenantID, input.DocumentID, input.Version)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &huma.StreamResponse{
+		Body: func(hctx huma.Context) {
+			defer file.Body.Close()
+
+			contentType := file.ContentType
+			if contentType == "" {
+				contentType = "application/octet-stream"
+			}
+			hctx.SetHeader("Content-Type", contentType)
+			hctx.SetHeader("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": file.Filename}))
+			hctx.SetHeader("Content-Length", strconv.FormatInt(file.SizeBytes, 10))
+			hctx.SetHeader("Cache-Control", "private, no-store")
+			hctx.SetHeader("X-Content-Type-Options", "nosniff")
+			if _, err := io.Copy(hctx.BodyWriter(), file.Body); err != nil {
+				slog.WarnContext(ctx, "failed to stream document file", slog.String("document_id", input.DocumentID.String()), slog.Any("error", err))
+			}
+		},
+	}, nil
+}
+
+// readUpload reads at most one byte past the limit so oversized files are
+// rejected without buffering them whole.
+func readUpload(file huma.FormFile) (usecase.Upload, error) {
+	defer file.Close()
+
+	if file.Size > usecase.MaxDocumentBytes {
+		return usecase.Upload{}, huma.NewError(http.StatusRequestEntityTooLarge, usecase.ErrFileTooLarge.Error())
+	}
+
+	data, err := io.ReadAll(io.LimitReader(file, usecase.MaxDocumentBytes+1))
+	if err != nil {
+		return usecase.Upload{}, fmt.Errorf("failed to read uploaded file: %w", err)
+	}
+
+	return usecase.Upload{
+		Filename:    file.Filename,
+		ContentType: file.ContentType,
+		Data:        data,
+	}, nil
+}
+
+func toHTTPError(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrTenantNotFound), errors.Is(err, usecase.ErrDocumentNotFound), errors.Is(err, usecase.ErrVersionNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, usecase.ErrFileTooLarge):
+		return huma.NewError(http.StatusRequestEntityTooLarge, err.Error())
+	case errors.Is(err, usecase.ErrUnsupportedFormat):
+		return huma.Error415UnsupportedMediaType(err.Error())
+	case errors.Is(err, usecase.ErrEmptyFile), errors.Is(err, usecase.ErrInvalidMetadata), errors.Is(err, usecase.ErrInvalidTitle):
+		return huma.Error400BadRequest(err.Error())
+	}
+	return err
+}
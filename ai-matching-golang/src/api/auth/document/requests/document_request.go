@@ -0,0 +1,18 @@
+package requests
+
+import "github.com/danielgtaylor/huma/v2"
+
+type UploadDocumentForm struct {
+	File     huma.FormFile `form:"file" contentType:"text/plain,text/markdown,text/html,application/pdf,application/octet-stream" required:"true" doc:"TXT, Markdown, HTML or PDF file"`
+	Title    string        `form:"title" maxLength:"255" doc:"Document title (defaults to the file name)"`
+	Metadata string        `form:"metadata" doc:"JSON object with free-form metadata"`
+}
+
+type UploadDocumentVersionForm struct {
+	File huma.FormFile `form:"file" contentType:"text/plain,text/markdown,text/html,application/pdf,application/octet-stream" required:"true" doc:"TXT, Markdown, HTML or PDF file"`
+}
+
+type UpdateDocumentRequest struct {
+	Title    string         `json:"title" required:"true" minLength:"1" maxLength:"255" doc:"Document title"`
+	Metadata map[string]any `json:"metadata,omitempty" doc:"Free-form metadata; replaces the stored metadata"`
+}
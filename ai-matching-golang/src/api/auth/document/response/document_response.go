@@ -0,0 +1,73 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DocumentVersionResponse struct {
+	Version          int        `json:"version" doc:"Version number, starting at 1"`
+	Filename         string     `json:"filename" doc:"Uploaded file name"`
+	ContentType      string     `json:"contentType" doc:"Uploaded content type"`
+	Format           string     `json:"format" doc:"Detected format: text, markdown, html or pdf"`
+	SizeBytes        int64      `json:"sizeBytes" doc:"File size"`
+	Checksum         string     `json:"checksum" doc:"SHA-256 of the file"`
+	ExtractionStatus string     `json:"extractionStatus" doc:"extracted or failed"`
+	ExtractionError  string     `json:"extractionError,omitempty" doc:"Why text extraction failed"`
+	TextLength       int        `json:"textLength" doc:"Length of the extracted text in characters"`
+	IndexStatus      string     `json:"indexStatus" doc:"pending, indexing, indexed, failed or skipped"`
+	IndexError       string     `json:"indexError,omitempty" doc:"Why indexing failed or was skipped"`
+	ChunkCount       int        `json:"chunkCount" doc:"Number of chunks stored for retrieval"`
+	EmbeddingModel   string     `json:"embeddingModel,omitempty" doc:"Model the chunks were embedded with"`
+	IndexedAt        *time.Time `json:"indexedAt,omitempty" doc:"When indexing completed"`
+	CreatedBy        *uuid.UUID `json:"createdBy,omitempty" doc:"Uploader"`
+	CreatedAt        time.Time  `json:"createdAt" doc:"Upload timestamp"`
+}
+
+type DocumentResponse struct {
+	ID             uuid.UUID                `json:"id" doc:"Document ID"`
+	TenantID       uuid.UUID                `json:"tenantId" doc:"Tenant ID"`
+	Title          string                   `json:"title" doc:"Document title"`
+	Metadata       map[string]any           `json:"metadata" doc:"Free-form metadata"`
+	CurrentVersion int                      `json:"currentVersion" doc:"Latest version number"`
+	Version        *DocumentVersionResponse `json:"version,omitempty" doc:"Latest version"`
+	CreatedAt      time.Time                `json:"createdAt" doc:"Creation timestamp"`
+	UpdatedAt      time.Time                `json:"updatedAt" doc:"Last update timestamp"`
+}
+
+type DocumentListResponse struct {
+	Documents []DocumentResponse `json:"documents" doc:"Documents, newest first"`
+	Total     int                `json:"total" doc:"Total number of documents"`
+	Page      int                `json:"page" doc:"Page number"`
+	PageSize  int                `json:"pageSize" doc:"Page size"`
+}
+
+type DocumentVersionListResponse struct {
+	Versions []DocumentVersionResponse `json:"versions" doc:"Versions, newest first"`
+}
+
+type DocumentTextResponse struct {
+	DocumentID uuid.UUID `json:"documentId" doc:"Document ID"`
+	Version    int       `json:"version" doc:"Version number"`
+	Format     string    `json:"format" doc:"Source format"`
+	Text       string    `json:"text" doc:"Extracted plain text"`
+}
+
+type DocumentChunkResponse struct {
+	ID             uuid.UUID `json:"id" doc:"Chunk ID"`
+	Index          int       `json:"index" doc:"Position of the chunk in the version"`
+	Heading        string    `json:"heading,omitempty" doc:"Markdown heading path the chunk falls under"`
+	Content        string    `json:"content" doc:"Chunk text"`
+	StartOffset    int       `json:"startOffset" doc:"Byte offset of the chunk in the extracted text"`
+	EndOffset      int       `json:"endOffset" doc:"Byte offset of the end of the chunk"`
+	TokenCount     int       `json:"tokenCount" doc:"Estimated number of tokens"`
+	EmbeddingModel string    `json:"embeddingModel" doc:"Model the chunk was embedded with"`
+}
+
+type DocumentChunkListResponse struct {
+	DocumentID  uuid.UUID               `json:"documentId" doc:"Document ID"`
+	Version     int                     `json:"version" doc:"Version number"`
+	IndexStatus string                  `json:"indexStatus" doc:"Index status of the version"`
+	Chunks      []DocumentChunkResponse `json:"chunks" doc:"Chunks in order"`
+}
@@ -0,0 +1,111 @@
+package router
+
+import (
+	"ai-matching/src/api/auth/document/controller"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterDocumentRoutes(api huma.API, router fiber.Router, documentController *controller.DocumentController) {
+	// Tenant document endpoints
+	huma.Register(api, huma.Operation{
+		OperationID: "create-document",
+		Method:      "POST",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/documents",
+		Summary:     "Upload document",
+		Description: "Upload a TXT, Markdown, HTML or PDF file as a new document and extract its text",
+		Tags:        []string{"Documents"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, documentController.CreateDocument)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-documents",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/documents",
+		Summary:     "List documents",
+		Description: "List the documents of a tenant, newest first",
+		Tags:        []string{"Documents"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, documentController.ListDocuments)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-document",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/documents/{documentId}",
+		Summary:     "Get document",
+		Description: "Get a document with its metadata and latest version",
+		Tags:        []string{"Documents"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, documentController.GetDocument)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-document",
+		Method:      "PUT",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/documents/{documentId}",
+		Summary:     "Update document",
+		Description: "Update the title and metadata of a document",
+		Tags:        []string{"Documents"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, documentController.UpdateDocument)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-document",
+		Method:      "DELETE",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/documents/{documentId}",
+		Summary:     "Delete document",
+		Description: "Delete a document and all of its versions",
+		Tags:        []string{"Documents"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, documentController.DeleteDocument)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "create-document-version",
+		Method:      "POST",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/documents/{documentId}/versions",
+		Summary:     "Upload document version",
+		Description: "Upload a new version of a document and extract its text",
+		Tags:        []string{"Documents"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, documentController.CreateVersion)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-document-versions",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/documents/{documentId}/versions",
+		Summary:     "List document versions",
+		Description: "List every version of a document, newest first",
+		Tags:        []string{"Documents"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, documentController.ListVersions)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-document-text",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/documents/{documentId}/versions/{version}/text",
+		Summary:     "Get document text",
+		Description: "Get the plain text extracted from a document version",
+		Tags:        []string{"Documents"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, documentController.GetText)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-document-chunks",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/documents/{documentId}/versions/{version}/chunks",
+		Summary:     "List document chunks",
+		Description: "List the chunks a document version was split into for retrieval",
+		Tags:        []string{"Documents"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, documentController.ListChunks)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "download-document-file",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/documents/{documentId}/versions/{version}/file",
+		Summary:     "Download document file",
+		Description: "Download the original file of a document version",
+		Tags:        []string{"Documents"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, documentController.DownloadFile)
+}
@@ -0,0 +1,589 @@
+package usecase
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/api/auth/document/response"
+	"ai-matching/src/domain/interface/external"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/indexing"
+	"ai-matching/src/infrastructure/jobs"
+	"ai-matching/src/infrastructure/textextract"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxDocumentBytes is the largest file accepted per version. The server
+	// body limit leaves room for the rest of the multipart form.
+	MaxDocumentBytes = 15 << 20
+
+	maxMetadataBytes = 16 << 10
+	maxTitleLength   = 255
+
+	defaultPageSize = 20
+	maxPageSize     = 100
+
+	extractionExtracted = "extracted"
+	extractionFailed    = "failed"
+)
+
+var (
+	ErrTenantNotFound    = errors.New("tenant not found")
+	ErrDocumentNotFound  = errors.New("document not found")
+	ErrVersionNotFound   = errors.New("document version not found")
+	ErrEmptyFile         = errors.New("file is empty")
+	ErrFileTooLarge      = fmt.Errorf("file exceeds the %d MB limit", MaxDocumentBytes>>20)
+	ErrUnsupportedFormat = errors.New("unsupported file type; upload TXT, Markdown, HTML or PDF")
+	ErrInvalidMetadata   = errors.New("metadata must be a JSON object")
+	ErrInvalidTitle      = fmt.Errorf("title must be at most %d characters", maxTitleLength)
+)
+
+// Upload is a file received with a document or version upload
+type Upload struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+type DocumentUsecase struct {
+	documentRepo repository.DocumentRepository
+	chunkRepo    repository.DocumentChunkRepository
+	tenantRepo   repository.TenantRepository
+	storage      external.FileStorage
+	queue        *jobs.Queue
+}
+
+func NewDocumentUsecase(documentRepo repository.DocumentRepository, chunkRepo repository.DocumentChunkRepository, tenantRepo repository.TenantRepository, storage external.FileStorage, queue *jobs.Queue) *DocumentUsecase {
+	return &DocumentUsecase{
+		documentRepo: documentRepo,
+		chunkRepo:    chunkRepo,
+		tenantRepo:   tenantRepo,
+		storage:      storage,
+		queue:        queue,
+	}
+}
+
+// CreateDocument stores the file as version 1 of a new document
+func (u *DocumentUsecase) CreateDocument(ctx context.Context, organizationID, tenantID, userID uuid.UUID, title, metadata string, file Upload) (*response.DocumentResponse, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	format, err := validateUpload(file)
+	if err != nil {
+		return nil, err
+	}
+
+	meta, err := parseMetadata(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	title = strings.TrimSpace(title)
+	if title == "" {
+		title = defaultTitle(file.Filename)
+	}
+	if utf8.RuneCountInString(title) > maxTitleLength {
+		return nil, ErrInvalidTitle
+	}
+
+	doc, err := u.documentRepo.CreateDocument(ctx, db.CreateDocumentParams{
+		TenantID:  tenantID,
+		Title:     title,
+		Metadata:  meta,
+		CreatedBy: uuid.NullUUID{UUID: userID, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create document: %w", err)
+	}
+
+	version, err := u.addVersion(ctx, organizationID, doc, userID, format, file)
+	if err != nil {
+		// Without a first version the document is unusable
+		if _, delErr := u.documentRepo.SoftDeleteDocument(ctx, tenantID, doc.ID); delErr != nil {
+			slog.WarnContext(ctx, "failed to remove document without versions", "document_id", doc.ID, "error", delErr)
+		}
+		return nil, err
+	}
+
+	doc.CurrentVersion = version.Version
+	return toDocumentResponse(doc, versionResponse(version)), nil
+}
+
+// AddVersion stores the file as the next version of an existing document
+func (u *DocumentUsecase) AddVersion(ctx context.Context, organizationID, tenantID, documentID, userID uuid.UUID, file Upload) (*response.DocumentResponse, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	format, err := validateUpload(file)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := u.getDocument(ctx, tenantID, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := u.addVersion(ctx, organizationID, doc, userID, format, file)
+	if err != nil {
+		return nil, err
+	}
+
+	doc.CurrentVersion = version.Version
+	doc.UpdatedAt = version.CreatedAt
+	return toDocumentResponse(doc, versionResponse(version)), nil
+}
+
+// GetDocument returns a document with its latest version
+func (u *DocumentUsecase) GetDocument(ctx context.Context, organizationID, tenantID, documentID uuid.UUID) (*response.DocumentResponse, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	doc, err := u.getDocument(ctx, tenantID, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := u.getVersion(ctx, tenantID, documentID, doc.CurrentVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDocumentResponse(doc, versionResponse(version)), nil
+}
+
+// ListDocuments returns a page of the tenant's documents
+func (u *DocumentUsecase) ListDocuments(ctx context.Context, organizationID, tenantID uuid.UUID, page, pageSize int) (*response.DocumentListResponse, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	docs, err := u.documentRepo.ListDocuments(ctx, tenantID, int32(pageSize), int32((page-1)*pageSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list documents: %w", err)
+	}
+
+	total, err := u.documentRepo.CountDocuments(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count documents: %w", err)
+	}
+
+	resp := &response.DocumentListResponse{
+		Documents: make([]response.DocumentResponse, 0, len(docs)),
+		Total:     int(total),
+		Page:      page,
+		PageSize:  pageSize,
+	}
+	for _, doc := range docs {
+		resp.Documents = append(resp.Documents, *toDocumentResponse(doc, nil))
+	}
+	return resp, nil
+}
+
+// UpdateDocument replaces the title and metadata of a document
+func (u *DocumentUsecase) UpdateDocument(ctx context.Context, organizationID, tenantID, documentID uuid.UUID, title string, metadata map[string]any) (*response.DocumentResponse, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	title = strings.TrimSpace(title)
+	if title == "" || utf8.RuneCountInString(title) > maxTitleLength {
+		return nil, ErrInvalidTitle
+	}
+
+	if metadata == nil {
+		metadata = map[string]any{}
+	}
+	meta, err := json.Marshal(metadata)
+	if err != nil || len(meta) > maxMetadataBytes {
+		return nil, ErrInvalidMetadata
+	}
+
+	doc, err := u.documentRepo.UpdateDocument(ctx, db.UpdateDocumentParams{
+		Title:    title,
+		Metadata: meta,
+		ID:       documentID,
+		TenantID: tenantID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrDocumentNotFound
+		}
+		return nil, fmt.Errorf("failed to update document: %w", err)
+	}
+
+	return toDocumentResponse(doc, nil), nil
+}
+
+// DeleteDocument hides a document and all of its versions. Stored files are
+// kept until the document is purged.
+func (u *DocumentUsecase) DeleteDocument(ctx context.Context, organizationID, tenantID, documentID uuid.UUID) error {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return err
+	}
+
+	deleted, err := u.documentRepo.SoftDeleteDocument(ctx, tenantID, documentID)
+	if err != nil {
+		return fmt.Errorf("failed to delete document: %w", err)
+	}
+	if !deleted {
+		return ErrDocumentNotFound
+	}
+	return nil
+}
+
+// ListVersions returns every version of a document, newest first
+func (u *DocumentUsecase) ListVersions(ctx context.Context, organizationID, tenantID, documentID uuid.UUID) (*response.DocumentVersionListResponse, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	if _, err := u.getDocument(ctx, tenantID, documentID); err != nil {
+		return nil, err
+	}
+
+	versions, err := u.documentRepo.ListDocumentVersions(ctx, tenantID, documentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list document versions: %w", err)
+	}
+
+	resp := &response.DocumentVersionListResponse{
+		Versions: make([]response.DocumentVersionResponse, 0, len(versions)),
+	}
+	for _, v := range versions {
+		resp.Versions = append(resp.Versions, response.DocumentVersionResponse{
+			Version:          int(v.Version),
+			Filename:         v.Filename,
+			ContentType:      v.ContentType,
+			Format:           v.Format,
+			SizeBytes:        v.SizeBytes,
+			Checksum:         v.Checksum,
+			ExtractionStatus: v.ExtractionStatus,
+			ExtractionError:  v.ExtractionError.String,
+			TextLength:       int(v.TextLength),
+			IndexStatus:      v.IndexStatus,
+			IndexError:       v.IndexError.String,
+			ChunkCount:       int(v.ChunkCount),
+			EmbeddingModel:   v.EmbeddingModel.String,
+			IndexedAt:        nullTimePtr(v.IndexedAt),
+			CreatedBy:        nullUUIDPtr(v.CreatedBy),
+			CreatedAt:        v.CreatedAt,
+		})
+	}
+	return resp, nil
+}
+
+// GetText returns the text extracted from a version
+func (u *DocumentUsecase) GetText(ctx context.Context, organizationID, tenantID, documentID uuid.UUID, version int) (*response.DocumentTextResponse, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	v, err := u.getVersion(ctx, tenantID, documentID, int32(version))
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.DocumentTextResponse{
+		DocumentID: documentID,
+		Version:    int(v.Version),
+		Format:     v.Format,
+		Text:       v.ExtractedText,
+	}, nil
+}
+
+// ListChunks returns the chunks a version was split into for retrieval
+func (u *DocumentUsecase) ListChunks(ctx context.Context, organizationID, tenantID, documentID uuid.UUID, version int) (*response.DocumentChunkListResponse, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	v, err := u.getVersion(ctx, tenantID, documentID, int32(version))
+	if err != nil {
+		return nil, err
+	}
+
+	chunks, err := u.chunkRepo.ListChunks(ctx, tenantID, documentID, v.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list document chunks: %w", err)
+	}
+
+	resp := &response.DocumentChunkListResponse{
+		DocumentID:  documentID,
+		Version:     int(v.Version),
+		IndexStatus: v.IndexStatus,
+		Chunks:      make([]response.DocumentChunkResponse, 0, len(chunks)),
+	}
+	for _, c := range chunks {
+		resp.Chunks = append(resp.Chunks, response.DocumentChunkResponse{
+			ID:             c.ID,
+			Index:          int(c.ChunkIndex),
+			Heading:        c.Heading,
+			Content:        c.Content,
+			StartOffset:    int(c.StartOffset),
+			EndOffset:      int(c.EndOffset),
+			TokenCount:     int(c.TokenCount),
+			EmbeddingModel: c.EmbeddingModel,
+		})
+	}
+	return resp, nil
+}
+
+// File is the original upload of a version
+type File struct {
+	Filename    string
+	ContentType string
+	SizeBytes   int64
+	Body        io.ReadCloser
+}
+
+// OpenFile opens the original upload of a version. The caller closes Body.
+func (u *DocumentUsecase) OpenFile(ctx context.Context, organizationID, tenantID, documentID uuid.UUID, version int) (*File, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	v, err := u.getVersion(ctx, tenantID, documentID, int32(version))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := u.storage.Open(ctx, v.StorageKey)
+	if err != nil {
+		if errors.Is(err, external.ErrFileNotFound) {
+			return nil, ErrVersionNotFound
+		}
+		return nil, fmt.Errorf("failed to open document file: %w", err)
+	}
+
+	return &File{
+		Filename:    v.Filename,
+		ContentType: v.ContentType,
+		SizeBytes:   v.SizeBytes,
+		Body:        body,
+	}, nil
+}
+
+// addVersion stores the file, extracts its text and records the version.
+// A failed extraction is recorded on the version rather than rejecting the
+// upload, so the original is kept either way.
+func (u *DocumentUsecase) addVersion(ctx context.Context, organizationID uuid.UUID, doc db.Document, userID uuid.UUID, format string, file Upload) (db.DocumentVersion, error) {
+	key := fmt.Sprintf("documents/%s/%s/%s", doc.TenantID, doc.ID, uuid.New())
+	if _, err := u.storage.Put(ctx, key, bytes.NewReader(file.Data)); err != nil {
+		return db.DocumentVersion{}, fmt.Errorf("failed to store document file: %w", err)
+	}
+
+	params := db.CreateDocumentVersionParams{
+		Filename:         filepath.Base(file.Filename),
+		ContentType:      file.ContentType,
+		Format:           format,
+		SizeBytes:        int64(len(file.Data)),
+		Checksum:         checksum(file.Data),
+		StorageKey:       key,
+		ExtractionStatus: extractionExtracted,
+		CreatedBy:        uuid.NullUUID{UUID: userID, Valid: true},
+		DocumentID:       doc.ID,
+		TenantID:         doc.TenantID,
+	}
+
+	text, err := textextract.Extract(format, file.Data)
+	if err != nil {
+		slog.WarnContext(ctx, "document text extraction failed", "document_id", doc.ID, "format", format, "error", err)
+		params.ExtractionStatus = extractionFailed
+		params.ExtractionError = sql.NullString{String: err.Error(), Valid: true}
+	} else {
+		params.ExtractedText = text
+	}
+
+	version, err := u.documentRepo.CreateDocumentVersion(ctx, params)
+	if err != nil {
+		if delErr := u.storage.Delete(ctx, key); delErr != nil {
+			slog.WarnContext(ctx, "failed to remove orphaned document file", "key", key, "error", delErr)
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.DocumentVersion{}, ErrDocumentNotFound
+		}
+		return db.DocumentVersion{}, fmt.Errorf("failed to create document version: %w", err)
+	}
+
+	// Chunking and embedding can take a while; the version reports its
+	// index status until it is searchable.
+	_, err = u.queue.Enqueue(ctx, indexing.JobKind, indexing.Job{VersionID: version.ID}, jobs.Options{
+		OrganizationID: organizationID,
+		TenantID:       doc.TenantID,
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to queue document indexing", "version_id", version.ID, "error", err)
+		version.IndexStatus = indexing.StatusFailed
+		version.IndexError = sql.NullString{String: "failed to queue indexing", Valid: true}
+		if err := u.chunkRepo.SetIndexStatus(ctx, version.ID, version.IndexStatus, version.IndexError.String); err != nil {
+			slog.ErrorContext(ctx, "failed to record document indexing failure", "version_id", version.ID, "error", err)
+		}
+	}
+
+	return version, nil
+}
+
+// ensureTenant checks that the tenant belongs to the organization in the path
+func (u *DocumentUsecase) ensureTenant(ctx context.Context, organizationID, tenantID uuid.UUID) error {
+	tenant, err := u.tenantRepo.GetTenant(ctx, tenantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTenantNotFound
+		}
+		return fmt.Errorf("failed to get tenant: %w", err)
+	}
+	if tenant.OrganizationID != organizationID {
+		return ErrTenantNotFound
+	}
+	return nil
+}
+
+func (u *DocumentUsecase) getDocument(ctx context.Context, tenantID, documentID uuid.UUID) (db.Document, error) {
+	doc, err := u.documentRepo.GetDocument(ctx, tenantID, documentID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.Document{}, ErrDocumentNotFound
+		}
+		return db.Document{}, fmt.Errorf("failed to get document: %w", err)
+	}
+	return doc, nil
+}
+
+func (u *DocumentUsecase) getVersion(ctx context.Context, tenantID, documentID uuid.UUID, version int32) (db.DocumentVersion, error) {
+	v, err := u.documentRepo.GetDocumentVersion(ctx, tenantID, documentID, version)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.DocumentVersion{}, ErrVersionNotFound
+		}
+		return db.DocumentVersion{}, fmt.Errorf("failed to get document version: %w", err)
+	}
+	return v, nil
+}
+
+func validateUpload(file Upload) (string, error) {
+	if len(file.Data) == 0 {
+		return "", ErrEmptyFile
+	}
+	if len(file.Data) > MaxDocumentBytes {
+		return "", ErrFileTooLarge
+	}
+	format, err := textextract.Detect(file.ContentType, file.Filename)
+	if err != nil {
+		return "", ErrUnsupportedFormat
+	}
+	return format, nil
+}
+
+// parseMetadata accepts an empty string or a JSON object
+func parseMetadata(metadata string) (json.RawMessage, error) {
+	metadata = strings.TrimSpace(metadata)
+	if metadata == "" {
+		return json.RawMessage("{}"), nil
+	}
+	if len(metadata) > maxMetadataBytes {
+		return nil, ErrInvalidMetadata
+	}
+
+	var obj map[string]any
+	if err := json.Unmarshal([]byte(metadata), &obj); err != nil || obj == nil {
+		return nil, ErrInvalidMetadata
+	}
+	return json.Marshal(obj)
+}
+
+func defaultTitle(filename string) string {
+	name := filepath.Base(filename)
+	if title := strings.TrimSpace(strings.TrimSuffix(name, filepath.Ext(name))); title != "" {
+		name = title
+	}
+	if utf8.RuneCountInString(name) > maxTitleLength {
+		name = string([]rune(name)[:maxTitleLength])
+	}
+	return name
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func nullUUIDPtr(id uuid.NullUUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	return &id.UUID
+}
+
+func nullTimePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+func versionResponse(v db.DocumentVersion) *response.DocumentVersionResponse {
+	return &response.DocumentVersionResponse{
+		Version:          int(v.Version),
+		Filename:         v.Filename,
+		ContentType:      v.ContentType,
+		Format:           v.Format,
+		SizeBytes:        v.SizeBytes,
+		Checksum:         v.Checksum,
+		ExtractionStatus: v.ExtractionStatus,
+		ExtractionError:  v.ExtractionError.String,
+		TextLength:       utf8.RuneCountInString(v.ExtractedText),
+		IndexStatus:      v.IndexStatus,
+		IndexError:       v.IndexError.String,
+		ChunkCount:       int(v.ChunkCount),
+		EmbeddingModel:   v.EmbeddingModel.String,
+		IndexedAt:        nullTimePtr(v.IndexedAt),
+		CreatedBy:        nullUUIDPtr(v.CreatedBy),
+		CreatedAt:        v.CreatedAt,
+	}
+}
+
+func toDocumentResponse(doc db.Document, version *response.DocumentVersionResponse) *response.DocumentResponse {
+	metadata := map[string]any{}
+	if len(doc.Metadata) > 0 {
+		_ = json.Unmarshal(doc.Metadata, &metadata)
+	}
+
+	return &response.DocumentResponse{
+		ID:             doc.ID,
+		TenantID:       doc.TenantID,
+		Title:          doc.Title,
+		Metadata:       metadata,
+		CurrentVersion: int(doc.CurrentVersion),
+		Version:        version,
+		CreatedAt:      doc.CreatedAt,
+		UpdatedAt:      doc.UpdatedAt,
+	}
+}
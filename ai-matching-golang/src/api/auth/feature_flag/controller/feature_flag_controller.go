@@ -0,0 +1,161 @@
+package controller
+
+import (
+	"ai-matching/src/api/auth/feature_flag/requests"
+	"ai-matching/src/api/auth/feature_flag/response"
+	"ai-matching/src/api/auth/feature_flag/usecase"
+	"context"
+	"errors"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+type FeatureFlagController struct {
+	usecase *usecase.FeatureFlagUsecase
+}
+
+func NewFeatureFlagController(flagUsecase *usecase.FeatureFlagUsecase) *FeatureFlagController {
+	return &FeatureFlagController{
+		usecase: flagUsecase,
+	}
+}
+
+type ListFlagsInput struct{}
+
+type ListFlagsOutput struct {
+	Body response.FeatureFlagListResponse
+}
+
+func (c *FeatureFlagController) ListFlags(ctx context.Context, input *ListFlagsInput) (*ListFlagsOutput, error) {
+	resp, err := c.usecase.ListFlags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListFlagsOutput{Body: *resp}, nil
+}
+
+type UpsertFlagInput struct {
+	Key  string `path:"flagKey" doc:"Flag key"`
+	Body requests.UpsertFeatureFlagRequest
+}
+
+type FlagOutput struct {
+	Body response.FeatureFlagResponse
+}
+
+func (c *FeatureFlagController) UpsertFlag(ctx context.Context, input *UpsertFlagInput) (*FlagOutput, error) {
+	resp, err := c.usecase.UpsertFlag(ctx, input.Key, input.Body)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &FlagOutput{Body: *resp}, nil
+}
+
+type DeleteFlagInput struct {
+	Key string `path:"flagKey" doc:"Flag key"`
+}
+
+type DeleteFlagOutput struct {
+	Body response.MessageResponse
+}
+
+func (c *FeatureFlagController) DeleteFlag(ctx context.Context, input *DeleteFlagInput) (*DeleteFlagOutput, error) {
+	if err := c.usecase.DeleteFlag(ctx, input.Key); err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &DeleteFlagOutput{
+		Body: response.MessageResponse{
+			Message: "Feature flag deleted successfully",
+		},
+	}, nil
+}
+
+type SetOrganizationOverrideInput struct {
+	Key            string    `path:"flagKey" doc:"Flag key"`
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	Body           requests.SetOverrideRequest
+}
+
+func (c *FeatureFlagController) SetOrganizationOverride(ctx context.Context, input *SetOrganizationOverrideInput) (*FlagOutput, error) {
+	resp, err := c.usecase.SetOrganizationOverride(ctx, input.Key, input.OrganizationID, input.Body.Enabled)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &FlagOutput{Body: *resp}, nil
+}
+
+type ClearOrganizationOverrideInput struct {
+	Key            string    `path:"flagKey" doc:"Flag key"`
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+}
+
+func (c *FeatureFlagController) ClearOrganizationOverride(ctx context.Context, input *ClearOrganizationOverrideInput) (*FlagOutput, error) {
+	resp, err := c.usecase.ClearOrganizationOverride(ctx, input.Key, input.OrganizationID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &FlagOutput{Body: *resp}, nil
+}
+
+type SetTenantOverrideInput struct {
+	Key      string    `path:"flagKey" doc:"Flag key"`
+	TenantID uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	Body     requests.SetOverrideRequest
+}
+
+func (c *FeatureFlagController) SetTenantOverride(ctx context.Context, input *SetTenantOverrideInput) (*FlagOutput, error) {
+	resp, err := c.usecase.SetTenantOverride(ctx, input.Key, input.TenantID, input.Body.Enabled)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &FlagOutput{Body: *resp}, nil
+}
+
+type ClearTenantOverrideInput struct {
+	Key      string    `path:"flagKey" doc:"Flag key"`
+	TenantID uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+}
+
+func (c *FeatureFlagController) ClearTenantOverride(ctx context.Context, input *ClearTenantOverrideInput) (*FlagOutput, error) {
+	resp, err := c.usecase.ClearTenantOverride(ctx, input.Key, input.TenantID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &FlagOutput{Body: *resp}, nil
+}
+
+type GetTenantFeaturesInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+}
+
+type GetTenantFeaturesOutput struct {
+	Body response.EvaluatedFeaturesResponse
+}
+
+func (c *FeatureFlagController) GetTenantFeatures(ctx context.Context, input *GetTenantFeaturesInput) (*GetTenantFeaturesOutput, error) {
+	resp, err := c.usecase.GetTenantFeatures(ctx, input.OrganizationID, input.TenantID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &GetTenantFeaturesOutput{Body: *resp}, nil
+}
+
+func toHTTPError(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidFlagKey):
+		return huma.Error422UnprocessableEntity(err.Error())
+	case errors.Is(err, usecase.ErrFlagNotFound), errors.Is(err, usecase.ErrOrganizationNotFound), errors.Is(err, usecase.ErrTenantNotFound):
+		return huma.Error404NotFound(err.Error())
+	}
+	return err
+}
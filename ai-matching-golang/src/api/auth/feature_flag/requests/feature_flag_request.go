@@ -0,0 +1,11 @@
+package requests
+
+type UpsertFeatureFlagRequest struct {
+	Description       string `json:"description,omitempty" doc:"What the flag gates"`
+	Enabled           bool   `json:"enabled" doc:"Global default; overrides still apply when false"`
+	RolloutPercentage int32  `json:"rolloutPercentage" default:"100" minimum:"0" maximum:"100" doc:"Share of tenants (bucketed by tenant ID) the enabled flag applies to"`
+}
+
+type SetOverrideRequest struct {
+	Enabled bool `json:"enabled" doc:"Force the flag on or off"`
+}
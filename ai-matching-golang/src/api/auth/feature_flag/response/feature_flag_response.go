@@ -0,0 +1,36 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type FeatureFlagOverrideResponse struct {
+	OrganizationID *uuid.UUID `json:"organizationId,omitempty" doc:"Organization the override applies to"`
+	TenantID       *uuid.UUID `json:"tenantId,omitempty" doc:"Tenant the override applies to"`
+	Enabled        bool       `json:"enabled" doc:"Forced value"`
+	UpdatedAt      time.Time  `json:"updatedAt" doc:"Last update timestamp"`
+}
+
+type FeatureFlagResponse struct {
+	Key               string                        `json:"key" doc:"Flag key"`
+	Description       string                        `json:"description" doc:"What the flag gates"`
+	Enabled           bool                          `json:"enabled" doc:"Global default"`
+	RolloutPercentage int32                         `json:"rolloutPercentage" doc:"Share of tenants the enabled flag applies to"`
+	Overrides         []FeatureFlagOverrideResponse `json:"overrides" doc:"Organization and tenant overrides"`
+	CreatedAt         time.Time                     `json:"createdAt" doc:"Creation timestamp"`
+	UpdatedAt         time.Time                     `json:"updatedAt" doc:"Last update timestamp"`
+}
+
+type FeatureFlagListResponse struct {
+	Flags []FeatureFlagResponse `json:"flags" doc:"List of feature flags"`
+}
+
+type EvaluatedFeaturesResponse struct {
+	Features map[string]bool `json:"features" doc:"Whether each defined flag is on for the tenant"`
+}
+
+type MessageResponse struct {
+	Message string `json:"message" doc:"Response message"`
+}
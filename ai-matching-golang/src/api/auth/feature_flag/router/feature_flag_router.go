@@ -0,0 +1,103 @@
+package router
+
+import (
+	"ai-matching/src/api/auth/feature_flag/controller"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/middleware"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterFeatureFlagRoutes(api huma.API, router fiber.Router, flagController *controller.FeatureFlagController, userRepo repository.UserRepository) {
+	adminOnly := huma.Middlewares{middleware.RequireSystemAdmin(api, userRepo)}
+
+	// System admin endpoints
+	huma.Register(api, huma.Operation{
+		OperationID: "list-feature-flags",
+		Method:      "GET",
+		Path:        "/api/v1/admin/feature-flags",
+		Summary:     "List feature flags",
+		Description: "List every feature flag with its organization and tenant overrides (system admins only)",
+		Tags:        []string{"Feature Flags"},
+		Security:    []map[string][]string{{"bearer": {}}},
+		Middlewares: adminOnly,
+	}, flagController.ListFlags)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "upsert-feature-flag",
+		Method:      "PUT",
+		Path:        "/api/v1/admin/feature-flags/{flagKey}",
+		Summary:     "Create or update feature flag",
+		Description: "Set a flag's global default and rollout percentage (system admins only)",
+		Tags:        []string{"Feature Flags"},
+		Security:    []map[string][]string{{"bearer": {}}},
+		Middlewares: adminOnly,
+	}, flagController.UpsertFlag)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-feature-flag",
+		Method:      "DELETE",
+		Path:        "/api/v1/admin/feature-flags/{flagKey}",
+		Summary:     "Delete feature flag",
+		Description: "Delete a flag and its overrides (system admins only)",
+		Tags:        []string{"Feature Flags"},
+		Security:    []map[string][]string{{"bearer": {}}},
+		Middlewares: adminOnly,
+	}, flagController.DeleteFlag)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "set-feature-flag-organization-override",
+		Method:      "PUT",
+		Path:        "/api/v1/admin/feature-flags/{flagKey}/organizations/{organizationId}",
+		Summary:     "Set organization override",
+		Description: "Force a flag on or off for an organization (system admins only)",
+		Tags:        []string{"Feature Flags"},
+		Security:    []map[string][]string{{"bearer": {}}},
+		Middlewares: adminOnly,
+	}, flagController.SetOrganizationOverride)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "clear-feature-flag-organization-override",
+		Method:      "DELETE",
+		Path:        "/api/v1/admin/feature-flags/{flagKey}/organizations/{organizationId}",
+		Summary:     "Clear organization override",
+		Description: "Remove an organization override (system admins only)",
+		Tags:        []string{"Feature Flags"},
+		Security:    []map[string][]string{{"bearer": {}}},
+		Middlewares: adminOnly,
+	}, flagController.ClearOrganizationOverride)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "set-feature-flag-tenant-override",
+		Method:      "PUT",
+		Path:        "/api/v1/admin/feature-flags/{flagKey}/tenants/{tenantId}",
+		Summary:     "Set tenant override",
+		Description: "Force a flag on or off for a tenant (system admins only)",
+		Tags:        []string{"Feature Flags"},
+		Security:    []map[string][]string{{"bearer": {}}},
+		Middlewares: adminOnly,
+	}, flagController.SetTenantOverride)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "clear-feature-flag-tenant-override",
+		Method:      "DELETE",
+		Path:        "/api/v1/admin/feature-flags/{flagKey}/tenants/{tenantId}",
+		Summary:     "Clear tenant override",
+		Description: "Remove a tenant override (system admins only)",
+		Tags:        []string{"Feature Flags"},
+		Security:    []map[string][]string{{"bearer": {}}},
+		Middlewares: adminOnly,
+	}, flagController.ClearTenantOverride)
+
+	// Evaluated flags for frontends
+	huma.Register(api, huma.Operation{
+		OperationID: "get-tenant-features",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/features",
+		Summary:     "Get tenant features",
+		Description: "Evaluate every feature flag for a tenant",
+		Tags:        []string{"Feature Flags"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, flagController.GetTenantFeatures)
+}
@@ -0,0 +1,240 @@
+package usecase
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/api/auth/feature_flag/requests"
+	"ai-matching/src/api/auth/feature_flag/response"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/featureflag"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidFlagKey       = errors.New("flag key must be 1-100 lowercase letters, digits, dots, hyphens or underscores")
+	ErrFlagNotFound         = errors.New("feature flag not found")
+	ErrOrganizationNotFound = errors.New("organization not found")
+	ErrTenantNotFound       = errors.New("tenant not found")
+)
+
+var flagKeyPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{0,99}$`)
+
+type FeatureFlagUsecase struct {
+	flagRepo   repository.FeatureFlagRepository
+	orgRepo    repository.OrganizationRepository
+	tenantRepo repository.TenantRepository
+	evaluator  *featureflag.Evaluator
+}
+
+func NewFeatureFlagUsecase(flagRepo repository.FeatureFlagRepository, orgRepo repository.OrganizationRepository, tenantRepo repository.TenantRepository, evaluator *featureflag.Evaluator) *FeatureFlagUsecase {
+	return &FeatureFlagUsecase{
+		flagRepo:   flagRepo,
+		orgRepo:    orgRepo,
+		tenantRepo: tenantRepo,
+		evaluator:  evaluator,
+	}
+}
+
+// ListFlags lists every flag with its overrides
+func (u *FeatureFlagUsecase) ListFlags(ctx context.Context) (*response.FeatureFlagListResponse, error) {
+	flags, err := u.flagRepo.ListFeatureFlags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list feature flags: %w", err)
+	}
+	overrides, err := u.flagRepo.ListFeatureFlagOverrides(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list feature flag overrides: %w", err)
+	}
+
+	byFlag := make(map[string][]db.FeatureFlagOverride)
+	for _, o := range overrides {
+		byFlag[o.FlagKey] = append(byFlag[o.FlagKey], o)
+	}
+
+	items := make([]response.FeatureFlagResponse, len(flags))
+	for i, flag := range flags {
+		items[i] = toFlagResponse(flag, byFlag[flag.Key])
+	}
+	return &response.FeatureFlagListResponse{Flags: items}, nil
+}
+
+// UpsertFlag creates or updates a flag's global default and rollout
+func (u *FeatureFlagUsecase) UpsertFlag(ctx context.Context, key string, req requests.UpsertFeatureFlagRequest) (*response.FeatureFlagResponse, error) {
+	if !flagKeyPattern.MatchString(key) {
+		return nil, ErrInvalidFlagKey
+	}
+
+	flag, err := u.flagRepo.UpsertFeatureFlag(ctx, db.UpsertFeatureFlagParams{
+		Key:               key,
+		Description:       sql.NullString{String: req.Description, Valid: req.Description != ""},
+		Enabled:           req.Enabled,
+		RolloutPercentage: req.RolloutPercentage,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save feature flag: %w", err)
+	}
+	u.evaluator.Invalidate()
+
+	return u.getFlag(ctx, flag)
+}
+
+// DeleteFlag removes a flag and its overrides
+func (u *FeatureFlagUsecase) DeleteFlag(ctx context.Context, key string) error {
+	if _, err := u.findFlag(ctx, key); err != nil {
+		return err
+	}
+	if err := u.flagRepo.DeleteFeatureFlag(ctx, key); err != nil {
+		return fmt.Errorf("failed to delete feature flag: %w", err)
+	}
+	u.evaluator.Invalidate()
+	return nil
+}
+
+// SetOrganizationOverride forces a flag on or off for an organization
+func (u *FeatureFlagUsecase) SetOrganizationOverride(ctx context.Context, key string, organizationID uuid.UUID, enabled bool) (*response.FeatureFlagResponse, error) {
+	flag, err := u.findFlag(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.orgRepo.GetOrganization(ctx, organizationID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOrganizationNotFound
+		}
+		return nil, fmt.Errorf("failed to get organization: %w", err)
+	}
+
+	if _, err := u.flagRepo.UpsertOrganizationOverride(ctx, key, organizationID, enabled); err != nil {
+		return nil, fmt.Errorf("failed to save override: %w", err)
+	}
+	u.evaluator.Invalidate()
+
+	return u.getFlag(ctx, flag)
+}
+
+// ClearOrganizationOverride removes an organization override
+func (u *FeatureFlagUsecase) ClearOrganizationOverride(ctx context.Context, key string, organizationID uuid.UUID) (*response.FeatureFlagResponse, error) {
+	flag, err := u.findFlag(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.flagRepo.DeleteOrganizationOverride(ctx, key, organizationID); err != nil {
+		return nil, fmt.Errorf("failed to delete override: %w", err)
+	}
+	u.evaluator.Invalidate()
+
+	return u.getFlag(ctx, flag)
+}
+
+// SetTenantOverride forces a flag on or off for a tenant
+func (u *FeatureFlagUsecase) SetTenantOverride(ctx context.Context, key string, tenantID uuid.UUID, enabled bool) (*response.FeatureFlagResponse, error) {
+	flag, err := u.findFlag(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.tenantRepo.GetTenant(ctx, tenantID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTenantNotFound
+		}
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	if _, err := u.flagRepo.UpsertTenantOverride(ctx, key, tenantID, enabled); err != nil {
+		return nil, fmt.Errorf("failed to save override: %w", err)
+	}
+	u.evaluator.Invalidate()
+
+	return u.getFlag(ctx, flag)
+}
+
+// ClearTenantOverride removes a tenant override
+func (u *FeatureFlagUsecase) ClearTenantOverride(ctx context.Context, key string, tenantID uuid.UUID) (*response.FeatureFlagResponse, error) {
+	flag, err := u.findFlag(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.flagRepo.DeleteTenantOverride(ctx, key, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to delete override: %w", err)
+	}
+	u.evaluator.Invalidate()
+
+	return u.getFlag(ctx, flag)
+}
+
+// GetTenantFeatures evaluates every flag for a tenant so frontends can hide disabled features
+func (u *FeatureFlagUsecase) GetTenantFeatures(ctx context.Context, organizationID, tenantID uuid.UUID) (*response.EvaluatedFeaturesResponse, error) {
+	tenant, err := u.tenantRepo.GetTenant(ctx, tenantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTenantNotFound
+		}
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+	if tenant.OrganizationID != organizationID {
+		return nil, ErrTenantNotFound
+	}
+
+	features, err := u.evaluator.All(ctx, organizationID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return &response.EvaluatedFeaturesResponse{Features: features}, nil
+}
+
+func (u *FeatureFlagUsecase) findFlag(ctx context.Context, key string) (db.FeatureFlag, error) {
+	flag, err := u.flagRepo.GetFeatureFlag(ctx, key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.FeatureFlag{}, ErrFlagNotFound
+		}
+		return db.FeatureFlag{}, fmt.Errorf("failed to get feature flag: %w", err)
+	}
+	return flag, nil
+}
+
+func (u *FeatureFlagUsecase) getFlag(ctx context.Context, flag db.FeatureFlag) (*response.FeatureFlagResponse, error) {
+	overrides, err := u.flagRepo.ListFeatureFlagOverrides(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list feature flag overrides: %w", err)
+	}
+
+	var own []db.FeatureFlagOverride
+	for _, o := range overrides {
+		if o.FlagKey == flag.Key {
+			own = append(own, o)
+		}
+	}
+	resp := toFlagResponse(flag, own)
+	return &resp, nil
+}
+
+func toFlagResponse(flag db.FeatureFlag, overrides []db.FeatureFlagOverride) response.FeatureFlagResponse {
+	items := make([]response.FeatureFlagOverrideResponse, len(overrides))
+	for i, o := range overrides {
+		item := response.FeatureFlagOverrideResponse{
+			Enabled:   o.Enabled,
+			UpdatedAt: o.UpdatedAt,
+		}
+		if o.OrganizationID.Valid {
+			item.OrganizationID = &o.OrganizationID.UUID
+		}
+		if o.TenantID.Valid {
+			item.TenantID = &o.TenantID.UUID
+		}
+		items[i] = item
+	}
+
+	return response.FeatureFlagResponse{
+		Key:               flag.Key,
+		Description:       flag.Description.String,
+		Enabled:           flag.Enabled,
+		RolloutPercentage: flag.RolloutPercentage,
+		Overrides:         items,
+		CreatedAt:         flag.CreatedAt,
+		UpdatedAt:         flag.UpdatedAt,
+	}
+}
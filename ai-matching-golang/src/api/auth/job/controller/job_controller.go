@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"ai-matching/src/api/auth/job/response"
+	"ai-matching/src/api/auth/job/usecase"
+	"ai-matching/src/infrastructure/middleware"
+	"context"
+	"errors"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+type JobController struct {
+	usecase *usecase.JobUsecase
+}
+
+func NewJobController(jobUsecase *usecase.JobUsecase) *JobController {
+	return &JobController{
+		usecase: jobUsecase,
+	}
+}
+
+type ListJobsInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	Status         string    `query:"status" enum:"queued,running,succeeded,dead" doc:"Only jobs with this status"`
+	Kind           string    `query:"kind" maxLength:"100" doc:"Only jobs of this kind"`
+	Page           int       `query:"page" minimum:"1" default:"1" doc:"Page number"`
+	PageSize       int       `query:"pageSize" minimum:"1" maximum:"100" default:"20" doc:"Jobs per page"`
+}
+
+type ListJobsOutput struct {
+	Body response.JobListResponse
+}
+
+func (c *JobController) ListJobs(ctx context.Context, input *ListJobsInput) (*ListJobsOutput, error) {
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.ListJobs(ctx, input.OrganizationID, actorID, input.Status, input.Kind, input.Page, input.PageSize)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &ListJobsOutput{Body: *resp}, nil
+}
+
+type JobInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	JobID          uuid.UUID `path:"jobId" doc:"Job ID"`
+}
+
+type JobOutput struct {
+	Body response.JobResponse
+}
+
+func (c *JobController) GetJob(ctx context.Context, input *JobInput) (*JobOutput, error) {
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.GetJob(ctx, input.OrganizationID, actorID, input.JobID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &JobOutput{Body: *resp}, nil
+}
+
+func (c *JobController) RetryJob(ctx context.Context, input *JobInput) (*JobOutput, error) {
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.RetryJob(ctx, input.OrganizationID, actorID, input.JobID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &JobOutput{Body: *resp}, nil
+}
+
+func currentUserID(ctx context.Context) (uuid.UUID, error) {
+	userCtx, err := middleware.GetUserFromContext(ctx)
+	if err != nil {
+		return uuid.Nil, huma.Error401Unauthorized("Authentication required")
+	}
+	return userCtx.UserID, nil
+}
+
+func toHTTPError(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrOrganizationNotFound), errors.Is(err, usecase.ErrJobNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, usecase.ErrForbidden):
+		return huma.Error403Forbidden(err.Error())
+	case errors.Is(err, usecase.ErrJobNotDead):
+		return huma.Error409Conflict(err.Error())
+	}
+	return err
+}
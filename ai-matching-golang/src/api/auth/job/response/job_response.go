@@ -0,0 +1,29 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type JobResponse struct {
+	ID          uuid.UUID      `json:"id" doc:"Job ID"`
+	Kind        string         `json:"kind" doc:"What the job does, such as document.index or data_export.build"`
+	Payload     map[string]any `json:"payload" doc:"Job arguments, such as the ID of the document version to index"`
+	Status      string         `json:"status" doc:"queued, running, succeeded or dead; a queued job with attempts is waiting to be retried"`
+	Attempts    int            `json:"attempts" doc:"Attempts made so far"`
+	MaxAttempts int            `json:"maxAttempts" doc:"Attempts allowed before the job is dead"`
+	RunAt       time.Time      `json:"runAt" doc:"When the job runs next, or last became due"`
+	LastError   string         `json:"lastError,omitempty" doc:"Error of the latest failed attempt"`
+	TenantID    *uuid.UUID     `json:"tenantId,omitempty" doc:"Tenant the job works on"`
+	CreatedAt   time.Time      `json:"createdAt" doc:"Creation timestamp"`
+	UpdatedAt   time.Time      `json:"updatedAt" doc:"Last update timestamp"`
+	FinishedAt  *time.Time     `json:"finishedAt,omitempty" doc:"When the job succeeded or died"`
+}
+
+type JobListResponse struct {
+	Jobs     []JobResponse `json:"jobs" doc:"Jobs, newest first"`
+	Total    int           `json:"total" doc:"Total number of matching jobs"`
+	Page     int           `json:"page" doc:"Page number"`
+	PageSize int           `json:"pageSize" doc:"Page size"`
+}
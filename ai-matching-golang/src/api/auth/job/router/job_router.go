@@ -0,0 +1,41 @@
+package router
+
+import (
+	"ai-matching/src/api/auth/job/controller"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterJobRoutes(api huma.API, router fiber.Router, jobController *controller.JobController) {
+	// Background job endpoints
+	huma.Register(api, huma.Operation{
+		OperationID: "list-jobs",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/jobs",
+		Summary:     "List background jobs",
+		Description: "List the background jobs of an organization, such as document indexing, user imports and data exports (owners and admins only)",
+		Tags:        []string{"Jobs"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, jobController.ListJobs)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-job",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/jobs/{jobId}",
+		Summary:     "Get background job",
+		Description: "Get the status, attempts and latest error of a background job",
+		Tags:        []string{"Jobs"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, jobController.GetJob)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "retry-job",
+		Method:      "POST",
+		Path:        "/api/v1/organizations/{organizationId}/jobs/{jobId}/retry",
+		Summary:     "Retry dead job",
+		Description: "Queue a job that ran out of attempts again with a fresh set of attempts",
+		Tags:        []string{"Jobs"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, jobController.RetryJob)
+}
@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/api/auth/job/response"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+var (
+	ErrOrganizationNotFound = errors.New("organization not found")
+	ErrJobNotFound          = errors.New("job not found")
+	ErrJobNotDead           = errors.New("only dead jobs can be retried")
+	ErrForbidden            = errors.New("only organization owners and admins can manage jobs")
+)
+
+type JobUsecase struct {
+	jobRepo    repository.JobRepository
+	orgRepo    repository.OrganizationRepository
+	memberRepo repository.OrganizationMemberRepository
+}
+
+func NewJobUsecase(jobRepo repository.JobRepository, orgRepo repository.OrganizationRepository, memberRepo repository.OrganizationMemberRepository) *JobUsecase {
+	return &JobUsecase{
+		jobRepo:    jobRepo,
+		orgRepo:    orgRepo,
+		memberRepo: memberRepo,
+	}
+}
+
+// ListJobs returns a page of the organization's jobs, optionally narrowed
+// to one status or kind
+func (u *JobUsecase) ListJobs(ctx context.Context, organizationID, actorID uuid.UUID, status, kind string, page, pageSize int) (*response.JobListResponse, error) {
+	if err := u.authorize(ctx, organizationID, actorID); err != nil {
+		return nil, err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	statusFilter := sql.NullString{String: status, Valid: status != ""}
+	kindFilter := sql.NullString{String: kind, Valid: kind != ""}
+
+	rows, err := u.jobRepo.ListOrganizationJobs(ctx, db.ListOrganizationJobsParams{
+		OrganizationID: organizationID,
+		Status:         statusFilter,
+		Kind:           kindFilter,
+		RowOffset:      int32((page - 1) * pageSize),
+		RowLimit:       int32(pageSize),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	total, err := u.jobRepo.CountOrganizationJobs(ctx, db.CountOrganizationJobsParams{
+		OrganizationID: organizationID,
+		Status:         statusFilter,
+		Kind:           kindFilter,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count jobs: %w", err)
+	}
+
+	resp := &response.JobListResponse{
+		Jobs:     make([]response.JobResponse, 0, len(rows)),
+		Total:    int(total),
+		Page:     page,
+		PageSize: pageSize,
+	}
+	for _, job := range rows {
+		resp.Jobs = append(resp.Jobs, toJobResponse(job))
+	}
+	return resp, nil
+}
+
+// GetJob returns the status of one job
+func (u *JobUsecase) GetJob(ctx context.Context, organizationID, actorID, jobID uuid.UUID) (*response.JobResponse, error) {
+	if err := u.authorize(ctx, organizationID, actorID); err != nil {
+		return nil, err
+	}
+
+	job, err := u.jobRepo.GetOrganizationJob(ctx, organizationID, jobID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrJobNotFound
+		}
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+
+	resp := toJobResponse(job)
+	return &resp, nil
+}
+
+// RetryJob queues a dead job again with a fresh set of attempts
+func (u *JobUsecase) RetryJob(ctx context.Context, organizationID, actorID, jobID uuid.UUID) (*response.JobResponse, error) {
+	if err := u.authorize(ctx, organizationID, actorID); err != nil {
+		return nil, err
+	}
+
+	job, err := u.jobRepo.RequeueJob(ctx, organizationID, jobID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed to requeue job: %w", err)
+		}
+		// Tell a missing job apart from one that is not dead
+		if _, err := u.jobRepo.GetOrganizationJob(ctx, organizationID, jobID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, ErrJobNotFound
+			}
+			return nil, fmt.Errorf("failed to get job: %w", err)
+		}
+		return nil, ErrJobNotDead
+	}
+
+	resp := toJobResponse(job)
+	return &resp, nil
+}
+
+func (u *JobUsecase) authorize(ctx context.Context, organizationID, actorID uuid.UUID) error {
+	if _, err := u.orgRepo.GetOrganization(ctx, organizationID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOrganizationNotFound
+		}
+		return fmt.Errorf("failed to get organization: %w", err)
+	}
+
+	actor, err := u.memberRepo.GetOrganizationMember(ctx, organizationID, actorID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrForbidden
+		}
+		return fmt.Errorf("failed to get organization member: %w", err)
+	}
+	if actor.Role != repository.OrganizationRoleOwner && actor.Role != repository.OrganizationRoleAdmin {
+		return ErrForbidden
+	}
+	return nil
+}
+
+func toJobResponse(job db.Job) response.JobResponse {
+	resp := response.JobResponse{
+		ID:          job.ID,
+		Kind:        job.Kind,
+		Payload:     map[string]any{},
+		Status:      job.Status,
+		Attempts:    int(job.Attempts),
+		MaxAttempts: int(job.MaxAttempts),
+		RunAt:       job.RunAt,
+		LastError:   job.LastError.String,
+		CreatedAt:   job.CreatedAt,
+		UpdatedAt:   job.UpdatedAt,
+	}
+	_ = json.Unmarshal(job.Payload, &resp.Payload)
+	if job.TenantID.Valid {
+		resp.TenantID = &job.TenantID.UUID
+	}
+	if job.FinishedAt.Valid {
+		resp.FinishedAt = &job.FinishedAt.Time
+	}
+	return resp
+}
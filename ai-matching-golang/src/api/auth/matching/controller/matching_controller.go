@@ -0,0 +1,262 @@
+package controller
+
+import (
+	"ai-matching/src/api/auth/matching/requests"
+	"ai-matching/src/api/auth/matching/response"
+	"ai-matching/src/api/auth/matching/usecase"
+	"ai-matching/src/infrastructure/middleware"
+	"context"
+	"errors"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+type MatchingController struct {
+	usecase *usecase.MatchingUsecase
+}
+
+func NewMatchingController(matchingUsecase *usecase.MatchingUsecase) *MatchingController {
+	return &MatchingController{
+		usecase: matchingUsecase,
+	}
+}
+
+type CreateCandidateInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	Body           requests.CandidateRequest
+}
+
+type CandidateOutput struct {
+	Body response.CandidateResponse
+}
+
+func (c *MatchingController) CreateCandidate(ctx context.Context, input *CreateCandidateInput) (*CandidateOutput, error) {
+	userCtx, err := middleware.RequireTenant(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.CreateCandidate(ctx, input.OrganizationID, input.TenantID, userCtx.UserID, &input.Body)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &CandidateOutput{Body: *resp}, nil
+}
+
+type ListInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	Page           int       `query:"page" minimum:"1" default:"1" doc:"Page number"`
+	PageSize       int       `query:"pageSize" minimum:"1" maximum:"100" default:"20" doc:"Items per page"`
+}
+
+type ListCandidatesOutput struct {
+	Body response.CandidateListResponse
+}
+
+func (c *MatchingController) ListCandidates(ctx context.Context, input *ListInput) (*ListCandidatesOutput, error) {
+	if _, err := middleware.RequireTenant(ctx, input.TenantID); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.ListCandidates(ctx, input.OrganizationID, input.TenantID, input.Page, input.PageSize)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &ListCandidatesOutput{Body: *resp}, nil
+}
+
+type CandidateInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	CandidateID    uuid.UUID `path:"candidateId" doc:"Candidate ID"`
+}
+
+func (c *MatchingController) GetCandidate(ctx context.Context, input *CandidateInput) (*CandidateOutput, error) {
+	if _, err := middleware.RequireTenant(ctx, input.TenantID); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.GetCandidate(ctx, input.OrganizationID, input.TenantID, input.CandidateID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &CandidateOutput{Body: *resp}, nil
+}
+
+type UpdateCandidateInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	CandidateID    uuid.UUID `path:"candidateId" doc:"Candidate ID"`
+	Body           requests.CandidateRequest
+}
+
+func (c *MatchingController) UpdateCandidate(ctx context.Context, input *UpdateCandidateInput) (*CandidateOutput, error) {
+	if _, err := middleware.RequireTenant(ctx, input.TenantID); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.UpdateCandidate(ctx, input.OrganizationID, input.TenantID, input.CandidateID, &input.Body)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &CandidateOutput{Body: *resp}, nil
+}
+
+type DeleteOutput struct {
+	Body struct {
+		Message string `json:"message" doc:"Result message"`
+	}
+}
+
+func (c *MatchingController) DeleteCandidate(ctx context.Context, input *CandidateInput) (*DeleteOutput, error) {
+	if _, err := middleware.RequireTenant(ctx, input.TenantID); err != nil {
+		return nil, err
+	}
+
+	if err := c.usecase.DeleteCandidate(ctx, input.OrganizationID, input.TenantID, input.CandidateID); err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	out := &DeleteOutput{}
+	out.Body.Message = "Candidate deleted successfully"
+	return out, nil
+}
+
+type CreateOpeningInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	Body           requests.OpeningRequest
+}
+
+type OpeningOutput struct {
+	Body response.OpeningResponse
+}
+
+func (c *MatchingController) CreateOpening(ctx context.Context, input *CreateOpeningInput) (*OpeningOutput, error) {
+	userCtx, err := middleware.RequireTenant(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.CreateOpening(ctx, input.OrganizationID, input.TenantID, userCtx.UserID, &input.Body)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &OpeningOutput{Body: *resp}, nil
+}
+
+type ListOpeningsOutput struct {
+	Body response.OpeningListResponse
+}
+
+func (c *MatchingController) ListOpenings(ctx context.Context, input *ListInput) (*ListOpeningsOutput, error) {
+	if _, err := middleware.RequireTenant(ctx, input.TenantID); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.ListOpenings(ctx, input.OrganizationID, input.TenantID, input.Page, input.PageSize)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &ListOpeningsOutput{Body: *resp}, nil
+}
+
+type OpeningInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	OpeningID      uuid.UUID `path:"openingId" doc:"Opening ID"`
+}
+
+func (c *MatchingController) GetOpening(ctx context.Context, input *OpeningInput) (*OpeningOutput, error) {
+	if _, err := middleware.RequireTenant(ctx, input.TenantID); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.GetOpening(ctx, input.OrganizationID, input.TenantID, input.OpeningID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &OpeningOutput{Body: *resp}, nil
+}
+
+type UpdateOpeningInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	OpeningID      uuid.UUID `path:"openingId" doc:"Opening ID"`
+	Body           requests.OpeningRequest
+}
+
+func (c *MatchingController) UpdateOpening(ctx context.Context, input *UpdateOpeningInput) (*OpeningOutput, error) {
+	if _, err := middleware.RequireTenant(ctx, input.TenantID); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.UpdateOpening(ctx, input.OrganizationID, input.TenantID, input.OpeningID, &input.Body)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &OpeningOutput{Body: *resp}, nil
+}
+
+func (c *MatchingController) DeleteOpening(ctx context.Context, input *OpeningInput) (*DeleteOutput, error) {
+	if _, err := middleware.RequireTenant(ctx, input.TenantID); err != nil {
+		return nil, err
+	}
+
+	if err := c.usecase.DeleteOpening(ctx, input.OrganizationID, input.TenantID, input.OpeningID); err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	out := &DeleteOutput{}
+	out.Body.Message = "Opening deleted successfully"
+	return out, nil
+}
+
+type MatchInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	Body           requests.MatchRequest
+}
+
+type MatchOutput struct {
+	Body response.MatchListResponse
+}
+
+func (c *MatchingController) Match(ctx context.Context, input *MatchInput) (*MatchOutput, error) {
+	if _, err := middleware.RequireTenant(ctx, input.TenantID); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.Match(ctx, input.OrganizationID, input.TenantID, &input.Body)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &MatchOutput{Body: *resp}, nil
+}
+
+func toHTTPError(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrTenantNotFound),
+		errors.Is(err, usecase.ErrCandidateNotFound),
+		errors.Is(err, usecase.ErrOpeningNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, usecase.ErrInvalidName),
+		errors.Is(err, usecase.ErrInvalidTitle),
+		errors.Is(err, usecase.ErrInvalidRule),
+		errors.Is(err, usecase.ErrInvalidMatchTarget):
+		return huma.Error400BadRequest(err.Error())
+	}
+	return err
+}
@@ -0,0 +1,35 @@
+package requests
+
+import "github.com/google/uuid"
+
+type CandidateRequest struct {
+	Name        string         `json:"name" required:"true" minLength:"1" maxLength:"255" doc:"Candidate name"`
+	Attributes  map[string]any `json:"attributes,omitempty" doc:"Structured attributes that opening requirements are checked against"`
+	Description string         `json:"description,omitempty" maxLength:"20000" doc:"Free text compared with opening descriptions by meaning"`
+}
+
+type RuleRequest struct {
+	Attribute string  `json:"attribute" required:"true" minLength:"1" maxLength:"100" doc:"Candidate attribute to check; dots reach into nested objects, as in license.type"`
+	Operator  string  `json:"operator" required:"true" enum:"eq,neq,in,contains,gte,lte,exists" doc:"eq/neq compare with value, in checks membership of a list, contains matches a substring or list element, gte/lte compare numbers, exists checks the attribute is set"`
+	Value     any     `json:"value,omitempty" doc:"Value to compare with; a list for in, nothing for exists"`
+	Weight    float64 `json:"weight,omitempty" minimum:"0" maximum:"100" default:"1" doc:"How much the rule counts in the score"`
+	Required  bool    `json:"required,omitempty" doc:"Exclude candidates that fail the rule"`
+}
+
+type OpeningRequest struct {
+	Title        string         `json:"title" required:"true" minLength:"1" maxLength:"255" doc:"Opening title"`
+	Attributes   map[string]any `json:"attributes,omitempty" doc:"Structured attributes of the opening"`
+	Description  string         `json:"description,omitempty" maxLength:"20000" doc:"Free text compared with candidate descriptions by meaning"`
+	Requirements []RuleRequest  `json:"requirements,omitempty" maxItems:"50" doc:"Weighted rules candidates are scored by"`
+	Status       string         `json:"status,omitempty" enum:"open,closed" default:"open" doc:"Closed openings are left out when matching a candidate"`
+}
+
+type MatchRequest struct {
+	OpeningID         *uuid.UUID  `json:"openingId,omitempty" doc:"Rank candidates for this opening"`
+	CandidateID       *uuid.UUID  `json:"candidateId,omitempty" doc:"Rank open openings for this candidate"`
+	IDs               []uuid.UUID `json:"ids,omitempty" maxItems:"500" doc:"Only score these candidates or openings"`
+	Limit             int         `json:"limit,omitempty" minimum:"1" maximum:"100" default:"20" doc:"Number of matches to return"`
+	MinScore          float64     `json:"minScore,omitempty" minimum:"0" maximum:"1" doc:"Drop matches scoring below this"`
+	SimilarityWeight  *float64    `json:"similarityWeight,omitempty" minimum:"0" maximum:"100" doc:"Weight of description similarity; defaults to the matching.similarityWeight setting"`
+	IncludeIneligible bool        `json:"includeIneligible,omitempty" doc:"Also return matches that fail a required rule, ranked after the eligible ones"`
+}
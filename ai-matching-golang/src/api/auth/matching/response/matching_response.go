@@ -0,0 +1,82 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CandidateResponse struct {
+	ID             uuid.UUID      `json:"id" doc:"Candidate ID"`
+	Name           string         `json:"name" doc:"Candidate name"`
+	Attributes     map[string]any `json:"attributes" doc:"Structured attributes"`
+	Description    string         `json:"description" doc:"Free text description"`
+	EmbeddingModel string         `json:"embeddingModel,omitempty" doc:"Model the description was embedded with"`
+	CreatedBy      *uuid.UUID     `json:"createdBy,omitempty" doc:"User who created the candidate"`
+	CreatedAt      time.Time      `json:"createdAt" doc:"Creation time"`
+	UpdatedAt      time.Time      `json:"updatedAt" doc:"Last update time"`
+}
+
+type CandidateListResponse struct {
+	Candidates []CandidateResponse `json:"candidates" doc:"Candidates, newest first"`
+	Total      int                 `json:"total" doc:"Total number of candidates"`
+	Page       int                 `json:"page" doc:"Page number"`
+	PageSize   int                 `json:"pageSize" doc:"Page size"`
+}
+
+type RuleResponse struct {
+	Attribute string  `json:"attribute" doc:"Candidate attribute checked"`
+	Operator  string  `json:"operator" doc:"Comparison"`
+	Value     any     `json:"value,omitempty" doc:"Value compared with"`
+	Weight    float64 `json:"weight" doc:"How much the rule counts in the score"`
+	Required  bool    `json:"required" doc:"Whether failing the rule excludes a candidate"`
+}
+
+type OpeningResponse struct {
+	ID             uuid.UUID      `json:"id" doc:"Opening ID"`
+	Title          string         `json:"title" doc:"Opening title"`
+	Attributes     map[string]any `json:"attributes" doc:"Structured attributes"`
+	Description    string         `json:"description" doc:"Free text description"`
+	Requirements   []RuleResponse `json:"requirements" doc:"Weighted rules candidates are scored by"`
+	Status         string         `json:"status" doc:"open or closed"`
+	EmbeddingModel string         `json:"embeddingModel,omitempty" doc:"Model the description was embedded with"`
+	CreatedBy      *uuid.UUID     `json:"createdBy,omitempty" doc:"User who created the opening"`
+	CreatedAt      time.Time      `json:"createdAt" doc:"Creation time"`
+	UpdatedAt      time.Time      `json:"updatedAt" doc:"Last update time"`
+}
+
+type OpeningListResponse struct {
+	Openings []OpeningResponse `json:"openings" doc:"Openings, newest first"`
+	Total    int               `json:"total" doc:"Total number of openings"`
+	Page     int               `json:"page" doc:"Page number"`
+	PageSize int               `json:"pageSize" doc:"Page size"`
+}
+
+type MatchFactorResponse struct {
+	Kind         string  `json:"kind" enum:"rule,similarity" doc:"Rule requirement or description similarity"`
+	Attribute    string  `json:"attribute,omitempty" doc:"Attribute a rule checked"`
+	Operator     string  `json:"operator,omitempty" doc:"Comparison of a rule"`
+	Expected     any     `json:"expected,omitempty" doc:"Value a rule compared with"`
+	Actual       any     `json:"actual,omitempty" doc:"Candidate attribute value a rule saw"`
+	Required     bool    `json:"required,omitempty" doc:"Whether the rule is required"`
+	Score        float64 `json:"score" doc:"1 or 0 for a rule, cosine similarity for similarity"`
+	Weight       float64 `json:"weight" doc:"Weight of the factor"`
+	Contribution float64 `json:"contribution" doc:"Share of the total score from this factor"`
+}
+
+type MatchResultResponse struct {
+	CandidateID   uuid.UUID             `json:"candidateId" doc:"Candidate ID"`
+	CandidateName string                `json:"candidateName" doc:"Candidate name"`
+	OpeningID     uuid.UUID             `json:"openingId" doc:"Opening ID"`
+	OpeningTitle  string                `json:"openingTitle" doc:"Opening title"`
+	Score         float64               `json:"score" doc:"Weighted score between 0 and 1"`
+	Eligible      bool                  `json:"eligible" doc:"False when a required rule failed"`
+	Factors       []MatchFactorResponse `json:"factors" doc:"Components of the score"`
+}
+
+type MatchListResponse struct {
+	Target           string                `json:"target" enum:"candidates,openings" doc:"What was ranked"`
+	Model            string                `json:"model" doc:"Embedding model descriptions were compared with"`
+	SimilarityWeight float64               `json:"similarityWeight" doc:"Weight of description similarity"`
+	Matches          []MatchResultResponse `json:"matches" doc:"Matches, best first"`
+}
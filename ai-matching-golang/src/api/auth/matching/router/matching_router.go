@@ -0,0 +1,121 @@
+package router
+
+import (
+	"ai-matching/src/api/auth/matching/controller"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterMatchingRoutes(api huma.API, router fiber.Router, matchingController *controller.MatchingController) {
+	// Tenant matching endpoints
+	huma.Register(api, huma.Operation{
+		OperationID: "create-match-candidate",
+		Method:      "POST",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/candidates",
+		Summary:     "Create candidate",
+		Description: "Add a candidate with structured attributes and a free text description to match against openings",
+		Tags:        []string{"Matching"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, matchingController.CreateCandidate)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-match-candidates",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/candidates",
+		Summary:     "List candidates",
+		Description: "List the candidates of a tenant, newest first",
+		Tags:        []string{"Matching"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, matchingController.ListCandidates)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-match-candidate",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/candidates/{candidateId}",
+		Summary:     "Get candidate",
+		Description: "Get a candidate",
+		Tags:        []string{"Matching"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, matchingController.GetCandidate)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-match-candidate",
+		Method:      "PUT",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/candidates/{candidateId}",
+		Summary:     "Update candidate",
+		Description: "Replace the name, attributes and description of a candidate",
+		Tags:        []string{"Matching"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, matchingController.UpdateCandidate)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-match-candidate",
+		Method:      "DELETE",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/candidates/{candidateId}",
+		Summary:     "Delete candidate",
+		Description: "Delete a candidate",
+		Tags:        []string{"Matching"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, matchingController.DeleteCandidate)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "create-match-opening",
+		Method:      "POST",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/openings",
+		Summary:     "Create opening",
+		Description: "Add an opening with weighted requirements on candidate attributes and a free text description",
+		Tags:        []string{"Matching"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, matchingController.CreateOpening)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-match-openings",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/openings",
+		Summary:     "List openings",
+		Description: "List the openings of a tenant, newest first",
+		Tags:        []string{"Matching"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, matchingController.ListOpenings)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-match-opening",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/openings/{openingId}",
+		Summary:     "Get opening",
+		Description: "Get an opening with its requirements",
+		Tags:        []string{"Matching"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, matchingController.GetOpening)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-match-opening",
+		Method:      "PUT",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/openings/{openingId}",
+		Summary:     "Update opening",
+		Description: "Replace the title, attributes, description, requirements and status of an opening",
+		Tags:        []string{"Matching"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, matchingController.UpdateOpening)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-match-opening",
+		Method:      "DELETE",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/openings/{openingId}",
+		Summary:     "Delete opening",
+		Description: "Delete an opening",
+		Tags:        []string{"Matching"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, matchingController.DeleteOpening)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "find-matches",
+		Method:      "POST",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/matches",
+		Summary:     "Find matches",
+		Description: "Rank the candidates for an opening, or the open openings for a candidate, by the opening's weighted requirements plus the similarity of their descriptions, with each factor's share of the score",
+		Tags:        []string{"Matching"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, matchingController.Match)
+}
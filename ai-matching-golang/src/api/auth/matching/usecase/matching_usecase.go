@@ -0,0 +1,771 @@
+package usecase
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/api/auth/matching/requests"
+	"ai-matching/src/api/auth/matching/response"
+	"ai-matching/src/domain/interface/external"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/matching"
+	"ai-matching/src/infrastructure/settings"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultPageSize   = 20
+	maxPageSize       = 100
+	defaultMatchLimit = 20
+
+	// scanPageSize is how many rows are scored per query
+	scanPageSize = 500
+
+	statusOpen = "open"
+
+	TargetCandidates = "candidates"
+	TargetOpenings   = "openings"
+)
+
+var (
+	ErrTenantNotFound     = errors.New("tenant not found")
+	ErrCandidateNotFound  = errors.New("candidate not found")
+	ErrOpeningNotFound    = errors.New("opening not found")
+	ErrInvalidName        = errors.New("name must not be blank")
+	ErrInvalidTitle       = errors.New("title must not be blank")
+	ErrInvalidRule        = errors.New("invalid requirement")
+	ErrInvalidMatchTarget = errors.New("exactly one of openingId and candidateId is required")
+)
+
+type MatchingUsecase struct {
+	matchRepo  repository.MatchRepository
+	tenantRepo repository.TenantRepository
+	embedder   external.EmbeddingProvider
+	settings   *settings.Resolver
+}
+
+func NewMatchingUsecase(matchRepo repository.MatchRepository, tenantRepo repository.TenantRepository, embedder external.EmbeddingProvider, settingsResolver *settings.Resolver) *MatchingUsecase {
+	return &MatchingUsecase{
+		matchRepo:  matchRepo,
+		tenantRepo: tenantRepo,
+		embedder:   embedder,
+		settings:   settingsResolver,
+	}
+}
+
+// CreateCandidate stores a candidate with the embedding of its description
+func (u *MatchingUsecase) CreateCandidate(ctx context.Context, organizationID, tenantID, userID uuid.UUID, req *requests.CandidateRequest) (*response.CandidateResponse, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, ErrInvalidName
+	}
+	attributes, err := encodeAttributes(req.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	embedding, model := u.embed(ctx, candidateText(req.Description))
+
+	candidate, err := u.matchRepo.CreateCandidate(ctx, db.CreateMatchCandidateParams{
+		TenantID:       tenantID,
+		Name:           name,
+		Attributes:     attributes,
+		Description:    req.Description,
+		Embedding:      embedding,
+		EmbeddingModel: model,
+		CreatedBy:      uuid.NullUUID{UUID: userID, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create candidate: %w", err)
+	}
+	return toCandidateResponse(candidate), nil
+}
+
+// GetCandidate returns a candidate of the tenant
+func (u *MatchingUsecase) GetCandidate(ctx context.Context, organizationID, tenantID, candidateID uuid.UUID) (*response.CandidateResponse, error) {
+	candidate, err := u.getCandidate(ctx, organizationID, tenantID, candidateID)
+	if err != nil {
+		return nil, err
+	}
+	return toCandidateResponse(candidate), nil
+}
+
+// ListCandidates returns the tenant's candidates, newest first
+func (u *MatchingUsecase) ListCandidates(ctx context.Context, organizationID, tenantID uuid.UUID, page, pageSize int) (*response.CandidateListResponse, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	page, pageSize = normalizePage(page, pageSize)
+	candidates, err := u.matchRepo.ListCandidates(ctx, tenantID, int32(pageSize), int32((page-1)*pageSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list candidates: %w", err)
+	}
+
+	total, err := u.matchRepo.CountCandidates(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count candidates: %w", err)
+	}
+
+	resp := &response.CandidateListResponse{
+		Candidates: make([]response.CandidateResponse, 0, len(candidates)),
+		Total:      int(total),
+		Page:       page,
+		PageSize:   pageSize,
+	}
+	for _, candidate := range candidates {
+		resp.Candidates = append(resp.Candidates, *toCandidateResponse(candidate))
+	}
+	return resp, nil
+}
+
+// UpdateCandidate replaces a candidate and re-embeds its description
+func (u *MatchingUsecase) UpdateCandidate(ctx context.Context, organizationID, tenantID, candidateID uuid.UUID, req *requests.CandidateRequest) (*response.CandidateResponse, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, ErrInvalidName
+	}
+	attributes, err := encodeAttributes(req.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	embedding, model := u.embed(ctx, candidateText(req.Description))
+
+	candidate, err := u.matchRepo.UpdateCandidate(ctx, db.UpdateMatchCandidateParams{
+		ID:             candidateID,
+		TenantID:       tenantID,
+		Name:           name,
+		Attributes:     attributes,
+		Description:    req.Description,
+		Embedding:      embedding,
+		EmbeddingModel: model,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrCandidateNotFound
+		}
+		return nil, fmt.Errorf("failed to update candidate: %w", err)
+	}
+	return toCandidateResponse(candidate), nil
+}
+
+// DeleteCandidate removes a candidate
+func (u *MatchingUsecase) DeleteCandidate(ctx context.Context, organizationID, tenantID, candidateID uuid.UUID) error {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return err
+	}
+
+	deleted, err := u.matchRepo.DeleteCandidate(ctx, tenantID, candidateID)
+	if err != nil {
+		return fmt.Errorf("failed to delete candidate: %w", err)
+	}
+	if !deleted {
+		return ErrCandidateNotFound
+	}
+	return nil
+}
+
+// CreateOpening stores an opening with its requirements and the embedding
+// of its title and description
+func (u *MatchingUsecase) CreateOpening(ctx context.Context, organizationID, tenantID, userID uuid.UUID, req *requests.OpeningRequest) (*response.OpeningResponse, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	params, err := openingParams(req)
+	if err != nil {
+		return nil, err
+	}
+	embedding, model := u.embed(ctx, openingText(params.title, req.Description))
+
+	opening, err := u.matchRepo.CreateOpening(ctx, db.CreateMatchOpeningParams{
+		TenantID:       tenantID,
+		Title:          params.title,
+		Attributes:     params.attributes,
+		Description:    req.Description,
+		Requirements:   params.requirements,
+		Status:         params.status,
+		Embedding:      embedding,
+		EmbeddingModel: model,
+		CreatedBy:      uuid.NullUUID{UUID: userID, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create opening: %w", err)
+	}
+	return toOpeningResponse(opening), nil
+}
+
+// GetOpening returns an opening of the tenant
+func (u *MatchingUsecase) GetOpening(ctx context.Context, organizationID, tenantID, openingID uuid.UUID) (*response.OpeningResponse, error) {
+	opening, err := u.getOpening(ctx, organizationID, tenantID, openingID)
+	if err != nil {
+		return nil, err
+	}
+	return toOpeningResponse(opening), nil
+}
+
+// ListOpenings returns the tenant's openings, newest first
+func (u *MatchingUsecase) ListOpenings(ctx context.Context, organizationID, tenantID uuid.UUID, page, pageSize int) (*response.OpeningListResponse, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	page, pageSize = normalizePage(page, pageSize)
+	openings, err := u.matchRepo.ListOpenings(ctx, tenantID, int32(pageSize), int32((page-1)*pageSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list openings: %w", err)
+	}
+
+	total, err := u.matchRepo.CountOpenings(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count openings: %w", err)
+	}
+
+	resp := &response.OpeningListResponse{
+		Openings: make([]response.OpeningResponse, 0, len(openings)),
+		Total:    int(total),
+		Page:     page,
+		PageSize: pageSize,
+	}
+	for _, opening := range openings {
+		resp.Openings = append(resp.Openings, *toOpeningResponse(opening))
+	}
+	return resp, nil
+}
+
+// UpdateOpening replaces an opening and re-embeds its description
+func (u *MatchingUsecase) UpdateOpening(ctx context.Context, organizationID, tenantID, openingID uuid.UUID, req *requests.OpeningRequest) (*response.OpeningResponse, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	params, err := openingParams(req)
+	if err != nil {
+		return nil, err
+	}
+	embedding, model := u.embed(ctx, openingText(params.title, req.Description))
+
+	opening, err := u.matchRepo.UpdateOpening(ctx, db.UpdateMatchOpeningParams{
+		ID:             openingID,
+		TenantID:       tenantID,
+		Title:          params.title,
+		Attributes:     params.attributes,
+		Description:    req.Description,
+		Requirements:   params.requirements,
+		Status:         params.status,
+		Embedding:      embedding,
+		EmbeddingModel: model,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOpeningNotFound
+		}
+		return nil, fmt.Errorf("failed to update opening: %w", err)
+	}
+	return toOpeningResponse(opening), nil
+}
+
+// DeleteOpening removes an opening
+func (u *MatchingUsecase) DeleteOpening(ctx context.Context, organizationID, tenantID, openingID uuid.UUID) error {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return err
+	}
+
+	deleted, err := u.matchRepo.DeleteOpening(ctx, tenantID, openingID)
+	if err != nil {
+		return fmt.Errorf("failed to delete opening: %w", err)
+	}
+	if !deleted {
+		return ErrOpeningNotFound
+	}
+	return nil
+}
+
+// Match ranks the candidates for an opening, or the open openings for a
+// candidate, by the opening's rules and the similarity of descriptions
+func (u *MatchingUsecase) Match(ctx context.Context, organizationID, tenantID uuid.UUID, req *requests.MatchRequest) (*response.MatchListResponse, error) {
+	if (req.OpeningID == nil) == (req.CandidateID == nil) {
+		return nil, ErrInvalidMatchTarget
+	}
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultMatchLimit
+	}
+
+	similarityWeight := 0.0
+	if req.SimilarityWeight != nil {
+		similarityWeight = *req.SimilarityWeight
+	} else {
+		values, err := u.settings.ForTenant(ctx, tenantID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve matching settings: %w", err)
+		}
+		similarityWeight = settings.Get(values, settings.MatchingSimilarityWeight)
+	}
+
+	ranking := &ranking{limit: limit, minScore: req.MinScore, includeIneligible: req.IncludeIneligible}
+	resp := &response.MatchListResponse{
+		Model:            u.embedder.Model(),
+		SimilarityWeight: similarityWeight,
+	}
+
+	var err error
+	if req.OpeningID != nil {
+		resp.Target = TargetCandidates
+		err = u.rankCandidates(ctx, tenantID, *req.OpeningID, req.IDs, similarityWeight, ranking)
+	} else {
+		resp.Target = TargetOpenings
+		err = u.rankOpenings(ctx, tenantID, *req.CandidateID, req.IDs, similarityWeight, ranking)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Matches = ranking.results()
+	return resp, nil
+}
+
+func (u *MatchingUsecase) rankCandidates(ctx context.Context, tenantID, openingID uuid.UUID, ids []uuid.UUID, similarityWeight float64, ranking *ranking) error {
+	opening, err := u.matchRepo.GetOpening(ctx, tenantID, openingID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOpeningNotFound
+		}
+		return fmt.Errorf("failed to get opening: %w", err)
+	}
+	rules := decodeRules(opening.Requirements)
+	openingVector := u.openingEmbeddings(ctx, []db.MatchOpening{opening})[0]
+
+	after := uuid.Nil
+	for {
+		candidates, err := u.matchRepo.ListCandidatesAfter(ctx, tenantID, after, ids, scanPageSize)
+		if err != nil {
+			return fmt.Errorf("failed to list candidates: %w", err)
+		}
+
+		vectors := u.candidateEmbeddings(ctx, candidates)
+		for i, candidate := range candidates {
+			score := matching.Evaluate(rules, decodeAttributes(candidate.Attributes), vectors[i], openingVector, similarityWeight)
+			ranking.add(candidate, opening, score)
+		}
+
+		if len(candidates) < scanPageSize {
+			return nil
+		}
+		after = candidates[len(candidates)-1].ID
+	}
+}
+
+func (u *MatchingUsecase) rankOpenings(ctx context.Context, tenantID, candidateID uuid.UUID, ids []uuid.UUID, similarityWeight float64, ranking *ranking) error {
+	candidate, err := u.matchRepo.GetCandidate(ctx, tenantID, candidateID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrCandidateNotFound
+		}
+		return fmt.Errorf("failed to get candidate: %w", err)
+	}
+	attributes := decodeAttributes(candidate.Attributes)
+	candidateVector := u.candidateEmbeddings(ctx, []db.MatchCandidate{candidate})[0]
+
+	after := uuid.Nil
+	for {
+		openings, err := u.matchRepo.ListOpenOpeningsAfter(ctx, tenantID, after, ids, scanPageSize)
+		if err != nil {
+			return fmt.Errorf("failed to list openings: %w", err)
+		}
+
+		vectors := u.openingEmbeddings(ctx, openings)
+		for i, opening := range openings {
+			score := matching.Evaluate(decodeRules(opening.Requirements), attributes, candidateVector, vectors[i], similarityWeight)
+			ranking.add(candidate, opening, score)
+		}
+
+		if len(openings) < scanPageSize {
+			return nil
+		}
+		after = openings[len(openings)-1].ID
+	}
+}
+
+// candidateEmbeddings returns the embedding of each candidate under the
+// current model. Those stored under another model, such as after switching
+// providers, are embedded again and saved.
+func (u *MatchingUsecase) candidateEmbeddings(ctx context.Context, candidates []db.MatchCandidate) [][]float32 {
+	texts := make([]string, len(candidates))
+	stored := make([][]float32, len(candidates))
+	models := make([]string, len(candidates))
+	for i, candidate := range candidates {
+		texts[i] = candidateText(candidate.Description)
+		stored[i] = candidate.Embedding
+		models[i] = candidate.EmbeddingModel.String
+	}
+
+	return u.currentEmbeddings(ctx, texts, stored, models, func(i int, vector []float32) error {
+		return u.matchRepo.SetCandidateEmbedding(ctx, db.SetMatchCandidateEmbeddingParams{
+			ID:             candidates[i].ID,
+			Embedding:      vector,
+			EmbeddingModel: sql.NullString{String: u.embedder.Model(), Valid: true},
+		})
+	})
+}
+
+// openingEmbeddings is candidateEmbeddings for openings
+func (u *MatchingUsecase) openingEmbeddings(ctx context.Context, openings []db.MatchOpening) [][]float32 {
+	texts := make([]string, len(openings))
+	stored := make([][]float32, len(openings))
+	models := make([]string, len(openings))
+	for i, opening := range openings {
+		texts[i] = openingText(opening.Title, opening.Description)
+		stored[i] = opening.Embedding
+		models[i] = opening.EmbeddingModel.String
+	}
+
+	return u.currentEmbeddings(ctx, texts, stored, models, func(i int, vector []float32) error {
+		return u.matchRepo.SetOpeningEmbedding(ctx, db.SetMatchOpeningEmbeddingParams{
+			ID:             openings[i].ID,
+			Embedding:      vector,
+			EmbeddingModel: sql.NullString{String: u.embedder.Model(), Valid: true},
+		})
+	})
+}
+
+// currentEmbeddings embeds the texts whose stored vector is missing or from
+// another model. A failure leaves those rows without similarity rather than
+// failing the whole match.
+func (u *MatchingUsecase) currentEmbeddings(ctx context.Context, texts []string, stored [][]float32, models []string, save func(i int, vector []float32) error) [][]float32 {
+	model := u.embedder.Model()
+	vectors := make([][]float32, len(texts))
+
+	var stale []int
+	for i := range texts {
+		switch {
+		case texts[i] == "":
+		case models[i] == model && len(stored[i]) > 0:
+			vectors[i] = stored[i]
+		default:
+			stale = append(stale, i)
+		}
+	}
+	if len(stale) == 0 {
+		return vectors
+	}
+
+	batch := make([]string, len(stale))
+	for j, i := range stale {
+		batch[j] = texts[i]
+	}
+	embedded, err := u.embedder.Embed(ctx, batch)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to embed match descriptions", slog.Int("count", len(batch)), slog.Any("error", err))
+		return vectors
+	}
+	for j, i := range stale {
+		vectors[i] = embedded[j]
+		if err := save(i, embedded[j]); err != nil {
+			slog.WarnContext(ctx, "failed to store match embedding", slog.Any("error", err))
+		}
+	}
+	return vectors
+}
+
+// embed returns the embedding of text for storage. Failures are logged and
+// left for matching to retry, so that saving does not depend on the
+// embedding provider being up.
+func (u *MatchingUsecase) embed(ctx context.Context, text string) ([]float32, sql.NullString) {
+	if text == "" {
+		return nil, sql.NullString{}
+	}
+	vectors, err := u.embedder.Embed(ctx, []string{text})
+	if err != nil {
+		slog.WarnContext(ctx, "failed to embed match description", slog.Any("error", err))
+		return nil, sql.NullString{}
+	}
+	return vectors[0], sql.NullString{String: u.embedder.Model(), Valid: true}
+}
+
+func (u *MatchingUsecase) getCandidate(ctx context.Context, organizationID, tenantID, candidateID uuid.UUID) (db.MatchCandidate, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return db.MatchCandidate{}, err
+	}
+
+	candidate, err := u.matchRepo.GetCandidate(ctx, tenantID, candidateID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.MatchCandidate{}, ErrCandidateNotFound
+		}
+		return db.MatchCandidate{}, fmt.Errorf("failed to get candidate: %w", err)
+	}
+	return candidate, nil
+}
+
+func (u *MatchingUsecase) getOpening(ctx context.Context, organizationID, tenantID, openingID uuid.UUID) (db.MatchOpening, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return db.MatchOpening{}, err
+	}
+
+	opening, err := u.matchRepo.GetOpening(ctx, tenantID, openingID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.MatchOpening{}, ErrOpeningNotFound
+		}
+		return db.MatchOpening{}, fmt.Errorf("failed to get opening: %w", err)
+	}
+	return opening, nil
+}
+
+// ensureTenant checks that the tenant belongs to the organization in the path
+func (u *MatchingUsecase) ensureTenant(ctx context.Context, organizationID, tenantID uuid.UUID) error {
+	tenant, err := u.tenantRepo.GetTenant(ctx, tenantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTenantNotFound
+		}
+		return fmt.Errorf("failed to get tenant: %w", err)
+	}
+	if tenant.OrganizationID != organizationID {
+		return ErrTenantNotFound
+	}
+	return nil
+}
+
+// ranking keeps the best matches seen so far: eligible ones first, then by
+// score
+type ranking struct {
+	limit             int
+	minScore          float64
+	includeIneligible bool
+	matches           []match
+}
+
+type match struct {
+	candidate db.MatchCandidate
+	opening   db.MatchOpening
+	score     matching.Score
+}
+
+func (r *ranking) add(candidate db.MatchCandidate, opening db.MatchOpening, score matching.Score) {
+	if !score.Eligible && !r.includeIneligible {
+		return
+	}
+	if score.Total < r.minScore {
+		return
+	}
+	r.matches = append(r.matches, match{candidate: candidate, opening: opening, score: score})
+	// Trim now and then instead of on every add
+	if len(r.matches) >= 2*r.limit+scanPageSize {
+		r.sort()
+		r.matches = r.matches[:r.limit]
+	}
+}
+
+func (r *ranking) sort() {
+	sort.Slice(r.matches, func(i, j int) bool {
+		a, b := r.matches[i], r.matches[j]
+		if a.score.Eligible != b.score.Eligible {
+			return a.score.Eligible
+		}
+		if a.score.Total != b.score.Total {
+			return a.score.Total > b.score.Total
+		}
+		if a.candidate.ID != b.candidate.ID {
+			return a.candidate.ID.String() < b.candidate.ID.String()
+		}
+		return a.opening.ID.String() < b.opening.ID.String()
+	})
+}
+
+func (r *ranking) results() []response.MatchResultResponse {
+	r.sort()
+	if len(r.matches) > r.limit {
+		r.matches = r.matches[:r.limit]
+	}
+
+	results := make([]response.MatchResultResponse, len(r.matches))
+	for i, m := range r.matches {
+		results[i] = response.MatchResultResponse{
+			CandidateID:   m.candidate.ID,
+			CandidateName: m.candidate.Name,
+			OpeningID:     m.opening.ID,
+			OpeningTitle:  m.opening.Title,
+			Score:         m.score.Total,
+			Eligible:      m.score.Eligible,
+			Factors:       toFactorResponses(m.score.Factors),
+		}
+	}
+	return results
+}
+
+type validOpening struct {
+	title        string
+	attributes   json.RawMessage
+	requirements json.RawMessage
+	status       string
+}
+
+func openingParams(req *requests.OpeningRequest) (validOpening, error) {
+	params := validOpening{
+		title:  strings.TrimSpace(req.Title),
+		status: req.Status,
+	}
+	if params.title == "" {
+		return validOpening{}, ErrInvalidTitle
+	}
+	if params.status == "" {
+		params.status = statusOpen
+	}
+
+	var err error
+	if params.attributes, err = encodeAttributes(req.Attributes); err != nil {
+		return validOpening{}, err
+	}
+
+	rules := make([]matching.Rule, len(req.Requirements))
+	for i, r := range req.Requirements {
+		rules[i] = matching.Rule{
+			Attribute: strings.TrimSpace(r.Attribute),
+			Operator:  r.Operator,
+			Value:     r.Value,
+			Weight:    r.Weight,
+			Required:  r.Required,
+		}
+		if err := rules[i].Validate(); err != nil {
+			return validOpening{}, fmt.Errorf("%w %d: %v", ErrInvalidRule, i+1, err)
+		}
+	}
+	if params.requirements, err = json.Marshal(rules); err != nil {
+		return validOpening{}, fmt.Errorf("failed to encode requirements: %w", err)
+	}
+	return params, nil
+}
+
+func normalizePage(page, pageSize int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	return page, pageSize
+}
+
+// candidateText is what a candidate is embedded from. The name is left out
+// since it says nothing about fit.
+func candidateText(description string) string {
+	return strings.TrimSpace(description)
+}
+
+func openingText(title, description string) string {
+	return strings.TrimSpace(title + "\n\n" + strings.TrimSpace(description))
+}
+
+func encodeAttributes(attributes map[string]any) (json.RawMessage, error) {
+	if attributes == nil {
+		attributes = map[string]any{}
+	}
+	raw, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode attributes: %w", err)
+	}
+	return raw, nil
+}
+
+func decodeAttributes(raw json.RawMessage) map[string]any {
+	attributes := map[string]any{}
+	if len(raw) > 0 {
+		_ = json.Unmarshal(raw, &attributes)
+	}
+	return attributes
+}
+
+func decodeRules(raw json.RawMessage) []matching.Rule {
+	rules := []matching.Rule{}
+	if len(raw) > 0 {
+		_ = json.Unmarshal(raw, &rules)
+	}
+	return rules
+}
+
+func toCandidateResponse(candidate db.MatchCandidate) *response.CandidateResponse {
+	resp := &response.CandidateResponse{
+		ID:             candidate.ID,
+		Name:           candidate.Name,
+		Attributes:     decodeAttributes(candidate.Attributes),
+		Description:    candidate.Description,
+		EmbeddingModel: candidate.EmbeddingModel.String,
+		CreatedAt:      candidate.CreatedAt,
+		UpdatedAt:      candidate.UpdatedAt,
+	}
+	if candidate.CreatedBy.Valid {
+		resp.CreatedBy = &candidate.CreatedBy.UUID
+	}
+	return resp
+}
+
+func toOpeningResponse(opening db.MatchOpening) *response.OpeningResponse {
+	rules := decodeRules(opening.Requirements)
+	resp := &response.OpeningResponse{
+		ID:             opening.ID,
+		Title:          opening.Title,
+		Attributes:     decodeAttributes(opening.Attributes),
+		Description:    opening.Description,
+		Requirements:   make([]response.RuleResponse, len(rules)),
+		Status:         opening.Status,
+		EmbeddingModel: opening.EmbeddingModel.String,
+		CreatedAt:      opening.CreatedAt,
+		UpdatedAt:      opening.UpdatedAt,
+	}
+	for i, rule := range rules {
+		resp.Requirements[i] = response.RuleResponse{
+			Attribute: rule.Attribute,
+			Operator:  rule.Operator,
+			Value:     rule.Value,
+			Weight:    rule.Weight,
+			Required:  rule.Required,
+		}
+	}
+	if opening.CreatedBy.Valid {
+		resp.CreatedBy = &opening.CreatedBy.UUID
+	}
+	return resp
+}
+
+func toFactorResponses(factors []matching.Factor) []response.MatchFactorResponse {
+	resp := make([]response.MatchFactorResponse, len(factors))
+	for i, factor := range factors {
+		resp[i] = response.MatchFactorResponse{
+			Kind:         factor.Kind,
+			Actual:       factor.Actual,
+			Score:        factor.Score,
+			Weight:       factor.Weight,
+			Contribution: factor.Contribution,
+		}
+		if factor.Rule != nil {
+			resp[i].Attribute = factor.Rule.Attribute
+			resp[i].Operator = factor.Rule.Operator
+			resp[i].Expected = factor.Rule.Value
+			resp[i].Required = factor.Rule.Required
+		}
+	}
+	return resp
+}
@@ -0,0 +1,207 @@
+package controller
+
+import (
+	"ai-matching/src/api/auth/organization_member/requests"
+	"ai-matching/src/api/auth/organization_member/response"
+	"ai-matching/src/api/auth/organization_member/usecase"
+	"ai-matching/src/infrastructure/middleware"
+	"context"
+	"errors"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+type OrganizationMemberController struct {
+	usecase *usecase.OrganizationMemberUsecase
+}
+
+func NewOrganizationMemberController(memberUsecase *usecase.OrganizationMemberUsecase) *OrganizationMemberController {
+	return &OrganizationMemberController{
+		usecase: memberUsecase,
+	}
+}
+
+type ListMembersInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+}
+
+type ListMembersOutput struct {
+	Body response.OrganizationMemberListResponse
+}
+
+func (c *OrganizationMemberController) ListMembers(ctx context.Context, input *ListMembersInput) (*ListMembersOutput, error) {
+	resp, err := c.usecase.ListMembers(ctx, input.OrganizationID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &ListMembersOutput{Body: *resp}, nil
+}
+
+type AddMemberInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	Body           requests.AddOrganizationMemberRequest
+}
+
+type MemberOutput struct {
+	Body response.OrganizationMemberResponse
+}
+
+func (c *OrganizationMemberController) AddMember(ctx context.Context, input *AddMemberInput) (*MemberOutput, error) {
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.AddMember(ctx, input.OrganizationID, actorID, input.Body)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &MemberOutput{Body: *resp}, nil
+}
+
+type UpdateMemberInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	UserID         uuid.UUID `path:"userId" doc:"User ID"`
+	Body           requests.UpdateOrganizationMemberRequest
+}
+
+func (c *OrganizationMemberController) UpdateMember(ctx context.Context, input *UpdateMemberInput) (*MemberOutput, error) {
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.UpdateMemberRole(ctx, input.OrganizationID, actorID, input.UserID, input.Body)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &MemberOutput{Body: *resp}, nil
+}
+
+type RemoveMemberInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	UserID         uuid.UUID `path:"userId" doc:"User ID"`
+}
+
+type MessageOutput struct {
+	Body response.MessageResponse
+}
+
+func (c *OrganizationMemberController) RemoveMember(ctx context.Context, input *RemoveMemberInput) (*MessageOutput, error) {
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.usecase.RemoveMember(ctx, input.OrganizationID, actorID, input.UserID); err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &MessageOutput{
+		Body: response.MessageResponse{
+			Message: "Member removed from organization successfully",
+		},
+	}, nil
+}
+
+type StartOwnershipTransferInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	Body           requests.StartOwnershipTransferRequest
+}
+
+type OwnershipTransferOutput struct {
+	Body response.OwnershipTransferResponse
+}
+
+func (c *OrganizationMemberController) StartOwnershipTransfer(ctx context.Context, input *StartOwnershipTransferInput) (*OwnershipTransferOutput, error) {
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.StartOwnershipTransfer(ctx, input.OrganizationID, actorID, input.Body)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &OwnershipTransferOutput{Body: *resp}, nil
+}
+
+type GetPendingOwnershipTransferInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+}
+
+func (c *OrganizationMemberController) GetPendingOwnershipTransfer(ctx context.Context, input *GetPendingOwnershipTransferInput) (*OwnershipTransferOutput, error) {
+	resp, err := c.usecase.GetPendingOwnershipTransfer(ctx, input.OrganizationID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &OwnershipTransferOutput{Body: *resp}, nil
+}
+
+type OwnershipTransferInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TransferID     uuid.UUID `path:"transferId" doc:"Transfer ID"`
+}
+
+func (c *OrganizationMemberController) AcceptOwnershipTransfer(ctx context.Context, input *OwnershipTransferInput) (*OwnershipTransferOutput, error) {
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.AcceptOwnershipTransfer(ctx, input.OrganizationID, actorID, input.TransferID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &OwnershipTransferOutput{Body: *resp}, nil
+}
+
+func (c *OrganizationMemberController) CancelOwnershipTransfer(ctx context.Context, input *OwnershipTransferInput) (*MessageOutput, error) {
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.usecase.CancelOwnershipTransfer(ctx, input.OrganizationID, actorID, input.TransferID); err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &MessageOutput{
+		Body: response.MessageResponse{
+			Message: "Ownership transfer canceled successfully",
+		},
+	}, nil
+}
+
+// currentUserID returns the authenticated user; membership changes are
+// always attributed to someone.
+func currentUserID(ctx context.Context) (uuid.UUID, error) {
+	userCtx, err := middleware.GetUserFromContext(ctx)
+	if err != nil {
+		return uuid.Nil, huma.Error401Unauthorized("Authentication required")
+	}
+	return userCtx.UserID, nil
+}
+
+func toHTTPError(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrOrganizationNotFound), errors.Is(err, usecase.ErrUserNotFound),
+		errors.Is(err, usecase.ErrMemberNotFound), errors.Is(err, usecase.ErrTransferNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, usecase.ErrForbidden), errors.Is(err, usecase.ErrTransferNotRecipient):
+		return huma.Error403Forbidden(err.Error())
+	case errors.Is(err, usecase.ErrMemberAlreadyExists), errors.Is(err, usecase.ErrLastOwner),
+		errors.Is(err, usecase.ErrAlreadyOwner), errors.Is(err, usecase.ErrTransferNotPending):
+		return huma.Error409Conflict(err.Error())
+	case errors.Is(err, usecase.ErrTransferToSelf):
+		return huma.Error422UnprocessableEntity(err.Error())
+	}
+	return err
+}
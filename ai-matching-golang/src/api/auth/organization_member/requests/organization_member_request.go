@@ -0,0 +1,16 @@
+package requests
+
+import "github.com/google/uuid"
+
+type AddOrganizationMemberRequest struct {
+	UserID uuid.UUID `json:"userId" doc:"User to add"`
+	Role   string    `json:"role" enum:"owner,admin,billing" doc:"Organization role"`
+}
+
+type UpdateOrganizationMemberRequest struct {
+	Role string `json:"role" enum:"owner,admin,billing" doc:"New organization role"`
+}
+
+type StartOwnershipTransferRequest struct {
+	NewOwnerID uuid.UUID `json:"newOwnerId" doc:"Member who will become owner once they accept"`
+}
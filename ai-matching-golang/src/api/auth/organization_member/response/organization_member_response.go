@@ -0,0 +1,36 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OrganizationMemberResponse struct {
+	UserID    uuid.UUID `json:"userId" doc:"User ID"`
+	Email     string    `json:"email,omitempty" doc:"User email"`
+	FirstName string    `json:"firstName,omitempty" doc:"User first name"`
+	LastName  string    `json:"lastName,omitempty" doc:"User last name"`
+	Role      string    `json:"role" doc:"Organization role"`
+	CreatedAt time.Time `json:"createdAt" doc:"Creation timestamp"`
+	UpdatedAt time.Time `json:"updatedAt" doc:"Last update timestamp"`
+}
+
+type OrganizationMemberListResponse struct {
+	Members []OrganizationMemberResponse `json:"members" doc:"List of organization members"`
+}
+
+type OwnershipTransferResponse struct {
+	ID             uuid.UUID  `json:"id" doc:"Transfer ID"`
+	OrganizationID uuid.UUID  `json:"organizationId" doc:"Organization ID"`
+	FromUserID     uuid.UUID  `json:"fromUserId" doc:"Owner who started the transfer"`
+	ToUserID       uuid.UUID  `json:"toUserId" doc:"Member who must accept the transfer"`
+	Status         string     `json:"status" doc:"pending, accepted, canceled or expired"`
+	ExpiresAt      time.Time  `json:"expiresAt" doc:"When a pending transfer lapses"`
+	ResolvedAt     *time.Time `json:"resolvedAt,omitempty" doc:"When the transfer was accepted, canceled or expired"`
+	CreatedAt      time.Time  `json:"createdAt" doc:"Creation timestamp"`
+}
+
+type MessageResponse struct {
+	Message string `json:"message" doc:"Response message"`
+}
@@ -0,0 +1,92 @@
+package router
+
+import (
+	"ai-matching/src/api/auth/organization_member/controller"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterOrganizationMemberRoutes(api huma.API, router fiber.Router, memberController *controller.OrganizationMemberController) {
+	// Organization member endpoints
+	huma.Register(api, huma.Operation{
+		OperationID: "list-organization-members",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/members",
+		Summary:     "List organization members",
+		Description: "List owners, admins and billing contacts of an organization",
+		Tags:        []string{"Organization Members"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, memberController.ListMembers)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "add-organization-member",
+		Method:      "POST",
+		Path:        "/api/v1/organizations/{organizationId}/members",
+		Summary:     "Add organization member",
+		Description: "Give a user an organization role (owners and admins only; only owners can add owners)",
+		Tags:        []string{"Organization Members"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, memberController.AddMember)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-organization-member",
+		Method:      "PUT",
+		Path:        "/api/v1/organizations/{organizationId}/members/{userId}",
+		Summary:     "Update organization member",
+		Description: "Change a member's organization role; the last owner cannot be demoted",
+		Tags:        []string{"Organization Members"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, memberController.UpdateMember)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "remove-organization-member",
+		Method:      "DELETE",
+		Path:        "/api/v1/organizations/{organizationId}/members/{userId}",
+		Summary:     "Remove organization member",
+		Description: "Remove a member from the organization; the last owner cannot be removed",
+		Tags:        []string{"Organization Members"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, memberController.RemoveMember)
+
+	// Ownership transfer endpoints
+	huma.Register(api, huma.Operation{
+		OperationID: "start-ownership-transfer",
+		Method:      "POST",
+		Path:        "/api/v1/organizations/{organizationId}/ownership-transfers",
+		Summary:     "Start ownership transfer",
+		Description: "Offer ownership to another member; it takes effect once they accept",
+		Tags:        []string{"Organization Members"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, memberController.StartOwnershipTransfer)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-pending-ownership-transfer",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/ownership-transfers/pending",
+		Summary:     "Get pending ownership transfer",
+		Description: "Get the organization's pending ownership transfer",
+		Tags:        []string{"Organization Members"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, memberController.GetPendingOwnershipTransfer)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "accept-ownership-transfer",
+		Method:      "POST",
+		Path:        "/api/v1/organizations/{organizationId}/ownership-transfers/{transferId}/accept",
+		Summary:     "Accept ownership transfer",
+		Description: "Accept a pending transfer as its recipient; the previous owner becomes admin",
+		Tags:        []string{"Organization Members"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, memberController.AcceptOwnershipTransfer)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "cancel-ownership-transfer",
+		Method:      "DELETE",
+		Path:        "/api/v1/organizations/{organizationId}/ownership-transfers/{transferId}",
+		Summary:     "Cancel ownership transfer",
+		Description: "Withdraw or decline a pending ownership transfer",
+		Tags:        []string{"Organization Members"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, memberController.CancelOwnershipTransfer)
+}
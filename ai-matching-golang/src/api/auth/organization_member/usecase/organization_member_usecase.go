@@ -0,0 +1,322 @@
+package usecase
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/api/auth/organization_member/requests"
+	"ai-matching/src/api/auth/organization_member/response"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// transferTTL is how long the new owner has to accept a transfer.
+const transferTTL = 7 * 24 * time.Hour
+
+var (
+	ErrOrganizationNotFound = errors.New("organization not found")
+	ErrUserNotFound         = errors.New("user not found")
+	ErrMemberNotFound       = errors.New("organization member not found")
+	ErrMemberAlreadyExists  = errors.New("user is already a member of this organization")
+	ErrForbidden            = errors.New("insufficient organization role")
+	ErrLastOwner            = errors.New("an organization must keep at least one owner")
+	ErrAlreadyOwner         = errors.New("user is already an owner")
+	ErrTransferNotFound     = errors.New("ownership transfer not found")
+	ErrTransferNotPending   = errors.New("ownership transfer is no longer pending")
+	ErrTransferNotRecipient = errors.New("only the new owner can accept an ownership transfer")
+	ErrTransferToSelf       = errors.New("cannot transfer ownership to yourself")
+)
+
+type OrganizationMemberUsecase struct {
+	memberRepo repository.OrganizationMemberRepository
+	orgRepo    repository.OrganizationRepository
+	userRepo   repository.UserRepository
+}
+
+func NewOrganizationMemberUsecase(memberRepo repository.OrganizationMemberRepository, orgRepo repository.OrganizationRepository, userRepo repository.UserRepository) *OrganizationMemberUsecase {
+	return &OrganizationMemberUsecase{
+		memberRepo: memberRepo,
+		orgRepo:    orgRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// ListMembers lists the members of an organization, owners first
+func (u *OrganizationMemberUsecase) ListMembers(ctx context.Context, organizationID uuid.UUID) (*response.OrganizationMemberListResponse, error) {
+	if err := u.ensureOrganization(ctx, organizationID); err != nil {
+		return nil, err
+	}
+
+	rows, err := u.memberRepo.ListOrganizationMembers(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization members: %w", err)
+	}
+
+	items := make([]response.OrganizationMemberResponse, len(rows))
+	for i, row := range rows {
+		items[i] = response.OrganizationMemberResponse{
+			UserID:    row.UserID,
+			Email:     row.Email,
+			FirstName: row.FirstName.String,
+			LastName:  row.LastName.String,
+			Role:      row.Role,
+			CreatedAt: row.CreatedAt,
+			UpdatedAt: row.UpdatedAt,
+		}
+	}
+	return &response.OrganizationMemberListResponse{Members: items}, nil
+}
+
+// AddMember adds a user to the organization. Owners and admins can add
+// members; only owners can add other owners.
+func (u *OrganizationMemberUsecase) AddMember(ctx context.Context, organizationID, actorID uuid.UUID, req requests.AddOrganizationMemberRequest) (*response.OrganizationMemberResponse, error) {
+	if err := u.ensureOrganization(ctx, organizationID); err != nil {
+		return nil, err
+	}
+	if err := u.authorize(ctx, organizationID, actorID, req.Role); err != nil {
+		return nil, err
+	}
+
+	if _, err := u.userRepo.GetUser(ctx, req.UserID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	member, err := u.memberRepo.AddOrganizationMember(ctx, organizationID, req.UserID, req.Role)
+	if err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil, ErrMemberAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to add organization member: %w", err)
+	}
+	return toMemberResponse(member), nil
+}
+
+// UpdateMemberRole changes a member's role. Granting or revoking the owner
+// role requires an owner, and the last owner cannot be demoted.
+func (u *OrganizationMemberUsecase) UpdateMemberRole(ctx context.Context, organizationID, actorID, userID uuid.UUID, req requests.UpdateOrganizationMemberRequest) (*response.OrganizationMemberResponse, error) {
+	member, err := u.findMember(ctx, organizationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.authorize(ctx, organizationID, actorID, member.Role, req.Role); err != nil {
+		return nil, err
+	}
+
+	updated, err := u.memberRepo.UpdateOrganizationMemberRole(ctx, organizationID, userID, req.Role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update organization member: %w", err)
+	}
+	if !updated {
+		return nil, ErrLastOwner
+	}
+
+	member, err = u.findMember(ctx, organizationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toMemberResponse(member), nil
+}
+
+// RemoveMember removes a member. Members may always remove themselves unless
+// they are the last owner.
+func (u *OrganizationMemberUsecase) RemoveMember(ctx context.Context, organizationID, actorID, userID uuid.UUID) error {
+	member, err := u.findMember(ctx, organizationID, userID)
+	if err != nil {
+		return err
+	}
+	if actorID != userID {
+		if err := u.authorize(ctx, organizationID, actorID, member.Role); err != nil {
+			return err
+		}
+	}
+
+	deleted, err := u.memberRepo.DeleteOrganizationMember(ctx, organizationID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove organization member: %w", err)
+	}
+	if !deleted {
+		return ErrLastOwner
+	}
+	return nil
+}
+
+// StartOwnershipTransfer lets an owner hand the organization to another
+// member. Nothing changes until the new owner accepts; starting a new
+// transfer cancels the pending one.
+func (u *OrganizationMemberUsecase) StartOwnershipTransfer(ctx context.Context, organizationID, actorID uuid.UUID, req requests.StartOwnershipTransferRequest) (*response.OwnershipTransferResponse, error) {
+	if err := u.ensureOrganization(ctx, organizationID); err != nil {
+		return nil, err
+	}
+	if err := u.requireRole(ctx, organizationID, actorID, repository.OrganizationRoleOwner); err != nil {
+		return nil, err
+	}
+	if req.NewOwnerID == actorID {
+		return nil, ErrTransferToSelf
+	}
+
+	target, err := u.findMember(ctx, organizationID, req.NewOwnerID)
+	if err != nil {
+		return nil, err
+	}
+	if target.Role == repository.OrganizationRoleOwner {
+		return nil, ErrAlreadyOwner
+	}
+
+	transfer, err := u.memberRepo.CreateOwnershipTransfer(ctx, organizationID, actorID, req.NewOwnerID, time.Now().Add(transferTTL))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ownership transfer: %w", err)
+	}
+	return toTransferResponse(transfer), nil
+}
+
+// GetPendingOwnershipTransfer returns the organization's pending transfer
+func (u *OrganizationMemberUsecase) GetPendingOwnershipTransfer(ctx context.Context, organizationID uuid.UUID) (*response.OwnershipTransferResponse, error) {
+	transfer, err := u.memberRepo.GetPendingOwnershipTransfer(ctx, organizationID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTransferNotFound
+		}
+		return nil, fmt.Errorf("failed to get ownership transfer: %w", err)
+	}
+	return toTransferResponse(transfer), nil
+}
+
+// AcceptOwnershipTransfer confirms a transfer as its recipient. The recipient
+// becomes owner and the previous owner becomes admin.
+func (u *OrganizationMemberUsecase) AcceptOwnershipTransfer(ctx context.Context, organizationID, actorID, transferID uuid.UUID) (*response.OwnershipTransferResponse, error) {
+	transfer, err := u.findTransfer(ctx, organizationID, transferID)
+	if err != nil {
+		return nil, err
+	}
+	if transfer.ToUserID != actorID {
+		return nil, ErrTransferNotRecipient
+	}
+
+	accepted, err := u.memberRepo.AcceptOwnershipTransfer(ctx, organizationID, transferID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to accept ownership transfer: %w", err)
+	}
+	if !accepted {
+		return nil, ErrTransferNotPending
+	}
+
+	transfer, err = u.findTransfer(ctx, organizationID, transferID)
+	if err != nil {
+		return nil, err
+	}
+	return toTransferResponse(transfer), nil
+}
+
+// CancelOwnershipTransfer withdraws or declines a pending transfer. Either
+// party or any owner may cancel.
+func (u *OrganizationMemberUsecase) CancelOwnershipTransfer(ctx context.Context, organizationID, actorID, transferID uuid.UUID) error {
+	transfer, err := u.findTransfer(ctx, organizationID, transferID)
+	if err != nil {
+		return err
+	}
+	if actorID != transfer.FromUserID && actorID != transfer.ToUserID {
+		if err := u.requireRole(ctx, organizationID, actorID, repository.OrganizationRoleOwner); err != nil {
+			return err
+		}
+	}
+
+	canceled, err := u.memberRepo.CancelOwnershipTransfer(ctx, organizationID, transferID)
+	if err != nil {
+		return fmt.Errorf("failed to cancel ownership transfer: %w", err)
+	}
+	if !canceled {
+		return ErrTransferNotPending
+	}
+	return nil
+}
+
+// authorize requires the actor to be an owner or admin, and an owner when any
+// of the given roles is owner.
+func (u *OrganizationMemberUsecase) authorize(ctx context.Context, organizationID, actorID uuid.UUID, roles ...string) error {
+	for _, role := range roles {
+		if role == repository.OrganizationRoleOwner {
+			return u.requireRole(ctx, organizationID, actorID, repository.OrganizationRoleOwner)
+		}
+	}
+	return u.requireRole(ctx, organizationID, actorID, repository.OrganizationRoleOwner, repository.OrganizationRoleAdmin)
+}
+
+func (u *OrganizationMemberUsecase) requireRole(ctx context.Context, organizationID, actorID uuid.UUID, allowed ...string) error {
+	actor, err := u.memberRepo.GetOrganizationMember(ctx, organizationID, actorID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrForbidden
+		}
+		return fmt.Errorf("failed to get organization member: %w", err)
+	}
+	for _, role := range allowed {
+		if actor.Role == role {
+			return nil
+		}
+	}
+	return ErrForbidden
+}
+
+func (u *OrganizationMemberUsecase) ensureOrganization(ctx context.Context, organizationID uuid.UUID) error {
+	if _, err := u.orgRepo.GetOrganization(ctx, organizationID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOrganizationNotFound
+		}
+		return fmt.Errorf("failed to get organization: %w", err)
+	}
+	return nil
+}
+
+func (u *OrganizationMemberUsecase) findMember(ctx context.Context, organizationID, userID uuid.UUID) (db.OrganizationMember, error) {
+	member, err := u.memberRepo.GetOrganizationMember(ctx, organizationID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.OrganizationMember{}, ErrMemberNotFound
+		}
+		return db.OrganizationMember{}, fmt.Errorf("failed to get organization member: %w", err)
+	}
+	return member, nil
+}
+
+func (u *OrganizationMemberUsecase) findTransfer(ctx context.Context, organizationID, transferID uuid.UUID) (db.OrganizationOwnershipTransfer, error) {
+	transfer, err := u.memberRepo.GetOwnershipTransfer(ctx, organizationID, transferID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.OrganizationOwnershipTransfer{}, ErrTransferNotFound
+		}
+		return db.OrganizationOwnershipTransfer{}, fmt.Errorf("failed to get ownership transfer: %w", err)
+	}
+	return transfer, nil
+}
+
+func toMemberResponse(member db.OrganizationMember) *response.OrganizationMemberResponse {
+	return &response.OrganizationMemberResponse{
+		UserID:    member.UserID,
+		Role:      member.Role,
+		CreatedAt: member.CreatedAt,
+		UpdatedAt: member.UpdatedAt,
+	}
+}
+
+func toTransferResponse(transfer db.OrganizationOwnershipTransfer) *response.OwnershipTransferResponse {
+	resp := &response.OwnershipTransferResponse{
+		ID:             transfer.ID,
+		OrganizationID: transfer.OrganizationID,
+		FromUserID:     transfer.FromUserID,
+		ToUserID:       transfer.ToUserID,
+		Status:         transfer.Status,
+		ExpiresAt:      transfer.ExpiresAt,
+		CreatedAt:      transfer.CreatedAt,
+	}
+	if transfer.ResolvedAt.Valid {
+		resp.ResolvedAt = &transfer.ResolvedAt.Time
+	}
+	return resp
+}
@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"ai-matching/src/api/auth/organization_origin/requests"
+	"ai-matching/src/api/auth/organization_origin/response"
+	"ai-matching/src/api/auth/organization_origin/usecase"
+	"ai-matching/src/infrastructure/cors"
+	"context"
+	"errors"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+type OrganizationOriginController struct {
+	usecase *usecase.OrganizationOriginUsecase
+}
+
+func NewOrganizationOriginController(originUsecase *usecase.OrganizationOriginUsecase) *OrganizationOriginController {
+	return &OrganizationOriginController{
+		usecase: originUsecase,
+	}
+}
+
+type ListOriginsInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+}
+
+type ListOriginsOutput struct {
+	Body response.OrganizationOriginListResponse
+}
+
+func (c *OrganizationOriginController) ListOrigins(ctx context.Context, input *ListOriginsInput) (*ListOriginsOutput, error) {
+	resp, err := c.usecase.ListOrigins(ctx, input.OrganizationID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &ListOriginsOutput{Body: *resp}, nil
+}
+
+type CreateOriginInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	Body           requests.CreateOrganizationOriginRequest
+}
+
+type CreateOriginOutput struct {
+	Body response.OrganizationOriginResponse
+}
+
+func (c *OrganizationOriginController) CreateOrigin(ctx context.Context, input *CreateOriginInput) (*CreateOriginOutput, error) {
+	resp, err := c.usecase.CreateOrigin(ctx, input.OrganizationID, input.Body)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &CreateOriginOutput{Body: *resp}, nil
+}
+
+type DeleteOriginInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	OriginID       uuid.UUID `path:"originId" doc:"Origin ID"`
+}
+
+type DeleteOriginOutput struct {
+	Body response.MessageResponse
+}
+
+func (c *OrganizationOriginController) DeleteOrigin(ctx context.Context, input *DeleteOriginInput) (*DeleteOriginOutput, error) {
+	if err := c.usecase.DeleteOrigin(ctx, input.OrganizationID, input.OriginID); err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &DeleteOriginOutput{
+		Body: response.MessageResponse{
+			Message: "Origin deleted successfully",
+		},
+	}, nil
+}
+
+func toHTTPError(err error) error {
+	switch {
+	case errors.Is(err, cors.ErrInvalidOrigin):
+		return huma.Error422UnprocessableEntity(err.Error())
+	case errors.Is(err, usecase.ErrOrganizationNotFound), errors.Is(err, usecase.ErrOriginNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, usecase.ErrOriginAlreadyExists):
+		return huma.Error409Conflict(err.Error())
+	}
+	return err
+}
@@ -0,0 +1,5 @@
+package requests
+
+type CreateOrganizationOriginRequest struct {
+	Origin string `json:"origin" validate:"required" example:"https://app.example-clinic.jp" doc:"Frontend origin (scheme://host[:port]) allowed to call the API"`
+}
@@ -0,0 +1,23 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OrganizationOriginResponse struct {
+	ID             uuid.UUID `json:"id" doc:"Origin ID"`
+	OrganizationID uuid.UUID `json:"organizationId" doc:"Organization ID"`
+	Origin         string    `json:"origin" doc:"Allowed frontend origin"`
+	CreatedAt      time.Time `json:"createdAt" doc:"Creation timestamp"`
+	UpdatedAt      time.Time `json:"updatedAt" doc:"Last update timestamp"`
+}
+
+type OrganizationOriginListResponse struct {
+	Origins []OrganizationOriginResponse `json:"origins" doc:"List of allowed origins"`
+}
+
+type MessageResponse struct {
+	Message string `json:"message" doc:"Response message"`
+}
@@ -0,0 +1,43 @@
+package router
+
+import (
+	"ai-matching/src/api/auth/organization_origin/controller"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterOrganizationOriginRoutes(api huma.API, router fiber.Router, originController *controller.OrganizationOriginController) {
+	// List allowed origins
+	huma.Register(api, huma.Operation{
+		OperationID: "list-organization-origins",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/origins",
+		Summary:     "List allowed origins",
+		Description: "List the additional frontend origins allowed to call the API for an organization",
+		Tags:        []string{"Organization Origins"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, originController.ListOrigins)
+
+	// Register allowed origin
+	huma.Register(api, huma.Operation{
+		OperationID: "create-organization-origin",
+		Method:      "POST",
+		Path:        "/api/v1/organizations/{organizationId}/origins",
+		Summary:     "Register allowed origin",
+		Description: "Register a frontend origin that may make credentialed CORS requests",
+		Tags:        []string{"Organization Origins"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, originController.CreateOrigin)
+
+	// Remove allowed origin
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-organization-origin",
+		Method:      "DELETE",
+		Path:        "/api/v1/organizations/{organizationId}/origins/{originId}",
+		Summary:     "Remove allowed origin",
+		Description: "Remove a registered frontend origin from an organization",
+		Tags:        []string{"Organization Origins"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, originController.DeleteOrigin)
+}
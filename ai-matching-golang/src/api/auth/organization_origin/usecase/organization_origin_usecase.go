@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/api/auth/organization_origin/requests"
+	"ai-matching/src/api/auth/organization_origin/response"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/cors"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrOrganizationNotFound = errors.New("organization not found")
+	ErrOriginNotFound       = errors.New("origin not found")
+	ErrOriginAlreadyExists  = errors.New("origin is already registered")
+)
+
+type OrganizationOriginUsecase struct {
+	originRepo repository.OrganizationOriginRepository
+	orgRepo    repository.OrganizationRepository
+	resolver   *cors.Resolver
+}
+
+func NewOrganizationOriginUsecase(originRepo repository.OrganizationOriginRepository, orgRepo repository.OrganizationRepository, resolver *cors.Resolver) *OrganizationOriginUsecase {
+	return &OrganizationOriginUsecase{
+		originRepo: originRepo,
+		orgRepo:    orgRepo,
+		resolver:   resolver,
+	}
+}
+
+// ListOrigins lists the additional frontend origins registered by an organization
+func (u *OrganizationOriginUsecase) ListOrigins(ctx context.Context, organizationID uuid.UUID) (*response.OrganizationOriginListResponse, error) {
+	if err := u.ensureOrganization(ctx, organizationID); err != nil {
+		return nil, err
+	}
+
+	origins, err := u.originRepo.ListOrganizationOrigins(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list origins: %w", err)
+	}
+
+	items := make([]response.OrganizationOriginResponse, len(origins))
+	for i, origin := range origins {
+		items[i] = toOriginResponse(origin)
+	}
+
+	return &response.OrganizationOriginListResponse{Origins: items}, nil
+}
+
+// CreateOrigin registers a frontend origin for an organization
+func (u *OrganizationOriginUsecase) CreateOrigin(ctx context.Context, organizationID uuid.UUID, req requests.CreateOrganizationOriginRequest) (*response.OrganizationOriginResponse, error) {
+	origin, err := cors.NormalizeOrigin(req.Origin)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.ensureOrganization(ctx, organizationID); err != nil {
+		return nil, err
+	}
+
+	created, err := u.originRepo.CreateOrganizationOrigin(ctx, organizationID, origin)
+	if err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil, ErrOriginAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to create origin: %w", err)
+	}
+	u.resolver.Invalidate(origin)
+
+	resp := toOriginResponse(created)
+	return &resp, nil
+}
+
+// DeleteOrigin removes a registered origin from an organization
+func (u *OrganizationOriginUsecase) DeleteOrigin(ctx context.Context, organizationID, originID uuid.UUID) error {
+	origin, err := u.originRepo.GetOrganizationOrigin(ctx, organizationID, originID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOriginNotFound
+		}
+		return fmt.Errorf("failed to get origin: %w", err)
+	}
+
+	if err := u.originRepo.DeleteOrganizationOrigin(ctx, organizationID, originID); err != nil {
+		return fmt.Errorf("failed to delete origin: %w", err)
+	}
+	u.resolver.Invalidate(origin.Origin)
+
+	return nil
+}
+
+func (u *OrganizationOriginUsecase) ensureOrganization(ctx context.Context, organizationID uuid.UUID) error {
+	_, err := u.orgRepo.GetOrganization(ctx, organizationID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOrganizationNotFound
+		}
+		return fmt.Errorf("failed to get organization: %w", err)
+	}
+	return nil
+}
+
+func toOriginResponse(origin db.OrganizationOrigin) response.OrganizationOriginResponse {
+	return response.OrganizationOriginResponse{
+		ID:             origin.ID,
+		OrganizationID: origin.OrganizationID,
+		Origin:         origin.Origin,
+		CreatedAt:      origin.CreatedAt,
+		UpdatedAt:      origin.UpdatedAt,
+	}
+}
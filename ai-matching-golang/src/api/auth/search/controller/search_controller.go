@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"ai-matching/src/api/auth/search/requests"
+	"ai-matching/src/api/auth/search/response"
+	"ai-matching/src/api/auth/search/usecase"
+	"ai-matching/src/infrastructure/middleware"
+	"context"
+	"errors"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+type SearchController struct {
+	usecase *usecase.SearchUsecase
+}
+
+func NewSearchController(searchUsecase *usecase.SearchUsecase) *SearchController {
+	return &SearchController{
+		usecase: searchUsecase,
+	}
+}
+
+type SearchInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	Body           requests.SearchRequest
+}
+
+type SearchOutput struct {
+	Body response.SearchResponse
+}
+
+func (c *SearchController) Search(ctx context.Context, input *SearchInput) (*SearchOutput, error) {
+	if _, err := middleware.RequireTenant(ctx, input.TenantID); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.Search(ctx, input.OrganizationID, input.TenantID, &input.Body)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &SearchOutput{Body: *resp}, nil
+}
+
+func toHTTPError(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrTenantNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, usecase.ErrEmptyQuery):
+		return huma.Error400BadRequest(err.Error())
+	}
+	return err
+}
@@ -0,0 +1,17 @@
+package requests
+
+import "github.com/google/uuid"
+
+type SearchFilter struct {
+	Metadata    map[string]any `json:"metadata,omitempty" doc:"Only match documents whose metadata contains these key/value pairs"`
+	DocumentIDs []uuid.UUID    `json:"documentIds,omitempty" maxItems:"100" doc:"Only match these documents"`
+}
+
+type SearchRequest struct {
+	Query    string        `json:"query" required:"true" minLength:"1" maxLength:"2000" doc:"Text to search for; keyword matching accepts \"quoted phrases\", OR and -excluded terms"`
+	Mode     string        `json:"mode,omitempty" enum:"hybrid,vector,keyword" default:"hybrid" doc:"Rank by vector similarity, keyword match or both fused"`
+	TopK     int           `json:"topK,omitempty" minimum:"1" maximum:"50" default:"5" doc:"Number of chunks to return"`
+	MinScore float64       `json:"minScore,omitempty" minimum:"-1" maximum:"1" doc:"Vector mode only: drop chunks less similar than this cosine similarity"`
+	Explain  bool          `json:"explain,omitempty" doc:"Include the ranks and scores behind each result"`
+	Filter   *SearchFilter `json:"filter,omitempty" doc:"Restrict the search to matching documents"`
+}
@@ -0,0 +1,42 @@
+package response
+
+import "github.com/google/uuid"
+
+type SearchResultResponse struct {
+	ChunkID          uuid.UUID                  `json:"chunkId" doc:"Chunk ID"`
+	DocumentID       uuid.UUID                  `json:"documentId" doc:"Document ID"`
+	DocumentTitle    string                     `json:"documentTitle" doc:"Document title"`
+	DocumentMetadata map[string]any             `json:"documentMetadata" doc:"Document metadata"`
+	Version          int                        `json:"version" doc:"Document version the chunk belongs to"`
+	ChunkIndex       int                        `json:"chunkIndex" doc:"Position of the chunk in the version"`
+	Heading          string                     `json:"heading,omitempty" doc:"Markdown heading path the chunk falls under"`
+	Content          string                     `json:"content" doc:"Chunk text"`
+	StartOffset      int                        `json:"startOffset" doc:"Byte offset of the chunk in the extracted text"`
+	EndOffset        int                        `json:"endOffset" doc:"Byte offset of the end of the chunk"`
+	Score            float64                    `json:"score" doc:"Cosine similarity in vector mode, full-text rank in keyword mode, fused score in hybrid mode"`
+	Explanation      *SearchExplanationResponse `json:"explanation,omitempty" doc:"Components of the score (explain mode)"`
+}
+
+type SearchExplanationResponse struct {
+	VectorRank          *int     `json:"vectorRank,omitempty" doc:"Position in the vector similarity ranking"`
+	VectorScore         *float64 `json:"vectorScore,omitempty" doc:"Cosine similarity to the query"`
+	VectorContribution  float64  `json:"vectorContribution" doc:"Share of the fused score from the vector ranking"`
+	KeywordRank         *int     `json:"keywordRank,omitempty" doc:"Position in the keyword ranking"`
+	KeywordScore        *float64 `json:"keywordScore,omitempty" doc:"Full-text rank (ts_rank_cd)"`
+	KeywordContribution float64  `json:"keywordContribution" doc:"Share of the fused score from the keyword ranking"`
+}
+
+type SearchWeightsResponse struct {
+	Vector  float64 `json:"vector" doc:"Weight of the vector ranking (search.vectorWeight)"`
+	Keyword float64 `json:"keyword" doc:"Weight of the keyword ranking (search.keywordWeight)"`
+	K       int     `json:"k" doc:"Rank constant (search.rrfK)"`
+}
+
+type SearchResponse struct {
+	Query   string                 `json:"query" doc:"Text that was searched for"`
+	Mode    string                 `json:"mode" doc:"Ranking mode used"`
+	Backend string                 `json:"backend,omitempty" doc:"Vector search backend: pgvector or bruteforce"`
+	Model   string                 `json:"model" doc:"Embedding model"`
+	Weights *SearchWeightsResponse `json:"weights,omitempty" doc:"Fusion weights in effect (hybrid explain mode)"`
+	Results []SearchResultResponse `json:"results" doc:"Chunks, best first"`
+}
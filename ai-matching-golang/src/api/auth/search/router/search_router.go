@@ -0,0 +1,21 @@
+package router
+
+import (
+	"ai-matching/src/api/auth/search/controller"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterSearchRoutes(api huma.API, router fiber.Router, searchController *controller.SearchController) {
+	// Tenant document search endpoints
+	huma.Register(api, huma.Operation{
+		OperationID: "search-documents",
+		Method:      "POST",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/search",
+		Summary:     "Search documents",
+		Description: "Find the document chunks of a tenant that best match a query by vector similarity, full-text keywords or both fused with reciprocal rank fusion, optionally filtered by document metadata",
+		Tags:        []string{"Search"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, searchController.Search)
+}
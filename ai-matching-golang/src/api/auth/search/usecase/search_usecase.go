@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"ai-matching/src/api/auth/search/requests"
+	"ai-matching/src/api/auth/search/response"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/retrieval"
+	"ai-matching/src/infrastructure/settings"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const defaultTopK = 5
+
+var (
+	ErrTenantNotFound = errors.New("tenant not found")
+	ErrEmptyQuery     = errors.New("query must not be blank")
+)
+
+type SearchUsecase struct {
+	tenantRepo repository.TenantRepository
+	searcher   *retrieval.HybridSearcher
+	settings   *settings.Resolver
+}
+
+func NewSearchUsecase(tenantRepo repository.TenantRepository, searcher *retrieval.HybridSearcher, settingsResolver *settings.Resolver) *SearchUsecase {
+	return &SearchUsecase{
+		tenantRepo: tenantRepo,
+		searcher:   searcher,
+		settings:   settingsResolver,
+	}
+}
+
+// Search returns the chunks of the tenant's documents that best match the
+// query. Hybrid mode fuses the vector and keyword rankings with the weights
+// of the tenant's search settings.
+func (u *SearchUsecase) Search(ctx context.Context, organizationID, tenantID uuid.UUID, req *requests.SearchRequest) (*response.SearchResponse, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		return nil, ErrEmptyQuery
+	}
+
+	topK := req.TopK
+	if topK <= 0 {
+		topK = defaultTopK
+	}
+
+	mode := req.Mode
+	if mode == "" {
+		mode = retrieval.ModeHybrid
+	}
+
+	weights, err := u.weights(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	var filter retrieval.Filter
+	if req.Filter != nil {
+		filter.Metadata = req.Filter.Metadata
+		filter.DocumentIDs = req.Filter.DocumentIDs
+	}
+
+	results, backend, err := u.searcher.Search(ctx, tenantID, query, filter, topK, mode, weights)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search documents: %w", err)
+	}
+
+	resp := &response.SearchResponse{
+		Query:   query,
+		Mode:    mode,
+		Backend: backend,
+		Model:   u.searcher.Model(),
+		Results: make([]response.SearchResultResponse, 0, len(results)),
+	}
+	if req.Explain && mode == retrieval.ModeHybrid {
+		resp.Weights = &response.SearchWeightsResponse{
+			Vector:  weights.Vector,
+			Keyword: weights.Keyword,
+			K:       weights.K,
+		}
+	}
+	for _, result := range results {
+		if mode == retrieval.ModeVector && req.MinScore != 0 && result.Score < req.MinScore {
+			continue
+		}
+		item := toResultResponse(result.Result)
+		if req.Explain {
+			item.Explanation = toExplanationResponse(result.Explanation)
+		}
+		resp.Results = append(resp.Results, item)
+	}
+	return resp, nil
+}
+
+func (u *SearchUsecase) weights(ctx context.Context, tenantID uuid.UUID) (retrieval.Weights, error) {
+	values, err := u.settings.ForTenant(ctx, tenantID)
+	if err != nil {
+		return retrieval.Weights{}, fmt.Errorf("failed to resolve search settings: %w", err)
+	}
+	return retrieval.Weights{
+		Vector:  settings.Get(values, settings.SearchVectorWeight),
+		Keyword: settings.Get(values, settings.SearchKeywordWeight),
+		K:       settings.Get(values, settings.SearchRRFK),
+	}, nil
+}
+
+// ensureTenant checks that the tenant belongs to the organization in the path
+func (u *SearchUsecase) ensureTenant(ctx context.Context, organizationID, tenantID uuid.UUID) error {
+	tenant, err := u.tenantRepo.GetTenant(ctx, tenantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTenantNotFound
+		}
+		return fmt.Errorf("failed to get tenant: %w", err)
+	}
+	if tenant.OrganizationID != organizationID {
+		return ErrTenantNotFound
+	}
+	return nil
+}
+
+func toResultResponse(result retrieval.Result) response.SearchResultResponse {
+	chunk := result.Chunk
+	metadata := map[string]any{}
+	if len(chunk.DocumentMetadata) > 0 {
+		_ = json.Unmarshal(chunk.DocumentMetadata, &metadata)
+	}
+
+	return response.SearchResultResponse{
+		ChunkID:          chunk.ID,
+		DocumentID:       chunk.DocumentID,
+		DocumentTitle:    chunk.DocumentTitle,
+		DocumentMetadata: metadata,
+		Version:          int(chunk.Version),
+		ChunkIndex:       int(chunk.ChunkIndex),
+		Heading:          chunk.Heading,
+		Content:          chunk.Content,
+		StartOffset:      int(chunk.StartOffset),
+		EndOffset:        int(chunk.EndOffset),
+		Score:            result.Score,
+	}
+}
+
+func toExplanationResponse(e retrieval.Explanation) *response.SearchExplanationResponse {
+	resp := &response.SearchExplanationResponse{
+		VectorContribution:  e.VectorContribution,
+		KeywordContribution: e.KeywordContribution,
+	}
+	if e.VectorRank > 0 {
+		resp.VectorRank = &e.VectorRank
+		resp.VectorScore = &e.VectorScore
+	}
+	if e.KeywordRank > 0 {
+		resp.KeywordRank = &e.KeywordRank
+		resp.KeywordScore = &e.KeywordScore
+	}
+	return resp
+}
@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"ai-matching/src/api/auth/settings/requests"
+	"ai-matching/src/api/auth/settings/response"
+	"ai-matching/src/api/auth/settings/usecase"
+	"ai-matching/src/infrastructure/middleware"
+	"ai-matching/src/infrastructure/settings"
+	"context"
+	"errors"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+type SettingsController struct {
+	usecase *usecase.SettingsUsecase
+}
+
+func NewSettingsController(settingsUsecase *usecase.SettingsUsecase) *SettingsController {
+	return &SettingsController{
+		usecase: settingsUsecase,
+	}
+}
+
+type ListSettingDefinitionsInput struct{}
+
+type ListSettingDefinitionsOutput struct {
+	Body response.SettingDefinitionsResponse
+}
+
+func (c *SettingsController) ListSettingDefinitions(ctx context.Context, input *ListSettingDefinitionsInput) (*ListSettingDefinitionsOutput, error) {
+	return &ListSettingDefinitionsOutput{Body: *c.usecase.ListDefinitions()}, nil
+}
+
+type OrganizationSettingsInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+}
+
+type UpdateOrganizationSettingsInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	Body           requests.UpdateSettingsRequest
+}
+
+type TenantSettingsInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+}
+
+type UpdateTenantSettingsInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	Body           requests.UpdateSettingsRequest
+}
+
+type SettingsOutput struct {
+	Body response.SettingsResponse
+}
+
+func (c *SettingsController) GetOrganizationSettings(ctx context.Context, input *OrganizationSettingsInput) (*SettingsOutput, error) {
+	resp, err := c.usecase.GetOrganizationSettings(ctx, input.OrganizationID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &SettingsOutput{Body: *resp}, nil
+}
+
+func (c *SettingsController) UpdateOrganizationSettings(ctx context.Context, input *UpdateOrganizationSettingsInput) (*SettingsOutput, error) {
+	resp, err := c.usecase.UpdateOrganizationSettings(ctx, input.OrganizationID, currentUserID(ctx), input.Body)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &SettingsOutput{Body: *resp}, nil
+}
+
+func (c *SettingsController) GetTenantSettings(ctx context.Context, input *TenantSettingsInput) (*SettingsOutput, error) {
+	resp, err := c.usecase.GetTenantSettings(ctx, input.OrganizationID, input.TenantID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &SettingsOutput{Body: *resp}, nil
+}
+
+func (c *SettingsController) UpdateTenantSettings(ctx context.Context, input *UpdateTenantSettingsInput) (*SettingsOutput, error) {
+	resp, err := c.usecase.UpdateTenantSettings(ctx, input.OrganizationID, input.TenantID, currentUserID(ctx), input.Body)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &SettingsOutput{Body: *resp}, nil
+}
+
+// currentUserID records who changed a setting when the request is authenticated.
+func currentUserID(ctx context.Context) uuid.NullUUID {
+	user, err := middleware.GetUserFromContext(ctx)
+	if err != nil {
+		return uuid.NullUUID{}
+	}
+	return uuid.NullUUID{UUID: user.UserID, Valid: true}
+}
+
+func toHTTPError(err error) error {
+	switch {
+	case errors.Is(err, settings.ErrUnknownKey), errors.Is(err, settings.ErrScopeNotAllowed), errors.Is(err, settings.ErrInvalidValue):
+		return huma.Error422UnprocessableEntity(err.Error())
+	case errors.Is(err, usecase.ErrOrganizationNotFound), errors.Is(err, usecase.ErrTenantNotFound):
+		return huma.Error404NotFound(err.Error())
+	}
+	return err
+}
@@ -0,0 +1,5 @@
+package requests
+
+type UpdateSettingsRequest struct {
+	Values map[string]any `json:"values" required:"true" doc:"Setting values keyed by setting key. A null value removes the override so the inherited value applies."`
+}
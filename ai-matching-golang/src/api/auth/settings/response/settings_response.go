@@ -0,0 +1,27 @@
+package response
+
+import "time"
+
+type SettingResponse struct {
+	Key       string     `json:"key" doc:"Setting key"`
+	Value     any        `json:"value" doc:"Effective value"`
+	Source    string     `json:"source" enum:"default,organization,tenant" doc:"Level the effective value comes from"`
+	Version   int32      `json:"version,omitempty" doc:"Number of times the override at the source level has been written"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty" doc:"Last update of the override at the source level"`
+}
+
+type SettingsResponse struct {
+	Settings []SettingResponse `json:"settings" doc:"Effective settings"`
+}
+
+type SettingDefinitionResponse struct {
+	Key         string   `json:"key" doc:"Setting key"`
+	Description string   `json:"description" doc:"What the setting controls"`
+	Type        string   `json:"type" doc:"JSON type of the value"`
+	Default     any      `json:"default" doc:"Value used when no level overrides it"`
+	Scopes      []string `json:"scopes" doc:"Levels the setting may be stored at"`
+}
+
+type SettingDefinitionsResponse struct {
+	Definitions []SettingDefinitionResponse `json:"definitions" doc:"Registered settings"`
+}
@@ -0,0 +1,63 @@
+package router
+
+import (
+	"ai-matching/src/api/auth/settings/controller"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterSettingsRoutes(api huma.API, router fiber.Router, settingsController *controller.SettingsController) {
+	// List registered setting keys
+	huma.Register(api, huma.Operation{
+		OperationID: "list-setting-definitions",
+		Method:      "GET",
+		Path:        "/api/v1/settings/definitions",
+		Summary:     "List setting definitions",
+		Description: "List the registered setting keys with their types, defaults and allowed levels",
+		Tags:        []string{"Settings"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, settingsController.ListSettingDefinitions)
+
+	// Organization settings
+	huma.Register(api, huma.Operation{
+		OperationID: "get-organization-settings",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/settings",
+		Summary:     "Get organization settings",
+		Description: "Get the effective settings of an organization",
+		Tags:        []string{"Settings"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, settingsController.GetOrganizationSettings)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-organization-settings",
+		Method:      "PATCH",
+		Path:        "/api/v1/organizations/{organizationId}/settings",
+		Summary:     "Update organization settings",
+		Description: "Set or clear organization-level setting overrides",
+		Tags:        []string{"Settings"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, settingsController.UpdateOrganizationSettings)
+
+	// Tenant settings
+	huma.Register(api, huma.Operation{
+		OperationID: "get-tenant-settings",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/settings",
+		Summary:     "Get tenant settings",
+		Description: "Get the effective settings of a tenant, including values inherited from its organization",
+		Tags:        []string{"Settings"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, settingsController.GetTenantSettings)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-tenant-settings",
+		Method:      "PATCH",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/settings",
+		Summary:     "Update tenant settings",
+		Description: "Set or clear tenant-level setting overrides",
+		Tags:        []string{"Settings"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, settingsController.UpdateTenantSettings)
+}
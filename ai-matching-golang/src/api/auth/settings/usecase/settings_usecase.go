@@ -0,0 +1,198 @@
+package usecase
+
+import (
+	"ai-matching/src/api/auth/settings/requests"
+	"ai-matching/src/api/auth/settings/response"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/settings"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrOrganizationNotFound = errors.New("organization not found")
+	ErrTenantNotFound       = errors.New("tenant not found")
+)
+
+type SettingsUsecase struct {
+	settingsRepo repository.SettingsRepository
+	orgRepo      repository.OrganizationRepository
+	tenantRepo   repository.TenantRepository
+	resolver     *settings.Resolver
+}
+
+func NewSettingsUsecase(settingsRepo repository.SettingsRepository, orgRepo repository.OrganizationRepository, tenantRepo repository.TenantRepository, resolver *settings.Resolver) *SettingsUsecase {
+	return &SettingsUsecase{
+		settingsRepo: settingsRepo,
+		orgRepo:      orgRepo,
+		tenantRepo:   tenantRepo,
+		resolver:     resolver,
+	}
+}
+
+// ListDefinitions lists the registered setting keys
+func (u *SettingsUsecase) ListDefinitions() *response.SettingDefinitionsResponse {
+	defs := settings.Definitions()
+	items := make([]response.SettingDefinitionResponse, len(defs))
+	for i, def := range defs {
+		scopes := make([]string, len(def.Scopes))
+		for j, scope := range def.Scopes {
+			scopes[j] = string(scope)
+		}
+		items[i] = response.SettingDefinitionResponse{
+			Key:         def.Key,
+			Description: def.Description,
+			Type:        def.Type,
+			Default:     decode(def.Default),
+			Scopes:      scopes,
+		}
+	}
+	return &response.SettingDefinitionsResponse{Definitions: items}
+}
+
+// GetOrganizationSettings returns the effective settings of an organization
+func (u *SettingsUsecase) GetOrganizationSettings(ctx context.Context, organizationID uuid.UUID) (*response.SettingsResponse, error) {
+	if err := u.ensureOrganization(ctx, organizationID); err != nil {
+		return nil, err
+	}
+
+	values, err := u.resolver.ForOrganization(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	return toSettingsResponse(values, settings.ScopeOrganization), nil
+}
+
+// UpdateOrganizationSettings writes or removes organization-level overrides
+func (u *SettingsUsecase) UpdateOrganizationSettings(ctx context.Context, organizationID uuid.UUID, updatedBy uuid.NullUUID, req requests.UpdateSettingsRequest) (*response.SettingsResponse, error) {
+	if err := u.ensureOrganization(ctx, organizationID); err != nil {
+		return nil, err
+	}
+
+	changes, err := validateChanges(req.Values, settings.ScopeOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.settingsRepo.UpdateOrganizationSettings(ctx, organizationID, changes, updatedBy); err != nil {
+		return nil, fmt.Errorf("failed to update settings: %w", err)
+	}
+
+	return u.GetOrganizationSettings(ctx, organizationID)
+}
+
+// GetTenantSettings returns the effective settings of a tenant, including inherited values
+func (u *SettingsUsecase) GetTenantSettings(ctx context.Context, organizationID, tenantID uuid.UUID) (*response.SettingsResponse, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	values, err := u.resolver.ForTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return toSettingsResponse(values, settings.ScopeTenant), nil
+}
+
+// UpdateTenantSettings writes or removes tenant-level overrides
+func (u *SettingsUsecase) UpdateTenantSettings(ctx context.Context, organizationID, tenantID uuid.UUID, updatedBy uuid.NullUUID, req requests.UpdateSettingsRequest) (*response.SettingsResponse, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	changes, err := validateChanges(req.Values, settings.ScopeTenant)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.settingsRepo.UpdateTenantSettings(ctx, tenantID, changes, updatedBy); err != nil {
+		return nil, fmt.Errorf("failed to update settings: %w", err)
+	}
+
+	return u.GetTenantSettings(ctx, organizationID, tenantID)
+}
+
+// validateChanges checks every key before anything is written and returns
+// the encoded values, nil for keys to remove.
+func validateChanges(values map[string]any, scope settings.Scope) (map[string]json.RawMessage, error) {
+	changes := make(map[string]json.RawMessage, len(values))
+	for key, value := range values {
+		def, err := settings.Lookup(key)
+		if err != nil {
+			return nil, err
+		}
+		if !def.AllowedAt(scope) {
+			return nil, fmt.Errorf("%w: %s at %s level", settings.ErrScopeNotAllowed, key, scope)
+		}
+		if value == nil {
+			changes[key] = nil
+			continue
+		}
+
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s", settings.ErrInvalidValue, key)
+		}
+		if err := def.Validate(raw); err != nil {
+			return nil, err
+		}
+		changes[key] = raw
+	}
+	return changes, nil
+}
+
+func (u *SettingsUsecase) ensureOrganization(ctx context.Context, organizationID uuid.UUID) error {
+	_, err := u.orgRepo.GetOrganization(ctx, organizationID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOrganizationNotFound
+		}
+		return fmt.Errorf("failed to get organization: %w", err)
+	}
+	return nil
+}
+
+func (u *SettingsUsecase) ensureTenant(ctx context.Context, organizationID, tenantID uuid.UUID) error {
+	tenant, err := u.tenantRepo.GetTenant(ctx, tenantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTenantNotFound
+		}
+		return fmt.Errorf("failed to get tenant: %w", err)
+	}
+	if tenant.OrganizationID != organizationID {
+		return ErrTenantNotFound
+	}
+	return nil
+}
+
+// toSettingsResponse lists the keys that can be stored at scope or inherited
+// into it.
+func toSettingsResponse(values settings.Values, scope settings.Scope) *response.SettingsResponse {
+	items := []response.SettingResponse{}
+	for _, def := range settings.Definitions() {
+		if scope == settings.ScopeOrganization && !def.AllowedAt(settings.ScopeOrganization) {
+			continue
+		}
+		value := values[def.Key]
+		items = append(items, response.SettingResponse{
+			Key:       def.Key,
+			Value:     decode(value.Raw),
+			Source:    value.Source,
+			Version:   value.Version,
+			UpdatedAt: value.UpdatedAt,
+		})
+	}
+	return &response.SettingsResponse{Settings: items}
+}
+
+func decode(raw json.RawMessage) any {
+	var v any
+	_ = json.Unmarshal(raw, &v)
+	return v
+}
@@ -0,0 +1,95 @@
+package controller
+
+import (
+	"ai-matching/src/api/auth/subscription/requests"
+	"ai-matching/src/api/auth/subscription/response"
+	"ai-matching/src/api/auth/subscription/usecase"
+	"context"
+	"errors"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+type SubscriptionController struct {
+	usecase *usecase.SubscriptionUsecase
+}
+
+func NewSubscriptionController(subscriptionUsecase *usecase.SubscriptionUsecase) *SubscriptionController {
+	return &SubscriptionController{
+		usecase: subscriptionUsecase,
+	}
+}
+
+type ListPlansInput struct{}
+
+type ListPlansOutput struct {
+	Body response.PlanListResponse
+}
+
+func (c *SubscriptionController) ListPlans(ctx context.Context, input *ListPlansInput) (*ListPlansOutput, error) {
+	resp, err := c.usecase.ListPlans(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListPlansOutput{Body: *resp}, nil
+}
+
+type GetSubscriptionInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+}
+
+type SubscriptionOutput struct {
+	Body response.SubscriptionResponse
+}
+
+func (c *SubscriptionController) GetSubscription(ctx context.Context, input *GetSubscriptionInput) (*SubscriptionOutput, error) {
+	resp, err := c.usecase.GetSubscription(ctx, input.OrganizationID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &SubscriptionOutput{Body: *resp}, nil
+}
+
+type UpdateSubscriptionInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	Body           requests.UpdateSubscriptionRequest
+}
+
+func (c *SubscriptionController) UpdateSubscription(ctx context.Context, input *UpdateSubscriptionInput) (*SubscriptionOutput, error) {
+	resp, err := c.usecase.UpdateSubscription(ctx, input.OrganizationID, input.Body)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &SubscriptionOutput{Body: *resp}, nil
+}
+
+type GetUsageInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+}
+
+type GetUsageOutput struct {
+	Body response.UsageSummaryResponse
+}
+
+func (c *SubscriptionController) GetUsage(ctx context.Context, input *GetUsageInput) (*GetUsageOutput, error) {
+	resp, err := c.usecase.GetUsage(ctx, input.OrganizationID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &GetUsageOutput{Body: *resp}, nil
+}
+
+func toHTTPError(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrOrganizationNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, usecase.ErrPlanNotFound):
+		return huma.Error422UnprocessableEntity(err.Error())
+	}
+	return err
+}
@@ -0,0 +1,6 @@
+package requests
+
+type UpdateSubscriptionRequest struct {
+	PlanCode string `json:"planCode" minLength:"1" doc:"Plan to move the organization to"`
+	Status   string `json:"status,omitempty" enum:"active,canceled" default:"active" doc:"Subscription status; canceled organizations fall back to the free plan"`
+}
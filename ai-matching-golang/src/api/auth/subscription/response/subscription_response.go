@@ -0,0 +1,46 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PlanResponse struct {
+	Code                string `json:"code" doc:"Plan code"`
+	Name                string `json:"name" doc:"Plan name"`
+	MaxTenants          *int64 `json:"maxTenants" doc:"Maximum tenants per organization (null means unlimited)"`
+	MaxSeatsPerTenant   *int64 `json:"maxSeatsPerTenant" doc:"Maximum users per tenant (null means unlimited)"`
+	MaxAPICallsPerMonth *int64 `json:"maxApiCallsPerMonth" doc:"Maximum API calls per calendar month (null means unlimited)"`
+}
+
+type PlanListResponse struct {
+	Plans []PlanResponse `json:"plans" doc:"List of plans"`
+}
+
+type SubscriptionResponse struct {
+	OrganizationID uuid.UUID    `json:"organizationId" doc:"Organization ID"`
+	Status         string       `json:"status" doc:"Subscription status"`
+	Plan           PlanResponse `json:"plan" doc:"Plan in effect"`
+	StartedAt      *time.Time   `json:"startedAt,omitempty" doc:"When the current plan started (absent for the default plan)"`
+}
+
+type UsageMetric struct {
+	Used  int64  `json:"used" doc:"Current usage"`
+	Limit *int64 `json:"limit" doc:"Plan limit (null means unlimited)"`
+}
+
+type TenantSeatUsage struct {
+	TenantID uuid.UUID   `json:"tenantId" doc:"Tenant ID"`
+	Name     string      `json:"name" doc:"Tenant name"`
+	Seats    UsageMetric `json:"seats" doc:"Users in the tenant"`
+}
+
+type UsageSummaryResponse struct {
+	OrganizationID uuid.UUID         `json:"organizationId" doc:"Organization ID"`
+	Plan           PlanResponse      `json:"plan" doc:"Plan in effect"`
+	PeriodStart    time.Time         `json:"periodStart" doc:"Start of the current monthly API quota period"`
+	Tenants        UsageMetric       `json:"tenants" doc:"Tenants in the organization"`
+	APICalls       UsageMetric       `json:"apiCalls" doc:"API calls in the current period"`
+	Seats          []TenantSeatUsage `json:"seats" doc:"Seat usage per tenant"`
+}
@@ -0,0 +1,54 @@
+package router
+
+import (
+	"ai-matching/src/api/auth/subscription/controller"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/middleware"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterSubscriptionRoutes(api huma.API, router fiber.Router, subscriptionController *controller.SubscriptionController, userRepo repository.UserRepository) {
+	huma.Register(api, huma.Operation{
+		OperationID: "list-plans",
+		Method:      "GET",
+		Path:        "/api/v1/plans",
+		Summary:     "List plans",
+		Description: "List subscription plans and their quotas",
+		Tags:        []string{"Subscriptions"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, subscriptionController.ListPlans)
+
+	// Organization-scoped subscription endpoints
+	huma.Register(api, huma.Operation{
+		OperationID: "get-organization-subscription",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/subscription",
+		Summary:     "Get organization subscription",
+		Description: "Get the plan in effect for an organization",
+		Tags:        []string{"Subscriptions"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, subscriptionController.GetSubscription)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-organization-subscription",
+		Method:      "PUT",
+		Path:        "/api/v1/organizations/{organizationId}/subscription",
+		Summary:     "Update organization subscription",
+		Description: "Move an organization to another plan (system admins only)",
+		Tags:        []string{"Subscriptions"},
+		Security:    []map[string][]string{{"bearer": {}}},
+		Middlewares: huma.Middlewares{middleware.RequireSystemAdmin(api, userRepo)},
+	}, subscriptionController.UpdateSubscription)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-organization-usage",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/usage",
+		Summary:     "Get organization usage",
+		Description: "Summarize tenants, seats and API calls against the plan limits",
+		Tags:        []string{"Subscriptions"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, subscriptionController.GetUsage)
+}
@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/api/auth/subscription/requests"
+	"ai-matching/src/api/auth/subscription/response"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/quota"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrOrganizationNotFound = errors.New("organization not found")
+	ErrPlanNotFound         = errors.New("plan not found")
+)
+
+type SubscriptionUsecase struct {
+	subscriptionRepo repository.SubscriptionRepository
+	orgRepo          repository.OrganizationRepository
+	tenantRepo       repository.TenantRepository
+	quotas           *quota.Enforcer
+}
+
+func NewSubscriptionUsecase(subscriptionRepo repository.SubscriptionRepository, orgRepo repository.OrganizationRepository, tenantRepo repository.TenantRepository, quotas *quota.Enforcer) *SubscriptionUsecase {
+	return &SubscriptionUsecase{
+		subscriptionRepo: subscriptionRepo,
+		orgRepo:          orgRepo,
+		tenantRepo:       tenantRepo,
+		quotas:           quotas,
+	}
+}
+
+// ListPlans lists the available plans
+func (u *SubscriptionUsecase) ListPlans(ctx context.Context) (*response.PlanListResponse, error) {
+	plans, err := u.subscriptionRepo.ListPlans(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plans: %w", err)
+	}
+
+	items := make([]response.PlanResponse, len(plans))
+	for i, plan := range plans {
+		items[i] = toPlanResponse(plan)
+	}
+	return &response.PlanListResponse{Plans: items}, nil
+}
+
+// GetSubscription returns the plan in effect for an organization
+func (u *SubscriptionUsecase) GetSubscription(ctx context.Context, organizationID uuid.UUID) (*response.SubscriptionResponse, error) {
+	if err := u.ensureOrganization(ctx, organizationID); err != nil {
+		return nil, err
+	}
+
+	plan, err := u.quotas.Plan(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &response.SubscriptionResponse{
+		OrganizationID: organizationID,
+		Status:         quota.StatusActive,
+		Plan:           toPlanResponse(plan),
+	}
+
+	sub, err := u.subscriptionRepo.GetOrganizationSubscription(ctx, organizationID)
+	switch {
+	case err == nil:
+		resp.Status = sub.Status
+		resp.StartedAt = &sub.StartedAt
+	case !errors.Is(err, sql.ErrNoRows):
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+	return resp, nil
+}
+
+// UpdateSubscription moves an organization to another plan. Existing tenants
+// and seats over the new limits are kept; only further growth is blocked.
+func (u *SubscriptionUsecase) UpdateSubscription(ctx context.Context, organizationID uuid.UUID, req requests.UpdateSubscriptionRequest) (*response.SubscriptionResponse, error) {
+	if err := u.ensureOrganization(ctx, organizationID); err != nil {
+		return nil, err
+	}
+
+	if _, err := u.subscriptionRepo.GetPlan(ctx, req.PlanCode); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPlanNotFound
+		}
+		return nil, fmt.Errorf("failed to get plan: %w", err)
+	}
+
+	status := req.Status
+	if status == "" {
+		status = quota.StatusActive
+	}
+	if _, err := u.subscriptionRepo.UpsertOrganizationSubscription(ctx, organizationID, req.PlanCode, status); err != nil {
+		return nil, fmt.Errorf("failed to save subscription: %w", err)
+	}
+
+	return u.GetSubscription(ctx, organizationID)
+}
+
+// GetUsage summarizes an organization's usage against its plan limits
+func (u *SubscriptionUsecase) GetUsage(ctx context.Context, organizationID uuid.UUID) (*response.UsageSummaryResponse, error) {
+	if err := u.ensureOrganization(ctx, organizationID); err != nil {
+		return nil, err
+	}
+
+	plan, err := u.quotas.Plan(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantCount, err := u.tenantRepo.CountTenantsByOrganization(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count tenants: %w", err)
+	}
+
+	periodStart := u.quotas.PeriodStart()
+	apiCalls, err := u.subscriptionRepo.GetAPIUsage(ctx, organizationID, periodStart)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api usage: %w", err)
+	}
+
+	seatCounts, err := u.subscriptionRepo.ListTenantSeatCounts(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count seats: %w", err)
+	}
+
+	seatLimit := nullInt32(plan.MaxSeatsPerTenant)
+	seats := make([]response.TenantSeatUsage, len(seatCounts))
+	for i, row := range seatCounts {
+		seats[i] = response.TenantSeatUsage{
+			TenantID: row.ID,
+			Name:     row.Name,
+			Seats:    response.UsageMetric{Used: row.SeatCount, Limit: seatLimit},
+		}
+	}
+
+	return &response.UsageSummaryResponse{
+		OrganizationID: organizationID,
+		Plan:           toPlanResponse(plan),
+		PeriodStart:    periodStart,
+		Tenants:        response.UsageMetric{Used: tenantCount, Limit: nullInt32(plan.MaxTenants)},
+		APICalls:       response.UsageMetric{Used: apiCalls, Limit: nullInt64(plan.MaxApiCallsPerMonth)},
+		Seats:          seats,
+	}, nil
+}
+
+func (u *SubscriptionUsecase) ensureOrganization(ctx context.Context, organizationID uuid.UUID) error {
+	if _, err := u.orgRepo.GetOrganization(ctx, organizationID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOrganizationNotFound
+		}
+		return fmt.Errorf("failed to get organization: %w", err)
+	}
+	return nil
+}
+
+func toPlanResponse(plan db.Plan) response.PlanResponse {
+	return response.PlanResponse{
+		Code:                plan.Code,
+		Name:                plan.Name,
+		MaxTenants:          nullInt32(plan.MaxTenants),
+		MaxSeatsPerTenant:   nullInt32(plan.MaxSeatsPerTenant),
+		MaxAPICallsPerMonth: nullInt64(plan.MaxApiCallsPerMonth),
+	}
+}
+
+func nullInt32(v sql.NullInt32) *int64 {
+	if !v.Valid {
+		return nil
+	}
+	n := int64(v.Int32)
+	return &n
+}
+
+func nullInt64(v sql.NullInt64) *int64 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Int64
+}
@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"ai-matching/src/api/auth/task/response"
+	"ai-matching/src/api/auth/task/usecase"
+	"ai-matching/src/infrastructure/middleware"
+	"context"
+	"errors"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+type TaskController struct {
+	usecase *usecase.TaskUsecase
+}
+
+func NewTaskController(taskUsecase *usecase.TaskUsecase) *TaskController {
+	return &TaskController{
+		usecase: taskUsecase,
+	}
+}
+
+type ListTasksOutput struct {
+	Body response.TaskListResponse
+}
+
+func (c *TaskController) ListTasks(ctx context.Context, input *struct{}) (*ListTasksOutput, error) {
+	resp, err := c.usecase.ListTasks(ctx)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &ListTasksOutput{Body: *resp}, nil
+}
+
+type ListTaskRunsInput struct {
+	Name     string `path:"name" maxLength:"100" doc:"Task name"`
+	Page     int    `query:"page" minimum:"1" default:"1" doc:"Page number"`
+	PageSize int    `query:"pageSize" minimum:"1" maximum:"100" default:"20" doc:"Runs per page"`
+}
+
+type ListTaskRunsOutput struct {
+	Body response.TaskRunListResponse
+}
+
+func (c *TaskController) ListTaskRuns(ctx context.Context, input *ListTaskRunsInput) (*ListTaskRunsOutput, error) {
+	resp, err := c.usecase.ListRuns(ctx, input.Name, input.Page, input.PageSize)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &ListTaskRunsOutput{Body: *resp}, nil
+}
+
+type TriggerTaskInput struct {
+	Name string `path:"name" maxLength:"100" doc:"Task name"`
+}
+
+type TriggerTaskOutput struct {
+	Body response.TaskTriggerResponse
+}
+
+func (c *TaskController) TriggerTask(ctx context.Context, input *TriggerTaskInput) (*TriggerTaskOutput, error) {
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.TriggerTask(ctx, input.Name, actorID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &TriggerTaskOutput{Body: *resp}, nil
+}
+
+func currentUserID(ctx context.Context) (uuid.UUID, error) {
+	userCtx, err := middleware.GetUserFromContext(ctx)
+	if err != nil {
+		return uuid.Nil, huma.Error401Unauthorized("Authentication required")
+	}
+	return userCtx.UserID, nil
+}
+
+func toHTTPError(err error) error {
+	if errors.Is(err, usecase.ErrTaskNotFound) {
+		return huma.Error404NotFound(err.Error())
+	}
+	return err
+}
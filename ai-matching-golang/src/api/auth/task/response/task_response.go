@@ -0,0 +1,45 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TaskResponse struct {
+	Name        string           `json:"name" doc:"Task name, such as documents.purge"`
+	Description string           `json:"description" doc:"What the task does"`
+	Schedule    string           `json:"schedule" doc:"Cron expression in UTC"`
+	NextRunAt   *time.Time       `json:"nextRunAt,omitempty" doc:"When the task is next scheduled to run"`
+	LastRun     *TaskRunResponse `json:"lastRun,omitempty" doc:"Most recent run, scheduled or manual"`
+}
+
+type TaskListResponse struct {
+	Tasks []TaskResponse `json:"tasks" doc:"Registered tasks"`
+}
+
+type TaskRunResponse struct {
+	ID           uuid.UUID  `json:"id" doc:"Run ID"`
+	Trigger      string     `json:"trigger" doc:"schedule or manual"`
+	ScheduledFor *time.Time `json:"scheduledFor,omitempty" doc:"Slot of a scheduled run"`
+	Status       string     `json:"status" doc:"running, succeeded or failed"`
+	Summary      string     `json:"summary,omitempty" doc:"What the run did"`
+	ErrorMessage string     `json:"errorMessage,omitempty" doc:"Why the run failed"`
+	TriggeredBy  *uuid.UUID `json:"triggeredBy,omitempty" doc:"Admin who started a manual run"`
+	WorkerID     string     `json:"workerId" doc:"Process that ran the task"`
+	StartedAt    time.Time  `json:"startedAt" doc:"When the run started"`
+	FinishedAt   *time.Time `json:"finishedAt,omitempty" doc:"When the run finished"`
+}
+
+type TaskRunListResponse struct {
+	Runs     []TaskRunResponse `json:"runs" doc:"Runs, newest first"`
+	Total    int               `json:"total" doc:"Total number of runs"`
+	Page     int               `json:"page" doc:"Page number"`
+	PageSize int               `json:"pageSize" doc:"Page size"`
+}
+
+type TaskTriggerResponse struct {
+	Task     string    `json:"task" doc:"Task name"`
+	JobID    uuid.UUID `json:"jobId" doc:"Job that runs the task; the run shows in the history once a worker picks it up"`
+	QueuedAt time.Time `json:"queuedAt" doc:"When the run was queued"`
+}
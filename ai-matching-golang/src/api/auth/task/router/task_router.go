@@ -0,0 +1,46 @@
+package router
+
+import (
+	"ai-matching/src/api/auth/task/controller"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/middleware"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterTaskRoutes(api huma.API, router fiber.Router, taskController *controller.TaskController, userRepo repository.UserRepository) {
+	// System admin endpoints
+	huma.Register(api, huma.Operation{
+		OperationID: "list-tasks",
+		Method:      "GET",
+		Path:        "/api/v1/admin/tasks",
+		Summary:     "List scheduled tasks",
+		Description: "List the scheduled tasks with their cron schedule, next run and latest run (system admins only)",
+		Tags:        []string{"Tasks"},
+		Security:    []map[string][]string{{"bearer": {}}},
+		Middlewares: huma.Middlewares{middleware.RequireSystemAdmin(api, userRepo)},
+	}, taskController.ListTasks)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-task-runs",
+		Method:      "GET",
+		Path:        "/api/v1/admin/tasks/{name}/runs",
+		Summary:     "List task runs",
+		Description: "List the run history of a scheduled task, newest first (system admins only)",
+		Tags:        []string{"Tasks"},
+		Security:    []map[string][]string{{"bearer": {}}},
+		Middlewares: huma.Middlewares{middleware.RequireSystemAdmin(api, userRepo)},
+	}, taskController.ListTaskRuns)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "trigger-task",
+		Method:      "POST",
+		Path:        "/api/v1/admin/tasks/{name}/run",
+		Summary:     "Run task now",
+		Description: "Queue a run of a scheduled task outside its schedule; a worker runs it unless the task is already running (system admins only)",
+		Tags:        []string{"Tasks"},
+		Security:    []map[string][]string{{"bearer": {}}},
+		Middlewares: huma.Middlewares{middleware.RequireSystemAdmin(api, userRepo)},
+	}, taskController.TriggerTask)
+}
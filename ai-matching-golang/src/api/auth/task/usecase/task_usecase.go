@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/api/auth/task/response"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/scheduler"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+var ErrTaskNotFound = errors.New("task not found")
+
+type TaskUsecase struct {
+	scheduler *scheduler.Scheduler
+	runRepo   repository.TaskRunRepository
+	now       func() time.Time
+}
+
+func NewTaskUsecase(taskScheduler *scheduler.Scheduler, runRepo repository.TaskRunRepository) *TaskUsecase {
+	return &TaskUsecase{
+		scheduler: taskScheduler,
+		runRepo:   runRepo,
+		now:       time.Now,
+	}
+}
+
+// ListTasks returns the registered tasks with their next and latest runs
+func (u *TaskUsecase) ListTasks(ctx context.Context) (*response.TaskListResponse, error) {
+	runs, err := u.runRepo.ListLatestRuns(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list task runs: %w", err)
+	}
+	latest := make(map[string]db.TaskRun, len(runs))
+	for _, run := range runs {
+		latest[run.TaskName] = run
+	}
+
+	tasks := u.scheduler.Tasks()
+	resp := &response.TaskListResponse{
+		Tasks: make([]response.TaskResponse, 0, len(tasks)),
+	}
+	for _, task := range tasks {
+		item := response.TaskResponse{
+			Name:        task.Name,
+			Description: task.Description,
+			Schedule:    task.Schedule.String(),
+		}
+		if next := task.Schedule.Next(u.now()); !next.IsZero() {
+			item.NextRunAt = &next
+		}
+		if run, ok := latest[task.Name]; ok {
+			lastRun := toTaskRunResponse(run)
+			item.LastRun = &lastRun
+		}
+		resp.Tasks = append(resp.Tasks, item)
+	}
+	return resp, nil
+}
+
+// ListRuns returns a page of a task's run history
+func (u *TaskUsecase) ListRuns(ctx context.Context, name string, page, pageSize int) (*response.TaskRunListResponse, error) {
+	if _, ok := u.scheduler.Task(name); !ok {
+		return nil, ErrTaskNotFound
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	runs, err := u.runRepo.ListRuns(ctx, name, int32(pageSize), int32((page-1)*pageSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list task runs: %w", err)
+	}
+
+	total, err := u.runRepo.CountRuns(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count task runs: %w", err)
+	}
+
+	resp := &response.TaskRunListResponse{
+		Runs:     make([]response.TaskRunResponse, 0, len(runs)),
+		Total:    int(total),
+		Page:     page,
+		PageSize: pageSize,
+	}
+	for _, run := range runs {
+		resp.Runs = append(resp.Runs, toTaskRunResponse(run))
+	}
+	return resp, nil
+}
+
+// TriggerTask queues a run of the task outside its schedule
+func (u *TaskUsecase) TriggerTask(ctx context.Context, name string, actorID uuid.UUID) (*response.TaskTriggerResponse, error) {
+	job, err := u.scheduler.Trigger(ctx, name, actorID)
+	if err != nil {
+		if errors.Is(err, scheduler.ErrTaskNotFound) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, fmt.Errorf("failed to queue task: %w", err)
+	}
+
+	return &response.TaskTriggerResponse{
+		Task:     name,
+		JobID:    job.ID,
+		QueuedAt: job.CreatedAt,
+	}, nil
+}
+
+func toTaskRunResponse(run db.TaskRun) response.TaskRunResponse {
+	resp := response.TaskRunResponse{
+		ID:           run.ID,
+		Trigger:      run.Trigger,
+		Status:       run.Status,
+		Summary:      run.Summary.String,
+		ErrorMessage: run.ErrorMessage.String,
+		WorkerID:     run.WorkerID,
+		StartedAt:    run.StartedAt,
+	}
+	if run.ScheduledFor.Valid {
+		resp.ScheduledFor = &run.ScheduledFor.Time
+	}
+	if run.TriggeredBy.Valid {
+		resp.TriggeredBy = &run.TriggeredBy.UUID
+	}
+	if run.FinishedAt.Valid {
+		resp.FinishedAt = &run.FinishedAt.Time
+	}
+	return resp
+}
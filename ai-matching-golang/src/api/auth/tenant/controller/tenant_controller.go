@@ -4,8 +4,12 @@ import (
 	"ai-matching/src/api/auth/tenant/requests"
 	"ai-matching/src/api/auth/tenant/response"
 	"ai-matching/src/api/auth/tenant/usecase"
+	"ai-matching/src/infrastructure/middleware"
+	"ai-matching/src/infrastructure/tenancy"
 	"context"
+	"errors"
 
+	"github.com/danielgtaylor/huma/v2"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 )
@@ -55,6 +59,23 @@ func (c *TenantController) GetTenantBySubdomain(ctx context.Context, input *GetT
 	return &GetTenantBySubdomainOutput{Body: *resp}, nil
 }
 
+type GetTenantByHostInput struct {
+	Host string `path:"host" doc:"Hostname the tenant is served from"`
+}
+
+type GetTenantByHostOutput struct {
+	Body response.TenantResponse
+}
+
+func (c *TenantController) GetTenantByHost(ctx context.Context, input *GetTenantByHostInput) (*GetTenantByHostOutput, error) {
+	resp, err := c.usecase.GetTenantByHost(ctx, input.Host)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &GetTenantByHostOutput{Body: *resp}, nil
+}
+
 type ListTenantsByOrganizationInput struct {
 	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
 	Page           int       `query:"page" default:"1" doc:"Page number"`
@@ -88,7 +109,7 @@ func (c *TenantController) CreateTenant(ctx context.Context, input *CreateTenant
 	input.Body.OrganizationID = input.OrganizationID
 	resp, err := c.usecase.CreateTenant(ctx, input.Body)
 	if err != nil {
-		return nil, err
+		return nil, toHTTPError(err)
 	}
 
 	return &CreateTenantOutput{Body: *resp}, nil
@@ -107,7 +128,7 @@ type UpdateTenantOutput struct {
 func (c *TenantController) UpdateTenant(ctx context.Context, input *UpdateTenantInput) (*UpdateTenantOutput, error) {
 	resp, err := c.usecase.UpdateTenant(ctx, input.ID, input.Body)
 	if err != nil {
-		return nil, err
+		return nil, toHTTPError(err)
 	}
 
 	return &UpdateTenantOutput{Body: *resp}, nil
@@ -172,7 +193,7 @@ func (c *TenantController) CreateTenantInOrganization(ctx context.Context, input
 
 	resp, err := c.usecase.CreateTenant(ctx, input.Body)
 	if err != nil {
-		return nil, err
+		return nil, toHTTPError(err)
 	}
 
 	return &CreateTenantInOrganizationOutput{Body: *resp}, nil
@@ -202,7 +223,7 @@ func (c *TenantController) UpdateTenantInOrganization(ctx context.Context, input
 
 	resp, err := c.usecase.UpdateTenant(ctx, input.TenantID, input.Body)
 	if err != nil {
-		return nil, err
+		return nil, toHTTPError(err)
 	}
 
 	return &UpdateTenantInOrganizationOutput{Body: *resp}, nil
@@ -236,3 +257,15 @@ func (c *TenantController) DeleteTenantInOrganization(ctx context.Context, input
 
 	return &DeleteTenantInOrganizationOutput{Success: true}, nil
 }
+
+func toHTTPError(err error) error {
+	switch {
+	case errors.Is(err, tenancy.ErrInvalidSubdomain), errors.Is(err, tenancy.ErrReservedSubdomain):
+		return huma.Error422UnprocessableEntity(err.Error())
+	case errors.Is(err, tenancy.ErrSubdomainTaken):
+		return huma.Error409Conflict(err.Error())
+	case errors.Is(err, usecase.ErrTenantNotFound):
+		return huma.Error404NotFound(err.Error())
+	}
+	return middleware.QuotaError(err)
+}
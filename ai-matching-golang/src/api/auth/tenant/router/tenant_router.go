@@ -69,4 +69,15 @@ func RegisterTenantRoutes(api huma.API, router fiber.Router, tenantController *c
 		Tags:        []string{"Tenants"},
 		Security:    []map[string][]string{{"bearer": {}}},
 	}, tenantController.GetTenantBySubdomain)
+
+	// Global tenant endpoint (for custom domain lookup during login)
+	huma.Register(api, huma.Operation{
+		OperationID: "get-tenant-by-host",
+		Method:      "GET",
+		Path:        "/api/v1/tenants/host/{host}",
+		Summary:     "Get tenant by host",
+		Description: "Get tenant by hostname, either a subdomain of the base domain or a verified custom domain",
+		Tags:        []string{"Tenants"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, tenantController.GetTenantByHost)
 }
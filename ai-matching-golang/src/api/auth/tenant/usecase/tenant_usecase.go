@@ -5,18 +5,34 @@ import (
 	"ai-matching/src/api/auth/tenant/requests"
 	"ai-matching/src/api/auth/tenant/response"
 	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/quota"
+	"ai-matching/src/infrastructure/tenancy"
+	"ai-matching/src/infrastructure/webhook"
 	"context"
+	"database/sql"
+	"errors"
+	"strings"
 
 	"github.com/google/uuid"
 )
 
+var ErrTenantNotFound = errors.New("tenant not found")
+
 type TenantUsecase struct {
-	tenantRepo repository.TenantRepository
+	tenantRepo   repository.TenantRepository
+	hostResolver *tenancy.HostResolver
+	subdomains   *tenancy.SubdomainRegistry
+	quotas       *quota.Enforcer
+	webhooks     *webhook.Dispatcher
 }
 
-func NewTenantUsecase(tenantRepo repository.TenantRepository) *TenantUsecase {
+func NewTenantUsecase(tenantRepo repository.TenantRepository, hostResolver *tenancy.HostResolver, subdomains *tenancy.SubdomainRegistry, quotas *quota.Enforcer, webhooks *webhook.Dispatcher) *TenantUsecase {
 	return &TenantUsecase{
-		tenantRepo: tenantRepo,
+		tenantRepo:   tenantRepo,
+		hostResolver: hostResolver,
+		subdomains:   subdomains,
+		quotas:       quotas,
+		webhooks:     webhooks,
 	}
 }
 
@@ -37,9 +53,35 @@ func (u *TenantUsecase) GetTenant(ctx context.Context, id uuid.UUID) (*response.
 	}, nil
 }
 
+// GetTenantBySubdomain also answers for subdomains a tenant was renamed away
+// from; callers redirect when the returned subdomain differs from the request.
 func (u *TenantUsecase) GetTenantBySubdomain(ctx context.Context, subdomain string) (*response.TenantResponse, error) {
+	subdomain = strings.ToLower(strings.TrimSpace(subdomain))
 	tenant, err := u.tenantRepo.GetTenantBySubdomain(ctx, subdomain)
+	if errors.Is(err, sql.ErrNoRows) {
+		tenant, err = u.tenantRepo.GetTenantBySubdomainAlias(ctx, subdomain)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.TenantResponse{
+		ID:             tenant.ID,
+		OrganizationID: tenant.OrganizationID,
+		Name:           tenant.Name,
+		Subdomain:      tenant.Subdomain,
+		IsActive:       tenant.IsActive,
+		CreatedAt:      tenant.CreatedAt,
+		UpdatedAt:      tenant.UpdatedAt,
+	}, nil
+}
+
+func (u *TenantUsecase) GetTenantByHost(ctx context.Context, host string) (*response.TenantResponse, error) {
+	tenant, err := u.hostResolver.Resolve(ctx, host)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTenantNotFound
+		}
 		return nil, err
 	}
 
@@ -89,16 +131,30 @@ func (u *TenantUsecase) ListTenantsByOrganization(ctx context.Context, organizat
 }
 
 func (u *TenantUsecase) CreateTenant(ctx context.Context, req requests.CreateTenantRequest) (*response.TenantResponse, error) {
+	if err := u.quotas.CheckTenant(ctx, req.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	subdomain, err := u.subdomains.Claim(ctx, req.Subdomain, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+
 	tenant, err := u.tenantRepo.CreateTenant(ctx, db.CreateTenantParams{
 		OrganizationID: req.OrganizationID,
 		Name:           req.Name,
-		Subdomain:      req.Subdomain,
+		Subdomain:      subdomain,
 		IsActive:       req.IsActive,
 	})
 	if err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil, tenancy.ErrSubdomainTaken
+		}
 		return nil, err
 	}
 
+	u.webhooks.Publish(ctx, tenant.OrganizationID, webhook.EventTenantCreated, tenantData(tenant))
+
 	return &response.TenantResponse{
 		ID:             tenant.ID,
 		OrganizationID: tenant.OrganizationID,
@@ -110,14 +166,36 @@ func (u *TenantUsecase) CreateTenant(ctx context.Context, req requests.CreateTen
 	}, nil
 }
 
+// UpdateTenant keeps the previous subdomain as a redirect alias when the
+// subdomain changes.
 func (u *TenantUsecase) UpdateTenant(ctx context.Context, id uuid.UUID, req requests.UpdateTenantRequest) (*response.TenantResponse, error) {
+	current, err := u.tenantRepo.GetTenant(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	subdomain := current.Subdomain
+	if !strings.EqualFold(strings.TrimSpace(req.Subdomain), current.Subdomain) {
+		subdomain, err = u.subdomains.Claim(ctx, req.Subdomain, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	tenant, err := u.tenantRepo.UpdateTenant(ctx, db.UpdateTenantParams{
 		ID:        id,
 		Name:      req.Name,
-		Subdomain: req.Subdomain,
+		Subdomain: subdomain,
 		IsActive:  req.IsActive,
 	})
 	if err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil, tenancy.ErrSubdomainTaken
+		}
+		return nil, err
+	}
+
+	if err := u.subdomains.Rename(ctx, tenant.ID, current.Subdomain, tenant.Subdomain); err != nil {
 		return nil, err
 	}
 
@@ -133,5 +211,27 @@ func (u *TenantUsecase) UpdateTenant(ctx context.Context, id uuid.UUID, req requ
 }
 
 func (u *TenantUsecase) DeleteTenant(ctx context.Context, id uuid.UUID) error {
-	return u.tenantRepo.DeleteTenant(ctx, id)
+	tenant, err := u.tenantRepo.GetTenant(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	if err := u.tenantRepo.DeleteTenant(ctx, id); err != nil {
+		return err
+	}
+
+	u.webhooks.Publish(ctx, tenant.OrganizationID, webhook.EventTenantDeleted, tenantData(tenant))
+	return nil
+}
+
+func tenantData(tenant db.Tenant) webhook.TenantData {
+	return webhook.TenantData{
+		ID:        tenant.ID,
+		Name:      tenant.Name,
+		Subdomain: tenant.Subdomain,
+		IsActive:  tenant.IsActive,
+	}
 }
@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"ai-matching/src/api/auth/tenant_domain/requests"
+	"ai-matching/src/api/auth/tenant_domain/response"
+	"ai-matching/src/api/auth/tenant_domain/usecase"
+	"ai-matching/src/infrastructure/tenancy"
+	"context"
+	"errors"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+type TenantDomainController struct {
+	usecase *usecase.TenantDomainUsecase
+}
+
+func NewTenantDomainController(domainUsecase *usecase.TenantDomainUsecase) *TenantDomainController {
+	return &TenantDomainController{
+		usecase: domainUsecase,
+	}
+}
+
+type ListDomainsInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+}
+
+type ListDomainsOutput struct {
+	Body response.TenantDomainListResponse
+}
+
+func (c *TenantDomainController) ListDomains(ctx context.Context, input *ListDomainsInput) (*ListDomainsOutput, error) {
+	resp, err := c.usecase.ListDomains(ctx, input.OrganizationID, input.TenantID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &ListDomainsOutput{Body: *resp}, nil
+}
+
+type CreateDomainInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	Body           requests.CreateTenantDomainRequest
+}
+
+type DomainOutput struct {
+	Body response.TenantDomainResponse
+}
+
+func (c *TenantDomainController) CreateDomain(ctx context.Context, input *CreateDomainInput) (*DomainOutput, error) {
+	resp, err := c.usecase.CreateDomain(ctx, input.OrganizationID, input.TenantID, input.Body)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &DomainOutput{Body: *resp}, nil
+}
+
+type DomainInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	TenantID       uuid.UUID `path:"tenantId" doc:"Tenant ID"`
+	DomainID       uuid.UUID `path:"domainId" doc:"Domain ID"`
+}
+
+func (c *TenantDomainController) VerifyDomain(ctx context.Context, input *DomainInput) (*DomainOutput, error) {
+	resp, err := c.usecase.VerifyDomain(ctx, input.OrganizationID, input.TenantID, input.DomainID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &DomainOutput{Body: *resp}, nil
+}
+
+func (c *TenantDomainController) SetPrimaryDomain(ctx context.Context, input *DomainInput) (*DomainOutput, error) {
+	resp, err := c.usecase.SetPrimaryDomain(ctx, input.OrganizationID, input.TenantID, input.DomainID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &DomainOutput{Body: *resp}, nil
+}
+
+type DeleteDomainOutput struct {
+	Body response.MessageResponse
+}
+
+func (c *TenantDomainController) DeleteDomain(ctx context.Context, input *DomainInput) (*DeleteDomainOutput, error) {
+	if err := c.usecase.DeleteDomain(ctx, input.OrganizationID, input.TenantID, input.DomainID); err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &DeleteDomainOutput{
+		Body: response.MessageResponse{
+			Message: "Domain deleted successfully",
+		},
+	}, nil
+}
+
+func toHTTPError(err error) error {
+	switch {
+	case errors.Is(err, tenancy.ErrInvalidDomain), errors.Is(err, usecase.ErrDomainReserved):
+		return huma.Error422UnprocessableEntity(err.Error())
+	case errors.Is(err, usecase.ErrTenantNotFound), errors.Is(err, usecase.ErrDomainNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, usecase.ErrDomainAlreadyExists), errors.Is(err, usecase.ErrDomainClaimed), errors.Is(err, usecase.ErrDomainNotVerified), errors.Is(err, usecase.ErrVerificationNotFound):
+		return huma.Error409Conflict(err.Error())
+	case errors.Is(err, usecase.ErrDNSLookupFailed):
+		return huma.Error502BadGateway(err.Error())
+	}
+	return err
+}
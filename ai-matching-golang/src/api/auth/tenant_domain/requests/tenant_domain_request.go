@@ -0,0 +1,5 @@
+package requests
+
+type CreateTenantDomainRequest struct {
+	Domain string `json:"domain" validate:"required" example:"reserve.example-clinic.jp" doc:"Custom hostname to serve the tenant from"`
+}
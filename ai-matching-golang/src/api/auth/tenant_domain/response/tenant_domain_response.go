@@ -0,0 +1,33 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type VerificationRecord struct {
+	Type  string `json:"type" doc:"DNS record type"`
+	Name  string `json:"name" doc:"DNS record name"`
+	Value string `json:"value" doc:"DNS record value"`
+}
+
+type TenantDomainResponse struct {
+	ID           uuid.UUID          `json:"id" doc:"Domain ID"`
+	TenantID     uuid.UUID          `json:"tenantId" doc:"Tenant ID"`
+	Domain       string             `json:"domain" doc:"Custom hostname"`
+	IsPrimary    bool               `json:"isPrimary" doc:"Is this the tenant's primary domain"`
+	Verified     bool               `json:"verified" doc:"Has domain ownership been verified"`
+	VerifiedAt   *time.Time         `json:"verifiedAt,omitempty" doc:"Verification timestamp"`
+	Verification VerificationRecord `json:"verification" doc:"TXT record to publish to prove ownership"`
+	CreatedAt    time.Time          `json:"createdAt" doc:"Creation timestamp"`
+	UpdatedAt    time.Time          `json:"updatedAt" doc:"Last update timestamp"`
+}
+
+type TenantDomainListResponse struct {
+	Domains []TenantDomainResponse `json:"domains" doc:"List of custom domains"`
+}
+
+type MessageResponse struct {
+	Message string `json:"message" doc:"Response message"`
+}
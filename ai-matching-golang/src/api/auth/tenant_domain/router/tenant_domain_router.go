@@ -0,0 +1,65 @@
+package router
+
+import (
+	"ai-matching/src/api/auth/tenant_domain/controller"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterTenantDomainRoutes(api huma.API, router fiber.Router, domainController *controller.TenantDomainController) {
+	// List custom domains
+	huma.Register(api, huma.Operation{
+		OperationID: "list-tenant-domains",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/domains",
+		Summary:     "List tenant domains",
+		Description: "List the custom domains of a tenant",
+		Tags:        []string{"Tenant Domains"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, domainController.ListDomains)
+
+	// Add custom domain
+	huma.Register(api, huma.Operation{
+		OperationID: "create-tenant-domain",
+		Method:      "POST",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/domains",
+		Summary:     "Add tenant domain",
+		Description: "Add a custom domain to a tenant. The response contains the TXT record to publish before verifying.",
+		Tags:        []string{"Tenant Domains"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, domainController.CreateDomain)
+
+	// Verify custom domain
+	huma.Register(api, huma.Operation{
+		OperationID: "verify-tenant-domain",
+		Method:      "POST",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/domains/{domainId}/verify",
+		Summary:     "Verify tenant domain",
+		Description: "Check the verification TXT record and mark the domain verified",
+		Tags:        []string{"Tenant Domains"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, domainController.VerifyDomain)
+
+	// Select primary domain
+	huma.Register(api, huma.Operation{
+		OperationID: "set-primary-tenant-domain",
+		Method:      "PUT",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/domains/{domainId}/primary",
+		Summary:     "Set primary tenant domain",
+		Description: "Make a verified domain the tenant's primary domain",
+		Tags:        []string{"Tenant Domains"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, domainController.SetPrimaryDomain)
+
+	// Remove custom domain
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-tenant-domain",
+		Method:      "DELETE",
+		Path:        "/api/v1/organizations/{organizationId}/tenants/{tenantId}/domains/{domainId}",
+		Summary:     "Remove tenant domain",
+		Description: "Remove a custom domain from a tenant",
+		Tags:        []string{"Tenant Domains"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, domainController.DeleteDomain)
+}
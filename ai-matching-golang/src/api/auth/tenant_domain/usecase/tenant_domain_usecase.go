@@ -0,0 +1,232 @@
+package usecase
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/api/auth/tenant_domain/requests"
+	"ai-matching/src/api/auth/tenant_domain/response"
+	"ai-matching/src/domain/interface/external"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/cors"
+	"ai-matching/src/infrastructure/tenancy"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTenantNotFound       = errors.New("tenant not found")
+	ErrDomainNotFound       = errors.New("domain not found")
+	ErrDomainAlreadyExists  = errors.New("domain is already registered")
+	ErrDomainClaimed        = errors.New("domain is already verified by another tenant")
+	ErrDomainReserved       = errors.New("subdomains of the platform domain cannot be registered as custom domains")
+	ErrDomainNotVerified    = errors.New("domain must be verified first")
+	ErrVerificationNotFound = errors.New("verification TXT record was not found")
+	ErrDNSLookupFailed      = errors.New("failed to look up verification TXT record")
+)
+
+type TenantDomainUsecase struct {
+	domainRepo   repository.TenantDomainRepository
+	tenantRepo   repository.TenantRepository
+	dnsResolver  external.DNSResolver
+	corsResolver *cors.Resolver
+}
+
+func NewTenantDomainUsecase(domainRepo repository.TenantDomainRepository, tenantRepo repository.TenantRepository, dnsResolver external.DNSResolver, corsResolver *cors.Resolver) *TenantDomainUsecase {
+	return &TenantDomainUsecase{
+		domainRepo:   domainRepo,
+		tenantRepo:   tenantRepo,
+		dnsResolver:  dnsResolver,
+		corsResolver: corsResolver,
+	}
+}
+
+// ListDomains lists the custom domains of a tenant
+func (u *TenantDomainUsecase) ListDomains(ctx context.Context, organizationID, tenantID uuid.UUID) (*response.TenantDomainListResponse, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	domains, err := u.domainRepo.ListTenantDomains(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list domains: %w", err)
+	}
+
+	items := make([]response.TenantDomainResponse, len(domains))
+	for i, domain := range domains {
+		items[i] = toDomainResponse(domain)
+	}
+
+	return &response.TenantDomainListResponse{Domains: items}, nil
+}
+
+// CreateDomain registers an unverified custom domain and issues its verification token.
+// Several tenants may claim the same domain; only the first to verify it keeps it.
+func (u *TenantDomainUsecase) CreateDomain(ctx context.Context, organizationID, tenantID uuid.UUID, req requests.CreateTenantDomainRequest) (*response.TenantDomainResponse, error) {
+	domain, err := tenancy.NormalizeDomain(req.Domain)
+	if err != nil {
+		return nil, err
+	}
+	if base := tenancy.BaseDomain(); base != "" && (domain == base || strings.HasSuffix(domain, "."+base)) {
+		return nil, ErrDomainReserved
+	}
+
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return nil, err
+	}
+
+	token, err := tenancy.NewVerificationToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate verification token: %w", err)
+	}
+
+	created, err := u.domainRepo.CreateTenantDomain(ctx, db.CreateTenantDomainParams{
+		TenantID:          tenantID,
+		Domain:            domain,
+		VerificationToken: token,
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil, ErrDomainAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to create domain: %w", err)
+	}
+
+	resp := toDomainResponse(created)
+	return &resp, nil
+}
+
+// VerifyDomain checks the TXT record for the domain and marks it verified when it matches
+func (u *TenantDomainUsecase) VerifyDomain(ctx context.Context, organizationID, tenantID, domainID uuid.UUID) (*response.TenantDomainResponse, error) {
+	domain, err := u.getDomain(ctx, organizationID, tenantID, domainID)
+	if err != nil {
+		return nil, err
+	}
+	if domain.VerifiedAt.Valid {
+		resp := toDomainResponse(domain)
+		return &resp, nil
+	}
+
+	records, err := u.dnsResolver.LookupTXT(ctx, tenancy.VerificationRecordName(domain.Domain))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDNSLookupFailed, err)
+	}
+
+	expected := tenancy.VerificationRecordValue(domain.VerificationToken)
+	found := false
+	for _, record := range records {
+		if strings.TrimSpace(record) == expected {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, ErrVerificationNotFound
+	}
+
+	verified, err := u.domainRepo.MarkTenantDomainVerified(ctx, tenantID, domainID)
+	if err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil, ErrDomainClaimed
+		}
+		return nil, fmt.Errorf("failed to mark domain verified: %w", err)
+	}
+	u.invalidateOrigins(verified.Domain)
+
+	resp := toDomainResponse(verified)
+	return &resp, nil
+}
+
+// SetPrimaryDomain makes a verified domain the tenant's primary domain
+func (u *TenantDomainUsecase) SetPrimaryDomain(ctx context.Context, organizationID, tenantID, domainID uuid.UUID) (*response.TenantDomainResponse, error) {
+	domain, err := u.getDomain(ctx, organizationID, tenantID, domainID)
+	if err != nil {
+		return nil, err
+	}
+	if !domain.VerifiedAt.Valid {
+		return nil, ErrDomainNotVerified
+	}
+
+	if err := u.domainRepo.SetPrimaryTenantDomain(ctx, tenantID, domainID); err != nil {
+		return nil, fmt.Errorf("failed to set primary domain: %w", err)
+	}
+
+	domain.IsPrimary = true
+	resp := toDomainResponse(domain)
+	return &resp, nil
+}
+
+// DeleteDomain removes a custom domain from a tenant
+func (u *TenantDomainUsecase) DeleteDomain(ctx context.Context, organizationID, tenantID, domainID uuid.UUID) error {
+	domain, err := u.getDomain(ctx, organizationID, tenantID, domainID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.domainRepo.DeleteTenantDomain(ctx, tenantID, domainID); err != nil {
+		return fmt.Errorf("failed to delete domain: %w", err)
+	}
+	u.invalidateOrigins(domain.Domain)
+
+	return nil
+}
+
+func (u *TenantDomainUsecase) ensureTenant(ctx context.Context, organizationID, tenantID uuid.UUID) error {
+	tenant, err := u.tenantRepo.GetTenant(ctx, tenantID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTenantNotFound
+		}
+		return fmt.Errorf("failed to get tenant: %w", err)
+	}
+	if tenant.OrganizationID != organizationID {
+		return ErrTenantNotFound
+	}
+	return nil
+}
+
+func (u *TenantDomainUsecase) getDomain(ctx context.Context, organizationID, tenantID, domainID uuid.UUID) (db.TenantDomain, error) {
+	if err := u.ensureTenant(ctx, organizationID, tenantID); err != nil {
+		return db.TenantDomain{}, err
+	}
+
+	domain, err := u.domainRepo.GetTenantDomain(ctx, tenantID, domainID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.TenantDomain{}, ErrDomainNotFound
+		}
+		return db.TenantDomain{}, fmt.Errorf("failed to get domain: %w", err)
+	}
+	return domain, nil
+}
+
+// invalidateOrigins drops cached CORS decisions for the domain so the change
+// applies without waiting for the cache to expire.
+func (u *TenantDomainUsecase) invalidateOrigins(domain string) {
+	u.corsResolver.Invalidate("https://" + domain)
+	u.corsResolver.Invalidate("http://" + domain)
+}
+
+func toDomainResponse(domain db.TenantDomain) response.TenantDomainResponse {
+	resp := response.TenantDomainResponse{
+		ID:        domain.ID,
+		TenantID:  domain.TenantID,
+		Domain:    domain.Domain,
+		IsPrimary: domain.IsPrimary,
+		Verified:  domain.VerifiedAt.Valid,
+		Verification: response.VerificationRecord{
+			Type:  "TXT",
+			Name:  tenancy.VerificationRecordName(domain.Domain),
+			Value: tenancy.VerificationRecordValue(domain.VerificationToken),
+		},
+		CreatedAt: domain.CreatedAt,
+		UpdatedAt: domain.UpdatedAt,
+	}
+	if domain.VerifiedAt.Valid {
+		resp.VerifiedAt = &domain.VerifiedAt.Time
+	}
+	return resp
+}
@@ -4,6 +4,7 @@ import (
 	"ai-matching/src/api/auth/tenant_user/requests"
 	"ai-matching/src/api/auth/tenant_user/response"
 	"ai-matching/src/api/auth/tenant_user/usecase"
+	"ai-matching/src/infrastructure/middleware"
 	"context"
 
 	"github.com/gofiber/fiber/v2"
@@ -33,7 +34,7 @@ type AddUserToTenantOutput struct {
 func (c *TenantUserController) AddUserToTenant(ctx context.Context, input *AddUserToTenantInput) (*AddUserToTenantOutput, error) {
 	err := c.usecase.AddUserToTenant(ctx, input.TenantID, input.Body.UserID, input.Body.Role)
 	if err != nil {
-		return nil, err
+		return nil, middleware.QuotaError(err)
 	}
 
 	return &AddUserToTenantOutput{
@@ -3,6 +3,8 @@ package usecase
 import (
 	"ai-matching/db/sqlc"
 	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/quota"
+	"ai-matching/src/infrastructure/webhook"
 	"context"
 	"database/sql"
 	"errors"
@@ -15,20 +17,24 @@ type TenantUserUsecase struct {
 	tenantUserRepo repository.TenantUserRepository
 	tenantRepo     repository.TenantRepository
 	userRepo       repository.UserRepository
+	quotas         *quota.Enforcer
+	webhooks       *webhook.Dispatcher
 }
 
-func NewTenantUserUsecase(tenantUserRepo repository.TenantUserRepository, tenantRepo repository.TenantRepository, userRepo repository.UserRepository) *TenantUserUsecase {
+func NewTenantUserUsecase(tenantUserRepo repository.TenantUserRepository, tenantRepo repository.TenantRepository, userRepo repository.UserRepository, quotas *quota.Enforcer, webhooks *webhook.Dispatcher) *TenantUserUsecase {
 	return &TenantUserUsecase{
 		tenantUserRepo: tenantUserRepo,
 		tenantRepo:     tenantRepo,
 		userRepo:       userRepo,
+		quotas:         quotas,
+		webhooks:       webhooks,
 	}
 }
 
 // AddUserToTenant adds a user to a tenant with a specified role
 func (u *TenantUserUsecase) AddUserToTenant(ctx context.Context, tenantID, userID uuid.UUID, role string) error {
 	// Verify tenant exists
-	_, err := u.tenantRepo.GetTenant(ctx, tenantID)
+	tenant, err := u.tenantRepo.GetTenant(ctx, tenantID)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return errors.New("tenant not found")
@@ -37,7 +43,7 @@ func (u *TenantUserUsecase) AddUserToTenant(ctx context.Context, tenantID, userI
 	}
 
 	// Verify user exists
-	_, err = u.userRepo.GetUser(ctx, userID)
+	user, err := u.userRepo.GetUser(ctx, userID)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return errors.New("user not found")
@@ -54,8 +60,13 @@ func (u *TenantUserUsecase) AddUserToTenant(ctx context.Context, tenantID, userI
 		return errors.New("user already belongs to this tenant")
 	}
 
+	// Check the plan's seat limit
+	if err := u.quotas.CheckSeat(ctx, tenantID); err != nil {
+		return err
+	}
+
 	// Add user to tenant
-	_, err = u.tenantUserRepo.AddUserToTenant(ctx, db.AddUserToTenantParams{
+	membership, err := u.tenantUserRepo.AddUserToTenant(ctx, db.AddUserToTenantParams{
 		TenantID: tenantID,
 		UserID:   userID,
 		Role:     sql.NullString{String: role, Valid: role != ""},
@@ -64,16 +75,43 @@ func (u *TenantUserUsecase) AddUserToTenant(ctx context.Context, tenantID, userI
 		return fmt.Errorf("failed to add user to tenant: %w", err)
 	}
 
+	u.webhooks.Publish(ctx, tenant.OrganizationID, webhook.EventTenantUserAdded, webhook.TenantUserData{
+		TenantID: tenantID,
+		UserID:   userID,
+		Email:    user.Email,
+		Role:     membership.Role.String,
+	})
+
 	return nil
 }
 
 // RemoveUserFromTenant removes a user from a tenant
 func (u *TenantUserUsecase) RemoveUserFromTenant(ctx context.Context, tenantID, userID uuid.UUID) error {
-	err := u.tenantUserRepo.RemoveUserFromTenant(ctx, tenantID, userID)
+	// Nothing to remove (or announce) when the user is not a member
+	membership, err := u.tenantUserRepo.GetTenantUser(ctx, tenantID, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return fmt.Errorf("failed to get tenant user: %w", err)
+	}
+
+	tenant, err := u.tenantRepo.GetTenant(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	err = u.tenantUserRepo.RemoveUserFromTenant(ctx, tenantID, userID)
 	if err != nil {
 		return fmt.Errorf("failed to remove user from tenant: %w", err)
 	}
 
+	u.webhooks.Publish(ctx, tenant.OrganizationID, webhook.EventTenantUserRemoved, webhook.TenantUserData{
+		TenantID: tenantID,
+		UserID:   userID,
+		Role:     membership.Role.String,
+	})
+
 	return nil
 }
 
@@ -117,11 +155,33 @@ func (u *TenantUserUsecase) GetTenantsByUser(ctx context.Context, userID uuid.UU
 
 // UpdateUserRoleInTenant updates a user's role in a tenant
 func (u *TenantUserUsecase) UpdateUserRoleInTenant(ctx context.Context, tenantID, userID uuid.UUID, role string) error {
-	_, err := u.tenantUserRepo.UpdateUserRoleInTenant(ctx, tenantID, userID, role)
+	previous, err := u.tenantUserRepo.GetTenantUser(ctx, tenantID, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return errors.New("user does not belong to this tenant")
+		}
+		return fmt.Errorf("failed to get tenant user: %w", err)
+	}
+
+	tenant, err := u.tenantRepo.GetTenant(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	membership, err := u.tenantUserRepo.UpdateUserRoleInTenant(ctx, tenantID, userID, role)
 	if err != nil {
 		return fmt.Errorf("failed to update user role: %w", err)
 	}
 
+	if membership.Role.String != previous.Role.String {
+		u.webhooks.Publish(ctx, tenant.OrganizationID, webhook.EventTenantUserRoleChanged, webhook.TenantUserData{
+			TenantID:     tenantID,
+			UserID:       userID,
+			Role:         membership.Role.String,
+			PreviousRole: previous.Role.String,
+		})
+	}
+
 	return nil
 }
 
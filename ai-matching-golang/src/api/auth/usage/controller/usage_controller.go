@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"ai-matching/src/api/auth/usage/usecase"
+	"ai-matching/src/infrastructure/metering"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+type UsageController struct {
+	usecase *usecase.UsageUsecase
+}
+
+func NewUsageController(usageUsecase *usecase.UsageUsecase) *UsageController {
+	return &UsageController{
+		usecase: usageUsecase,
+	}
+}
+
+type ExportUsageInput struct {
+	Period string `query:"period" required:"true" pattern:"^[0-9]{4}-[0-9]{2}$" doc:"Billing period (YYYY-MM)"`
+	Format string `query:"format" enum:"csv,json" default:"csv" doc:"Export format"`
+}
+
+type ExportUsageOutput struct {
+	ContentType        string `header:"Content-Type"`
+	ContentDisposition string `header:"Content-Disposition"`
+	Body               []byte
+}
+
+func (c *UsageController) ExportUsage(ctx context.Context, input *ExportUsageInput) (*ExportUsageOutput, error) {
+	data, period, err := c.usecase.ExportUsage(ctx, input.Period, input.Format)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidFormat) {
+			return nil, huma.Error422UnprocessableEntity(err.Error())
+		}
+		return nil, err
+	}
+
+	contentType := "text/csv; charset=utf-8"
+	if input.Format == metering.FormatJSON {
+		contentType = "application/json"
+	}
+
+	return &ExportUsageOutput{
+		ContentType:        contentType,
+		ContentDisposition: fmt.Sprintf(`attachment; filename="usage-%s.%s"`, period, input.Format),
+		Body:               data,
+	}, nil
+}
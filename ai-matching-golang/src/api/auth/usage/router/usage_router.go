@@ -0,0 +1,24 @@
+package router
+
+import (
+	"ai-matching/src/api/auth/usage/controller"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/middleware"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterUsageRoutes(api huma.API, router fiber.Router, usageController *controller.UsageController, userRepo repository.UserRepository) {
+	// System admin endpoints
+	huma.Register(api, huma.Operation{
+		OperationID: "export-usage",
+		Method:      "GET",
+		Path:        "/api/v1/admin/usage/export",
+		Summary:     "Export usage",
+		Description: "Export metered seats, active users and API calls per organization and tenant for a billing period (system admins only)",
+		Tags:        []string{"Usage"},
+		Security:    []map[string][]string{{"bearer": {}}},
+		Middlewares: huma.Middlewares{middleware.RequireSystemAdmin(api, userRepo)},
+	}, usageController.ExportUsage)
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"ai-matching/src/infrastructure/metering"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidFormat = errors.New("format must be csv or json")
+
+type UsageUsecase struct {
+	meter *metering.Meter
+}
+
+func NewUsageUsecase(meter *metering.Meter) *UsageUsecase {
+	return &UsageUsecase{
+		meter: meter,
+	}
+}
+
+// ExportUsage renders the billing report of a period as CSV or JSON
+func (u *UsageUsecase) ExportUsage(ctx context.Context, periodValue, format string) ([]byte, metering.Period, error) {
+	if !metering.ValidFormat(format) {
+		return nil, metering.Period{}, ErrInvalidFormat
+	}
+	period, err := metering.ParsePeriod(periodValue)
+	if err != nil {
+		return nil, metering.Period{}, err
+	}
+
+	report, err := u.meter.Report(ctx, period)
+	if err != nil {
+		return nil, metering.Period{}, err
+	}
+
+	var buf bytes.Buffer
+	if err := report.Write(&buf, format); err != nil {
+		return nil, metering.Period{}, fmt.Errorf("failed to write usage report: %w", err)
+	}
+	return buf.Bytes(), period, nil
+}
@@ -6,7 +6,6 @@ import (
 	"ai-matching/src/api/auth/user/usecase"
 	"ai-matching/src/infrastructure/middleware"
 	"context"
-	"fmt"
 	"github.com/google/uuid"
 )
 
@@ -47,11 +46,9 @@ type ListUsersOutput struct {
 }
 
 func (c *UserController) ListUsers(ctx context.Context, input *ListUsersInput) (*ListUsersOutput, error) {
-	user, err := middleware.GetUserFromContext(ctx)
-	if err != nil {
+	if _, err := middleware.GetUserFromContext(ctx); err != nil {
 		return nil, err
 	}
-	fmt.Println(user.OrganizationID)
 
 	resp, err := c.usecase.ListUsers(ctx, input.Page, input.PageSize)
 	if err != nil {
@@ -72,7 +69,7 @@ type CreateUserOutput struct {
 func (c *UserController) CreateUser(ctx context.Context, input *CreateUserInput) (*CreateUserOutput, error) {
 	resp, err := c.usecase.CreateUser(ctx, input.Body)
 	if err != nil {
-		return nil, err
+		return nil, middleware.QuotaError(err)
 	}
 
 	return &CreateUserOutput{Body: *resp}, nil
@@ -165,7 +162,7 @@ type CreateOrganizationUserOutput struct {
 func (c *UserController) CreateOrganizationUser(ctx context.Context, input *CreateOrganizationUserInput) (*CreateOrganizationUserOutput, error) {
 	resp, err := c.usecase.CreateUser(ctx, input.Body)
 	if err != nil {
-		return nil, err
+		return nil, middleware.QuotaError(err)
 	}
 
 	return &CreateOrganizationUserOutput{Body: *resp}, nil
@@ -1,6 +1,10 @@
 package requests
 
-import "github.com/google/uuid"
+import (
+	"log/slog"
+
+	"github.com/google/uuid"
+)
 
 type CreateUserRequest struct {
 	Email      string     `json:"email" validate:"required,email" doc:"User email"`
@@ -15,4 +19,13 @@ type UpdateUserRequest struct {
 	Email     string `json:"email" validate:"required,email" doc:"User email"`
 	FirstName string `json:"firstName" validate:"required" doc:"User first name"`
 	LastName  string `json:"lastName" validate:"required" doc:"User last name"`
-}
\ No newline at end of file
+}
+
+// LogValue keeps the password out of structured logs.
+func (r CreateUserRequest) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("email", r.Email),
+		slog.String("firstName", r.FirstName),
+		slog.String("lastName", r.LastName),
+	)
+}
@@ -6,6 +6,8 @@ import (
 	"ai-matching/src/api/auth/user/response"
 	"ai-matching/src/domain/interface/external"
 	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/quota"
+	"ai-matching/src/infrastructure/webhook"
 	"context"
 	"database/sql"
 	"errors"
@@ -20,13 +22,17 @@ type UserUsecase struct {
 	userRepo       repository.UserRepository
 	tenantUserRepo repository.TenantUserRepository
 	cognitoClient  external.CognitoClient
+	quotas         *quota.Enforcer
+	webhooks       *webhook.Dispatcher
 }
 
-func NewUserUsecase(userRepo repository.UserRepository, tenantUserRepo repository.TenantUserRepository, cognitoClient external.CognitoClient) *UserUsecase {
+func NewUserUsecase(userRepo repository.UserRepository, tenantUserRepo repository.TenantUserRepository, cognitoClient external.CognitoClient, quotas *quota.Enforcer, webhooks *webhook.Dispatcher) *UserUsecase {
 	return &UserUsecase{
 		userRepo:       userRepo,
 		tenantUserRepo: tenantUserRepo,
 		cognitoClient:  cognitoClient,
+		quotas:         quotas,
+		webhooks:       webhooks,
 	}
 }
 
@@ -126,6 +132,13 @@ func (u *UserUsecase) ListUsers(ctx context.Context, page, pageSize int) (*respo
 }
 
 func (u *UserUsecase) CreateUser(ctx context.Context, req requests.CreateUserRequest) (*response.UserResponse, error) {
+	// Check the seat limit before anything is created in Cognito
+	if req.TenantID != nil {
+		if err := u.quotas.CheckSeat(ctx, *req.TenantID); err != nil {
+			return nil, err
+		}
+	}
+
 	// First, create user in Cognito
 	attributes := map[string]string{
 		"email":       req.Email,
@@ -135,12 +148,12 @@ func (u *UserUsecase) CreateUser(ctx context.Context, req requests.CreateUserReq
 
 	signUpResult, err := u.cognitoClient.SignUp(ctx, req.Email, req.Password, attributes)
 	if err != nil {
-		if awsErr, ok := err.(*types.UsernameExistsException); ok {
-			_ = awsErr
+		var exists *types.UsernameExistsException
+		if errors.As(err, &exists) {
 			return nil, errors.New("user already exists")
 		}
-		if awsErr, ok := err.(*types.InvalidPasswordException); ok {
-			_ = awsErr
+		var invalidPassword *types.InvalidPasswordException
+		if errors.As(err, &invalidPassword) {
 			return nil, errors.New("password does not meet requirements")
 		}
 		return nil, fmt.Errorf("failed to create user in Cognito: %w", err)
@@ -189,6 +202,23 @@ func (u *UserUsecase) CreateUser(ctx context.Context, req requests.CreateUserReq
 					Role:      role,
 				},
 			}
+
+			// Webhooks are scoped to an organization, so only users created
+			// into a tenant are announced
+			u.webhooks.Publish(ctx, tenant[0].OrganizationID, webhook.EventUserCreated, webhook.UserData{
+				ID:        user.ID,
+				Email:     user.Email,
+				FirstName: user.FirstName.String,
+				LastName:  user.LastName.String,
+				TenantID:  tenant[0].ID,
+				CreatedAt: user.CreatedAt,
+			})
+			u.webhooks.Publish(ctx, tenant[0].OrganizationID, webhook.EventTenantUserAdded, webhook.TenantUserData{
+				TenantID: tenant[0].ID,
+				UserID:   user.ID,
+				Email:    user.Email,
+				Role:     role,
+			})
 		}
 	}
 
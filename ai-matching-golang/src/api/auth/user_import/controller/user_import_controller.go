@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"ai-matching/src/api/auth/user_import/response"
+	"ai-matching/src/api/auth/user_import/usecase"
+	"ai-matching/src/infrastructure/middleware"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+type UserImportController struct {
+	usecase *usecase.UserImportUsecase
+}
+
+func NewUserImportController(importUsecase *usecase.UserImportUsecase) *UserImportController {
+	return &UserImportController{
+		usecase: importUsecase,
+	}
+}
+
+type ImportUsersInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	DryRun         bool      `query:"dryRun" doc:"Validate and preview the import without creating anything"`
+	RawBody        []byte    `contentType:"text/csv"`
+}
+
+type UserImportOutput struct {
+	Body response.UserImportResponse
+}
+
+func (c *UserImportController) ImportUsers(ctx context.Context, input *ImportUsersInput) (*UserImportOutput, error) {
+	var createdBy uuid.NullUUID
+	if userCtx, err := middleware.GetUserFromContext(ctx); err == nil {
+		createdBy = uuid.NullUUID{UUID: userCtx.UserID, Valid: true}
+	}
+
+	resp, err := c.usecase.Import(ctx, input.OrganizationID, createdBy, input.RawBody, input.DryRun)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &UserImportOutput{Body: *resp}, nil
+}
+
+type ListImportsInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+}
+
+type ListImportsOutput struct {
+	Body response.UserImportListResponse
+}
+
+func (c *UserImportController) ListImports(ctx context.Context, input *ListImportsInput) (*ListImportsOutput, error) {
+	resp, err := c.usecase.ListImports(ctx, input.OrganizationID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &ListImportsOutput{Body: *resp}, nil
+}
+
+type GetImportInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	ImportID       uuid.UUID `path:"importId" doc:"Import ID"`
+}
+
+func (c *UserImportController) GetImport(ctx context.Context, input *GetImportInput) (*UserImportOutput, error) {
+	resp, err := c.usecase.GetImport(ctx, input.OrganizationID, input.ImportID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &UserImportOutput{Body: *resp}, nil
+}
+
+func toHTTPError(err error) error {
+	var validationErr *usecase.ValidationError
+	if errors.As(err, &validationErr) {
+		details := make([]error, len(validationErr.Rows))
+		for i, row := range validationErr.Rows {
+			details[i] = &huma.ErrorDetail{
+				Location: fmt.Sprintf("body.line[%d].%s", row.Row, row.Field),
+				Message:  row.Message,
+			}
+		}
+		return huma.Error422UnprocessableEntity(err.Error(), details...)
+	}
+
+	switch {
+	case errors.Is(err, usecase.ErrOrganizationNotFound), errors.Is(err, usecase.ErrImportNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, usecase.ErrEmptyImport), errors.Is(err, usecase.ErrTooManyRows), errors.Is(err, usecase.ErrMalformedCSV):
+		return huma.Error400BadRequest(err.Error())
+	}
+	return err
+}
@@ -0,0 +1,37 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ImportRowResponse struct {
+	Row             int        `json:"row" doc:"Line number in the CSV file"`
+	Email           string     `json:"email" doc:"User email"`
+	FirstName       string     `json:"firstName" doc:"User first name"`
+	LastName        string     `json:"lastName" doc:"User last name"`
+	TenantSubdomain string     `json:"tenantSubdomain" doc:"Tenant the user joins"`
+	Role            string     `json:"role" doc:"Role in the tenant"`
+	Status          string     `json:"status" doc:"Dry run: create, add, skip or invalid. Import: pending, created, added, skipped or failed"`
+	Message         string     `json:"message,omitempty" doc:"Why the row was skipped, rejected or failed"`
+	UserID          *uuid.UUID `json:"userId,omitempty" doc:"Created or matched user"`
+}
+
+type UserImportResponse struct {
+	ID             *uuid.UUID          `json:"id,omitempty" doc:"Import job ID (absent for dry runs)"`
+	OrganizationID uuid.UUID           `json:"organizationId" doc:"Organization ID"`
+	DryRun         bool                `json:"dryRun" doc:"Whether this is a validation-only preview"`
+	Status         string              `json:"status" doc:"Dry run: valid or invalid. Import: pending, processing, completed or failed"`
+	TotalRows      int                 `json:"totalRows" doc:"Number of data rows in the CSV"`
+	SucceededRows  int                 `json:"succeededRows" doc:"Rows created, added or skipped"`
+	FailedRows     int                 `json:"failedRows" doc:"Rows that failed"`
+	Rows           []ImportRowResponse `json:"rows,omitempty" doc:"Per-row results"`
+	CreatedAt      *time.Time          `json:"createdAt,omitempty" doc:"Creation timestamp"`
+	StartedAt      *time.Time          `json:"startedAt,omitempty" doc:"When processing started"`
+	CompletedAt    *time.Time          `json:"completedAt,omitempty" doc:"When processing finished"`
+}
+
+type UserImportListResponse struct {
+	Imports []UserImportResponse `json:"imports" doc:"Recent import jobs, newest first"`
+}
@@ -0,0 +1,41 @@
+package router
+
+import (
+	"ai-matching/src/api/auth/user_import/controller"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterUserImportRoutes(api huma.API, router fiber.Router, importController *controller.UserImportController) {
+	// Bulk user import endpoints
+	huma.Register(api, huma.Operation{
+		OperationID: "import-users",
+		Method:      "POST",
+		Path:        "/api/v1/organizations/{organizationId}/users/import",
+		Summary:     "Import users from CSV",
+		Description: "Upload a CSV with email, first_name, last_name, tenant_subdomain and optional role columns. Every row is validated before anything is created; valid files are processed in the background. Use dryRun to preview the result.",
+		Tags:        []string{"User Imports"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, importController.ImportUsers)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-user-imports",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/users/imports",
+		Summary:     "List user imports",
+		Description: "List the most recent user import jobs of an organization",
+		Tags:        []string{"User Imports"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, importController.ListImports)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-user-import",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/users/imports/{importId}",
+		Summary:     "Get user import",
+		Description: "Get the status of a user import job with per-row results",
+		Tags:        []string{"User Imports"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, importController.GetImport)
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Columns of the import CSV. role is optional and defaults to member.
+const (
+	columnEmail           = "email"
+	columnFirstName       = "first_name"
+	columnLastName        = "last_name"
+	columnTenantSubdomain = "tenant_subdomain"
+	columnRole            = "role"
+)
+
+var requiredColumns = []string{columnEmail, columnFirstName, columnLastName, columnTenantSubdomain}
+
+type importRow struct {
+	number          int
+	email           string
+	firstName       string
+	lastName        string
+	tenantSubdomain string
+	role            string
+}
+
+// parseCSV reads the header and data rows. Structural problems (unreadable
+// CSV, missing columns, no or too many rows) fail the whole file.
+func parseCSV(data []byte) ([]importRow, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, ErrEmptyImport
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedCSV, err)
+	}
+
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	var missing []string
+	for _, name := range requiredColumns {
+		if _, ok := index[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: missing columns %s", ErrMalformedCSV, strings.Join(missing, ", "))
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := index[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var rows []importRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrMalformedCSV, err)
+		}
+		if len(rows) == MaxImportRows {
+			return nil, ErrTooManyRows
+		}
+
+		line, _ := reader.FieldPos(0)
+		rows = append(rows, importRow{
+			number:          line,
+			email:           strings.ToLower(field(record, columnEmail)),
+			firstName:       field(record, columnFirstName),
+			lastName:        field(record, columnLastName),
+			tenantSubdomain: strings.ToLower(field(record, columnTenantSubdomain)),
+			role:            field(record, columnRole),
+		})
+	}
+
+	if len(rows) == 0 {
+		return nil, ErrEmptyImport
+	}
+	return rows, nil
+}
@@ -0,0 +1,570 @@
+package usecase
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/api/auth/user_import/response"
+	"ai-matching/src/domain/interface/external"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/jobs"
+	"ai-matching/src/infrastructure/quota"
+	"ai-matching/src/infrastructure/webhook"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxImportRows caps a single upload so one request cannot tie up the
+	// identity provider for long.
+	MaxImportRows = 1000
+
+	defaultImportRole = "member"
+	maxRoleLength     = 50
+	listImportsLimit  = 50
+)
+
+// Import job statuses
+const (
+	StatusPending    = "pending"
+	StatusProcessing = "processing"
+	StatusCompleted  = "completed"
+	StatusFailed     = "failed"
+)
+
+// Row outcomes. Dry runs report the planned action, processed rows the
+// result.
+const (
+	RowPending = "pending"
+	RowCreated = "created"
+	RowAdded   = "added"
+	RowSkipped = "skipped"
+	RowFailed  = "failed"
+
+	ActionCreate  = "create"
+	ActionAdd     = "add"
+	ActionSkip    = "skip"
+	ActionInvalid = "invalid"
+)
+
+// JobKind is the job that works through the rows of an import
+const JobKind = "user_import.process"
+
+// Job is the payload of an import job
+type Job struct {
+	ImportID uuid.UUID `json:"importId"`
+}
+
+var (
+	ErrOrganizationNotFound = errors.New("organization not found")
+	ErrImportNotFound       = errors.New("import not found")
+	ErrEmptyImport          = errors.New("the CSV file has no data rows")
+	ErrTooManyRows          = fmt.Errorf("the CSV file has more than %d data rows", MaxImportRows)
+	ErrMalformedCSV         = errors.New("malformed CSV")
+)
+
+// RowError describes why a single CSV row was rejected.
+type RowError struct {
+	Row     int
+	Field   string
+	Message string
+}
+
+// ValidationError is returned when any row fails validation. Nothing is
+// imported in that case.
+type ValidationError struct {
+	Rows []RowError
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%d invalid rows in the CSV file", len(e.Rows))
+}
+
+type UserImportUsecase struct {
+	importRepo     repository.UserImportRepository
+	orgRepo        repository.OrganizationRepository
+	userRepo       repository.UserRepository
+	tenantRepo     repository.TenantRepository
+	tenantUserRepo repository.TenantUserRepository
+	cognitoClient  external.CognitoClient
+	quotas         *quota.Enforcer
+	queue          *jobs.Queue
+	webhooks       *webhook.Dispatcher
+}
+
+func NewUserImportUsecase(importRepo repository.UserImportRepository, orgRepo repository.OrganizationRepository, userRepo repository.UserRepository, tenantRepo repository.TenantRepository, tenantUserRepo repository.TenantUserRepository, cognitoClient external.CognitoClient, quotas *quota.Enforcer, queue *jobs.Queue, webhooks *webhook.Dispatcher) *UserImportUsecase {
+	return &UserImportUsecase{
+		importRepo:     importRepo,
+		orgRepo:        orgRepo,
+		userRepo:       userRepo,
+		tenantRepo:     tenantRepo,
+		tenantUserRepo: tenantUserRepo,
+		cognitoClient:  cognitoClient,
+		quotas:         quotas,
+		queue:          queue,
+		webhooks:       webhooks,
+	}
+}
+
+// validatedRow is a CSV row together with the tenant its subdomain resolved
+// to and the planned action.
+type validatedRow struct {
+	importRow
+	tenant  db.Tenant
+	action  string
+	message string
+}
+
+// Import validates every row of the CSV before anything is written. A dry
+// run only reports what would happen; otherwise a job is created and
+// processed in the background.
+func (u *UserImportUsecase) Import(ctx context.Context, organizationID uuid.UUID, createdBy uuid.NullUUID, data []byte, dryRun bool) (*response.UserImportResponse, error) {
+	if err := u.ensureOrganization(ctx, organizationID); err != nil {
+		return nil, err
+	}
+
+	parsed, err := parseCSV(data)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, rowErrors, err := u.validate(ctx, organizationID, parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	if dryRun {
+		return toPreviewResponse(organizationID, rows, len(rowErrors) == 0), nil
+	}
+	if len(rowErrors) > 0 {
+		return nil, &ValidationError{Rows: rowErrors}
+	}
+
+	job, err := u.importRepo.CreateUserImport(ctx, db.CreateUserImportParams{
+		OrganizationID: organizationID,
+		CreatedBy:      createdBy,
+		TotalRows:      int32(len(rows)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create import: %w", err)
+	}
+
+	for _, row := range rows {
+		err := u.importRepo.CreateUserImportRow(ctx, db.CreateUserImportRowParams{
+			ImportID:  job.ID,
+			RowNumber: int32(row.number),
+			Email:     row.email,
+			FirstName: row.firstName,
+			LastName:  row.lastName,
+			TenantID:  row.tenant.ID,
+			Role:      row.role,
+		})
+		if err != nil {
+			_ = u.importRepo.FinishUserImport(ctx, job.ID, StatusFailed)
+			return nil, fmt.Errorf("failed to create import row: %w", err)
+		}
+	}
+
+	if _, err := u.queue.Enqueue(ctx, JobKind, Job{ImportID: job.ID}, jobs.Options{OrganizationID: organizationID}); err != nil {
+		_ = u.importRepo.FinishUserImport(ctx, job.ID, StatusFailed)
+		return nil, err
+	}
+
+	resp := toImportResponse(job)
+	return &resp, nil
+}
+
+// GetImport returns an import job with its per-row results
+func (u *UserImportUsecase) GetImport(ctx context.Context, organizationID, importID uuid.UUID) (*response.UserImportResponse, error) {
+	job, err := u.importRepo.GetUserImport(ctx, organizationID, importID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrImportNotFound
+		}
+		return nil, fmt.Errorf("failed to get import: %w", err)
+	}
+
+	rows, err := u.importRepo.ListUserImportRows(ctx, job.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list import rows: %w", err)
+	}
+
+	resp := toImportResponse(job)
+	resp.Rows = make([]response.ImportRowResponse, len(rows))
+	for i, row := range rows {
+		resp.Rows[i] = response.ImportRowResponse{
+			Row:             int(row.RowNumber),
+			Email:           row.Email,
+			FirstName:       row.FirstName,
+			LastName:        row.LastName,
+			TenantSubdomain: row.TenantSubdomain,
+			Role:            row.Role,
+			Status:          row.Status,
+			Message:         row.Message.String,
+			UserID:          nullUUID(row.UserID),
+		}
+	}
+	return &resp, nil
+}
+
+// ListImports returns the most recent import jobs of an organization
+func (u *UserImportUsecase) ListImports(ctx context.Context, organizationID uuid.UUID) (*response.UserImportListResponse, error) {
+	if err := u.ensureOrganization(ctx, organizationID); err != nil {
+		return nil, err
+	}
+
+	jobs, err := u.importRepo.ListUserImports(ctx, organizationID, listImportsLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list imports: %w", err)
+	}
+
+	items := make([]response.UserImportResponse, len(jobs))
+	for i, job := range jobs {
+		items[i] = toImportResponse(job)
+	}
+	return &response.UserImportListResponse{Imports: items}, nil
+}
+
+// validate checks every row and plans its action. Row problems are collected
+// rather than returned so the caller sees all of them at once.
+func (u *UserImportUsecase) validate(ctx context.Context, organizationID uuid.UUID, parsed []importRow) ([]validatedRow, []RowError, error) {
+	tenants := make(map[string]*db.Tenant)
+	seen := make(map[string]int)
+
+	rows := make([]validatedRow, len(parsed))
+	var rowErrors []RowError
+	for i, row := range parsed {
+		if row.role == "" {
+			row.role = defaultImportRole
+		}
+		rows[i] = validatedRow{importRow: row}
+
+		var problems []RowError
+		reject := func(field, message string) {
+			problems = append(problems, RowError{Row: row.number, Field: field, Message: message})
+		}
+
+		if addr, err := mail.ParseAddress(row.email); err != nil || addr.Address != row.email {
+			reject(columnEmail, "invalid email address")
+		}
+		if row.firstName == "" {
+			reject(columnFirstName, "first name is required")
+		}
+		if row.lastName == "" {
+			reject(columnLastName, "last name is required")
+		}
+		if len(row.role) > maxRoleLength {
+			reject(columnRole, fmt.Sprintf("role must be at most %d characters", maxRoleLength))
+		}
+
+		tenant, err := u.lookupTenant(ctx, tenants, organizationID, row.tenantSubdomain)
+		if err != nil {
+			return nil, nil, err
+		}
+		if tenant == nil {
+			reject(columnTenantSubdomain, fmt.Sprintf("tenant %q not found in organization", row.tenantSubdomain))
+		} else {
+			rows[i].tenant = *tenant
+
+			key := row.email + "|" + tenant.ID.String()
+			if first, ok := seen[key]; ok {
+				reject(columnEmail, fmt.Sprintf("duplicate of row %d", first))
+			} else {
+				seen[key] = row.number
+			}
+		}
+
+		if len(problems) > 0 {
+			rows[i].action = ActionInvalid
+			rows[i].message = problems[0].Message
+			rowErrors = append(rowErrors, problems...)
+			continue
+		}
+
+		rows[i].action, rows[i].message, err = u.plan(ctx, row.email, tenant.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return rows, rowErrors, nil
+}
+
+// lookupTenant resolves a subdomain once per import. It returns nil when the
+// tenant does not exist or belongs to another organization.
+func (u *UserImportUsecase) lookupTenant(ctx context.Context, cache map[string]*db.Tenant, organizationID uuid.UUID, subdomain string) (*db.Tenant, error) {
+	if tenant, ok := cache[subdomain]; ok {
+		return tenant, nil
+	}
+
+	var found *db.Tenant
+	if subdomain != "" {
+		tenant, err := u.tenantRepo.GetTenantBySubdomain(ctx, subdomain)
+		switch {
+		case err == nil:
+			if tenant.OrganizationID == organizationID {
+				found = &tenant
+			}
+		case !errors.Is(err, sql.ErrNoRows):
+			return nil, fmt.Errorf("failed to get tenant: %w", err)
+		}
+	}
+
+	cache[subdomain] = found
+	return found, nil
+}
+
+// plan decides what processing a valid row would do
+func (u *UserImportUsecase) plan(ctx context.Context, email string, tenantID uuid.UUID) (string, string, error) {
+	user, err := u.userRepo.GetUserByEmail(ctx, email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ActionCreate, "", nil
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get user: %w", err)
+	}
+
+	member, err := u.tenantRepo.CheckUserBelongsToTenant(ctx, tenantID, user.ID)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to check tenant membership: %w", err)
+	}
+	if member {
+		return ActionSkip, "user is already a member of the tenant", nil
+	}
+	return ActionAdd, "existing user will be added to the tenant", nil
+}
+
+// Process works through the rows of a pending or failed import one at a
+// time. A failing row is recorded and does not stop the rest of the import;
+// rows already done are skipped when a failed or interrupted import is run
+// again.
+func (u *UserImportUsecase) Process(ctx context.Context, importID uuid.UUID) error {
+	started, err := u.importRepo.StartUserImport(ctx, importID)
+	if err != nil {
+		return fmt.Errorf("failed to start user import: %w", err)
+	}
+	if !started {
+		return nil
+	}
+
+	rows, err := u.importRepo.ListUserImportRows(ctx, importID)
+	if err != nil {
+		if err := u.importRepo.FinishUserImport(ctx, importID, StatusFailed); err != nil {
+			slog.ErrorContext(ctx, "failed to record user import failure", slog.String("import_id", importID.String()), slog.Any("error", err))
+		}
+		return fmt.Errorf("failed to list user import rows: %w", err)
+	}
+
+	for _, row := range rows {
+		if row.Status != RowPending {
+			continue
+		}
+
+		rowStatus, userID, err := u.importRow(ctx, row)
+		params := db.UpdateUserImportRowParams{
+			ID:     row.ID,
+			Status: rowStatus,
+			UserID: userID,
+		}
+		if err != nil {
+			params.Status = RowFailed
+			params.Message = sql.NullString{String: err.Error(), Valid: true}
+		} else if rowStatus == RowSkipped {
+			params.Message = sql.NullString{String: "user is already a member of the tenant", Valid: true}
+		}
+
+		if err := u.importRepo.UpdateUserImportRow(ctx, params); err != nil {
+			slog.ErrorContext(ctx, "failed to update user import row", slog.String("import_id", importID.String()), slog.Any("error", err))
+		}
+	}
+
+	if err := u.importRepo.FinishUserImport(ctx, importID, StatusCompleted); err != nil {
+		return fmt.Errorf("failed to finish user import: %w", err)
+	}
+	return nil
+}
+
+// importRow creates the user if needed and adds them to the tenant
+func (u *UserImportUsecase) importRow(ctx context.Context, row db.ListUserImportRowsRow) (string, uuid.NullUUID, error) {
+	user, err := u.userRepo.GetUserByEmail(ctx, row.Email)
+	switch {
+	case err == nil:
+		member, err := u.tenantRepo.CheckUserBelongsToTenant(ctx, row.TenantID, user.ID)
+		if err != nil {
+			return "", uuid.NullUUID{}, fmt.Errorf("failed to check tenant membership: %w", err)
+		}
+		if member {
+			return RowSkipped, uuid.NullUUID{UUID: user.ID, Valid: true}, nil
+		}
+		if err := u.addToTenant(ctx, row, user.ID); err != nil {
+			return "", uuid.NullUUID{UUID: user.ID, Valid: true}, err
+		}
+		return RowAdded, uuid.NullUUID{UUID: user.ID, Valid: true}, nil
+
+	case !errors.Is(err, sql.ErrNoRows):
+		return "", uuid.NullUUID{}, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// Check the seat limit before anything is created in Cognito
+	if err := u.quotas.CheckSeat(ctx, row.TenantID); err != nil {
+		return "", uuid.NullUUID{}, err
+	}
+
+	out, err := u.cognitoClient.AdminCreateUser(ctx, row.Email, map[string]string{
+		"given_name":  row.FirstName,
+		"family_name": row.LastName,
+	})
+	if err != nil {
+		var exists *types.UsernameExistsException
+		if errors.As(err, &exists) {
+			return "", uuid.NullUUID{}, errors.New("user already exists in the identity provider")
+		}
+		return "", uuid.NullUUID{}, fmt.Errorf("failed to create user in Cognito: %w", err)
+	}
+
+	var cognitoID string
+	if out.User != nil {
+		for _, attr := range out.User.Attributes {
+			if aws.ToString(attr.Name) == "sub" {
+				cognitoID = aws.ToString(attr.Value)
+			}
+		}
+	}
+	if cognitoID == "" {
+		return "", uuid.NullUUID{}, errors.New("identity provider returned no user ID")
+	}
+
+	user, err = u.userRepo.CreateUser(ctx, db.CreateUserParams{
+		CognitoID: cognitoID,
+		Email:     row.Email,
+		FirstName: sql.NullString{String: row.FirstName, Valid: true},
+		LastName:  sql.NullString{String: row.LastName, Valid: true},
+	})
+	if err != nil {
+		return "", uuid.NullUUID{}, fmt.Errorf("failed to create user in database: %w", err)
+	}
+
+	_, err = u.tenantUserRepo.AddUserToTenant(ctx, db.AddUserToTenantParams{
+		TenantID: row.TenantID,
+		UserID:   user.ID,
+		Role:     sql.NullString{String: row.Role, Valid: true},
+	})
+	if err != nil {
+		return "", uuid.NullUUID{UUID: user.ID, Valid: true}, fmt.Errorf("failed to associate user with tenant: %w", err)
+	}
+
+	u.webhooks.Publish(ctx, row.OrganizationID, webhook.EventUserCreated, webhook.UserData{
+		ID:        user.ID,
+		Email:     user.Email,
+		FirstName: user.FirstName.String,
+		LastName:  user.LastName.String,
+		TenantID:  row.TenantID,
+		CreatedAt: user.CreatedAt,
+	})
+	u.publishAdded(ctx, row, user.ID)
+	return RowCreated, uuid.NullUUID{UUID: user.ID, Valid: true}, nil
+}
+
+func (u *UserImportUsecase) addToTenant(ctx context.Context, row db.ListUserImportRowsRow, userID uuid.UUID) error {
+	if err := u.quotas.CheckSeat(ctx, row.TenantID); err != nil {
+		return err
+	}
+
+	_, err := u.tenantUserRepo.AddUserToTenant(ctx, db.AddUserToTenantParams{
+		TenantID: row.TenantID,
+		UserID:   userID,
+		Role:     sql.NullString{String: row.Role, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to associate user with tenant: %w", err)
+	}
+
+	u.publishAdded(ctx, row, userID)
+	return nil
+}
+
+func (u *UserImportUsecase) publishAdded(ctx context.Context, row db.ListUserImportRowsRow, userID uuid.UUID) {
+	u.webhooks.Publish(ctx, row.OrganizationID, webhook.EventTenantUserAdded, webhook.TenantUserData{
+		TenantID: row.TenantID,
+		UserID:   userID,
+		Email:    row.Email,
+		Role:     row.Role,
+	})
+}
+
+func (u *UserImportUsecase) ensureOrganization(ctx context.Context, organizationID uuid.UUID) error {
+	if _, err := u.orgRepo.GetOrganization(ctx, organizationID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOrganizationNotFound
+		}
+		return fmt.Errorf("failed to get organization: %w", err)
+	}
+	return nil
+}
+
+func toPreviewResponse(organizationID uuid.UUID, rows []validatedRow, valid bool) *response.UserImportResponse {
+	resp := &response.UserImportResponse{
+		OrganizationID: organizationID,
+		DryRun:         true,
+		Status:         "valid",
+		TotalRows:      len(rows),
+		Rows:           make([]response.ImportRowResponse, len(rows)),
+	}
+	if !valid {
+		resp.Status = "invalid"
+	}
+
+	for i, row := range rows {
+		if row.action == ActionInvalid {
+			resp.FailedRows++
+		} else {
+			resp.SucceededRows++
+		}
+		resp.Rows[i] = response.ImportRowResponse{
+			Row:             row.number,
+			Email:           row.email,
+			FirstName:       row.firstName,
+			LastName:        row.lastName,
+			TenantSubdomain: row.tenantSubdomain,
+			Role:            row.role,
+			Status:          row.action,
+			Message:         row.message,
+		}
+	}
+	return resp
+}
+
+func toImportResponse(job db.UserImport) response.UserImportResponse {
+	return response.UserImportResponse{
+		ID:             &job.ID,
+		OrganizationID: job.OrganizationID,
+		Status:         job.Status,
+		TotalRows:      int(job.TotalRows),
+		SucceededRows:  int(job.SucceededRows),
+		FailedRows:     int(job.FailedRows),
+		CreatedAt:      &job.CreatedAt,
+		StartedAt:      nullTime(job.StartedAt),
+		CompletedAt:    nullTime(job.CompletedAt),
+	}
+}
+
+func nullTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+func nullUUID(id uuid.NullUUID) *uuid.UUID {
+	if !id.Valid {
+		return nil
+	}
+	return &id.UUID
+}
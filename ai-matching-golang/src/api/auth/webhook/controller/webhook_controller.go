@@ -0,0 +1,219 @@
+package controller
+
+import (
+	"ai-matching/src/api/auth/webhook/requests"
+	"ai-matching/src/api/auth/webhook/response"
+	"ai-matching/src/api/auth/webhook/usecase"
+	"ai-matching/src/infrastructure/middleware"
+	"ai-matching/src/infrastructure/webhook"
+	"context"
+	"errors"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+type WebhookController struct {
+	usecase *usecase.WebhookUsecase
+}
+
+func NewWebhookController(webhookUsecase *usecase.WebhookUsecase) *WebhookController {
+	return &WebhookController{
+		usecase: webhookUsecase,
+	}
+}
+
+type ListWebhooksInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+}
+
+type ListWebhooksOutput struct {
+	Body response.WebhookListResponse
+}
+
+func (c *WebhookController) ListWebhooks(ctx context.Context, input *ListWebhooksInput) (*ListWebhooksOutput, error) {
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.ListWebhooks(ctx, input.OrganizationID, actorID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &ListWebhooksOutput{Body: *resp}, nil
+}
+
+type CreateWebhookInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	Body           requests.CreateWebhookRequest
+}
+
+type WebhookSecretOutput struct {
+	Body response.WebhookSecretResponse
+}
+
+func (c *WebhookController) CreateWebhook(ctx context.Context, input *CreateWebhookInput) (*WebhookSecretOutput, error) {
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.CreateWebhook(ctx, input.OrganizationID, actorID, input.Body)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &WebhookSecretOutput{Body: *resp}, nil
+}
+
+type WebhookInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	WebhookID      uuid.UUID `path:"webhookId" doc:"Webhook endpoint ID"`
+}
+
+type WebhookOutput struct {
+	Body response.WebhookResponse
+}
+
+func (c *WebhookController) GetWebhook(ctx context.Context, input *WebhookInput) (*WebhookOutput, error) {
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.GetWebhook(ctx, input.OrganizationID, actorID, input.WebhookID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &WebhookOutput{Body: *resp}, nil
+}
+
+type UpdateWebhookInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	WebhookID      uuid.UUID `path:"webhookId" doc:"Webhook endpoint ID"`
+	Body           requests.UpdateWebhookRequest
+}
+
+func (c *WebhookController) UpdateWebhook(ctx context.Context, input *UpdateWebhookInput) (*WebhookOutput, error) {
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.UpdateWebhook(ctx, input.OrganizationID, actorID, input.WebhookID, input.Body)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &WebhookOutput{Body: *resp}, nil
+}
+
+type DeleteWebhookOutput struct {
+	Body response.MessageResponse
+}
+
+func (c *WebhookController) DeleteWebhook(ctx context.Context, input *WebhookInput) (*DeleteWebhookOutput, error) {
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.usecase.DeleteWebhook(ctx, input.OrganizationID, actorID, input.WebhookID); err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &DeleteWebhookOutput{
+		Body: response.MessageResponse{
+			Message: "Webhook deleted successfully",
+		},
+	}, nil
+}
+
+func (c *WebhookController) RotateSecret(ctx context.Context, input *WebhookInput) (*WebhookSecretOutput, error) {
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.RotateSecret(ctx, input.OrganizationID, actorID, input.WebhookID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &WebhookSecretOutput{Body: *resp}, nil
+}
+
+type ListDeliveriesInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	WebhookID      uuid.UUID `path:"webhookId" doc:"Webhook endpoint ID"`
+	Status         string    `query:"status" enum:"pending,succeeded,failed" doc:"Only deliveries with this status"`
+	Page           int       `query:"page" minimum:"1" default:"1" doc:"Page number"`
+	PageSize       int       `query:"pageSize" minimum:"1" maximum:"100" default:"20" doc:"Deliveries per page"`
+}
+
+type ListDeliveriesOutput struct {
+	Body response.WebhookDeliveryListResponse
+}
+
+func (c *WebhookController) ListDeliveries(ctx context.Context, input *ListDeliveriesInput) (*ListDeliveriesOutput, error) {
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.ListDeliveries(ctx, input.OrganizationID, actorID, input.WebhookID, input.Status, input.Page, input.PageSize)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &ListDeliveriesOutput{Body: *resp}, nil
+}
+
+type RedeliverInput struct {
+	OrganizationID uuid.UUID `path:"organizationId" doc:"Organization ID"`
+	WebhookID      uuid.UUID `path:"webhookId" doc:"Webhook endpoint ID"`
+	DeliveryID     uuid.UUID `path:"deliveryId" doc:"Delivery to send again"`
+}
+
+type DeliveryOutput struct {
+	Body response.WebhookDeliveryResponse
+}
+
+func (c *WebhookController) Redeliver(ctx context.Context, input *RedeliverInput) (*DeliveryOutput, error) {
+	actorID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.usecase.Redeliver(ctx, input.OrganizationID, actorID, input.WebhookID, input.DeliveryID)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &DeliveryOutput{Body: *resp}, nil
+}
+
+func currentUserID(ctx context.Context) (uuid.UUID, error) {
+	userCtx, err := middleware.GetUserFromContext(ctx)
+	if err != nil {
+		return uuid.Nil, huma.Error401Unauthorized("Authentication required")
+	}
+	return userCtx.UserID, nil
+}
+
+func toHTTPError(err error) error {
+	switch {
+	case errors.Is(err, webhook.ErrInvalidURL), errors.Is(err, webhook.ErrPrivateURL), errors.Is(err, usecase.ErrInvalidEventType):
+		return huma.Error422UnprocessableEntity(err.Error())
+	case errors.Is(err, usecase.ErrOrganizationNotFound), errors.Is(err, usecase.ErrWebhookNotFound), errors.Is(err, usecase.ErrDeliveryNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, usecase.ErrForbidden):
+		return huma.Error403Forbidden(err.Error())
+	case errors.Is(err, usecase.ErrWebhookInactive):
+		return huma.Error409Conflict(err.Error())
+	}
+	return err
+}
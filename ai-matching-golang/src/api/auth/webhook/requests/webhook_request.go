@@ -0,0 +1,14 @@
+package requests
+
+type CreateWebhookRequest struct {
+	URL         string   `json:"url" validate:"required" maxLength:"2048" example:"https://hooks.example-clinic.jp/ai-matching" doc:"HTTPS URL that receives signed event POSTs"`
+	Description string   `json:"description,omitempty" maxLength:"500" doc:"Note on what the endpoint is for"`
+	EventTypes  []string `json:"eventTypes" validate:"required" minItems:"1" enum:"user.created,tenant.created,tenant.deleted,tenant_user.added,tenant_user.role_changed,tenant_user.removed" doc:"Event types to send to the endpoint"`
+}
+
+type UpdateWebhookRequest struct {
+	URL         string   `json:"url" validate:"required" maxLength:"2048" doc:"HTTPS URL that receives signed event POSTs"`
+	Description string   `json:"description,omitempty" maxLength:"500" doc:"Note on what the endpoint is for"`
+	EventTypes  []string `json:"eventTypes" validate:"required" minItems:"1" enum:"user.created,tenant.created,tenant.deleted,tenant_user.added,tenant_user.role_changed,tenant_user.removed" doc:"Event types to send to the endpoint"`
+	IsActive    bool     `json:"isActive" doc:"Inactive endpoints receive no new events, and their pending deliveries fail"`
+}
@@ -0,0 +1,59 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookResponse struct {
+	ID                      uuid.UUID  `json:"id" doc:"Webhook endpoint ID"`
+	OrganizationID          uuid.UUID  `json:"organizationId" doc:"Organization ID"`
+	URL                     string     `json:"url" doc:"URL that receives events"`
+	Description             string     `json:"description" doc:"Note on what the endpoint is for"`
+	EventTypes              []string   `json:"eventTypes" doc:"Event types sent to the endpoint"`
+	IsActive                bool       `json:"isActive" doc:"Whether the endpoint receives events"`
+	PreviousSecretExpiresAt *time.Time `json:"previousSecretExpiresAt,omitempty" doc:"Until when deliveries are also signed with the secret in use before the last rotation"`
+	CreatedAt               time.Time  `json:"createdAt" doc:"Creation timestamp"`
+	UpdatedAt               time.Time  `json:"updatedAt" doc:"Last update timestamp"`
+}
+
+// WebhookSecretResponse is returned when a secret is created or rotated,
+// the only times it is shown
+type WebhookSecretResponse struct {
+	WebhookResponse
+	Secret string `json:"secret" doc:"Signing secret; verify the Webhook-Signature header with it. It is not shown again."`
+}
+
+type WebhookListResponse struct {
+	Webhooks []WebhookResponse `json:"webhooks" doc:"Webhook endpoints"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             uuid.UUID      `json:"id" doc:"Delivery ID, sent in the Webhook-Delivery header"`
+	WebhookID      uuid.UUID      `json:"webhookId" doc:"Webhook endpoint ID"`
+	EventID        uuid.UUID      `json:"eventId" doc:"Event ID, sent in the Webhook-Id header; the same for retries and redeliveries"`
+	EventType      string         `json:"eventType" doc:"Event type"`
+	Payload        map[string]any `json:"payload" doc:"Request body sent to the endpoint"`
+	Status         string         `json:"status" doc:"pending, succeeded or failed; a pending delivery with attempts is waiting to be retried"`
+	Attempts       int            `json:"attempts" doc:"Attempts made so far"`
+	ResponseStatus *int           `json:"responseStatus,omitempty" doc:"HTTP status of the latest response"`
+	ResponseBody   string         `json:"responseBody,omitempty" doc:"Start of the latest response body"`
+	ErrorMessage   string         `json:"errorMessage,omitempty" doc:"Why the latest attempt failed"`
+	DurationMs     *int           `json:"durationMs,omitempty" doc:"Duration of the latest attempt in milliseconds"`
+	RedeliveryOf   *uuid.UUID     `json:"redeliveryOf,omitempty" doc:"Delivery this one resends"`
+	LastAttemptAt  *time.Time     `json:"lastAttemptAt,omitempty" doc:"When the latest attempt was made"`
+	DeliveredAt    *time.Time     `json:"deliveredAt,omitempty" doc:"When the endpoint accepted the event"`
+	CreatedAt      time.Time      `json:"createdAt" doc:"When the delivery was queued"`
+}
+
+type WebhookDeliveryListResponse struct {
+	Deliveries []WebhookDeliveryResponse `json:"deliveries" doc:"Deliveries, newest first"`
+	Total      int                       `json:"total" doc:"Total number of matching deliveries"`
+	Page       int                       `json:"page" doc:"Page number"`
+	PageSize   int                       `json:"pageSize" doc:"Page size"`
+}
+
+type MessageResponse struct {
+	Message string `json:"message" doc:"Response message"`
+}
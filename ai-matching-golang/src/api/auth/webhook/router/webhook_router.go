@@ -0,0 +1,98 @@
+package router
+
+import (
+	"ai-matching/src/api/auth/webhook/controller"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterWebhookRoutes(api huma.API, router fiber.Router, webhookController *controller.WebhookController) {
+	// List webhook endpoints
+	huma.Register(api, huma.Operation{
+		OperationID: "list-webhooks",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/webhooks",
+		Summary:     "List webhooks",
+		Description: "List the endpoints that receive the organization's events (organization owners and admins only)",
+		Tags:        []string{"Webhooks"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, webhookController.ListWebhooks)
+
+	// Register webhook endpoint
+	huma.Register(api, huma.Operation{
+		OperationID: "create-webhook",
+		Method:      "POST",
+		Path:        "/api/v1/organizations/{organizationId}/webhooks",
+		Summary:     "Create webhook",
+		Description: "Register an endpoint for user, tenant and membership events. Each request is signed with HMAC-SHA256 in the Webhook-Signature header; the secret is only returned now.",
+		Tags:        []string{"Webhooks"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, webhookController.CreateWebhook)
+
+	// Get webhook endpoint
+	huma.Register(api, huma.Operation{
+		OperationID: "get-webhook",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/webhooks/{webhookId}",
+		Summary:     "Get webhook",
+		Description: "Get a webhook endpoint of the organization",
+		Tags:        []string{"Webhooks"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, webhookController.GetWebhook)
+
+	// Update webhook endpoint
+	huma.Register(api, huma.Operation{
+		OperationID: "update-webhook",
+		Method:      "PUT",
+		Path:        "/api/v1/organizations/{organizationId}/webhooks/{webhookId}",
+		Summary:     "Update webhook",
+		Description: "Change the URL, description and event types of an endpoint, or pause it",
+		Tags:        []string{"Webhooks"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, webhookController.UpdateWebhook)
+
+	// Delete webhook endpoint
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-webhook",
+		Method:      "DELETE",
+		Path:        "/api/v1/organizations/{organizationId}/webhooks/{webhookId}",
+		Summary:     "Delete webhook",
+		Description: "Delete an endpoint and its delivery log",
+		Tags:        []string{"Webhooks"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, webhookController.DeleteWebhook)
+
+	// Rotate signing secret
+	huma.Register(api, huma.Operation{
+		OperationID: "rotate-webhook-secret",
+		Method:      "POST",
+		Path:        "/api/v1/organizations/{organizationId}/webhooks/{webhookId}/rotate-secret",
+		Summary:     "Rotate webhook secret",
+		Description: "Replace the signing secret of an endpoint. Until previousSecretExpiresAt, requests carry a signature for each secret.",
+		Tags:        []string{"Webhooks"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, webhookController.RotateSecret)
+
+	// List deliveries
+	huma.Register(api, huma.Operation{
+		OperationID: "list-webhook-deliveries",
+		Method:      "GET",
+		Path:        "/api/v1/organizations/{organizationId}/webhooks/{webhookId}/deliveries",
+		Summary:     "List webhook deliveries",
+		Description: "List the events sent to an endpoint with the outcome of their latest attempt, newest first",
+		Tags:        []string{"Webhooks"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, webhookController.ListDeliveries)
+
+	// Redeliver
+	huma.Register(api, huma.Operation{
+		OperationID: "redeliver-webhook",
+		Method:      "POST",
+		Path:        "/api/v1/organizations/{organizationId}/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver",
+		Summary:     "Redeliver webhook",
+		Description: "Send the event of a delivery again as a new delivery; receivers see the same Webhook-Id",
+		Tags:        []string{"Webhooks"},
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, webhookController.Redeliver)
+}
@@ -0,0 +1,371 @@
+package usecase
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/api/auth/webhook/requests"
+	"ai-matching/src/api/auth/webhook/response"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/webhook"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+var (
+	ErrOrganizationNotFound = errors.New("organization not found")
+	ErrWebhookNotFound      = errors.New("webhook not found")
+	ErrDeliveryNotFound     = errors.New("webhook delivery not found")
+	ErrWebhookInactive      = errors.New("webhook is inactive")
+	ErrInvalidEventType     = errors.New("unknown webhook event type")
+	ErrForbidden            = errors.New("only organization owners and admins can manage webhooks")
+)
+
+type WebhookUsecase struct {
+	webhookRepo repository.WebhookRepository
+	orgRepo     repository.OrganizationRepository
+	memberRepo  repository.OrganizationMemberRepository
+	dispatcher  *webhook.Dispatcher
+}
+
+func NewWebhookUsecase(webhookRepo repository.WebhookRepository, orgRepo repository.OrganizationRepository, memberRepo repository.OrganizationMemberRepository, dispatcher *webhook.Dispatcher) *WebhookUsecase {
+	return &WebhookUsecase{
+		webhookRepo: webhookRepo,
+		orgRepo:     orgRepo,
+		memberRepo:  memberRepo,
+		dispatcher:  dispatcher,
+	}
+}
+
+// ListWebhooks lists the webhook endpoints of an organization
+func (u *WebhookUsecase) ListWebhooks(ctx context.Context, organizationID, actorID uuid.UUID) (*response.WebhookListResponse, error) {
+	if err := u.authorize(ctx, organizationID, actorID); err != nil {
+		return nil, err
+	}
+
+	endpoints, err := u.webhookRepo.ListEndpoints(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	items := make([]response.WebhookResponse, len(endpoints))
+	for i, endpoint := range endpoints {
+		items[i] = toWebhookResponse(endpoint)
+	}
+	return &response.WebhookListResponse{Webhooks: items}, nil
+}
+
+// CreateWebhook registers an endpoint and returns its signing secret
+func (u *WebhookUsecase) CreateWebhook(ctx context.Context, organizationID, actorID uuid.UUID, req requests.CreateWebhookRequest) (*response.WebhookSecretResponse, error) {
+	url, eventTypes, err := u.validate(req.URL, req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.authorize(ctx, organizationID, actorID); err != nil {
+		return nil, err
+	}
+
+	secret, err := webhook.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, err := u.webhookRepo.CreateEndpoint(ctx, db.CreateWebhookEndpointParams{
+		OrganizationID: organizationID,
+		Url:            url,
+		Description:    req.Description,
+		EventTypes:     eventTypes,
+		Secret:         secret,
+		CreatedBy:      uuid.NullUUID{UUID: actorID, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	return &response.WebhookSecretResponse{
+		WebhookResponse: toWebhookResponse(endpoint),
+		Secret:          endpoint.Secret,
+	}, nil
+}
+
+// GetWebhook returns one webhook endpoint
+func (u *WebhookUsecase) GetWebhook(ctx context.Context, organizationID, actorID, webhookID uuid.UUID) (*response.WebhookResponse, error) {
+	if err := u.authorize(ctx, organizationID, actorID); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := u.getEndpoint(ctx, organizationID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := toWebhookResponse(endpoint)
+	return &resp, nil
+}
+
+// UpdateWebhook replaces the URL, description, event types and active flag
+// of an endpoint
+func (u *WebhookUsecase) UpdateWebhook(ctx context.Context, organizationID, actorID, webhookID uuid.UUID, req requests.UpdateWebhookRequest) (*response.WebhookResponse, error) {
+	url, eventTypes, err := u.validate(req.URL, req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.authorize(ctx, organizationID, actorID); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := u.webhookRepo.UpdateEndpoint(ctx, db.UpdateWebhookEndpointParams{
+		ID:             webhookID,
+		OrganizationID: organizationID,
+		Url:            url,
+		Description:    req.Description,
+		EventTypes:     eventTypes,
+		IsActive:       req.IsActive,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrWebhookNotFound
+		}
+		return nil, fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	resp := toWebhookResponse(endpoint)
+	return &resp, nil
+}
+
+// DeleteWebhook removes an endpoint along with its delivery log
+func (u *WebhookUsecase) DeleteWebhook(ctx context.Context, organizationID, actorID, webhookID uuid.UUID) error {
+	if err := u.authorize(ctx, organizationID, actorID); err != nil {
+		return err
+	}
+
+	deleted, err := u.webhookRepo.DeleteEndpoint(ctx, organizationID, webhookID)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+	if !deleted {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
+
+// RotateSecret gives an endpoint a new signing secret. The old secret keeps
+// signing alongside it for a while so the receiver can switch over.
+func (u *WebhookUsecase) RotateSecret(ctx context.Context, organizationID, actorID, webhookID uuid.UUID) (*response.WebhookSecretResponse, error) {
+	if err := u.authorize(ctx, organizationID, actorID); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := u.dispatcher.RotateSecret(ctx, organizationID, webhookID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrWebhookNotFound
+		}
+		return nil, fmt.Errorf("failed to rotate webhook secret: %w", err)
+	}
+
+	return &response.WebhookSecretResponse{
+		WebhookResponse: toWebhookResponse(endpoint),
+		Secret:          endpoint.Secret,
+	}, nil
+}
+
+// ListDeliveries returns a page of an endpoint's delivery log, optionally
+// narrowed to one status
+func (u *WebhookUsecase) ListDeliveries(ctx context.Context, organizationID, actorID, webhookID uuid.UUID, status string, page, pageSize int) (*response.WebhookDeliveryListResponse, error) {
+	if err := u.authorize(ctx, organizationID, actorID); err != nil {
+		return nil, err
+	}
+	if _, err := u.getEndpoint(ctx, organizationID, webhookID); err != nil {
+		return nil, err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	statusFilter := sql.NullString{String: status, Valid: status != ""}
+
+	deliveries, err := u.webhookRepo.ListDeliveries(ctx, db.ListWebhookDeliveriesParams{
+		EndpointID: webhookID,
+		Status:     statusFilter,
+		RowOffset:  int32((page - 1) * pageSize),
+		RowLimit:   int32(pageSize),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+
+	total, err := u.webhookRepo.CountDeliveries(ctx, db.CountWebhookDeliveriesParams{
+		EndpointID: webhookID,
+		Status:     statusFilter,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count webhook deliveries: %w", err)
+	}
+
+	resp := &response.WebhookDeliveryListResponse{
+		Deliveries: make([]response.WebhookDeliveryResponse, 0, len(deliveries)),
+		Total:      int(total),
+		Page:       page,
+		PageSize:   pageSize,
+	}
+	for _, delivery := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, toDeliveryResponse(delivery))
+	}
+	return resp, nil
+}
+
+// Redeliver sends the event of an earlier delivery again, as a new delivery
+// with a fresh set of attempts
+func (u *WebhookUsecase) Redeliver(ctx context.Context, organizationID, actorID, webhookID, deliveryID uuid.UUID) (*response.WebhookDeliveryResponse, error) {
+	if err := u.authorize(ctx, organizationID, actorID); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := u.getEndpoint(ctx, organizationID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if !endpoint.IsActive {
+		return nil, ErrWebhookInactive
+	}
+
+	original, err := u.webhookRepo.GetDelivery(ctx, webhookID, deliveryID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrDeliveryNotFound
+		}
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+
+	delivery, err := u.dispatcher.Redeliver(ctx, organizationID, original)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := toDeliveryResponse(delivery)
+	return &resp, nil
+}
+
+// validate checks the URL and event types and returns them normalized
+func (u *WebhookUsecase) validate(rawURL string, eventTypes []string) (string, []string, error) {
+	url, err := u.dispatcher.ValidateURL(rawURL)
+	if err != nil {
+		return "", nil, err
+	}
+
+	types := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if !webhook.ValidEventType(eventType) {
+			return "", nil, fmt.Errorf("%w: %s", ErrInvalidEventType, eventType)
+		}
+		if !slices.Contains(types, eventType) {
+			types = append(types, eventType)
+		}
+	}
+	if len(types) == 0 {
+		return "", nil, fmt.Errorf("%w: at least one is required", ErrInvalidEventType)
+	}
+	return url, types, nil
+}
+
+func (u *WebhookUsecase) getEndpoint(ctx context.Context, organizationID, webhookID uuid.UUID) (db.WebhookEndpoint, error) {
+	endpoint, err := u.webhookRepo.GetEndpoint(ctx, organizationID, webhookID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.WebhookEndpoint{}, ErrWebhookNotFound
+		}
+		return db.WebhookEndpoint{}, fmt.Errorf("failed to get webhook: %w", err)
+	}
+	return endpoint, nil
+}
+
+func (u *WebhookUsecase) authorize(ctx context.Context, organizationID, actorID uuid.UUID) error {
+	if _, err := u.orgRepo.GetOrganization(ctx, organizationID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOrganizationNotFound
+		}
+		return fmt.Errorf("failed to get organization: %w", err)
+	}
+
+	actor, err := u.memberRepo.GetOrganizationMember(ctx, organizationID, actorID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrForbidden
+		}
+		return fmt.Errorf("failed to get organization member: %w", err)
+	}
+	if actor.Role != repository.OrganizationRoleOwner && actor.Role != repository.OrganizationRoleAdmin {
+		return ErrForbidden
+	}
+	return nil
+}
+
+func toWebhookResponse(endpoint db.WebhookEndpoint) response.WebhookResponse {
+	resp := response.WebhookResponse{
+		ID:             endpoint.ID,
+		OrganizationID: endpoint.OrganizationID,
+		URL:            endpoint.Url,
+		Description:    endpoint.Description,
+		EventTypes:     endpoint.EventTypes,
+		IsActive:       endpoint.IsActive,
+		CreatedAt:      endpoint.CreatedAt,
+		UpdatedAt:      endpoint.UpdatedAt,
+	}
+	if endpoint.PreviousSecret.Valid && endpoint.PreviousSecretExpiresAt.Valid {
+		resp.PreviousSecretExpiresAt = &endpoint.PreviousSecretExpiresAt.Time
+	}
+	return resp
+}
+
+func toDeliveryResponse(delivery db.WebhookDelivery) response.WebhookDeliveryResponse {
+	resp := response.WebhookDeliveryResponse{
+		ID:           delivery.ID,
+		WebhookID:    delivery.EndpointID,
+		EventID:      delivery.EventID,
+		EventType:    delivery.EventType,
+		Payload:      map[string]any{},
+		Status:       delivery.Status,
+		Attempts:     int(delivery.Attempts),
+		ResponseBody: delivery.ResponseBody.String,
+		ErrorMessage: delivery.ErrorMessage.String,
+		CreatedAt:    delivery.CreatedAt,
+	}
+	_ = json.Unmarshal(delivery.Payload, &resp.Payload)
+	if delivery.ResponseStatus.Valid {
+		status := int(delivery.ResponseStatus.Int32)
+		resp.ResponseStatus = &status
+	}
+	if delivery.DurationMs.Valid {
+		duration := int(delivery.DurationMs.Int32)
+		resp.DurationMs = &duration
+	}
+	if delivery.RedeliveryOf.Valid {
+		resp.RedeliveryOf = &delivery.RedeliveryOf.UUID
+	}
+	if delivery.LastAttemptAt.Valid {
+		resp.LastAttemptAt = &delivery.LastAttemptAt.Time
+	}
+	if delivery.DeliveredAt.Valid {
+		resp.DeliveredAt = &delivery.DeliveredAt.Time
+	}
+	return resp
+}
@@ -4,7 +4,10 @@ import (
 	"ai-matching/src/api/public/authentication/requests"
 	"ai-matching/src/api/public/authentication/response"
 	"ai-matching/src/api/public/authentication/usecase"
+	"ai-matching/src/infrastructure/middleware"
+	"ai-matching/src/infrastructure/tenancy"
 	"context"
+	"errors"
 	"github.com/danielgtaylor/huma/v2"
 	"os"
 )
@@ -30,7 +33,7 @@ type LoginOutput struct {
 func (c *AuthController) Login(ctx context.Context, input *LoginInput) (*LoginOutput, error) {
 	resp, err := c.usecase.Login(ctx, input.Body)
 	if err != nil {
-		return nil, err
+		return nil, middleware.RateLimitError(err)
 	}
 
 	return &LoginOutput{Body: *resp}, nil
@@ -57,7 +60,7 @@ func (c *AuthController) Register(ctx context.Context, input *RegisterInput) (*R
 
 	resp, err := c.usecase.Register(ctx, input.Body)
 	if err != nil {
-		return nil, err
+		return nil, registerError(err)
 	}
 
 	return &RegisterOutput{Body: *resp}, nil
@@ -93,7 +96,7 @@ type ConfirmSignUpOutput struct {
 func (c *AuthController) ConfirmSignUp(ctx context.Context, input *ConfirmSignUpInput) (*ConfirmSignUpOutput, error) {
 	err := c.usecase.ConfirmSignUp(ctx, input.Body.Email, input.Body.ConfirmationCode)
 	if err != nil {
-		return nil, err
+		return nil, middleware.RateLimitError(err)
 	}
 
 	return &ConfirmSignUpOutput{
@@ -118,7 +121,7 @@ type ForgotPasswordOutput struct {
 func (c *AuthController) ForgotPassword(ctx context.Context, input *ForgotPasswordInput) (*ForgotPasswordOutput, error) {
 	err := c.usecase.ForgotPassword(ctx, input.Body.Email)
 	if err != nil {
-		return nil, err
+		return nil, middleware.RateLimitError(err)
 	}
 
 	return &ForgotPasswordOutput{
@@ -143,7 +146,7 @@ type ConfirmForgotPasswordOutput struct {
 func (c *AuthController) ConfirmForgotPassword(ctx context.Context, input *ConfirmForgotPasswordInput) (*ConfirmForgotPasswordOutput, error) {
 	err := c.usecase.ConfirmForgotPassword(ctx, input.Body.Email, input.Body.Password, input.Body.ConfirmationCode)
 	if err != nil {
-		return nil, err
+		return nil, middleware.RateLimitError(err)
 	}
 
 	return &ConfirmForgotPasswordOutput{
@@ -154,3 +157,13 @@ func (c *AuthController) ConfirmForgotPassword(ctx context.Context, input *Confi
 		},
 	}, nil
 }
+
+func registerError(err error) error {
+	switch {
+	case errors.Is(err, tenancy.ErrInvalidSubdomain), errors.Is(err, tenancy.ErrReservedSubdomain):
+		return huma.Error422UnprocessableEntity(err.Error())
+	case errors.Is(err, tenancy.ErrSubdomainTaken):
+		return huma.Error409Conflict(err.Error())
+	}
+	return middleware.RateLimitError(err)
+}
@@ -1,5 +1,7 @@
 package requests
 
+import "log/slog"
+
 type LoginRequest struct {
 	Email    string `json:"email" validate:"required,email" doc:"User email"`
 	Password string `json:"password" validate:"required,min=6" doc:"User password"`
@@ -17,9 +19,28 @@ type RegisterRequest struct {
 
 	// Tenant creation fields (optional - used when creating new tenant)
 	TenantName      *string `json:"tenantName,omitempty" validate:"omitempty,min=1,max=255" doc:"Tenant name for new tenant"`
-	TenantSubdomain *string `json:"tenantSubdomain,omitempty" validate:"omitempty,min=1,max=100,alphanum" doc:"Unique subdomain for new tenant"`
+	TenantSubdomain *string `json:"tenantSubdomain,omitempty" validate:"omitempty,min=3,max=63" doc:"Unique subdomain for new tenant (lowercase letters, digits and hyphens)"`
 }
 
 type RefreshTokenRequest struct {
 	RefreshToken string `json:"refreshToken" validate:"required" doc:"Refresh token"`
 }
+
+// LogValue keeps the password out of structured logs.
+func (r LoginRequest) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("email", r.Email))
+}
+
+// LogValue keeps the password out of structured logs.
+func (r RegisterRequest) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("email", r.Email),
+		slog.String("firstName", r.FirstName),
+		slog.String("lastName", r.LastName),
+	)
+}
+
+// LogValue keeps the refresh token out of structured logs.
+func (r RefreshTokenRequest) LogValue() slog.Value {
+	return slog.GroupValue()
+}
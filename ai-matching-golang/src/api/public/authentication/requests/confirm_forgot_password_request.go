@@ -1,7 +1,14 @@
 package requests
 
+import "log/slog"
+
 type ConfirmForgotPasswordRequest struct {
 	Email            string `json:"email" validate:"required,email" doc:"User email"`
 	Password         string `json:"password" validate:"required,min=8" doc:"New password"`
 	ConfirmationCode string `json:"confirmationCode" validate:"required" doc:"Confirmation code from email"`
 }
+
+// LogValue keeps the new password and confirmation code out of structured logs.
+func (r ConfirmForgotPasswordRequest) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("email", r.Email))
+}
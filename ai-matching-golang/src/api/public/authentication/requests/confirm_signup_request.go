@@ -1,6 +1,13 @@
 package requests
 
+import "log/slog"
+
 type ConfirmSignUpRequest struct {
 	Email            string `json:"email" validate:"required,email" doc:"User email"`
 	ConfirmationCode string `json:"confirmationCode" validate:"required" doc:"Confirmation code from email"`
 }
+
+// LogValue keeps the confirmation code out of structured logs.
+func (r ConfirmSignUpRequest) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("email", r.Email))
+}
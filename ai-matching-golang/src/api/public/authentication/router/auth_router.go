@@ -2,12 +2,14 @@ package router
 
 import (
 	"ai-matching/src/api/public/authentication/controller"
+	"ai-matching/src/infrastructure/middleware"
+	"ai-matching/src/infrastructure/ratelimit"
 
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/gofiber/fiber/v2"
 )
 
-func RegisterAuthRoutes(api huma.API, router fiber.Router, authController *controller.AuthController) {
+func RegisterAuthRoutes(api huma.API, router fiber.Router, authController *controller.AuthController, limiter *ratelimit.Limiter) {
 
 	huma.Register(api, huma.Operation{
 		OperationID: "login",
@@ -16,6 +18,7 @@ func RegisterAuthRoutes(api huma.API, router fiber.Router, authController *contr
 		Summary:     "User login",
 		Description: "Authenticate user and get access token",
 		Tags:        []string{"Authentication"},
+		Middlewares: huma.Middlewares{middleware.RateLimitByIP(api, limiter, ratelimit.LoginByIP)},
 	}, authController.Login)
 
 	huma.Register(api, huma.Operation{
@@ -25,6 +28,7 @@ func RegisterAuthRoutes(api huma.API, router fiber.Router, authController *contr
 		Summary:     "User registration",
 		Description: "Register a new user",
 		Tags:        []string{"Authentication"},
+		Middlewares: huma.Middlewares{middleware.RateLimitByIP(api, limiter, ratelimit.RegisterByIP)},
 	}, authController.Register)
 
 	huma.Register(api, huma.Operation{
@@ -43,6 +47,7 @@ func RegisterAuthRoutes(api huma.API, router fiber.Router, authController *contr
 		Summary:     "Confirm user registration",
 		Description: "Confirm user email with confirmation code",
 		Tags:        []string{"Authentication"},
+		Middlewares: huma.Middlewares{middleware.RateLimitByIP(api, limiter, ratelimit.ConfirmByIP)},
 	}, authController.ConfirmSignUp)
 
 	huma.Register(api, huma.Operation{
@@ -52,6 +57,7 @@ func RegisterAuthRoutes(api huma.API, router fiber.Router, authController *contr
 		Summary:     "Forgot password",
 		Description: "Request password reset code",
 		Tags:        []string{"Authentication"},
+		Middlewares: huma.Middlewares{middleware.RateLimitByIP(api, limiter, ratelimit.ForgotPasswordByIP)},
 	}, authController.ForgotPassword)
 
 	huma.Register(api, huma.Operation{
@@ -61,5 +67,6 @@ func RegisterAuthRoutes(api huma.API, router fiber.Router, authController *contr
 		Summary:     "Reset password",
 		Description: "Reset password with confirmation code",
 		Tags:        []string{"Authentication"},
+		Middlewares: huma.Middlewares{middleware.RateLimitByIP(api, limiter, ratelimit.ResetPasswordByIP)},
 	}, authController.ConfirmForgotPassword)
 }
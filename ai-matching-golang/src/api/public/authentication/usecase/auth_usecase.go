@@ -6,6 +6,8 @@ import (
 	"ai-matching/src/api/public/authentication/response"
 	"ai-matching/src/domain/interface/external"
 	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/ratelimit"
+	"ai-matching/src/infrastructure/tenancy"
 	"context"
 	"database/sql"
 	"errors"
@@ -15,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 type AuthUsecase struct {
@@ -22,33 +25,52 @@ type AuthUsecase struct {
 	tenantRepo       repository.TenantRepository
 	tenantUserRepo   repository.TenantUserRepository
 	organizationRepo repository.OrganizationRepository
+	memberRepo       repository.OrganizationMemberRepository
 	cognitoClient    external.CognitoClient
+	limiter          *ratelimit.Limiter
+	lockout          *ratelimit.LoginLockout
+	subdomains       *tenancy.SubdomainRegistry
 }
 
-func NewAuthUsecase(userRepo repository.UserRepository, tenantUserRepo repository.TenantUserRepository, tenantRepo repository.TenantRepository, organizationRepo repository.OrganizationRepository, cognitoClient external.CognitoClient) *AuthUsecase {
+func NewAuthUsecase(userRepo repository.UserRepository, tenantUserRepo repository.TenantUserRepository, tenantRepo repository.TenantRepository, organizationRepo repository.OrganizationRepository, memberRepo repository.OrganizationMemberRepository, cognitoClient external.CognitoClient, limiter *ratelimit.Limiter, lockout *ratelimit.LoginLockout, subdomains *tenancy.SubdomainRegistry) *AuthUsecase {
 	return &AuthUsecase{
 		userRepo:         userRepo,
 		tenantUserRepo:   tenantUserRepo,
 		tenantRepo:       tenantRepo,
 		organizationRepo: organizationRepo,
+		memberRepo:       memberRepo,
 		cognitoClient:    cognitoClient,
+		limiter:          limiter,
+		lockout:          lockout,
+		subdomains:       subdomains,
 	}
 }
 
 func (u *AuthUsecase) Login(ctx context.Context, req requests.LoginRequest) (*response.AuthResponse, error) {
+	if err := u.limiter.Allow(ctx, ratelimit.LoginByEmail, req.Email); err != nil {
+		return nil, err
+	}
+	if err := u.lockout.Check(ctx, req.Email); err != nil {
+		return nil, err
+	}
+
 	authResult, err := u.cognitoClient.InitiateAuth(ctx, req.Email, req.Password)
 	if err != nil {
-		if awsErr, ok := err.(*types.NotAuthorizedException); ok {
-			_ = awsErr
-			return nil, errors.New("invalid credentials")
+		var notAuthorized *types.NotAuthorizedException
+		if errors.As(err, &notAuthorized) {
+			return nil, u.loginFailed(ctx, req.Email)
 		}
-		if awsErr, ok := err.(*types.UserNotFoundException); ok {
-			_ = awsErr
-			return nil, errors.New("invalid credentials")
+		var notFound *types.UserNotFoundException
+		if errors.As(err, &notFound) {
+			return nil, u.loginFailed(ctx, req.Email)
 		}
 		return nil, fmt.Errorf("authentication failed: %w", err)
 	}
 
+	if err := u.lockout.RecordSuccess(ctx, req.Email); err != nil {
+		return nil, fmt.Errorf("failed to clear login failures: %w", err)
+	}
+
 	if authResult.AuthenticationResult == nil {
 		return nil, errors.New("authentication failed: no result")
 	}
@@ -117,6 +139,17 @@ func (u *AuthUsecase) Register(ctx context.Context, req requests.RegisterRequest
 		return nil, errors.New("either complete organization/tenant information must be provided")
 	}
 
+	if err := u.limiter.Allow(ctx, ratelimit.RegisterByEmail, req.Email); err != nil {
+		return nil, err
+	}
+
+	// Check the subdomain before creating the Cognito user so a taken or
+	// reserved name does not leave an orphaned account behind.
+	subdomain, err := u.subdomains.Claim(ctx, *req.TenantSubdomain, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+
 	attributes := map[string]string{
 		"email":       req.Email,
 		"given_name":  req.FirstName,
@@ -125,12 +158,12 @@ func (u *AuthUsecase) Register(ctx context.Context, req requests.RegisterRequest
 
 	signUpResult, err := u.cognitoClient.SignUp(ctx, req.Email, req.Password, attributes)
 	if err != nil {
-		if awsErr, ok := err.(*types.UsernameExistsException); ok {
-			_ = awsErr
+		var exists *types.UsernameExistsException
+		if errors.As(err, &exists) {
 			return nil, errors.New("user already exists")
 		}
-		if awsErr, ok := err.(*types.InvalidPasswordException); ok {
-			_ = awsErr
+		var invalidPassword *types.InvalidPasswordException
+		if errors.As(err, &invalidPassword) {
 			return nil, errors.New("password does not meet requirements")
 		}
 		return nil, fmt.Errorf("registration failed: %w", err)
@@ -160,7 +193,7 @@ func (u *AuthUsecase) Register(ctx context.Context, req requests.RegisterRequest
 	tenant, err := u.tenantRepo.CreateTenant(ctx, db.CreateTenantParams{
 		OrganizationID: org.ID,
 		Name:           *req.TenantName,
-		Subdomain:      *req.TenantSubdomain,
+		Subdomain:      subdomain,
 		IsActive:       true,
 	})
 	if err != nil {
@@ -170,10 +203,15 @@ func (u *AuthUsecase) Register(ctx context.Context, req requests.RegisterRequest
 	if _, err := u.tenantUserRepo.AddUserToTenant(ctx, db.AddUserToTenantParams{
 		TenantID: tenant.ID,
 		UserID:   user.ID,
+		Role:     sql.NullString{String: "admin", Valid: true},
 	}); err != nil {
 		return nil, fmt.Errorf("failed to associate user with tenant: %w", err)
 	}
 
+	if _, err := u.memberRepo.AddOrganizationMember(ctx, org.ID, user.ID, repository.OrganizationRoleOwner); err != nil {
+		return nil, fmt.Errorf("failed to make user organization owner: %w", err)
+	}
+
 	if signUpResult.UserConfirmed {
 		return u.Login(ctx, requests.LoginRequest{
 			Email:    req.Email,
@@ -199,8 +237,8 @@ func (u *AuthUsecase) RefreshToken(ctx context.Context, req requests.RefreshToke
 
 	authResult, err := u.cognitoClient.RefreshToken(ctx, req.RefreshToken)
 	if err != nil {
-		if awsErr, ok := err.(*types.NotAuthorizedException); ok {
-			_ = awsErr
+		var notAuthorized *types.NotAuthorizedException
+		if errors.As(err, &notAuthorized) {
 			return nil, errors.New("refresh token expired or invalid")
 		}
 		return nil, fmt.Errorf("token refresh failed: %w", err)
@@ -236,14 +274,18 @@ func (u *AuthUsecase) RefreshToken(ctx context.Context, req requests.RefreshToke
 }
 
 func (u *AuthUsecase) ConfirmSignUp(ctx context.Context, email, confirmationCode string) error {
+	if err := u.limiter.Allow(ctx, ratelimit.ConfirmByEmail, email); err != nil {
+		return err
+	}
+
 	err := u.cognitoClient.ConfirmSignUp(ctx, email, confirmationCode)
 	if err != nil {
-		if awsErr, ok := err.(*types.CodeMismatchException); ok {
-			_ = awsErr
+		var codeMismatch *types.CodeMismatchException
+		if errors.As(err, &codeMismatch) {
 			return errors.New("invalid confirmation code")
 		}
-		if awsErr, ok := err.(*types.ExpiredCodeException); ok {
-			_ = awsErr
+		var expiredCode *types.ExpiredCodeException
+		if errors.As(err, &expiredCode) {
 			return errors.New("confirmation code has expired")
 		}
 		return fmt.Errorf("confirmation failed: %w", err)
@@ -252,10 +294,14 @@ func (u *AuthUsecase) ConfirmSignUp(ctx context.Context, email, confirmationCode
 }
 
 func (u *AuthUsecase) ForgotPassword(ctx context.Context, email string) error {
+	if err := u.limiter.Allow(ctx, ratelimit.ForgotPasswordByEmail, email); err != nil {
+		return err
+	}
+
 	_, err := u.cognitoClient.ForgotPassword(ctx, email)
 	if err != nil {
-		if awsErr, ok := err.(*types.UserNotFoundException); ok {
-			_ = awsErr
+		var notFound *types.UserNotFoundException
+		if errors.As(err, &notFound) {
 			return nil
 		}
 		return fmt.Errorf("forgot password failed: %w", err)
@@ -264,18 +310,22 @@ func (u *AuthUsecase) ForgotPassword(ctx context.Context, email string) error {
 }
 
 func (u *AuthUsecase) ConfirmForgotPassword(ctx context.Context, email, password, confirmationCode string) error {
+	if err := u.limiter.Allow(ctx, ratelimit.ResetPasswordByEmail, email); err != nil {
+		return err
+	}
+
 	err := u.cognitoClient.ConfirmForgotPassword(ctx, email, password, confirmationCode)
 	if err != nil {
-		if awsErr, ok := err.(*types.CodeMismatchException); ok {
-			_ = awsErr
+		var codeMismatch *types.CodeMismatchException
+		if errors.As(err, &codeMismatch) {
 			return errors.New("invalid confirmation code")
 		}
-		if awsErr, ok := err.(*types.ExpiredCodeException); ok {
-			_ = awsErr
+		var expiredCode *types.ExpiredCodeException
+		if errors.As(err, &expiredCode) {
 			return errors.New("confirmation code has expired")
 		}
-		if awsErr, ok := err.(*types.InvalidPasswordException); ok {
-			_ = awsErr
+		var invalidPassword *types.InvalidPasswordException
+		if errors.As(err, &invalidPassword) {
 			return errors.New("password does not meet requirements")
 		}
 		return fmt.Errorf("password reset failed: %w", err)
@@ -286,6 +336,15 @@ func (u *AuthUsecase) ConfirmForgotPassword(ctx context.Context, email, password
 	return nil
 }
 
+// loginFailed records a failed attempt and returns the error for the caller:
+// a lockout error once the threshold is reached, invalid credentials otherwise.
+func (u *AuthUsecase) loginFailed(ctx context.Context, email string) error {
+	if err := u.lockout.RecordFailure(ctx, email); err != nil {
+		return err
+	}
+	return errors.New("invalid credentials")
+}
+
 // extractSubFromIDToken extracts the sub claim (Cognito User ID) from the ID token
 func extractSubFromIDToken(idToken string) (string, error) {
 	// Parse the token without validation (validation is done by Cognito)
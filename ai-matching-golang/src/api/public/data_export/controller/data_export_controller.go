@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"ai-matching/src/api/public/data_export/usecase"
+	"ai-matching/src/infrastructure/dataexport"
+	"ai-matching/src/infrastructure/signedurl"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+type DataExportController struct {
+	usecase *usecase.DataExportUsecase
+}
+
+func NewDataExportController(exportUsecase *usecase.DataExportUsecase) *DataExportController {
+	return &DataExportController{
+		usecase: exportUsecase,
+	}
+}
+
+type DownloadExportInput struct {
+	ExportID  uuid.UUID `path:"exportId" doc:"Export ID"`
+	Expires   string    `query:"expires" required:"true" doc:"Link expiry (Unix seconds)"`
+	Signature string    `query:"signature" required:"true" doc:"Link signature"`
+}
+
+func (c *DataExportController) DownloadExport(ctx context.Context, input *DownloadExportInput) (*huma.StreamResponse, error) {
+	download, err := c.usecase.Download(ctx, input.ExportID, input.Expires, input.Signature)
+	if err != nil {
+		return nil, toHTTPError(err)
+	}
+
+	return &huma.StreamResponse{
+		Body: func(hctx huma.Context) {
+			defer download.Body.Close()
+
+			hctx.SetHeader("Content-Type", "application/zip")
+			hctx.SetHeader("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, download.Filename))
+			hctx.SetHeader("Content-Length", strconv.FormatInt(download.SizeBytes, 10))
+			hctx.SetHeader("Cache-Control", "private, no-store")
+			if _, err := io.Copy(hctx.BodyWriter(), download.Body); err != nil {
+				slog.WarnContext(ctx, "failed to stream data export", slog.String("export_id", input.ExportID.String()), slog.Any("error", err))
+			}
+		},
+	}, nil
+}
+
+func toHTTPError(err error) error {
+	switch {
+	case errors.Is(err, signedurl.ErrInvalidSignature):
+		return huma.Error403Forbidden(err.Error())
+	case errors.Is(err, signedurl.ErrExpired), errors.Is(err, dataexport.ErrExportExpired):
+		return huma.Error410Gone(err.Error())
+	case errors.Is(err, dataexport.ErrExportNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, dataexport.ErrExportNotReady):
+		return huma.Error409Conflict(err.Error())
+	}
+	return err
+}
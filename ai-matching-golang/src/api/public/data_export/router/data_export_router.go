@@ -0,0 +1,19 @@
+package router
+
+import (
+	"ai-matching/src/api/public/data_export/controller"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterDataExportRoutes(api huma.API, router fiber.Router, exportController *controller.DataExportController) {
+	huma.Register(api, huma.Operation{
+		OperationID: "download-data-export",
+		Method:      "GET",
+		Path:        "/api/v1/public/exports/{exportId}/download",
+		Summary:     "Download data export",
+		Description: "Download an organization data export archive through a signed, expiring link",
+		Tags:        []string{"Data Exports"},
+	}, exportController.DownloadExport)
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"ai-matching/src/infrastructure/dataexport"
+	"context"
+	"io"
+
+	"github.com/google/uuid"
+)
+
+// Download is an export archive ready to be streamed to the client.
+type Download struct {
+	Body      io.ReadCloser
+	Filename  string
+	SizeBytes int64
+}
+
+type DataExportUsecase struct {
+	exporter *dataexport.Exporter
+}
+
+func NewDataExportUsecase(exporter *dataexport.Exporter) *DataExportUsecase {
+	return &DataExportUsecase{
+		exporter: exporter,
+	}
+}
+
+// Download opens the archive behind a signed download link. The signature is
+// the only credential, so the caller does not need to be signed in.
+func (u *DataExportUsecase) Download(ctx context.Context, exportID uuid.UUID, expires, signature string) (*Download, error) {
+	body, export, err := u.exporter.Open(ctx, exportID, expires, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Download{
+		Body:      body,
+		Filename:  dataexport.Filename(export),
+		SizeBytes: export.SizeBytes.Int64,
+	}, nil
+}
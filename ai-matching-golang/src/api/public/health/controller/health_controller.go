@@ -2,14 +2,21 @@ package controller
 
 import (
 	"ai-matching/src/api/public/health/response"
+	"ai-matching/src/infrastructure/buildinfo"
+	"ai-matching/src/infrastructure/health"
 	"context"
+	"log/slog"
+	"net/http"
 )
 
 type HealthController struct {
+	registry *health.Registry
 }
 
-func NewHealthController() *HealthController {
-	return &HealthController{}
+func NewHealthController(registry *health.Registry) *HealthController {
+	return &HealthController{
+		registry: registry,
+	}
 }
 
 type HealthOutput struct {
@@ -17,10 +24,54 @@ type HealthOutput struct {
 }
 
 func (c *HealthController) GetHealth(ctx context.Context, input *struct{}) (*HealthOutput, error) {
+	return c.GetLiveness(ctx, input)
+}
+
+// GetLiveness only reports that the process is serving requests; it never
+// touches dependencies so a slow database cannot get the pod restarted.
+func (c *HealthController) GetLiveness(ctx context.Context, input *struct{}) (*HealthOutput, error) {
 	return &HealthOutput{
 		Body: response.HealthResponse{
 			Status:  "ok",
-			Version: "1.0.0",
+			Version: buildinfo.Version,
 		},
 	}, nil
 }
+
+type ReadinessOutput struct {
+	Status int
+	Body   response.ReadinessResponse
+}
+
+// GetReadiness checks every dependency. The endpoint is public, so failure
+// reasons, which can name hosts or connection settings, are only logged.
+func (c *HealthController) GetReadiness(ctx context.Context, input *struct{}) (*ReadinessOutput, error) {
+	report := c.registry.Run(ctx)
+
+	components := make([]response.ComponentStatus, len(report.Components))
+	for i, component := range report.Components {
+		components[i] = response.ComponentStatus{
+			Name:      component.Name,
+			Status:    component.Status,
+			LatencyMs: float64(component.Latency.Microseconds()) / 1000,
+		}
+		if component.Status != health.StatusUp {
+			slog.WarnContext(ctx, "readiness check failed", slog.String("component", component.Name), slog.Duration("latency", component.Latency), slog.String("error", component.Error))
+		}
+	}
+
+	output := &ReadinessOutput{
+		Status: http.StatusOK,
+		Body: response.ReadinessResponse{
+			Status:     "ok",
+			Version:    buildinfo.Version,
+			Components: components,
+		},
+	}
+	if !report.Healthy {
+		output.Status = http.StatusServiceUnavailable
+		output.Body.Status = "unavailable"
+	}
+
+	return output, nil
+}
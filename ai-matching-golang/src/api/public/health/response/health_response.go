@@ -3,4 +3,16 @@ package response
 type HealthResponse struct {
 	Status  string `json:"status" example:"ok" doc:"Health status"`
 	Version string `json:"version" example:"1.0.0" doc:"API version"`
-}
\ No newline at end of file
+}
+
+type ComponentStatus struct {
+	Name      string  `json:"name" example:"postgres" doc:"Component name"`
+	Status    string  `json:"status" example:"up" enum:"up,down" doc:"Component status"`
+	LatencyMs float64 `json:"latencyMs" example:"1.25" doc:"Check latency in milliseconds"`
+}
+
+type ReadinessResponse struct {
+	Status     string            `json:"status" example:"ok" enum:"ok,unavailable" doc:"Overall readiness status"`
+	Version    string            `json:"version" example:"1.0.0" doc:"API version"`
+	Components []ComponentStatus `json:"components" doc:"Per-component check results"`
+}
@@ -17,4 +17,22 @@ func RegisterHealthRoutes(api huma.API, router fiber.Router, healthController *c
 		Description: "Check if the service is healthy",
 		Tags:        []string{"Health"},
 	}, healthController.GetHealth)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-health-live",
+		Method:      "GET",
+		Path:        "/api/v1/public/health/live",
+		Summary:     "Liveness probe",
+		Description: "Check if the process is running without checking dependencies",
+		Tags:        []string{"Health"},
+	}, healthController.GetLiveness)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-health-ready",
+		Method:      "GET",
+		Path:        "/api/v1/public/health/ready",
+		Summary:     "Readiness probe",
+		Description: "Check every dependency and report per-component status and latency. Responds with 503 when any component is down",
+		Tags:        []string{"Health"},
+	}, healthController.GetReadiness)
 }
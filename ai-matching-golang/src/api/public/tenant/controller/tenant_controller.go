@@ -0,0 +1,34 @@
+package controller
+
+import (
+	"ai-matching/src/api/public/tenant/response"
+	"ai-matching/src/api/public/tenant/usecase"
+	"context"
+)
+
+type TenantController struct {
+	usecase *usecase.TenantUsecase
+}
+
+func NewTenantController(tenantUsecase *usecase.TenantUsecase) *TenantController {
+	return &TenantController{
+		usecase: tenantUsecase,
+	}
+}
+
+type CheckSubdomainAvailabilityInput struct {
+	Name string `query:"name" required:"true" maxLength:"100" doc:"Subdomain to check"`
+}
+
+type CheckSubdomainAvailabilityOutput struct {
+	Body response.SubdomainAvailabilityResponse
+}
+
+func (c *TenantController) CheckSubdomainAvailability(ctx context.Context, input *CheckSubdomainAvailabilityInput) (*CheckSubdomainAvailabilityOutput, error) {
+	resp, err := c.usecase.CheckSubdomainAvailability(ctx, input.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CheckSubdomainAvailabilityOutput{Body: *resp}, nil
+}
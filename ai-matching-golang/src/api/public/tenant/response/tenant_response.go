@@ -0,0 +1,8 @@
+package response
+
+type SubdomainAvailabilityResponse struct {
+	Name        string   `json:"name" doc:"Normalized subdomain that was checked"`
+	Available   bool     `json:"available" doc:"Whether the subdomain can be used for a new tenant"`
+	Reason      string   `json:"reason,omitempty" enum:"invalid,reserved,taken" doc:"Why the subdomain is unavailable"`
+	Suggestions []string `json:"suggestions" doc:"Available alternatives derived from the requested name"`
+}
@@ -0,0 +1,22 @@
+package router
+
+import (
+	"ai-matching/src/api/public/tenant/controller"
+	"ai-matching/src/infrastructure/middleware"
+	"ai-matching/src/infrastructure/ratelimit"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RegisterTenantRoutes(api huma.API, router fiber.Router, tenantController *controller.TenantController, limiter *ratelimit.Limiter) {
+	huma.Register(api, huma.Operation{
+		OperationID: "check-subdomain-availability",
+		Method:      "GET",
+		Path:        "/api/v1/public/tenants/subdomain-availability",
+		Summary:     "Check subdomain availability",
+		Description: "Check whether a subdomain can be used for a new tenant and suggest alternatives when it cannot",
+		Tags:        []string{"Tenants"},
+		Middlewares: huma.Middlewares{middleware.RateLimitByIP(api, limiter, ratelimit.SubdomainCheckByIP)},
+	}, tenantController.CheckSubdomainAvailability)
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"ai-matching/src/api/public/tenant/response"
+	"ai-matching/src/infrastructure/tenancy"
+	"context"
+)
+
+type TenantUsecase struct {
+	subdomains *tenancy.SubdomainRegistry
+}
+
+func NewTenantUsecase(subdomains *tenancy.SubdomainRegistry) *TenantUsecase {
+	return &TenantUsecase{
+		subdomains: subdomains,
+	}
+}
+
+// CheckSubdomainAvailability reports whether a subdomain can be claimed and suggests alternatives when it cannot
+func (u *TenantUsecase) CheckSubdomainAvailability(ctx context.Context, name string) (*response.SubdomainAvailabilityResponse, error) {
+	availability, err := u.subdomains.Availability(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.SubdomainAvailabilityResponse{
+		Name:        availability.Name,
+		Available:   availability.Available,
+		Reason:      availability.Reason,
+		Suggestions: availability.Suggestions,
+	}, nil
+}
@@ -1,41 +1,151 @@
 package di
 
 import (
+	"ai-matching/db/migrations"
 	db "ai-matching/db/sqlc"
+	askController "ai-matching/src/api/auth/ask/controller"
+	askUsecase "ai-matching/src/api/auth/ask/usecase"
+	conversationController "ai-matching/src/api/auth/conversation/controller"
+	conversationUsecase "ai-matching/src/api/auth/conversation/usecase"
+	dataExportController "ai-matching/src/api/auth/data_export/controller"
+	dataExportUsecase "ai-matching/src/api/auth/data_export/usecase"
+	documentController "ai-matching/src/api/auth/document/controller"
+	documentUsecase "ai-matching/src/api/auth/document/usecase"
+	featureFlagController "ai-matching/src/api/auth/feature_flag/controller"
+	featureFlagUsecase "ai-matching/src/api/auth/feature_flag/usecase"
+	jobController "ai-matching/src/api/auth/job/controller"
+	jobUsecase "ai-matching/src/api/auth/job/usecase"
+	matchingController "ai-matching/src/api/auth/matching/controller"
+	matchingUsecase "ai-matching/src/api/auth/matching/usecase"
 	authController "ai-matching/src/api/auth/organization/controller"
 	organizationUsecase "ai-matching/src/api/auth/organization/usecase"
+	organizationMemberController "ai-matching/src/api/auth/organization_member/controller"
+	organizationMemberUsecase "ai-matching/src/api/auth/organization_member/usecase"
+	organizationOriginController "ai-matching/src/api/auth/organization_origin/controller"
+	organizationOriginUsecase "ai-matching/src/api/auth/organization_origin/usecase"
+	searchController "ai-matching/src/api/auth/search/controller"
+	searchUsecase "ai-matching/src/api/auth/search/usecase"
+	settingsController "ai-matching/src/api/auth/settings/controller"
+	settingsUsecase "ai-matching/src/api/auth/settings/usecase"
+	subscriptionController "ai-matching/src/api/auth/subscription/controller"
+	subscriptionUsecase "ai-matching/src/api/auth/subscription/usecase"
+	taskController "ai-matching/src/api/auth/task/controller"
+	taskUsecase "ai-matching/src/api/auth/task/usecase"
 	tenantController "ai-matching/src/api/auth/tenant/controller"
 	tenantUsecase "ai-matching/src/api/auth/tenant/usecase"
+	tenantDomainController "ai-matching/src/api/auth/tenant_domain/controller"
+	tenantDomainUsecase "ai-matching/src/api/auth/tenant_domain/usecase"
 	tenantUserController "ai-matching/src/api/auth/tenant_user/controller"
 	tenantUserUsecase "ai-matching/src/api/auth/tenant_user/usecase"
+	usageController "ai-matching/src/api/auth/usage/controller"
+	usageUsecase "ai-matching/src/api/auth/usage/usecase"
 	userController "ai-matching/src/api/auth/user/controller"
 	userUsecase "ai-matching/src/api/auth/user/usecase"
+	userImportController "ai-matching/src/api/auth/user_import/controller"
+	userImportUsecase "ai-matching/src/api/auth/user_import/usecase"
+	webhookController "ai-matching/src/api/auth/webhook/controller"
+	webhookUsecase "ai-matching/src/api/auth/webhook/usecase"
 	publicAuthController "ai-matching/src/api/public/authentication/controller"
 	publicAuthUsecase "ai-matching/src/api/public/authentication/usecase"
+	publicDataExportController "ai-matching/src/api/public/data_export/controller"
+	publicDataExportUsecase "ai-matching/src/api/public/data_export/usecase"
 	healthController "ai-matching/src/api/public/health/controller"
+	publicTenantController "ai-matching/src/api/public/tenant/controller"
+	publicTenantUsecase "ai-matching/src/api/public/tenant/usecase"
 	"ai-matching/src/domain/interface/external"
 	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/cors"
+	"ai-matching/src/infrastructure/dataexport"
 	"ai-matching/src/infrastructure/external/cognito"
+	"ai-matching/src/infrastructure/external/dns"
+	"ai-matching/src/infrastructure/external/embedding"
+	"ai-matching/src/infrastructure/external/llm"
+	"ai-matching/src/infrastructure/external/storage"
+	"ai-matching/src/infrastructure/featureflag"
+	"ai-matching/src/infrastructure/health"
+	"ai-matching/src/infrastructure/indexing"
+	"ai-matching/src/infrastructure/jobs"
+	"ai-matching/src/infrastructure/maintenance"
+	"ai-matching/src/infrastructure/metering"
+	"ai-matching/src/infrastructure/metrics"
+	"ai-matching/src/infrastructure/quota"
+	"ai-matching/src/infrastructure/rag"
+	"ai-matching/src/infrastructure/ratelimit"
 	infraRepository "ai-matching/src/infrastructure/repository"
+	"ai-matching/src/infrastructure/retrieval"
+	"ai-matching/src/infrastructure/scheduler"
+	"ai-matching/src/infrastructure/settings"
+	"ai-matching/src/infrastructure/signedurl"
+	"ai-matching/src/infrastructure/tenancy"
+	"ai-matching/src/infrastructure/tracing"
+	"ai-matching/src/infrastructure/webhook"
+	"context"
 	"database/sql"
 	"fmt"
-	"log"
+	"log/slog"
 	"os"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 type Container struct {
+	Logger        *slog.Logger
 	DB            *sqlx.DB
 	Queries       db.Querier
 	CognitoClient external.CognitoClient
+	DNSResolver   external.DNSResolver
+	FileStorage   external.FileStorage
+	HealthChecks  *health.Registry
+	Metrics       *prometheus.Registry
 
 	// Repositories
-	UserRepository         repository.UserRepository
-	OrganizationRepository repository.OrganizationRepository
-	TenantRepository       repository.TenantRepository
-	TenantUserRepository   repository.TenantUserRepository
+	UserRepository          repository.UserRepository
+	OrganizationRepository  repository.OrganizationRepository
+	TenantRepository        repository.TenantRepository
+	TenantUserRepository    repository.TenantUserRepository
+	RateLimitRepository     repository.RateLimitRepository
+	OriginRepository        repository.OrganizationOriginRepository
+	TenantDomainRepository  repository.TenantDomainRepository
+	SettingsRepository      repository.SettingsRepository
+	FeatureFlagRepository   repository.FeatureFlagRepository
+	SubscriptionRepository  repository.SubscriptionRepository
+	UsageRepository         repository.UsageRepository
+	MemberRepository        repository.OrganizationMemberRepository
+	UserImportRepository    repository.UserImportRepository
+	ExportRepository        repository.OrganizationExportRepository
+	DocumentRepository      repository.DocumentRepository
+	DocumentChunkRepository repository.DocumentChunkRepository
+	ConversationRepository  repository.ConversationRepository
+	MatchRepository         repository.MatchRepository
+	JobRepository           repository.JobRepository
+	TaskRunRepository       repository.TaskRunRepository
+	WebhookRepository       repository.WebhookRepository
+
+	// Services
+	RateLimiter    *ratelimit.Limiter
+	LoginLockout   *ratelimit.LoginLockout
+	OriginResolver *cors.Resolver
+	HostResolver   *tenancy.HostResolver
+	Subdomains     *tenancy.SubdomainRegistry
+	Settings       *settings.Resolver
+	FeatureFlags   *featureflag.Evaluator
+	Quotas         *quota.Enforcer
+	Meter          *metering.Meter
+	URLSigner      *signedurl.Signer
+	Exporter       *dataexport.Exporter
+	Embedder       external.EmbeddingProvider
+	LLM            external.LLMProvider
+	Indexer        *indexing.Indexer
+	VectorSearcher *retrieval.VectorSearcher
+	HybridSearcher *retrieval.HybridSearcher
+	Answerer       *rag.Answerer
+	JobQueue       *jobs.Queue
+	Worker         *jobs.Worker
+	Scheduler      *scheduler.Scheduler
+	Webhooks       *webhook.Dispatcher
 
 	// Usecases
 	AuthUsecase         *publicAuthUsecase.AuthUsecase
@@ -43,6 +153,25 @@ type Container struct {
 	OrganizationUsecase *organizationUsecase.OrganizationUsecase
 	TenantUsecase       *tenantUsecase.TenantUsecase
 	TenantUserUsecase   *tenantUserUsecase.TenantUserUsecase
+	OriginUsecase       *organizationOriginUsecase.OrganizationOriginUsecase
+	TenantDomainUsecase *tenantDomainUsecase.TenantDomainUsecase
+	PublicTenantUsecase *publicTenantUsecase.TenantUsecase
+	SettingsUsecase     *settingsUsecase.SettingsUsecase
+	FeatureFlagUsecase  *featureFlagUsecase.FeatureFlagUsecase
+	SubscriptionUsecase *subscriptionUsecase.SubscriptionUsecase
+	UsageUsecase        *usageUsecase.UsageUsecase
+	MemberUsecase       *organizationMemberUsecase.OrganizationMemberUsecase
+	UserImportUsecase   *userImportUsecase.UserImportUsecase
+	DataExportUsecase   *dataExportUsecase.DataExportUsecase
+	PublicExportUsecase *publicDataExportUsecase.DataExportUsecase
+	DocumentUsecase     *documentUsecase.DocumentUsecase
+	SearchUsecase       *searchUsecase.SearchUsecase
+	AskUsecase          *askUsecase.AskUsecase
+	ConversationUsecase *conversationUsecase.ConversationUsecase
+	MatchingUsecase     *matchingUsecase.MatchingUsecase
+	JobUsecase          *jobUsecase.JobUsecase
+	TaskUsecase         *taskUsecase.TaskUsecase
+	WebhookUsecase      *webhookUsecase.WebhookUsecase
 
 	// Controllers
 	AuthController         *publicAuthController.AuthController
@@ -51,9 +180,28 @@ type Container struct {
 	TenantController       *tenantController.TenantController
 	TenantUserController   *tenantUserController.TenantUserController
 	HealthController       *healthController.HealthController
+	OriginController       *organizationOriginController.OrganizationOriginController
+	TenantDomainController *tenantDomainController.TenantDomainController
+	PublicTenantController *publicTenantController.TenantController
+	SettingsController     *settingsController.SettingsController
+	FeatureFlagController  *featureFlagController.FeatureFlagController
+	SubscriptionController *subscriptionController.SubscriptionController
+	UsageController        *usageController.UsageController
+	MemberController       *organizationMemberController.OrganizationMemberController
+	UserImportController   *userImportController.UserImportController
+	DataExportController   *dataExportController.DataExportController
+	PublicExportController *publicDataExportController.DataExportController
+	DocumentController     *documentController.DocumentController
+	SearchController       *searchController.SearchController
+	AskController          *askController.AskController
+	ConversationController *conversationController.ConversationController
+	MatchingController     *matchingController.MatchingController
+	JobController          *jobController.JobController
+	TaskController         *taskController.TaskController
+	WebhookController      *webhookController.WebhookController
 }
 
-func NewContainer() *Container {
+func NewContainer(logger *slog.Logger) *Container {
 	dbURL := os.Getenv("DATABASE_URL")
 	if dbURL == "" {
 		dbHost := os.Getenv("DB_HOST")
@@ -79,33 +227,180 @@ func NewContainer() *Container {
 
 	sqlDB, err := sql.Open("postgres", dbURL)
 	if err != nil {
-		log.Fatal("Failed to connect to database:", err)
+		logger.Error("Failed to connect to database", slog.Any("error", err))
+		os.Exit(1)
 	}
 
 	if err := sqlDB.Ping(); err != nil {
-		log.Fatal("Failed to ping database:", err)
+		logger.Error("Failed to ping database", slog.Any("error", err))
+		os.Exit(1)
 	}
 
 	sqlxDB := sqlx.NewDb(sqlDB, "postgres")
-	queries := db.New(sqlDB)
+	queries := tracing.NewTracedQuerier(db.New(sqlDB))
+	transactor := infraRepository.NewTransactor(sqlDB, tracing.NewTracedQuerier)
+
+	metricsRegistry := metrics.NewRegistry(sqlDB)
+
+	rawCognitoClient, err := cognito.NewCognitoClient(logger)
+	if err != nil {
+		logger.Error("Failed to create Cognito client", slog.Any("error", err))
+		os.Exit(1)
+	}
+	cognitoClient := cognito.NewInstrumentedClient(rawCognitoClient)
+
+	// DNS_RESOLVER=stub answers verification lookups from DNS_STUB_TXT_RECORDS
+	// so custom domains can be verified locally.
+	var dnsResolver external.DNSResolver
+	if os.Getenv("DNS_RESOLVER") == "stub" {
+		dnsResolver = dns.NewStubResolver(os.Getenv("DNS_STUB_TXT_RECORDS"))
+	} else {
+		dnsResolver = dns.NewNetResolver()
+	}
+
+	// FILE_STORAGE_DIR is where generated files such as data exports are kept.
+	fileStorageDir := os.Getenv("FILE_STORAGE_DIR")
+	if fileStorageDir == "" {
+		fileStorageDir = "data"
+	}
+	fileStorage := storage.NewLocalStorage(fileStorageDir)
+
+	// EMBEDDING_PROVIDER=openai embeds document chunks through an
+	// OpenAI-compatible API; the default hashes text locally so development
+	// and tests need no model.
+	var embedder external.EmbeddingProvider
+	if os.Getenv("EMBEDDING_PROVIDER") == "openai" {
+		embedder = embedding.NewOpenAIProvider()
+	} else {
+		embedder = embedding.NewHashingProvider(embedding.DefaultHashingDimensions)
+	}
+
+	// LLM_PROVIDER=openai answers questions through an OpenAI-compatible chat
+	// API; the default echoes the prompt back so the answer flow can be tried
+	// without a model.
+	var llmProvider external.LLMProvider
+	if os.Getenv("LLM_PROVIDER") == "openai" {
+		llmProvider = llm.NewOpenAIProvider()
+	} else {
+		llmProvider = llm.NewEchoProvider()
+	}
 
-	cognitoClient, err := cognito.NewCognitoClient()
+	latestMigration, err := migrations.LatestVersion()
 	if err != nil {
-		log.Fatal("Failed to create Cognito client:", err)
+		logger.Error("Failed to read embedded migrations", slog.Any("error", err))
+		os.Exit(1)
 	}
 
+	healthChecks := health.NewRegistry(3*time.Second,
+		health.NewPostgresChecker(sqlDB),
+		health.NewMigrationChecker(sqlDB, latestMigration),
+		health.NewHTTPChecker("jwks", cognito.JWKSURL()),
+		health.NewCognitoChecker(cognitoClient),
+	)
+
 	// Initialize repositories
 	userRepo := infraRepository.NewUserRepository(queries)
 	orgRepo := infraRepository.NewOrganizationRepository(queries)
 	tenantRepo := infraRepository.NewTenantRepository(queries)
 	tenantUserRepo := infraRepository.NewTenantUserRepository(queries)
+	originRepo := infraRepository.NewOrganizationOriginRepository(queries)
+	tenantDomainRepo := infraRepository.NewTenantDomainRepository(queries)
+	settingsRepo := infraRepository.NewSettingsRepository(queries, transactor)
+	featureFlagRepo := infraRepository.NewFeatureFlagRepository(queries)
+	subscriptionRepo := infraRepository.NewSubscriptionRepository(queries)
+	usageRepo := infraRepository.NewUsageRepository(queries)
+	memberRepo := infraRepository.NewOrganizationMemberRepository(queries, transactor)
+	userImportRepo := infraRepository.NewUserImportRepository(queries)
+	exportRepo := infraRepository.NewOrganizationExportRepository(queries)
+	documentRepo := infraRepository.NewDocumentRepository(queries)
+	documentChunkRepo := infraRepository.NewDocumentChunkRepository(queries, transactor)
+	conversationRepo := infraRepository.NewConversationRepository(queries)
+	matchRepo := infraRepository.NewMatchRepository(queries)
+	jobRepo := infraRepository.NewJobRepository(queries)
+	taskRunRepo := infraRepository.NewTaskRunRepository(queries)
+	webhookRepo := infraRepository.NewWebhookRepository(queries)
+
+	// RATE_LIMIT_STORE=memory keeps counters per process; the default shares
+	// them between replicas through Postgres.
+	var rateLimitRepo repository.RateLimitRepository
+	if os.Getenv("RATE_LIMIT_STORE") == "memory" {
+		rateLimitRepo = infraRepository.NewMemoryRateLimitRepository()
+	} else {
+		rateLimitRepo = infraRepository.NewRateLimitRepository(queries)
+	}
+
+	// Initialize services
+	rateLimiter := ratelimit.NewLimiter(rateLimitRepo)
+	loginLockout := ratelimit.NewLoginLockout(rateLimitRepo)
+	hostResolver := tenancy.NewHostResolver(tenantRepo, tenantDomainRepo)
+	subdomains := tenancy.NewSubdomainRegistry(tenantRepo)
+	settingsResolver := settings.NewResolver(settingsRepo, tenantRepo)
+	featureFlags := featureflag.NewEvaluator(featureFlagRepo)
+	quotas := quota.NewEnforcer(subscriptionRepo, tenantRepo, tenantUserRepo)
+	meter := metering.NewMeter(usageRepo, quotas)
+	urlSigner := signedurl.NewSigner()
+	exporter := dataexport.NewExporter(exportRepo, orgRepo, memberRepo, fileStorage, urlSigner)
+	indexer := indexing.NewIndexer(documentChunkRepo, embedder)
+	vectorSearcher := retrieval.NewVectorSearcher(documentChunkRepo, embedder)
+	hybridSearcher := retrieval.NewHybridSearcher(vectorSearcher, documentChunkRepo)
+	answerer := rag.NewAnswerer(hybridSearcher, llmProvider)
+	jobQueue := jobs.NewQueue(jobRepo)
+	worker := jobs.NewWorker(jobQueue)
+	taskScheduler := scheduler.NewScheduler(sqlDB, taskRunRepo, jobQueue)
+	webhooks := webhook.NewDispatcher(webhookRepo, jobQueue)
+	originResolver := cors.NewResolver(hostResolver, originRepo)
 
 	// Initialize usecases
-	authUc := publicAuthUsecase.NewAuthUsecase(userRepo, tenantUserRepo, tenantRepo, orgRepo, cognitoClient)
-	userUc := userUsecase.NewUserUsecase(userRepo, tenantUserRepo, cognitoClient)
+	authUc := publicAuthUsecase.NewAuthUsecase(userRepo, tenantUserRepo, tenantRepo, orgRepo, memberRepo, cognitoClient, rateLimiter, loginLockout, subdomains)
+	userUc := userUsecase.NewUserUsecase(userRepo, tenantUserRepo, cognitoClient, quotas, webhooks)
 	orgUc := organizationUsecase.NewOrganizationUsecase(orgRepo)
-	tenantUc := tenantUsecase.NewTenantUsecase(tenantRepo)
-	tenantUserUc := tenantUserUsecase.NewTenantUserUsecase(tenantUserRepo, tenantRepo, userRepo)
+	tenantUc := tenantUsecase.NewTenantUsecase(tenantRepo, hostResolver, subdomains, quotas, webhooks)
+	tenantUserUc := tenantUserUsecase.NewTenantUserUsecase(tenantUserRepo, tenantRepo, userRepo, quotas, webhooks)
+	originUc := organizationOriginUsecase.NewOrganizationOriginUsecase(originRepo, orgRepo, originResolver)
+	tenantDomainUc := tenantDomainUsecase.NewTenantDomainUsecase(tenantDomainRepo, tenantRepo, dnsResolver, originResolver)
+	publicTenantUc := publicTenantUsecase.NewTenantUsecase(subdomains)
+	settingsUc := settingsUsecase.NewSettingsUsecase(settingsRepo, orgRepo, tenantRepo, settingsResolver)
+	featureFlagUc := featureFlagUsecase.NewFeatureFlagUsecase(featureFlagRepo, orgRepo, tenantRepo, featureFlags)
+	subscriptionUc := subscriptionUsecase.NewSubscriptionUsecase(subscriptionRepo, orgRepo, tenantRepo, quotas)
+	usageUc := usageUsecase.NewUsageUsecase(meter)
+	memberUc := organizationMemberUsecase.NewOrganizationMemberUsecase(memberRepo, orgRepo, userRepo)
+	userImportUc := userImportUsecase.NewUserImportUsecase(userImportRepo, orgRepo, userRepo, tenantRepo, tenantUserRepo, cognitoClient, quotas, jobQueue, webhooks)
+	dataExportUc := dataExportUsecase.NewDataExportUsecase(exportRepo, orgRepo, memberRepo, exporter, jobQueue)
+	publicExportUc := publicDataExportUsecase.NewDataExportUsecase(exporter)
+	documentUc := documentUsecase.NewDocumentUsecase(documentRepo, documentChunkRepo, tenantRepo, fileStorage, jobQueue)
+	searchUc := searchUsecase.NewSearchUsecase(tenantRepo, hybridSearcher, settingsResolver)
+	askUc := askUsecase.NewAskUsecase(tenantRepo, answerer, settingsResolver)
+	conversationUc := conversationUsecase.NewConversationUsecase(conversationRepo, tenantRepo, answerer, settingsResolver)
+	matchingUc := matchingUsecase.NewMatchingUsecase(matchRepo, tenantRepo, embedder, settingsResolver)
+	jobUc := jobUsecase.NewJobUsecase(jobRepo, orgRepo, memberRepo)
+	taskUc := taskUsecase.NewTaskUsecase(taskScheduler, taskRunRepo)
+	webhookUc := webhookUsecase.NewWebhookUsecase(webhookRepo, orgRepo, memberRepo, webhooks)
+
+	// Register background job handlers
+	jobs.Handle(jobQueue, indexing.JobKind, func(ctx context.Context, job indexing.Job) error {
+		return indexer.Run(ctx, job.VersionID)
+	})
+	jobs.Handle(jobQueue, userImportUsecase.JobKind, func(ctx context.Context, job userImportUsecase.Job) error {
+		return userImportUc.Process(ctx, job.ImportID)
+	})
+	jobs.Handle(jobQueue, dataexport.JobKind, func(ctx context.Context, job dataexport.Job) error {
+		return exporter.Run(ctx, job.ExportID)
+	})
+	jobs.Handle(jobQueue, scheduler.JobKind, taskScheduler.HandleJob)
+	jobs.Handle(jobQueue, webhook.JobKind, webhooks.Deliver)
+
+	// Register scheduled tasks; cron expressions are in UTC
+	tasks := maintenance.NewTasks(documentRepo, memberRepo, tenantRepo, rateLimitRepo, jobRepo, webhookRepo, fileStorage, exporter, meter)
+	reconciler := maintenance.NewCognitoReconciler(cognitoClient, userRepo)
+	taskScheduler.Register("documents.purge", "0 3 * * *", "Permanently delete documents soft-deleted longer than DOCUMENT_PURGE_AFTER, with their files", tasks.PurgeDocuments)
+	taskScheduler.Register("ownership_transfers.expire", "*/15 * * * *", "Expire ownership transfers that were not accepted in time", tasks.ExpireOwnershipTransfers)
+	taskScheduler.Register("usage.snapshot", "30 0 * * *", "Write yesterday's usage records", tasks.SnapshotUsage)
+	taskScheduler.Register("cognito.reconcile", "0 4 * * *", "Copy Cognito profile changes to users and report accounts missing on either side", reconciler.Reconcile)
+	taskScheduler.Register("exports.cleanup", "0 * * * *", "Delete data export archives past their retention", tasks.DeleteExpiredExports)
+	taskScheduler.Register("rate_limits.cleanup", "*/10 * * * *", "Delete rate limit counters whose window ended", tasks.DeleteExpiredRateLimits)
+	taskScheduler.Register("subdomain_aliases.cleanup", "0 * * * *", "Release renamed subdomains after their redirect period", tasks.DeleteExpiredSubdomainAliases)
+	taskScheduler.Register("jobs.cleanup", "0 5 * * *", "Delete succeeded jobs older than JOB_RETENTION", tasks.DeleteSucceededJobs)
+	taskScheduler.Register("webhook_deliveries.cleanup", "30 5 * * *", "Delete finished webhook deliveries older than WEBHOOK_DELIVERY_RETENTION", tasks.DeleteWebhookDeliveries)
 
 	// Initialize controllers
 	authCtrl := publicAuthController.NewAuthController(authUc)
@@ -113,18 +408,82 @@ func NewContainer() *Container {
 	orgCtrl := authController.NewOrganizationController(orgUc)
 	tenantCtrl := tenantController.NewTenantController(tenantUc)
 	tenantUserCtrl := tenantUserController.NewTenantUserController(tenantUserUc)
-	healthCtrl := healthController.NewHealthController()
+	healthCtrl := healthController.NewHealthController(healthChecks)
+	originCtrl := organizationOriginController.NewOrganizationOriginController(originUc)
+	tenantDomainCtrl := tenantDomainController.NewTenantDomainController(tenantDomainUc)
+	publicTenantCtrl := publicTenantController.NewTenantController(publicTenantUc)
+	settingsCtrl := settingsController.NewSettingsController(settingsUc)
+	featureFlagCtrl := featureFlagController.NewFeatureFlagController(featureFlagUc)
+	subscriptionCtrl := subscriptionController.NewSubscriptionController(subscriptionUc)
+	usageCtrl := usageController.NewUsageController(usageUc)
+	memberCtrl := organizationMemberController.NewOrganizationMemberController(memberUc)
+	userImportCtrl := userImportController.NewUserImportController(userImportUc)
+	dataExportCtrl := dataExportController.NewDataExportController(dataExportUc)
+	publicExportCtrl := publicDataExportController.NewDataExportController(publicExportUc)
+	documentCtrl := documentController.NewDocumentController(documentUc)
+	searchCtrl := searchController.NewSearchController(searchUc)
+	askCtrl := askController.NewAskController(askUc)
+	conversationCtrl := conversationController.NewConversationController(conversationUc)
+	matchingCtrl := matchingController.NewMatchingController(matchingUc)
+	jobCtrl := jobController.NewJobController(jobUc)
+	taskCtrl := taskController.NewTaskController(taskUc)
+	webhookCtrl := webhookController.NewWebhookController(webhookUc)
 
 	return &Container{
+		Logger:        logger,
 		DB:            sqlxDB,
 		Queries:       queries,
 		CognitoClient: cognitoClient,
+		DNSResolver:   dnsResolver,
+		FileStorage:   fileStorage,
+		HealthChecks:  healthChecks,
+		Metrics:       metricsRegistry,
 
 		// Repositories
-		UserRepository:         userRepo,
-		OrganizationRepository: orgRepo,
-		TenantRepository:       tenantRepo,
-		TenantUserRepository:   tenantUserRepo,
+		UserRepository:          userRepo,
+		OrganizationRepository:  orgRepo,
+		TenantRepository:        tenantRepo,
+		TenantUserRepository:    tenantUserRepo,
+		RateLimitRepository:     rateLimitRepo,
+		OriginRepository:        originRepo,
+		TenantDomainRepository:  tenantDomainRepo,
+		SettingsRepository:      settingsRepo,
+		FeatureFlagRepository:   featureFlagRepo,
+		SubscriptionRepository:  subscriptionRepo,
+		UsageRepository:         usageRepo,
+		MemberRepository:        memberRepo,
+		UserImportRepository:    userImportRepo,
+		ExportRepository:        exportRepo,
+		DocumentRepository:      documentRepo,
+		DocumentChunkRepository: documentChunkRepo,
+		ConversationRepository:  conversationRepo,
+		MatchRepository:         matchRepo,
+		JobRepository:           jobRepo,
+		TaskRunRepository:       taskRunRepo,
+		WebhookRepository:       webhookRepo,
+
+		// Services
+		RateLimiter:    rateLimiter,
+		LoginLockout:   loginLockout,
+		OriginResolver: originResolver,
+		HostResolver:   hostResolver,
+		Subdomains:     subdomains,
+		Settings:       settingsResolver,
+		FeatureFlags:   featureFlags,
+		Quotas:         quotas,
+		Meter:          meter,
+		URLSigner:      urlSigner,
+		Exporter:       exporter,
+		Embedder:       embedder,
+		LLM:            llmProvider,
+		Indexer:        indexer,
+		VectorSearcher: vectorSearcher,
+		HybridSearcher: hybridSearcher,
+		Answerer:       answerer,
+		JobQueue:       jobQueue,
+		Worker:         worker,
+		Scheduler:      taskScheduler,
+		Webhooks:       webhooks,
 
 		// Usecases
 		AuthUsecase:         authUc,
@@ -132,6 +491,25 @@ func NewContainer() *Container {
 		OrganizationUsecase: orgUc,
 		TenantUsecase:       tenantUc,
 		TenantUserUsecase:   tenantUserUc,
+		OriginUsecase:       originUc,
+		TenantDomainUsecase: tenantDomainUc,
+		PublicTenantUsecase: publicTenantUc,
+		SettingsUsecase:     settingsUc,
+		FeatureFlagUsecase:  featureFlagUc,
+		SubscriptionUsecase: subscriptionUc,
+		UsageUsecase:        usageUc,
+		MemberUsecase:       memberUc,
+		UserImportUsecase:   userImportUc,
+		DataExportUsecase:   dataExportUc,
+		PublicExportUsecase: publicExportUc,
+		DocumentUsecase:     documentUc,
+		SearchUsecase:       searchUc,
+		AskUsecase:          askUc,
+		ConversationUsecase: conversationUc,
+		MatchingUsecase:     matchingUc,
+		JobUsecase:          jobUc,
+		TaskUsecase:         taskUc,
+		WebhookUsecase:      webhookUc,
 
 		// Controllers
 		AuthController:         authCtrl,
@@ -140,5 +518,24 @@ func NewContainer() *Container {
 		TenantController:       tenantCtrl,
 		TenantUserController:   tenantUserCtrl,
 		HealthController:       healthCtrl,
+		OriginController:       originCtrl,
+		TenantDomainController: tenantDomainCtrl,
+		PublicTenantController: publicTenantCtrl,
+		SettingsController:     settingsCtrl,
+		FeatureFlagController:  featureFlagCtrl,
+		SubscriptionController: subscriptionCtrl,
+		UsageController:        usageCtrl,
+		MemberController:       memberCtrl,
+		UserImportController:   userImportCtrl,
+		DataExportController:   dataExportCtrl,
+		PublicExportController: publicExportCtrl,
+		DocumentController:     documentCtrl,
+		SearchController:       searchCtrl,
+		AskController:          askCtrl,
+		ConversationController: conversationCtrl,
+		MatchingController:     matchingCtrl,
+		JobController:          jobCtrl,
+		TaskController:         taskCtrl,
+		WebhookController:      webhookCtrl,
 	}
 }
@@ -1,24 +1,49 @@
 package di
 
 import (
+	askRouter "ai-matching/src/api/auth/ask/router"
+	conversationRouter "ai-matching/src/api/auth/conversation/router"
+	dataExportRouter "ai-matching/src/api/auth/data_export/router"
+	documentRouter "ai-matching/src/api/auth/document/router"
+	featureFlagRouter "ai-matching/src/api/auth/feature_flag/router"
+	jobRouter "ai-matching/src/api/auth/job/router"
+	matchingRouter "ai-matching/src/api/auth/matching/router"
 	"ai-matching/src/api/auth/organization/router"
+	memberRouter "ai-matching/src/api/auth/organization_member/router"
+	originRouter "ai-matching/src/api/auth/organization_origin/router"
+	searchRouter "ai-matching/src/api/auth/search/router"
+	settingsRouter "ai-matching/src/api/auth/settings/router"
+	subscriptionRouter "ai-matching/src/api/auth/subscription/router"
+	taskRouter "ai-matching/src/api/auth/task/router"
 	tenantRouter "ai-matching/src/api/auth/tenant/router"
+	tenantDomainRouter "ai-matching/src/api/auth/tenant_domain/router"
 	tenantUserRouter "ai-matching/src/api/auth/tenant_user/router"
+	usageRouter "ai-matching/src/api/auth/usage/router"
 	userRouter "ai-matching/src/api/auth/user/router"
+	userImportRouter "ai-matching/src/api/auth/user_import/router"
+	webhookRouter "ai-matching/src/api/auth/webhook/router"
 	authRouter "ai-matching/src/api/public/authentication/router"
+	publicDataExportRouter "ai-matching/src/api/public/data_export/router"
 	healthRouter "ai-matching/src/api/public/health/router"
+	publicTenantRouter "ai-matching/src/api/public/tenant/router"
 	"ai-matching/src/infrastructure/middleware"
+	"os"
 
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/danielgtaylor/huma/v2/adapters/humafiber"
 	"github.com/gofiber/fiber/v2"
-	"github.com/gofiber/fiber/v2/middleware/cors"
-	"github.com/gofiber/fiber/v2/middleware/logger"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
 	"github.com/gofiber/fiber/v2/middleware/recover"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 func SetupRouter(container *Container) *fiber.App {
 	app := fiber.New(fiber.Config{
+		// PROXY_HEADER (e.g. X-Forwarded-For) makes c.IP() return the client
+		// address when running behind a load balancer.
+		ProxyHeader: os.Getenv("PROXY_HEADER"),
+		// Document uploads go up to 15 MB; the default limit is 4 MB.
+		BodyLimit: 16 << 20,
 		ErrorHandler: func(ctx *fiber.Ctx, err error) error {
 			code := fiber.StatusInternalServerError
 			if e, ok := err.(*fiber.Error); ok {
@@ -30,12 +55,9 @@ func SetupRouter(container *Container) *fiber.App {
 		},
 	})
 	app.Use(recover.New())
-	app.Use(logger.New())
-	app.Use(cors.New(cors.Config{
-		AllowOrigins: "*",
-		AllowHeaders: "Origin, Content-Type, Accept, Authorization, X-COMPANY-ID, X-SYSTEM-ADMIN-ID",
-		AllowMethods: "GET, HEAD, PUT, PATCH, POST, DELETE",
-	}))
+	app.Use(middleware.RequestIDMiddleware())
+	app.Use(middleware.NewRequestLogger(container.Logger).FiberMiddleware())
+	app.Use(middleware.NewCORSMiddleware(container.OriginResolver).FiberMiddleware())
 
 	config := huma.DefaultConfig("Clinic RAG API", "1.0.0")
 	config.DocsPath = "/docs"
@@ -47,21 +69,52 @@ func SetupRouter(container *Container) *fiber.App {
 		},
 	}
 
+	app.Get("/metrics", adaptor.HTTPHandler(promhttp.HandlerFor(container.Metrics, promhttp.HandlerOpts{})))
+
+	// Operations that declare bearer security are authenticated by a huma
+	// middleware; the routes live under /api/v1/organizations, /api/v1/admin
+	// and others rather than one fiber group.
+	authMiddleware := middleware.NewAuthMiddleware(container.UserRepository, container.TenantRepository, container.TenantUserRepository)
+
 	api := humafiber.New(app, config)
+	api.UseMiddleware(
+		middleware.TracingMiddleware,
+		middleware.MetricsMiddleware,
+		authMiddleware.HumaMiddleware(api),
+		middleware.LoggingMiddleware,
+		middleware.APIQuotaMiddleware(api, container.Quotas),
+		middleware.ActivityMiddleware(container.Meter),
+	)
 
 	publicAPI := app.Group("/api/v1/public")
 	authAPI := app.Group("/api/v1/auth")
 
-	authMiddleware := middleware.NewAuthMiddleware(container.UserRepository, container.TenantRepository, container.TenantUserRepository)
-	authAPI.Use(authMiddleware.FiberMiddleware())
-
 	healthRouter.RegisterHealthRoutes(api, publicAPI, container.HealthController)
-	authRouter.RegisterAuthRoutes(api, publicAPI, container.AuthController)
+	authRouter.RegisterAuthRoutes(api, publicAPI, container.AuthController, container.RateLimiter)
+	publicTenantRouter.RegisterTenantRoutes(api, publicAPI, container.PublicTenantController, container.RateLimiter)
+	publicDataExportRouter.RegisterDataExportRoutes(api, publicAPI, container.PublicExportController)
 
 	router.RegisterOrganizationRoutes(api, authAPI, container.OrganizationController)
 	tenantRouter.RegisterTenantRoutes(api, authAPI, container.TenantController)
 	userRouter.RegisterUserRoutes(api, authAPI, container.UserController)
 	tenantUserRouter.RegisterTenantUserRoutes(api, authAPI, container.TenantUserController)
+	originRouter.RegisterOrganizationOriginRoutes(api, authAPI, container.OriginController)
+	tenantDomainRouter.RegisterTenantDomainRoutes(api, authAPI, container.TenantDomainController)
+	settingsRouter.RegisterSettingsRoutes(api, authAPI, container.SettingsController)
+	featureFlagRouter.RegisterFeatureFlagRoutes(api, authAPI, container.FeatureFlagController, container.UserRepository)
+	subscriptionRouter.RegisterSubscriptionRoutes(api, authAPI, container.SubscriptionController, container.UserRepository)
+	usageRouter.RegisterUsageRoutes(api, authAPI, container.UsageController, container.UserRepository)
+	memberRouter.RegisterOrganizationMemberRoutes(api, authAPI, container.MemberController)
+	userImportRouter.RegisterUserImportRoutes(api, authAPI, container.UserImportController)
+	dataExportRouter.RegisterDataExportRoutes(api, authAPI, container.DataExportController)
+	documentRouter.RegisterDocumentRoutes(api, authAPI, container.DocumentController)
+	searchRouter.RegisterSearchRoutes(api, authAPI, container.SearchController)
+	askRouter.RegisterAskRoutes(api, authAPI, container.AskController)
+	conversationRouter.RegisterConversationRoutes(api, authAPI, container.ConversationController)
+	matchingRouter.RegisterMatchingRoutes(api, authAPI, container.MatchingController)
+	jobRouter.RegisterJobRoutes(api, authAPI, container.JobController)
+	taskRouter.RegisterTaskRoutes(api, authAPI, container.TaskController, container.UserRepository)
+	webhookRouter.RegisterWebhookRoutes(api, authAPI, container.WebhookController)
 
 	return app
 }
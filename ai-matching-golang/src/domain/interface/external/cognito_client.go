@@ -8,10 +8,15 @@ import (
 
 type CognitoClient interface {
 	SignUp(ctx context.Context, email, password string, attributes map[string]string) (*cognitoidentityprovider.SignUpOutput, error)
+	AdminCreateUser(ctx context.Context, email string, attributes map[string]string) (*cognitoidentityprovider.AdminCreateUserOutput, error)
 	ConfirmSignUp(ctx context.Context, email, confirmationCode string) error
 	InitiateAuth(ctx context.Context, email, password string) (*cognitoidentityprovider.InitiateAuthOutput, error)
 	RefreshToken(ctx context.Context, refreshToken string) (*cognitoidentityprovider.InitiateAuthOutput, error)
 	ForgotPassword(ctx context.Context, email string) (*cognitoidentityprovider.ForgotPasswordOutput, error)
 	ConfirmForgotPassword(ctx context.Context, email, password, confirmationCode string) error
 	GetUser(ctx context.Context, accessToken string) (*cognitoidentityprovider.GetUserOutput, error)
+	// ListUsers returns a page of pool users; pass the previous page's
+	// PaginationToken to continue, or "" for the first page
+	ListUsers(ctx context.Context, paginationToken string) (*cognitoidentityprovider.ListUsersOutput, error)
+	Ping(ctx context.Context) error
 }
@@ -0,0 +1,8 @@
+package external
+
+import "context"
+
+// DNSResolver looks up TXT records used to prove control of a custom domain.
+type DNSResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
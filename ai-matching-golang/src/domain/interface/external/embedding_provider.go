@@ -0,0 +1,13 @@
+package external
+
+import "context"
+
+// EmbeddingProvider turns texts into vectors whose cosine similarity reflects
+// how related the texts are. Vectors from different models are not
+// comparable, so stored embeddings are tagged with Model.
+type EmbeddingProvider interface {
+	// Embed returns one vector of Dimensions values per text, in order
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+	Model() string
+	Dimensions() int
+}
@@ -0,0 +1,18 @@
+package external
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrFileNotFound is returned by FileStorage when no file exists for a key.
+var ErrFileNotFound = errors.New("file not found")
+
+// FileStorage stores generated files such as data export archives under
+// slash-separated keys.
+type FileStorage interface {
+	Put(ctx context.Context, key string, r io.Reader) (int64, error)
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
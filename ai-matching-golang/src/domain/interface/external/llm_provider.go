@@ -0,0 +1,24 @@
+package external
+
+import "context"
+
+// Chat message roles
+const (
+	RoleSystem    = "system"
+	RoleUser      = "user"
+	RoleAssistant = "assistant"
+)
+
+type ChatMessage struct {
+	Role    string
+	Content string
+}
+
+// LLMProvider generates chat replies
+type LLMProvider interface {
+	// Stream generates a reply to messages and calls onToken with each piece
+	// of text as it arrives. An error from onToken stops generation and is
+	// returned.
+	Stream(ctx context.Context, messages []ChatMessage, onToken func(string) error) error
+	Model() string
+}
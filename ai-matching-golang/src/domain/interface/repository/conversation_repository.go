@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Conversation methods only ever match conversations of the given user in
+// the given tenant.
+type ConversationRepository interface {
+	CreateConversation(ctx context.Context, params db.CreateConversationParams) (db.Conversation, error)
+	GetConversation(ctx context.Context, tenantID, userID, id uuid.UUID) (db.Conversation, error)
+	ListConversations(ctx context.Context, tenantID, userID uuid.UUID, limit, offset int32) ([]db.Conversation, error)
+	CountConversations(ctx context.Context, tenantID, userID uuid.UUID) (int64, error)
+
+	// TouchConversation marks a conversation as active and titles it if it
+	// has no title yet
+	TouchConversation(ctx context.Context, id uuid.UUID, title string) error
+
+	// DeleteConversation returns false when the conversation does not exist
+	DeleteConversation(ctx context.Context, tenantID, userID, id uuid.UUID) (bool, error)
+
+	// Message methods
+	CreateMessage(ctx context.Context, params db.CreateMessageParams) (db.Message, error)
+	ListMessages(ctx context.Context, conversationID uuid.UUID) ([]db.Message, error)
+
+	// ListRecentMessages returns the latest messages, newest first
+	ListRecentMessages(ctx context.Context, conversationID uuid.UUID, limit int32) ([]db.Message, error)
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// DocumentChunkRepository stores the chunks and embeddings of document
+// versions and tracks their indexing state.
+type DocumentChunkRepository interface {
+	GetVersionForIndexing(ctx context.Context, versionID uuid.UUID) (db.GetDocumentVersionForIndexingRow, error)
+
+	// StartIndexing returns false when the version has been indexed or
+	// skipped. A version left indexing by a stopped worker is taken over.
+	StartIndexing(ctx context.Context, versionID uuid.UUID) (bool, error)
+	CompleteIndexing(ctx context.Context, versionID uuid.UUID, chunkCount int32, embeddingModel string) error
+	SetIndexStatus(ctx context.Context, versionID uuid.UUID, status, message string) error
+
+	CreateChunk(ctx context.Context, params db.CreateDocumentChunkParams) error
+	DeleteChunksFrom(ctx context.Context, documentID uuid.UUID, version, firstIndex int32) error
+	DeleteSupersededChunks(ctx context.Context, documentID uuid.UUID, version int32) (int64, error)
+	ListChunks(ctx context.Context, tenantID, documentID uuid.UUID, version int32) ([]db.ListDocumentChunksRow, error)
+
+	// Search methods only match chunks of the current version of documents
+	// of the given tenant that have not been deleted.
+	VectorExtensionInstalled(ctx context.Context) (bool, error)
+	// SearchByVector needs pgvector and returns the nearest chunks by cosine
+	// distance
+	SearchByVector(ctx context.Context, params db.SearchDocumentChunksByVectorParams) ([]db.SearchDocumentChunksByVectorRow, error)
+	ListEmbeddings(ctx context.Context, params db.ListDocumentChunkEmbeddingsParams) ([]db.ListDocumentChunkEmbeddingsRow, error)
+	// SearchByKeyword ranks chunks by full-text match of a web search style
+	// query ("quoted phrases", OR, -excluded)
+	SearchByKeyword(ctx context.Context, params db.SearchDocumentChunksByKeywordParams) ([]db.SearchDocumentChunksByKeywordRow, error)
+	GetChunksByIDs(ctx context.Context, tenantID uuid.UUID, ids []uuid.UUID) ([]db.GetDocumentChunksByIDsRow, error)
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Document methods only ever match documents of the given tenant that have
+// not been deleted.
+type DocumentRepository interface {
+	CreateDocument(ctx context.Context, params db.CreateDocumentParams) (db.Document, error)
+	GetDocument(ctx context.Context, tenantID, id uuid.UUID) (db.Document, error)
+	ListDocuments(ctx context.Context, tenantID uuid.UUID, limit, offset int32) ([]db.Document, error)
+	CountDocuments(ctx context.Context, tenantID uuid.UUID) (int64, error)
+	UpdateDocument(ctx context.Context, params db.UpdateDocumentParams) (db.Document, error)
+
+	// SoftDeleteDocument returns false when the document does not exist
+	SoftDeleteDocument(ctx context.Context, tenantID, id uuid.UUID) (bool, error)
+
+	// Version methods
+	CreateDocumentVersion(ctx context.Context, params db.CreateDocumentVersionParams) (db.DocumentVersion, error)
+	GetDocumentVersion(ctx context.Context, tenantID, documentID uuid.UUID, version int32) (db.DocumentVersion, error)
+	ListDocumentVersions(ctx context.Context, tenantID, documentID uuid.UUID) ([]db.ListDocumentVersionsRow, error)
+
+	// Purge methods work across tenants on documents deleted before a cutoff
+	ListPurgeableDocuments(ctx context.Context, deletedBefore time.Time, limit int32) ([]uuid.UUID, error)
+	ListVersionStorageKeys(ctx context.Context, documentID uuid.UUID) ([]string, error)
+	PurgeDocument(ctx context.Context, id uuid.UUID) (bool, error)
+}
@@ -0,0 +1,6 @@
+package repository
+
+import "errors"
+
+// ErrAlreadyExists is returned when a write violates a unique constraint.
+var ErrAlreadyExists = errors.New("already exists")
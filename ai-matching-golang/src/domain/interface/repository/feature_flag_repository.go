@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type FeatureFlagRepository interface {
+	ListFeatureFlags(ctx context.Context) ([]db.FeatureFlag, error)
+	GetFeatureFlag(ctx context.Context, key string) (db.FeatureFlag, error)
+	UpsertFeatureFlag(ctx context.Context, params db.UpsertFeatureFlagParams) (db.FeatureFlag, error)
+	DeleteFeatureFlag(ctx context.Context, key string) error
+
+	// Override methods
+	ListFeatureFlagOverrides(ctx context.Context) ([]db.FeatureFlagOverride, error)
+	UpsertOrganizationOverride(ctx context.Context, key string, organizationID uuid.UUID, enabled bool) (db.FeatureFlagOverride, error)
+	UpsertTenantOverride(ctx context.Context, key string, tenantID uuid.UUID, enabled bool) (db.FeatureFlagOverride, error)
+	DeleteOrganizationOverride(ctx context.Context, key string, organizationID uuid.UUID) error
+	DeleteTenantOverride(ctx context.Context, key string, tenantID uuid.UUID) error
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type JobRepository interface {
+	CreateJob(ctx context.Context, params db.CreateJobParams) (db.Job, error)
+	GetOrganizationJob(ctx context.Context, organizationID, id uuid.UUID) (db.Job, error)
+	ListOrganizationJobs(ctx context.Context, params db.ListOrganizationJobsParams) ([]db.Job, error)
+	CountOrganizationJobs(ctx context.Context, params db.CountOrganizationJobsParams) (int64, error)
+
+	// RequeueJob queues a dead job again with fresh attempts
+	RequeueJob(ctx context.Context, organizationID, id uuid.UUID) (db.Job, error)
+
+	// DeleteSucceededJobs removes jobs that succeeded before the cutoff
+	DeleteSucceededJobs(ctx context.Context, finishedBefore time.Time) (int64, error)
+
+	// Worker methods. Jobs locked before staleBefore belong to a worker that
+	// stopped; the finishing methods return false when the worker no longer
+	// holds the job.
+	ClaimJobs(ctx context.Context, workerID string, staleBefore time.Time, limit int32) ([]db.Job, error)
+	BuryStaleJobs(ctx context.Context, staleBefore time.Time) (int64, error)
+	CompleteJob(ctx context.Context, id uuid.UUID, workerID string) (bool, error)
+	RetryJob(ctx context.Context, id uuid.UUID, workerID string, delay time.Duration, lastError string) (bool, error)
+	BuryJob(ctx context.Context, id uuid.UUID, workerID string, lastError string) (bool, error)
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Match methods only ever match candidates and openings of the given tenant,
+// except the embedding setters, which take IDs read from a tenant query.
+type MatchRepository interface {
+	CreateCandidate(ctx context.Context, params db.CreateMatchCandidateParams) (db.MatchCandidate, error)
+	GetCandidate(ctx context.Context, tenantID, id uuid.UUID) (db.MatchCandidate, error)
+	ListCandidates(ctx context.Context, tenantID uuid.UUID, limit, offset int32) ([]db.MatchCandidate, error)
+	CountCandidates(ctx context.Context, tenantID uuid.UUID) (int64, error)
+	UpdateCandidate(ctx context.Context, params db.UpdateMatchCandidateParams) (db.MatchCandidate, error)
+	SetCandidateEmbedding(ctx context.Context, params db.SetMatchCandidateEmbeddingParams) error
+
+	// DeleteCandidate returns false when the candidate does not exist
+	DeleteCandidate(ctx context.Context, tenantID, id uuid.UUID) (bool, error)
+
+	// ListCandidatesAfter returns the next page of candidates ordered by ID,
+	// limited to ids unless it is empty
+	ListCandidatesAfter(ctx context.Context, tenantID, afterID uuid.UUID, ids []uuid.UUID, limit int32) ([]db.MatchCandidate, error)
+
+	// Opening methods
+	CreateOpening(ctx context.Context, params db.CreateMatchOpeningParams) (db.MatchOpening, error)
+	GetOpening(ctx context.Context, tenantID, id uuid.UUID) (db.MatchOpening, error)
+	ListOpenings(ctx context.Context, tenantID uuid.UUID, limit, offset int32) ([]db.MatchOpening, error)
+	CountOpenings(ctx context.Context, tenantID uuid.UUID) (int64, error)
+	UpdateOpening(ctx context.Context, params db.UpdateMatchOpeningParams) (db.MatchOpening, error)
+	SetOpeningEmbedding(ctx context.Context, params db.SetMatchOpeningEmbeddingParams) error
+
+	// DeleteOpening returns false when the opening does not exist
+	DeleteOpening(ctx context.Context, tenantID, id uuid.UUID) (bool, error)
+
+	// ListOpenOpeningsAfter returns the next page of open openings ordered by
+	// ID, limited to ids unless it is empty
+	ListOpenOpeningsAfter(ctx context.Context, tenantID, afterID uuid.UUID, ids []uuid.UUID, limit int32) ([]db.MatchOpening, error)
+}
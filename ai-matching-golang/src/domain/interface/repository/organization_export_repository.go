@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OrganizationExportRepository interface {
+	CreateOrganizationExport(ctx context.Context, organizationID uuid.UUID, requestedBy uuid.NullUUID) (db.OrganizationExport, error)
+	GetOrganizationExport(ctx context.Context, organizationID, id uuid.UUID) (db.OrganizationExport, error)
+	GetOrganizationExportByID(ctx context.Context, id uuid.UUID) (db.OrganizationExport, error)
+	ListOrganizationExports(ctx context.Context, organizationID uuid.UUID, limit int32) ([]db.OrganizationExport, error)
+
+	// StartOrganizationExport moves a pending, failed or abandoned processing
+	// export to processing and returns false when it has already finished.
+	StartOrganizationExport(ctx context.Context, id uuid.UUID) (bool, error)
+	CompleteOrganizationExport(ctx context.Context, id uuid.UUID, storageKey string, sizeBytes int64, expiresAt time.Time) error
+	FailOrganizationExport(ctx context.Context, id uuid.UUID, message string) error
+
+	// ListExpiredOrganizationExports returns completed exports past their
+	// retention whose archive is still stored
+	ListExpiredOrganizationExports(ctx context.Context, limit int32) ([]db.OrganizationExport, error)
+	ClearOrganizationExportStorage(ctx context.Context, id uuid.UUID) error
+
+	// Export data methods
+	ListTenants(ctx context.Context, organizationID uuid.UUID) ([]db.Tenant, error)
+	ListUsers(ctx context.Context, organizationID uuid.UUID) ([]db.User, error)
+	ListTenantMemberships(ctx context.Context, organizationID uuid.UUID) ([]db.ListOrganizationExportTenantMembershipsRow, error)
+	ListAuditEvents(ctx context.Context, organizationID uuid.UUID) ([]db.ListOrganizationAuditEventsRow, error)
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Organization member roles
+const (
+	OrganizationRoleOwner   = "owner"
+	OrganizationRoleAdmin   = "admin"
+	OrganizationRoleBilling = "billing"
+)
+
+type OrganizationMemberRepository interface {
+	GetOrganizationMember(ctx context.Context, organizationID, userID uuid.UUID) (db.OrganizationMember, error)
+	ListOrganizationMembers(ctx context.Context, organizationID uuid.UUID) ([]db.ListOrganizationMembersRow, error)
+	AddOrganizationMember(ctx context.Context, organizationID, userID uuid.UUID, role string) (db.OrganizationMember, error)
+
+	// UpdateOrganizationMemberRole and DeleteOrganizationMember return false
+	// when the change would leave the organization without an owner.
+	UpdateOrganizationMemberRole(ctx context.Context, organizationID, userID uuid.UUID, role string) (bool, error)
+	DeleteOrganizationMember(ctx context.Context, organizationID, userID uuid.UUID) (bool, error)
+
+	// Ownership transfer methods
+	GetOwnershipTransfer(ctx context.Context, organizationID, id uuid.UUID) (db.OrganizationOwnershipTransfer, error)
+	GetPendingOwnershipTransfer(ctx context.Context, organizationID uuid.UUID) (db.OrganizationOwnershipTransfer, error)
+	CreateOwnershipTransfer(ctx context.Context, organizationID, fromUserID, toUserID uuid.UUID, expiresAt time.Time) (db.OrganizationOwnershipTransfer, error)
+	CancelOwnershipTransfer(ctx context.Context, organizationID, id uuid.UUID) (bool, error)
+
+	// AcceptOwnershipTransfer returns false when the transfer is no longer
+	// pending or either party has left the organization
+	AcceptOwnershipTransfer(ctx context.Context, organizationID, id uuid.UUID) (bool, error)
+
+	// ExpireOwnershipTransfers marks pending transfers past their deadline
+	// expired and returns how many there were
+	ExpireOwnershipTransfers(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type OrganizationOriginRepository interface {
+	GetOrganizationOrigin(ctx context.Context, organizationID, id uuid.UUID) (db.OrganizationOrigin, error)
+	ListOrganizationOrigins(ctx context.Context, organizationID uuid.UUID) ([]db.OrganizationOrigin, error)
+	CreateOrganizationOrigin(ctx context.Context, organizationID uuid.UUID, origin string) (db.OrganizationOrigin, error)
+	DeleteOrganizationOrigin(ctx context.Context, organizationID, id uuid.UUID) error
+
+	// IsOriginRegistered reports whether an active organization registered the origin.
+	IsOriginRegistered(ctx context.Context, origin string) (bool, error)
+}
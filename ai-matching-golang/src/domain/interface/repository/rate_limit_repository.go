@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// RateLimitRepository stores fixed-window counters. The operations map onto
+// INCR/PEXPIRE/GET/PTTL/DEL so a Redis implementation can be swapped in.
+type RateLimitRepository interface {
+	// Increment bumps the counter for key, starting a new window of the given
+	// length when none is active, and returns the count and window end.
+	Increment(ctx context.Context, key string, window time.Duration) (int64, time.Time, error)
+
+	// Get returns the active count and window end for key, or zero when there
+	// is no active window.
+	Get(ctx context.Context, key string) (int64, time.Time, error)
+
+	Reset(ctx context.Context, key string) error
+
+	// DeleteExpired purges counters whose window has ended.
+	DeleteExpired(ctx context.Context) (int64, error)
+}
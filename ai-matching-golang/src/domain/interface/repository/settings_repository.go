@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+type SettingsRepository interface {
+	// Organization settings
+	ListOrganizationSettings(ctx context.Context, organizationID uuid.UUID) ([]db.OrganizationSetting, error)
+	// UpdateOrganizationSettings writes all values in one transaction; a nil
+	// value removes the override
+	UpdateOrganizationSettings(ctx context.Context, organizationID uuid.UUID, values map[string]json.RawMessage, updatedBy uuid.NullUUID) error
+
+	// Tenant settings
+	ListTenantSettings(ctx context.Context, tenantID uuid.UUID) ([]db.TenantSetting, error)
+	// UpdateTenantSettings writes all values in one transaction; a nil value
+	// removes the override
+	UpdateTenantSettings(ctx context.Context, tenantID uuid.UUID, values map[string]json.RawMessage, updatedBy uuid.NullUUID) error
+}
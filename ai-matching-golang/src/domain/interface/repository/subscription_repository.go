@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SubscriptionRepository interface {
+	ListPlans(ctx context.Context) ([]db.Plan, error)
+	GetPlan(ctx context.Context, code string) (db.Plan, error)
+
+	// Subscription methods
+	GetOrganizationSubscription(ctx context.Context, organizationID uuid.UUID) (db.OrganizationSubscription, error)
+	UpsertOrganizationSubscription(ctx context.Context, organizationID uuid.UUID, planCode, status string) (db.OrganizationSubscription, error)
+
+	// Usage methods
+	IncrementAPIUsage(ctx context.Context, organizationID uuid.UUID, periodStart time.Time) (int64, error)
+	GetAPIUsage(ctx context.Context, organizationID uuid.UUID, periodStart time.Time) (int64, error)
+	ListTenantSeatCounts(ctx context.Context, organizationID uuid.UUID) ([]db.ListTenantSeatCountsRow, error)
+}
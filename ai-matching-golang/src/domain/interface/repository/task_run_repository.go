@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TaskRunRepository interface {
+	// StartScheduledRun records a scheduled run and returns false when the
+	// slot was already run, such as by another replica
+	StartScheduledRun(ctx context.Context, taskName string, scheduledFor time.Time, workerID string) (db.TaskRun, bool, error)
+	StartManualRun(ctx context.Context, taskName string, triggeredBy uuid.NullUUID, workerID string) (db.TaskRun, error)
+	FinishRun(ctx context.Context, id uuid.UUID, status, summary, errorMessage string) error
+
+	// ListLatestRuns returns the most recent run of each task
+	ListLatestRuns(ctx context.Context) ([]db.TaskRun, error)
+	ListRuns(ctx context.Context, taskName string, limit, offset int32) ([]db.TaskRun, error)
+	CountRuns(ctx context.Context, taskName string) (int64, error)
+	DeleteRunsBefore(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type TenantDomainRepository interface {
+	GetTenantDomain(ctx context.Context, tenantID, id uuid.UUID) (db.TenantDomain, error)
+	ListTenantDomains(ctx context.Context, tenantID uuid.UUID) ([]db.TenantDomain, error)
+	CreateTenantDomain(ctx context.Context, params db.CreateTenantDomainParams) (db.TenantDomain, error)
+	MarkTenantDomainVerified(ctx context.Context, tenantID, id uuid.UUID) (db.TenantDomain, error)
+	SetPrimaryTenantDomain(ctx context.Context, tenantID, id uuid.UUID) error
+	DeleteTenantDomain(ctx context.Context, tenantID, id uuid.UUID) error
+
+	// GetTenantByVerifiedDomain resolves an active tenant from a verified custom domain.
+	GetTenantByVerifiedDomain(ctx context.Context, domain string) (db.Tenant, error)
+}
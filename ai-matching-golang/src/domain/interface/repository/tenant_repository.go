@@ -3,6 +3,7 @@ package repository
 import (
 	"ai-matching/db/sqlc"
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -22,4 +23,11 @@ type TenantRepository interface {
 
 	// Count methods
 	CountTenantsByOrganization(ctx context.Context, organizationID uuid.UUID) (int64, error)
+
+	// Subdomain alias methods
+	ListTakenSubdomains(ctx context.Context, subdomains []string, excludeTenantID uuid.UUID) ([]string, error)
+	UpsertSubdomainAlias(ctx context.Context, subdomain string, tenantID uuid.UUID, expiresAt time.Time) error
+	GetTenantBySubdomainAlias(ctx context.Context, subdomain string) (db.Tenant, error)
+	DeleteSubdomainAlias(ctx context.Context, subdomain string) error
+	DeleteExpiredSubdomainAliases(ctx context.Context) (int64, error)
 }
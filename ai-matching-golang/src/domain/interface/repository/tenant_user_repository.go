@@ -28,4 +28,7 @@ type TenantUserRepository interface {
 	
 	// List all users in tenant with details
 	ListTenantUsers(ctx context.Context, tenantID uuid.UUID) ([]db.ListTenantUsersRow, error)
+	
+	// Count users in tenant
+	CountTenantUsers(ctx context.Context, tenantID uuid.UUID) (int64, error)
 }
\ No newline at end of file
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UsageRepository interface {
+	RecordUserActivity(ctx context.Context, date time.Time, organizationID, tenantID, userID uuid.UUID) error
+
+	// Snapshot methods (each returns the number of usage records written)
+	SnapshotTenantActiveUsers(ctx context.Context, date time.Time) (int64, error)
+	SnapshotOrganizationActiveUsers(ctx context.Context, date time.Time) (int64, error)
+	SnapshotTenantSeats(ctx context.Context, date time.Time) (int64, error)
+	SnapshotOrganizationSeats(ctx context.Context, date time.Time) (int64, error)
+	SnapshotOrganizationAPICalls(ctx context.Context, date time.Time) (int64, error)
+
+	// Period methods (start inclusive, end exclusive)
+	ListUsageRecords(ctx context.Context, start, end time.Time) ([]db.ListUsageRecordsByPeriodRow, error)
+	CountTenantMonthlyActiveUsers(ctx context.Context, start, end time.Time) ([]db.CountTenantMonthlyActiveUsersRow, error)
+	CountOrganizationMonthlyActiveUsers(ctx context.Context, start, end time.Time) ([]db.CountOrganizationMonthlyActiveUsersRow, error)
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type UserImportRepository interface {
+	CreateUserImport(ctx context.Context, params db.CreateUserImportParams) (db.UserImport, error)
+	GetUserImport(ctx context.Context, organizationID, id uuid.UUID) (db.UserImport, error)
+	ListUserImports(ctx context.Context, organizationID uuid.UUID, limit int32) ([]db.UserImport, error)
+
+	// StartUserImport moves a pending, failed or abandoned processing import
+	// to processing and returns false when it has already finished.
+	StartUserImport(ctx context.Context, id uuid.UUID) (bool, error)
+	FinishUserImport(ctx context.Context, id uuid.UUID, status string) error
+
+	// Row methods
+	CreateUserImportRow(ctx context.Context, params db.CreateUserImportRowParams) error
+	ListUserImportRows(ctx context.Context, importID uuid.UUID) ([]db.ListUserImportRowsRow, error)
+	UpdateUserImportRow(ctx context.Context, params db.UpdateUserImportRowParams) error
+}
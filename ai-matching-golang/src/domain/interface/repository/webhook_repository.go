@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookRepository interface {
+	CreateEndpoint(ctx context.Context, params db.CreateWebhookEndpointParams) (db.WebhookEndpoint, error)
+	GetEndpoint(ctx context.Context, organizationID, id uuid.UUID) (db.WebhookEndpoint, error)
+	ListEndpoints(ctx context.Context, organizationID uuid.UUID) ([]db.WebhookEndpoint, error)
+	UpdateEndpoint(ctx context.Context, params db.UpdateWebhookEndpointParams) (db.WebhookEndpoint, error)
+	DeleteEndpoint(ctx context.Context, organizationID, id uuid.UUID) (bool, error)
+
+	// RotateEndpointSecret replaces the signing secret; the old one keeps
+	// signing until previousExpiresAt
+	RotateEndpointSecret(ctx context.Context, organizationID, id uuid.UUID, secret string, previousExpiresAt time.Time) (db.WebhookEndpoint, error)
+
+	// ListSubscribedEndpoints returns the active endpoints that receive an event type
+	ListSubscribedEndpoints(ctx context.Context, organizationID uuid.UUID, eventType string) ([]db.WebhookEndpoint, error)
+
+	// Delivery methods
+	CreateDelivery(ctx context.Context, params db.CreateWebhookDeliveryParams) (db.WebhookDelivery, error)
+	GetDelivery(ctx context.Context, endpointID, id uuid.UUID) (db.WebhookDelivery, error)
+	ListDeliveries(ctx context.Context, params db.ListWebhookDeliveriesParams) ([]db.WebhookDelivery, error)
+	CountDeliveries(ctx context.Context, params db.CountWebhookDeliveriesParams) (int64, error)
+	DeleteDeliveriesBefore(ctx context.Context, before time.Time) (int64, error)
+
+	// GetDeliveryTarget loads a delivery with its endpoint's URL and secrets
+	GetDeliveryTarget(ctx context.Context, id uuid.UUID) (db.GetWebhookDeliveryTargetRow, error)
+	// RecordDeliveryAttempt and FailDelivery only change pending deliveries
+	RecordDeliveryAttempt(ctx context.Context, params db.RecordWebhookDeliveryAttemptParams) error
+	FailDelivery(ctx context.Context, id uuid.UUID, errorMessage string) error
+}
@@ -0,0 +1,10 @@
+package buildinfo
+
+// Version, Commit and BuildTime are injected at link time, e.g.
+//
+//	go build -ldflags "-X ai-matching/src/infrastructure/buildinfo.Version=1.2.3"
+var (
+	Version   = "dev"
+	Commit    = "unknown"
+	BuildTime = "unknown"
+)
@@ -0,0 +1,262 @@
+package chunking
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Units a chunk size can be measured in
+const (
+	UnitTokens     = "tokens"
+	UnitCharacters = "characters"
+)
+
+// maxWordRunes splits very long runs without spaces (URLs, encoded data) so
+// that a single word can never blow up a chunk.
+const maxWordRunes = 64
+
+// Options controls the size of the windows a text is split into. Size and
+// Overlap are measured in Unit.
+type Options struct {
+	Unit    string
+	Size    int
+	Overlap int
+}
+
+// DefaultOptions returns windows of 256 tokens overlapping by 32
+func DefaultOptions() Options {
+	return Options{Unit: UnitTokens, Size: 256, Overlap: 32}
+}
+
+// Chunk is a piece of a text. Start and End are byte offsets into the text
+// that was split.
+type Chunk struct {
+	Index   int
+	Heading string
+	Content string
+	Start   int
+	End     int
+	Tokens  int
+}
+
+// EmbeddingText is the text to embed for the chunk. The heading path is
+// prepended so that chunks deep in a section keep its context.
+func (c Chunk) EmbeddingText() string {
+	if c.Heading == "" {
+		return c.Content
+	}
+	return c.Heading + "\n\n" + c.Content
+}
+
+// Chunker splits text into overlapping windows that prefer to end at
+// paragraph, line or sentence boundaries.
+type Chunker struct {
+	opts Options
+}
+
+func NewChunker(opts Options) *Chunker {
+	defaults := DefaultOptions()
+	if opts.Unit != UnitCharacters {
+		opts.Unit = UnitTokens
+	}
+	if opts.Size <= 0 {
+		opts.Size = defaults.Size
+		if opts.Unit == UnitCharacters {
+			opts.Size *= 4
+		}
+	}
+	if opts.Overlap < 0 {
+		opts.Overlap = 0
+	}
+	if opts.Overlap >= opts.Size {
+		opts.Overlap = opts.Size / 4
+	}
+	return &Chunker{opts: opts}
+}
+
+// Options returns the options in effect after defaults were applied
+func (c *Chunker) Options() Options {
+	return c.opts
+}
+
+// Split returns the chunks of text. Markdown is first divided into sections
+// at its headings so that no chunk spans two sections; each chunk carries
+// the path of headings it falls under.
+func (c *Chunker) Split(text string, markdown bool) []Chunk {
+	sections := []section{{start: 0, end: len(text)}}
+	if markdown {
+		sections = markdownSections(text)
+	}
+
+	var chunks []Chunk
+	for _, s := range sections {
+		for _, w := range c.windows(text, s.start, s.end) {
+			start, end := trimSpan(text, w.start, w.end)
+			if start >= end {
+				continue
+			}
+			content := text[start:end]
+			chunks = append(chunks, Chunk{
+				Index:   len(chunks),
+				Heading: s.heading,
+				Content: content,
+				Start:   start,
+				End:     end,
+				Tokens:  CountTokens(content),
+			})
+		}
+	}
+	return chunks
+}
+
+// CountTokens estimates the number of model tokens in text. Words count one
+// token per five characters, punctuation one token per mark.
+func CountTokens(text string) int {
+	total := 0
+	for _, w := range words(text, 0, len(text), maxWordRunes) {
+		total += tokenWeight(w)
+	}
+	return total
+}
+
+type span struct {
+	start, end int
+	runes      int
+	// gap is the whitespace that follows the span
+	gap string
+}
+
+func (c *Chunker) weight(s span) int {
+	if c.opts.Unit == UnitCharacters {
+		return s.runes + min(utf8.RuneCountInString(s.gap), 1)
+	}
+	return tokenWeight(s)
+}
+
+func tokenWeight(s span) int {
+	return (s.runes + 4) / 5
+}
+
+type window struct {
+	start, end int
+}
+
+func (c *Chunker) windows(text string, from, to int) []window {
+	pieceRunes := maxWordRunes
+	if c.opts.Unit == UnitCharacters {
+		pieceRunes = min(pieceRunes, c.opts.Size)
+	}
+	spans := words(text, from, to, pieceRunes)
+	if len(spans) == 0 {
+		return nil
+	}
+
+	// cum[i] is the weight of spans[:i]
+	cum := make([]int, len(spans)+1)
+	for i, s := range spans {
+		cum[i+1] = cum[i] + c.weight(s)
+	}
+
+	var out []window
+	for i := 0; i < len(spans); {
+		j := i + 1
+		for j < len(spans) && cum[j+1]-cum[i] <= c.opts.Size {
+			j++
+		}
+
+		// Pull the end back to a natural boundary in the second half of
+		// the window, unless this is the last one.
+		if j < len(spans) {
+			best, bestScore := j, 0
+			for k := j; k > i+1 && cum[k]-cum[i] > c.opts.Size/2; k-- {
+				if score := breakScore(text, spans[k-1]); score > bestScore {
+					best, bestScore = k, score
+				}
+			}
+			j = best
+		}
+
+		out = append(out, window{start: spans[i].start, end: spans[j-1].end})
+		if j >= len(spans) {
+			break
+		}
+
+		next := j
+		for next > i+1 && cum[j]-cum[next-1] <= c.opts.Overlap {
+			next--
+		}
+		i = next
+	}
+	return out
+}
+
+// breakScore ranks how good a place the end of s is to end a chunk
+func breakScore(text string, s span) int {
+	switch {
+	case strings.Count(s.gap, "\n") >= 2:
+		return 3
+	case strings.Contains(s.gap, "\n"):
+		return 2
+	}
+	last, _ := utf8.DecodeLastRuneInString(text[s.start:s.end])
+	switch last {
+	case '.', '!', '?', ';', '。', '！', '？':
+		return 1
+	}
+	return 0
+}
+
+// words returns the words and punctuation marks of text[from:to]. Words
+// longer than pieceRunes are cut into pieces.
+func words(text string, from, to, pieceRunes int) []span {
+	var spans []span
+	i := from
+	for i < to {
+		r, size := utf8.DecodeRuneInString(text[i:to])
+		if unicode.IsSpace(r) {
+			if n := len(spans); n > 0 {
+				spans[n-1].gap += string(r)
+			}
+			i += size
+			continue
+		}
+
+		start, runes := i, 1
+		i += size
+		if isWordRune(r) {
+			for i < to && runes < pieceRunes {
+				r, size = utf8.DecodeRuneInString(text[i:to])
+				if !isWordRune(r) {
+					break
+				}
+				i += size
+				runes++
+			}
+		}
+		spans = append(spans, span{start: start, end: i, runes: runes})
+	}
+	return spans
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r) || r == '_'
+}
+
+func trimSpan(text string, start, end int) (int, int) {
+	for start < end {
+		r, size := utf8.DecodeRuneInString(text[start:end])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		start += size
+	}
+	for end > start {
+		r, size := utf8.DecodeLastRuneInString(text[start:end])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		end -= size
+	}
+	return start, end
+}
@@ -0,0 +1,106 @@
+package chunking
+
+import "strings"
+
+// section is a byte range of a Markdown text under one heading path
+type section struct {
+	start, end int
+	heading    string
+}
+
+// markdownSections divides text at its ATX headings ("## Title"). Headings
+// inside fenced code blocks are ignored, and sections holding nothing but
+// their heading are dropped since the heading survives in the path of the
+// sections below it.
+func markdownSections(text string) []section {
+	var (
+		sections []section
+		path     [6]string
+		current  = section{start: 0}
+		fence    string
+		body     bool
+	)
+
+	closeSection := func(end int) {
+		current.end = end
+		if body {
+			sections = append(sections, current)
+		}
+	}
+
+	for offset := 0; offset < len(text); {
+		lineEnd := strings.IndexByte(text[offset:], '\n')
+		next := len(text)
+		if lineEnd >= 0 {
+			next = offset + lineEnd + 1
+		}
+		line := strings.TrimRight(text[offset:next], "\r\n")
+		trimmed := strings.TrimLeft(line, " ")
+
+		switch {
+		case fence != "":
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			body = true
+		case strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~"):
+			fence = trimmed[:3]
+			body = true
+		default:
+			if level, title, ok := parseHeading(line); ok {
+				closeSection(offset)
+
+				path[level-1] = title
+				for i := level; i < len(path); i++ {
+					path[i] = ""
+				}
+				current = section{start: offset, heading: joinPath(path[:level])}
+				body = false
+			} else if strings.TrimSpace(line) != "" {
+				body = true
+			}
+		}
+		offset = next
+	}
+	closeSection(len(text))
+
+	return sections
+}
+
+// parseHeading recognizes "# Title" through "###### Title" with up to three
+// spaces of indentation. Closing hashes are removed from the title.
+func parseHeading(line string) (int, string, bool) {
+	trimmed := strings.TrimLeft(line, " ")
+	if len(line)-len(trimmed) > 3 {
+		return 0, "", false
+	}
+
+	level := 0
+	for level < len(trimmed) && trimmed[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 {
+		return 0, "", false
+	}
+
+	rest := trimmed[level:]
+	if rest != "" && rest[0] != ' ' && rest[0] != '\t' {
+		return 0, "", false
+	}
+
+	title := strings.TrimSpace(rest)
+	if stripped := strings.TrimRight(title, "#"); stripped == "" || strings.HasSuffix(stripped, " ") {
+		title = strings.TrimSpace(stripped)
+	}
+	return level, title, true
+}
+
+func joinPath(levels []string) string {
+	parts := make([]string, 0, len(levels))
+	for _, title := range levels {
+		if title != "" {
+			parts = append(parts, title)
+		}
+	}
+	return strings.Join(parts, " > ")
+}
@@ -0,0 +1,40 @@
+package cors
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+var ErrInvalidOrigin = errors.New("origin must be an http(s) scheme and host without path, query or fragment")
+
+// NormalizeOrigin validates a browser origin and returns it in the canonical
+// form browsers send in the Origin header: lowercase scheme://host[:port].
+func NormalizeOrigin(origin string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(origin))
+	if err != nil {
+		return "", ErrInvalidOrigin
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", ErrInvalidOrigin
+	}
+	if u.Host == "" || u.User != nil || u.RawQuery != "" || u.Fragment != "" || u.Opaque != "" {
+		return "", ErrInvalidOrigin
+	}
+	if u.Path != "" && u.Path != "/" {
+		return "", ErrInvalidOrigin
+	}
+
+	host := strings.ToLower(u.Hostname())
+	port := u.Port()
+	if (u.Scheme == "http" && port == "80") || (u.Scheme == "https" && port == "443") {
+		port = ""
+	}
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	if port != "" {
+		host += ":" + port
+	}
+	return u.Scheme + "://" + host, nil
+}
@@ -0,0 +1,143 @@
+package cors
+
+import (
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/tenancy"
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	cacheTTL        = time.Minute
+	cacheMaxEntries = 10000
+	lookupTimeout   = 2 * time.Second
+)
+
+type cacheEntry struct {
+	allowed   bool
+	expiresAt time.Time
+}
+
+// Resolver decides whether a request origin may make credentialed CORS
+// requests. Origins are allowed when they are listed in CORS_ALLOWED_ORIGINS,
+// address an active tenant through its subdomain of TENANT_BASE_DOMAIN or a
+// verified custom domain, or were registered by an organization. Database
+// answers are cached for a minute so preflight requests do not hit Postgres
+// every time.
+type Resolver struct {
+	static       map[string]struct{}
+	hostResolver *tenancy.HostResolver
+	originRepo   repository.OrganizationOriginRepository
+
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+}
+
+func NewResolver(hostResolver *tenancy.HostResolver, originRepo repository.OrganizationOriginRepository) *Resolver {
+	static := make(map[string]struct{})
+	for _, origin := range strings.Split(os.Getenv("CORS_ALLOWED_ORIGINS"), ",") {
+		if origin = strings.TrimSpace(origin); origin == "" {
+			continue
+		}
+		normalized, err := NormalizeOrigin(origin)
+		if err != nil {
+			slog.Warn("ignoring invalid CORS_ALLOWED_ORIGINS entry", "origin", origin)
+			continue
+		}
+		static[normalized] = struct{}{}
+	}
+
+	return &Resolver{
+		static:       static,
+		hostResolver: hostResolver,
+		originRepo:   originRepo,
+		cache:        make(map[string]cacheEntry),
+	}
+}
+
+// Allowed reports whether origin may access the API.
+func (r *Resolver) Allowed(ctx context.Context, origin string) bool {
+	normalized, err := NormalizeOrigin(origin)
+	if err != nil {
+		return false
+	}
+	if _, ok := r.static[normalized]; ok {
+		return true
+	}
+
+	now := time.Now()
+	r.mu.Lock()
+	entry, ok := r.cache[normalized]
+	r.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.allowed
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, lookupTimeout)
+	defer cancel()
+
+	allowed, err := r.lookup(ctx, normalized)
+	if err != nil {
+		// Do not cache failures so the origin is re-evaluated once the
+		// database is reachable again.
+		slog.WarnContext(ctx, "failed to resolve CORS origin", "origin", normalized, "error", err)
+		return false
+	}
+
+	r.mu.Lock()
+	if len(r.cache) >= cacheMaxEntries {
+		r.sweep(now)
+	}
+	r.cache[normalized] = cacheEntry{allowed: allowed, expiresAt: now.Add(cacheTTL)}
+	r.mu.Unlock()
+
+	return allowed
+}
+
+// Invalidate drops the cached decision for origin so changes made through the
+// management API take effect immediately on this instance.
+func (r *Resolver) Invalidate(origin string) {
+	normalized, err := NormalizeOrigin(origin)
+	if err != nil {
+		return
+	}
+	r.mu.Lock()
+	delete(r.cache, normalized)
+	r.mu.Unlock()
+}
+
+func (r *Resolver) lookup(ctx context.Context, origin string) (bool, error) {
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false, err
+	}
+	_, err = r.hostResolver.Resolve(ctx, u.Hostname())
+	if err == nil {
+		return true, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return false, err
+	}
+
+	return r.originRepo.IsOriginRegistered(ctx, origin)
+}
+
+// sweep removes expired entries and, if the cache is still full, starts over
+// so arbitrary Origin headers cannot grow it without bound.
+func (r *Resolver) sweep(now time.Time) {
+	for origin, entry := range r.cache {
+		if !now.Before(entry.expiresAt) {
+			delete(r.cache, origin)
+		}
+	}
+	if len(r.cache) >= cacheMaxEntries {
+		r.cache = make(map[string]cacheEntry)
+	}
+}
@@ -0,0 +1,181 @@
+package dataexport
+
+import (
+	"ai-matching/db/sqlc"
+	"archive/zip"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// archive is everything exported for one organization. Tabular data is
+// written as CSV, nested or free-form data as JSON.
+type archive struct {
+	exportID    uuid.UUID
+	generatedAt time.Time
+	org         db.Organization
+	tenants     []db.Tenant
+	users       []db.User
+	memberships []db.ListOrganizationExportTenantMembershipsRow
+	members     []db.ListOrganizationMembersRow
+	events      []db.ListOrganizationAuditEventsRow
+}
+
+type manifest struct {
+	ExportID       uuid.UUID      `json:"exportId"`
+	OrganizationID uuid.UUID      `json:"organizationId"`
+	GeneratedAt    time.Time      `json:"generatedAt"`
+	Files          []manifestFile `json:"files"`
+}
+
+type manifestFile struct {
+	Name    string `json:"name"`
+	Records int    `json:"records"`
+}
+
+type organizationRecord struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	IsActive    bool      `json:"isActive"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+type auditEventRecord struct {
+	OccurredAt time.Time `json:"occurredAt"`
+	EventType  string    `json:"eventType"`
+	ActorID    string    `json:"actorId,omitempty"`
+	SubjectID  string    `json:"subjectId"`
+	Detail     string    `json:"detail,omitempty"`
+}
+
+func (a *archive) write(w io.Writer) error {
+	zw := zip.NewWriter(w)
+
+	m := manifest{
+		ExportID:       a.exportID,
+		OrganizationID: a.org.ID,
+		GeneratedAt:    a.generatedAt,
+	}
+	files := []struct {
+		name    string
+		records int
+		write   func(io.Writer) error
+	}{
+		{"organization.json", 1, a.writeOrganization},
+		{"tenants.csv", len(a.tenants), a.writeTenants},
+		{"users.csv", len(a.users), a.writeUsers},
+		{"tenant_memberships.csv", len(a.memberships), a.writeMemberships},
+		{"organization_members.csv", len(a.members), a.writeMembers},
+		{"audit_events.json", len(a.events), a.writeEvents},
+	}
+
+	for _, file := range files {
+		if err := a.add(zw, file.name, file.write); err != nil {
+			return err
+		}
+		m.Files = append(m.Files, manifestFile{Name: file.name, Records: file.records})
+	}
+
+	if err := a.add(zw, "manifest.json", func(w io.Writer) error {
+		return writeJSON(w, m)
+	}); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+func (a *archive) add(zw *zip.Writer, name string, write func(io.Writer) error) error {
+	w, err := zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: a.generatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add %s: %w", name, err)
+	}
+	if err := write(w); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+func (a *archive) writeOrganization(w io.Writer) error {
+	return writeJSON(w, organizationRecord{
+		ID:          a.org.ID,
+		Name:        a.org.Name,
+		Description: a.org.Description.String,
+		IsActive:    a.org.IsActive,
+		CreatedAt:   a.org.CreatedAt,
+		UpdatedAt:   a.org.UpdatedAt,
+	})
+}
+
+func (a *archive) writeTenants(w io.Writer) error {
+	rows := [][]string{{"id", "name", "subdomain", "is_active", "created_at", "updated_at"}}
+	for _, t := range a.tenants {
+		rows = append(rows, []string{t.ID.String(), t.Name, t.Subdomain, fmt.Sprint(t.IsActive), formatTime(t.CreatedAt), formatTime(t.UpdatedAt)})
+	}
+	return writeCSV(w, rows)
+}
+
+func (a *archive) writeUsers(w io.Writer) error {
+	rows := [][]string{{"id", "email", "first_name", "last_name", "cognito_id", "created_at", "updated_at"}}
+	for _, u := range a.users {
+		rows = append(rows, []string{u.ID.String(), u.Email, u.FirstName.String, u.LastName.String, u.CognitoID, formatTime(u.CreatedAt), formatTime(u.UpdatedAt)})
+	}
+	return writeCSV(w, rows)
+}
+
+func (a *archive) writeMemberships(w io.Writer) error {
+	rows := [][]string{{"tenant_id", "tenant_subdomain", "user_id", "email", "role", "created_at"}}
+	for _, m := range a.memberships {
+		rows = append(rows, []string{m.TenantID.String(), m.TenantSubdomain, m.UserID.String(), m.Email, m.Role.String, formatTime(m.CreatedAt)})
+	}
+	return writeCSV(w, rows)
+}
+
+func (a *archive) writeMembers(w io.Writer) error {
+	rows := [][]string{{"user_id", "email", "role", "created_at"}}
+	for _, m := range a.members {
+		rows = append(rows, []string{m.UserID.String(), m.Email, m.Role, formatTime(m.CreatedAt)})
+	}
+	return writeCSV(w, rows)
+}
+
+func (a *archive) writeEvents(w io.Writer) error {
+	events := make([]auditEventRecord, len(a.events))
+	for i, e := range a.events {
+		events[i] = auditEventRecord{
+			OccurredAt: e.OccurredAt,
+			EventType:  e.EventType,
+			ActorID:    e.ActorID,
+			SubjectID:  e.SubjectID,
+			Detail:     e.Detail,
+		}
+	}
+	return writeJSON(w, events)
+}
+
+func writeCSV(w io.Writer, rows [][]string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,265 @@
+package dataexport
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/external"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/signedurl"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Export job statuses
+const (
+	StatusPending    = "pending"
+	StatusProcessing = "processing"
+	StatusCompleted  = "completed"
+	StatusFailed     = "failed"
+)
+
+// JobKind is the job that builds an export archive
+const JobKind = "data_export.build"
+
+// Job is the payload of an export job
+type Job struct {
+	ExportID uuid.UUID `json:"exportId"`
+}
+
+const (
+	defaultRetention = 7 * 24 * time.Hour
+	defaultLinkTTL   = 15 * time.Minute
+
+	cleanupBatchSize = 100
+)
+
+var (
+	ErrExportNotFound = errors.New("export not found")
+	ErrExportNotReady = errors.New("export is not ready for download")
+	ErrExportExpired  = errors.New("export has expired")
+)
+
+// Exporter builds organization data archives and serves them through signed
+// download links. Archives are kept for EXPORT_RETENTION (default 7 days);
+// each link is valid for EXPORT_LINK_TTL (default 15 minutes).
+type Exporter struct {
+	exportRepo repository.OrganizationExportRepository
+	orgRepo    repository.OrganizationRepository
+	memberRepo repository.OrganizationMemberRepository
+	storage    external.FileStorage
+	signer     *signedurl.Signer
+	retention  time.Duration
+	linkTTL    time.Duration
+	now        func() time.Time
+}
+
+func NewExporter(exportRepo repository.OrganizationExportRepository, orgRepo repository.OrganizationRepository, memberRepo repository.OrganizationMemberRepository, storage external.FileStorage, signer *signedurl.Signer) *Exporter {
+	return &Exporter{
+		exportRepo: exportRepo,
+		orgRepo:    orgRepo,
+		memberRepo: memberRepo,
+		storage:    storage,
+		signer:     signer,
+		retention:  durationFromEnv("EXPORT_RETENTION", defaultRetention),
+		linkTTL:    durationFromEnv("EXPORT_LINK_TTL", defaultLinkTTL),
+		now:        time.Now,
+	}
+}
+
+// Run builds the archive of a pending or failed export, or of one whose
+// worker stopped mid-build. Failures are recorded on the export and returned
+// so the job is retried.
+func (e *Exporter) Run(ctx context.Context, exportID uuid.UUID) error {
+	started, err := e.exportRepo.StartOrganizationExport(ctx, exportID)
+	if err != nil {
+		return fmt.Errorf("failed to start data export: %w", err)
+	}
+	if !started {
+		return nil
+	}
+
+	if err := e.build(ctx, exportID); err != nil {
+		if err := e.exportRepo.FailOrganizationExport(ctx, exportID, err.Error()); err != nil {
+			slog.ErrorContext(ctx, "failed to record data export failure", slog.String("export_id", exportID.String()), slog.Any("error", err))
+		}
+		return err
+	}
+	return nil
+}
+
+func (e *Exporter) build(ctx context.Context, exportID uuid.UUID) error {
+	export, err := e.exportRepo.GetOrganizationExportByID(ctx, exportID)
+	if err != nil {
+		return fmt.Errorf("failed to get export: %w", err)
+	}
+
+	contents, err := e.collect(ctx, export)
+	if err != nil {
+		return err
+	}
+
+	// Stream the archive into storage instead of holding it in memory
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(contents.write(pw))
+	}()
+
+	key := storageKey(export)
+	size, err := e.storage.Put(ctx, key, pr)
+	pr.Close()
+	if err != nil {
+		return fmt.Errorf("failed to store archive: %w", err)
+	}
+
+	expiresAt := e.now().Add(e.retention)
+	if err := e.exportRepo.CompleteOrganizationExport(ctx, exportID, key, size, expiresAt); err != nil {
+		return fmt.Errorf("failed to complete export: %w", err)
+	}
+	return nil
+}
+
+func (e *Exporter) collect(ctx context.Context, export db.OrganizationExport) (*archive, error) {
+	org, err := e.orgRepo.GetOrganization(ctx, export.OrganizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization: %w", err)
+	}
+	tenants, err := e.exportRepo.ListTenants(ctx, org.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tenants: %w", err)
+	}
+	users, err := e.exportRepo.ListUsers(ctx, org.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	memberships, err := e.exportRepo.ListTenantMemberships(ctx, org.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tenant memberships: %w", err)
+	}
+	members, err := e.memberRepo.ListOrganizationMembers(ctx, org.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization members: %w", err)
+	}
+	events, err := e.exportRepo.ListAuditEvents(ctx, org.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit events: %w", err)
+	}
+
+	return &archive{
+		exportID:    export.ID,
+		generatedAt: e.now().UTC(),
+		org:         org,
+		tenants:     tenants,
+		users:       users,
+		memberships: memberships,
+		members:     members,
+		events:      events,
+	}, nil
+}
+
+// DownloadURL signs a short-lived link to a completed export. The link is
+// relative to the API host.
+func (e *Exporter) DownloadURL(export db.OrganizationExport) (string, time.Time, bool) {
+	if export.Status != StatusCompleted || e.expired(export) {
+		return "", time.Time{}, false
+	}
+
+	url, expiresAt := e.signer.Sign(DownloadPath(export.ID), e.linkTTL)
+	if export.ExpiresAt.Time.Before(expiresAt) {
+		expiresAt = export.ExpiresAt.Time
+	}
+	return url, expiresAt, true
+}
+
+// Open verifies a signed link and opens the archive it points to.
+func (e *Exporter) Open(ctx context.Context, exportID uuid.UUID, expires, signature string) (io.ReadCloser, db.OrganizationExport, error) {
+	if err := e.signer.Verify(DownloadPath(exportID), expires, signature); err != nil {
+		return nil, db.OrganizationExport{}, err
+	}
+
+	export, err := e.exportRepo.GetOrganizationExportByID(ctx, exportID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, db.OrganizationExport{}, ErrExportNotFound
+		}
+		return nil, db.OrganizationExport{}, fmt.Errorf("failed to get export: %w", err)
+	}
+	if export.Status != StatusCompleted {
+		return nil, export, ErrExportNotReady
+	}
+	if e.expired(export) {
+		return nil, export, ErrExportExpired
+	}
+
+	f, err := e.storage.Open(ctx, export.StorageKey.String)
+	if err != nil {
+		if errors.Is(err, external.ErrFileNotFound) {
+			return nil, export, ErrExportExpired
+		}
+		return nil, export, fmt.Errorf("failed to open archive: %w", err)
+	}
+	return f, export, nil
+}
+
+// DeleteExpired removes the archives of exports past their retention and
+// returns how many were deleted. The export records are kept as history.
+func (e *Exporter) DeleteExpired(ctx context.Context) (int, error) {
+	deleted := 0
+	for {
+		exports, err := e.exportRepo.ListExpiredOrganizationExports(ctx, cleanupBatchSize)
+		if err != nil {
+			return deleted, fmt.Errorf("failed to list expired exports: %w", err)
+		}
+
+		for _, export := range exports {
+			if err := e.storage.Delete(ctx, export.StorageKey.String); err != nil && !errors.Is(err, external.ErrFileNotFound) {
+				return deleted, fmt.Errorf("failed to delete archive of export %s: %w", export.ID, err)
+			}
+			if err := e.exportRepo.ClearOrganizationExportStorage(ctx, export.ID); err != nil {
+				return deleted, fmt.Errorf("failed to clear export %s: %w", export.ID, err)
+			}
+			deleted++
+		}
+
+		if len(exports) < cleanupBatchSize {
+			return deleted, nil
+		}
+	}
+}
+
+func (e *Exporter) expired(export db.OrganizationExport) bool {
+	return export.ExpiresAt.Valid && !e.now().Before(export.ExpiresAt.Time)
+}
+
+// DownloadPath is the public route that serves an export archive.
+func DownloadPath(exportID uuid.UUID) string {
+	return "/api/v1/public/exports/" + exportID.String() + "/download"
+}
+
+// Filename is the name offered to the browser for an export archive.
+func Filename(export db.OrganizationExport) string {
+	return fmt.Sprintf("organization-export-%s.zip", export.CreatedAt.UTC().Format("20060102-150405"))
+}
+
+func storageKey(export db.OrganizationExport) string {
+	return fmt.Sprintf("exports/%s/%s.zip", export.OrganizationID, export.ID)
+}
+
+func durationFromEnv(name string, fallback time.Duration) time.Duration {
+	v := os.Getenv(name)
+	if v == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		slog.Warn("ignoring invalid "+name, "value", v)
+		return fallback
+	}
+	return d
+}
@@ -0,0 +1,103 @@
+package eventstream
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/adapters/humafiber"
+)
+
+// Sender writes one named event to the stream and flushes it to the client.
+// It fails once the client has gone away.
+type Sender func(event string, data any) error
+
+// Response streams Server-Sent Events produced by run. Unlike huma's sse
+// package it lets the handler fail with a regular error response before the
+// stream starts.
+//
+// Fiber buffers whatever is written to the body until the handler returns,
+// so events go through fasthttp's body stream writer instead, which runs
+// after the handler. run gets a context detached from the request that is
+// cancelled when a write to the client fails.
+func Response(ctx context.Context, run func(ctx context.Context, send Sender)) *huma.StreamResponse {
+	return &huma.StreamResponse{
+		Body: func(hctx huma.Context) {
+			hctx.SetHeader("Content-Type", "text/event-stream")
+			hctx.SetHeader("Cache-Control", "no-cache")
+			// Keep reverse proxies such as nginx from buffering the stream
+			hctx.SetHeader("X-Accel-Buffering", "no")
+
+			humafiber.Unwrap(hctx).Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+				streamCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+				defer cancel()
+
+				send := func(event string, data any) error {
+					if err := streamCtx.Err(); err != nil {
+						return err
+					}
+					payload, err := json.Marshal(data)
+					if err != nil {
+						return fmt.Errorf("failed to encode %s event: %w", event, err)
+					}
+					fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+					if err := w.Flush(); err != nil {
+						cancel()
+						return err
+					}
+					return nil
+				}
+				run(streamCtx, send)
+			})
+		},
+	}
+}
+
+// Responses documents the events an operation streams, keyed by event name
+func Responses(api huma.API, events map[string]any) map[string]*huma.Response {
+	names := make([]string, 0, len(events))
+	for name := range events {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	schemas := make([]*huma.Schema, 0, len(events))
+	for _, name := range names {
+		t := reflect.TypeOf(events[name])
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		schemas = append(schemas, &huma.Schema{
+			Title: "Event " + name,
+			Type:  huma.TypeObject,
+			Properties: map[string]*huma.Schema{
+				"event": {
+					Type:       huma.TypeString,
+					Extensions: map[string]any{"const": name},
+				},
+				"data": api.OpenAPI().Components.Schemas.Schema(t, true, name),
+			},
+			Required: []string{"event", "data"},
+		})
+	}
+
+	return map[string]*huma.Response{
+		"200": {
+			Description: "Server-Sent Events",
+			Content: map[string]*huma.MediaType{
+				"text/event-stream": {
+					Schema: &huma.Schema{
+						Type: huma.TypeArray,
+						Items: &huma.Schema{
+							Extensions: map[string]any{"oneOf": schemas},
+						},
+					},
+				},
+			},
+		},
+	}
+}
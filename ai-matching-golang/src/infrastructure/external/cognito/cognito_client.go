@@ -6,6 +6,7 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"log/slog"
 	"os"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -15,13 +16,14 @@ import (
 )
 
 type CognitoClient struct {
+	logger       *slog.Logger
 	client       *cognitoidentityprovider.Client
 	userPoolID   string
 	clientID     string
 	clientSecret string
 }
 
-func NewCognitoClient() (*CognitoClient, error) {
+func NewCognitoClient(logger *slog.Logger) (*CognitoClient, error) {
 	cognitoEndpoint := os.Getenv("COGNITO_ENDPOINT")
 	if cognitoEndpoint == "" {
 		cognitoEndpoint = "https://cognito-idp.us-east-1.amazonaws.com"
@@ -45,6 +47,7 @@ func NewCognitoClient() (*CognitoClient, error) {
 	}
 
 	return &CognitoClient{
+		logger:       logger,
 		client:       cognitoidentityprovider.NewFromConfig(cfg),
 		userPoolID:   os.Getenv("COGNITO_USER_POOL_ID"),
 		clientID:     os.Getenv("COGNITO_CLIENT_ID"),
@@ -91,7 +94,7 @@ func (c *CognitoClient) SignUp(ctx context.Context, email, password string, attr
 		_, confirmErr := c.client.AdminConfirmSignUp(ctx, confirmInput)
 		if confirmErr != nil {
 			// Log the error but don't fail the signup
-			fmt.Printf("Warning: Failed to auto-confirm user %s: %v\n", email, confirmErr)
+			c.logger.WarnContext(ctx, "failed to auto-confirm user", slog.String("email", email), slog.Any("error", confirmErr))
 		} else {
 			// Mark the user as confirmed in the result
 			result.UserConfirmed = true
@@ -101,6 +104,38 @@ func (c *CognitoClient) SignUp(ctx context.Context, email, password string, attr
 	return result, nil
 }
 
+// AdminCreateUser creates a confirmed user on behalf of an administrator.
+// Cognito emails the user a temporary password that must be changed at first
+// sign-in.
+func (c *CognitoClient) AdminCreateUser(ctx context.Context, email string, attributes map[string]string) (*cognitoidentityprovider.AdminCreateUserOutput, error) {
+	userAttributes := []types.AttributeType{
+		{
+			Name:  aws.String("email"),
+			Value: aws.String(email),
+		},
+		{
+			Name:  aws.String("email_verified"),
+			Value: aws.String("true"),
+		},
+	}
+
+	for key, value := range attributes {
+		userAttributes = append(userAttributes, types.AttributeType{
+			Name:  aws.String(key),
+			Value: aws.String(value),
+		})
+	}
+
+	input := &cognitoidentityprovider.AdminCreateUserInput{
+		UserPoolId:             aws.String(c.userPoolID),
+		Username:               aws.String(email),
+		UserAttributes:         userAttributes,
+		DesiredDeliveryMediums: []types.DeliveryMediumType{types.DeliveryMediumTypeEmail},
+	}
+
+	return c.client.AdminCreateUser(ctx, input)
+}
+
 func (c *CognitoClient) ConfirmSignUp(ctx context.Context, email, confirmationCode string) error {
 	secretHash := c.calculateSecretHash(email)
 
@@ -181,6 +216,31 @@ func (c *CognitoClient) GetUser(ctx context.Context, accessToken string) (*cogni
 	return c.client.GetUser(ctx, input)
 }
 
+// ListUsers returns one page of up to 60 users of the user pool; pass the
+// PaginationToken of the previous page to get the next one.
+func (c *CognitoClient) ListUsers(ctx context.Context, paginationToken string) (*cognitoidentityprovider.ListUsersOutput, error) {
+	input := &cognitoidentityprovider.ListUsersInput{
+		UserPoolId: aws.String(c.userPoolID),
+		Limit:      aws.Int32(60),
+	}
+	if paginationToken != "" {
+		input.PaginationToken = aws.String(paginationToken)
+	}
+
+	return c.client.ListUsers(ctx, input)
+}
+
+// Ping verifies that the identity provider is reachable and the configured
+// user pool exists.
+func (c *CognitoClient) Ping(ctx context.Context) error {
+	input := &cognitoidentityprovider.DescribeUserPoolInput{
+		UserPoolId: aws.String(c.userPoolID),
+	}
+
+	_, err := c.client.DescribeUserPool(ctx, input)
+	return err
+}
+
 func (c *CognitoClient) calculateSecretHash(username string) string {
 	mac := hmac.New(sha256.New, []byte(c.clientSecret))
 	mac.Write([]byte(username + c.clientID))
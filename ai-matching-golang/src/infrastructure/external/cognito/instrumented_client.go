@@ -0,0 +1,124 @@
+package cognito
+
+import (
+	"ai-matching/src/domain/interface/external"
+	"ai-matching/src/infrastructure/metrics"
+	"ai-matching/src/infrastructure/tracing"
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"github.com/aws/smithy-go"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentedClient decorates an external.CognitoClient with call outcome
+// metrics and a client span per call.
+type instrumentedClient struct {
+	next external.CognitoClient
+}
+
+func NewInstrumentedClient(next external.CognitoClient) external.CognitoClient {
+	return &instrumentedClient{next: next}
+}
+
+func (c *instrumentedClient) SignUp(ctx context.Context, email, password string, attributes map[string]string) (*cognitoidentityprovider.SignUpOutput, error) {
+	ctx, span := startSpan(ctx, "SignUp")
+	out, err := c.next.SignUp(ctx, email, password, attributes)
+	observe("SignUp", span, err)
+	return out, err
+}
+
+func (c *instrumentedClient) AdminCreateUser(ctx context.Context, email string, attributes map[string]string) (*cognitoidentityprovider.AdminCreateUserOutput, error) {
+	ctx, span := startSpan(ctx, "AdminCreateUser")
+	out, err := c.next.AdminCreateUser(ctx, email, attributes)
+	observe("AdminCreateUser", span, err)
+	return out, err
+}
+
+func (c *instrumentedClient) ConfirmSignUp(ctx context.Context, email, confirmationCode string) error {
+	ctx, span := startSpan(ctx, "ConfirmSignUp")
+	err := c.next.ConfirmSignUp(ctx, email, confirmationCode)
+	observe("ConfirmSignUp", span, err)
+	return err
+}
+
+func (c *instrumentedClient) InitiateAuth(ctx context.Context, email, password string) (*cognitoidentityprovider.InitiateAuthOutput, error) {
+	ctx, span := startSpan(ctx, "InitiateAuth")
+	out, err := c.next.InitiateAuth(ctx, email, password)
+	observe("InitiateAuth", span, err)
+	return out, err
+}
+
+func (c *instrumentedClient) RefreshToken(ctx context.Context, refreshToken string) (*cognitoidentityprovider.InitiateAuthOutput, error) {
+	ctx, span := startSpan(ctx, "RefreshToken")
+	out, err := c.next.RefreshToken(ctx, refreshToken)
+	observe("RefreshToken", span, err)
+	return out, err
+}
+
+func (c *instrumentedClient) ForgotPassword(ctx context.Context, email string) (*cognitoidentityprovider.ForgotPasswordOutput, error) {
+	ctx, span := startSpan(ctx, "ForgotPassword")
+	out, err := c.next.ForgotPassword(ctx, email)
+	observe("ForgotPassword", span, err)
+	return out, err
+}
+
+func (c *instrumentedClient) ConfirmForgotPassword(ctx context.Context, email, password, confirmationCode string) error {
+	ctx, span := startSpan(ctx, "ConfirmForgotPassword")
+	err := c.next.ConfirmForgotPassword(ctx, email, password, confirmationCode)
+	observe("ConfirmForgotPassword", span, err)
+	return err
+}
+
+func (c *instrumentedClient) GetUser(ctx context.Context, accessToken string) (*cognitoidentityprovider.GetUserOutput, error) {
+	ctx, span := startSpan(ctx, "GetUser")
+	out, err := c.next.GetUser(ctx, accessToken)
+	observe("GetUser", span, err)
+	return out, err
+}
+
+func (c *instrumentedClient) ListUsers(ctx context.Context, paginationToken string) (*cognitoidentityprovider.ListUsersOutput, error) {
+	ctx, span := startSpan(ctx, "ListUsers")
+	out, err := c.next.ListUsers(ctx, paginationToken)
+	observe("ListUsers", span, err)
+	return out, err
+}
+
+func (c *instrumentedClient) Ping(ctx context.Context) error {
+	ctx, span := startSpan(ctx, "Ping")
+	err := c.next.Ping(ctx)
+	observe("Ping", span, err)
+	return err
+}
+
+func startSpan(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return tracing.Tracer().Start(ctx, "cognito."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.RPCSystemKey.String("aws-api"),
+			semconv.RPCServiceKey.String("CognitoIdentityProvider"),
+			semconv.RPCMethodKey.String(operation),
+		),
+	)
+}
+
+func observe(operation string, span trace.Span, err error) {
+	metrics.CognitoRequestsTotal.WithLabelValues(operation, outcome(err)).Inc()
+	tracing.End(span, err)
+}
+
+// outcome keeps label cardinality bounded by using the AWS error code rather
+// than the error message.
+func outcome(err error) string {
+	if err == nil {
+		return "success"
+	}
+
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode()
+	}
+	return "error"
+}
@@ -2,6 +2,8 @@ package cognito
 
 import (
 	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/metrics"
+	"ai-matching/src/infrastructure/tracing"
 	"context"
 	"crypto/rsa"
 	"encoding/base64"
@@ -15,6 +17,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type JWK struct {
@@ -44,30 +47,28 @@ type CognitoJWTValidator struct {
 }
 
 func NewCognitoJWTValidator(userRepo repository.UserRepository, tenantRepo repository.TenantRepository) *CognitoJWTValidator {
-	userPoolID := os.Getenv("COGNITO_USER_POOL_ID")
-	region := os.Getenv("AWS_REGION")
-	clientID := os.Getenv("COGNITO_CLIENT_ID")
-	cognitoEndpoint := os.Getenv("COGNITO_ENDPOINT")
-
-	var jwksURL string
-	if cognitoEndpoint != "" {
-		jwksURL = fmt.Sprintf("%s/%s/.well-known/jwks.json", cognitoEndpoint, userPoolID)
-	} else {
-		jwksURL = fmt.Sprintf("https://cognito-idp.%s.amazonaws.com/%s/.well-known/jwks.json", region, userPoolID)
-	}
-
 	return &CognitoJWTValidator{
-		userPoolID:    userPoolID,
-		clientID:      clientID,
-		region:        region,
-		jwksURL:       jwksURL,
+		userPoolID:    os.Getenv("COGNITO_USER_POOL_ID"),
+		clientID:      os.Getenv("COGNITO_CLIENT_ID"),
+		region:        os.Getenv("AWS_REGION"),
+		jwksURL:       JWKSURL(),
 		cacheDuration: 1 * time.Hour,
 		userRepo:      userRepo,
 		tenantRepo:    tenantRepo,
 	}
 }
 
-func (v *CognitoJWTValidator) ValidateToken(tokenString string) (*jwt.Token, error) {
+// JWKSURL returns the location of the user pool's JSON Web Key Set, honouring
+// COGNITO_ENDPOINT for cognito-local.
+func JWKSURL() string {
+	userPoolID := os.Getenv("COGNITO_USER_POOL_ID")
+	if cognitoEndpoint := os.Getenv("COGNITO_ENDPOINT"); cognitoEndpoint != "" {
+		return fmt.Sprintf("%s/%s/.well-known/jwks.json", cognitoEndpoint, userPoolID)
+	}
+	return fmt.Sprintf("https://cognito-idp.%s.amazonaws.com/%s/.well-known/jwks.json", os.Getenv("AWS_REGION"), userPoolID)
+}
+
+func (v *CognitoJWTValidator) ValidateToken(ctx context.Context, tokenString string) (*jwt.Token, error) {
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
@@ -78,7 +79,7 @@ func (v *CognitoJWTValidator) ValidateToken(tokenString string) (*jwt.Token, err
 			return nil, errors.New("kid header not found")
 		}
 
-		keys, err := v.getJWKSet()
+		keys, err := v.getJWKSet(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -178,10 +179,11 @@ func (v *CognitoJWTValidator) validateClaims(claims jwt.MapClaims) error {
 	return nil
 }
 
-func (v *CognitoJWTValidator) getJWKSet() (*JWK, error) {
+func (v *CognitoJWTValidator) getJWKSet(ctx context.Context) (*JWK, error) {
 	v.jwkSetMutex.RLock()
 	if v.jwkSet != nil && time.Since(v.lastFetched) < v.cacheDuration {
 		defer v.jwkSetMutex.RUnlock()
+		metrics.JWKSCacheTotal.WithLabelValues("hit").Inc()
 		return v.jwkSet, nil
 	}
 	v.jwkSetMutex.RUnlock()
@@ -190,10 +192,28 @@ func (v *CognitoJWTValidator) getJWKSet() (*JWK, error) {
 	defer v.jwkSetMutex.Unlock()
 
 	if v.jwkSet != nil && time.Since(v.lastFetched) < v.cacheDuration {
+		metrics.JWKSCacheTotal.WithLabelValues("hit").Inc()
 		return v.jwkSet, nil
 	}
+	metrics.JWKSCacheTotal.WithLabelValues("miss").Inc()
+
+	jwkSet, err := v.fetchJWKSet(ctx)
+	if err != nil {
+		metrics.JWKSFetchErrorsTotal.Inc()
+		return nil, err
+	}
+
+	v.jwkSet = jwkSet
+	v.lastFetched = time.Now()
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	return v.jwkSet, nil
+}
+
+func (v *CognitoJWTValidator) fetchJWKSet(ctx context.Context) (jwkSet *JWK, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "cognito.FetchJWKS", trace.WithSpanKind(trace.SpanKindClient))
+	defer func() { tracing.End(span, err) }()
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	req, err := http.NewRequestWithContext(ctx, "GET", v.jwksURL, nil)
@@ -211,15 +231,12 @@ func (v *CognitoJWTValidator) getJWKSet() (*JWK, error) {
 		return nil, fmt.Errorf("failed to fetch JWKS: %s", resp.Status)
 	}
 
-	var jwkSet JWK
-	if err := json.NewDecoder(resp.Body).Decode(&jwkSet); err != nil {
+	jwkSet = &JWK{}
+	if err := json.NewDecoder(resp.Body).Decode(jwkSet); err != nil {
 		return nil, err
 	}
 
-	v.jwkSet = &jwkSet
-	v.lastFetched = time.Now()
-
-	return v.jwkSet, nil
+	return jwkSet, nil
 }
 
 func (v *CognitoJWTValidator) convertJWKToRSAPublicKey(jwk *JWKKey) (*rsa.PublicKey, error) {
@@ -0,0 +1,33 @@
+package dns
+
+import (
+	"ai-matching/src/domain/interface/external"
+	"context"
+	"errors"
+	"net"
+)
+
+type netResolver struct {
+	resolver *net.Resolver
+}
+
+// NewNetResolver queries the system DNS resolver.
+func NewNetResolver() external.DNSResolver {
+	return &netResolver{
+		resolver: net.DefaultResolver,
+	}
+}
+
+func (r *netResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	records, err := r.resolver.LookupTXT(ctx, name)
+	if err != nil {
+		// A missing record is an expected answer while the customer is still
+		// configuring DNS, not a resolver failure.
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return records, nil
+}
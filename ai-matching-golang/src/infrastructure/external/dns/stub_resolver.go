@@ -0,0 +1,49 @@
+package dns
+
+import (
+	"ai-matching/src/domain/interface/external"
+	"context"
+	"strings"
+	"sync"
+)
+
+// StubResolver answers TXT lookups from memory so domain verification can be
+// exercised locally without touching real DNS.
+type StubResolver struct {
+	mu      sync.RWMutex
+	records map[string][]string
+}
+
+// NewStubResolver seeds records from a "name=value,name=value" list such as
+// the DNS_STUB_TXT_RECORDS environment variable.
+func NewStubResolver(seed string) *StubResolver {
+	r := &StubResolver{records: make(map[string][]string)}
+	for _, entry := range strings.Split(seed, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || name == "" {
+			continue
+		}
+		r.Set(name, value)
+	}
+	return r
+}
+
+// Set appends TXT values for name.
+func (r *StubResolver) Set(name string, values ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := canonicalName(name)
+	r.records[key] = append(r.records[key], values...)
+}
+
+func (r *StubResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]string(nil), r.records[canonicalName(name)]...), nil
+}
+
+func canonicalName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+}
+
+var _ external.DNSResolver = (*StubResolver)(nil)
@@ -0,0 +1,97 @@
+package embedding
+
+import (
+	"ai-matching/src/domain/interface/external"
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// DefaultHashingDimensions is used when no size is configured for the
+// hashing provider
+const DefaultHashingDimensions = 256
+
+type hashingProvider struct {
+	dimensions int
+}
+
+// NewHashingProvider embeds texts locally by hashing their words, word pairs
+// and character trigrams into a fixed number of buckets. It needs no network
+// or model and always returns the same vector for the same text, which makes
+// it suitable for offline development and tests; it only captures lexical
+// overlap, not meaning.
+func NewHashingProvider(dimensions int) external.EmbeddingProvider {
+	if dimensions <= 0 {
+		dimensions = DefaultHashingDimensions
+	}
+	return &hashingProvider{
+		dimensions: dimensions,
+	}
+}
+
+func (p *hashingProvider) Model() string {
+	return fmt.Sprintf("local-hashing-%d", p.dimensions)
+}
+
+func (p *hashingProvider) Dimensions() int {
+	return p.dimensions
+}
+
+func (p *hashingProvider) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		vectors[i] = p.embed(text)
+	}
+	return vectors, nil
+}
+
+func (p *hashingProvider) embed(text string) []float32 {
+	vector := make([]float32, p.dimensions)
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	for i, word := range words {
+		p.add(vector, "w:"+word, 1)
+		if i > 0 {
+			p.add(vector, "b:"+words[i-1]+" "+word, 0.5)
+		}
+
+		runes := []rune("^" + word + "$")
+		for j := 0; j+3 <= len(runes); j++ {
+			p.add(vector, "t:"+string(runes[j:j+3]), 0.25)
+		}
+	}
+
+	var norm float64
+	for _, v := range vector {
+		norm += float64(v) * float64(v)
+	}
+	if norm > 0 {
+		scale := float32(1 / math.Sqrt(norm))
+		for i := range vector {
+			vector[i] *= scale
+		}
+	}
+	return vector
+}
+
+// add hashes feature into a bucket. One bit of the hash picks the sign so
+// that collisions tend to cancel out instead of piling up.
+func (p *hashingProvider) add(vector []float32, feature string, weight float32) {
+	h := fnv.New64a()
+	h.Write([]byte(feature))
+	sum := h.Sum64()
+
+	bucket := int(sum % uint64(p.dimensions))
+	if sum&(1<<63) != 0 {
+		weight = -weight
+	}
+	vector[bucket] += weight
+}
@@ -0,0 +1,234 @@
+package embedding
+
+import (
+	"ai-matching/src/domain/interface/external"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultOpenAIBaseURL    = "https://api.openai.com/v1"
+	defaultOpenAIModel      = "text-embedding-3-small"
+	defaultOpenAIDimensions = 1536
+
+	// openAIBatchSize keeps requests well under the per-request input limits
+	// of OpenAI-compatible servers
+	openAIBatchSize = 64
+	openAIAttempts  = 3
+	maxRetryDelay   = 10 * time.Second
+)
+
+type openAIProvider struct {
+	baseURL    string
+	apiKey     string
+	model      string
+	dimensions int
+	// sendDimensions is set when the size was configured explicitly; not
+	// every compatible server accepts the parameter.
+	sendDimensions bool
+	client         *http.Client
+}
+
+// NewOpenAIProvider calls the /embeddings endpoint of the OpenAI API or any
+// server compatible with it. It is configured by EMBEDDING_API_URL,
+// EMBEDDING_API_KEY, EMBEDDING_MODEL and EMBEDDING_DIMENSIONS.
+func NewOpenAIProvider() external.EmbeddingProvider {
+	p := &openAIProvider{
+		baseURL:    strings.TrimRight(os.Getenv("EMBEDDING_API_URL"), "/"),
+		apiKey:     os.Getenv("EMBEDDING_API_KEY"),
+		model:      os.Getenv("EMBEDDING_MODEL"),
+		dimensions: defaultOpenAIDimensions,
+		client:     &http.Client{Timeout: 60 * time.Second},
+	}
+	if p.baseURL == "" {
+		p.baseURL = defaultOpenAIBaseURL
+	}
+	if p.model == "" {
+		p.model = defaultOpenAIModel
+	}
+	if v := os.Getenv("EMBEDDING_DIMENSIONS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			p.dimensions = n
+			p.sendDimensions = true
+		} else {
+			slog.Warn("ignoring invalid EMBEDDING_DIMENSIONS", "value", v)
+		}
+	}
+	return p
+}
+
+func (p *openAIProvider) Model() string {
+	return p.model
+}
+
+func (p *openAIProvider) Dimensions() int {
+	return p.dimensions
+}
+
+type embeddingRequest struct {
+	Model          string   `json:"model"`
+	Input          []string `json:"input"`
+	Dimensions     int      `json:"dimensions,omitempty"`
+	EncodingFormat string   `json:"encoding_format"`
+}
+
+type embeddingResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+type apiErrorResponse struct {
+	Error struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (p *openAIProvider) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, 0, len(texts))
+	for start := 0; start < len(texts); start += openAIBatchSize {
+		batch := texts[start:min(start+openAIBatchSize, len(texts))]
+		embedded, err := p.embedBatch(ctx, batch)
+		if err != nil {
+			return nil, err
+		}
+		vectors = append(vectors, embedded...)
+	}
+	return vectors, nil
+}
+
+func (p *openAIProvider) embedBatch(ctx context.Context, texts []string) ([][]float32, error) {
+	body := embeddingRequest{
+		Model:          p.model,
+		Input:          texts,
+		EncodingFormat: "float",
+	}
+	if p.sendDimensions {
+		body.Dimensions = p.dimensions
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode embedding request: %w", err)
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < openAIAttempts; attempt++ {
+		if attempt > 0 {
+			delay := retryDelay(lastErr, attempt)
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+
+		var result *embeddingResponse
+		result, lastErr = p.post(ctx, payload)
+		if lastErr == nil {
+			return p.collect(result, len(texts))
+		}
+
+		var statusErr *statusError
+		if errors.As(lastErr, &statusErr) && !statusErr.retryable() {
+			break
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+	}
+	return nil, fmt.Errorf("failed to create embeddings: %w", lastErr)
+}
+
+func (p *openAIProvider) post(ctx context.Context, payload []byte) (*embeddingResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/embeddings", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		statusErr := &statusError{status: resp.StatusCode, retryAfter: resp.Header.Get("Retry-After")}
+		var apiErr apiErrorResponse
+		raw, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+		if json.Unmarshal(raw, &apiErr) == nil && apiErr.Error.Message != "" {
+			statusErr.message = apiErr.Error.Message
+		}
+		return nil, statusErr
+	}
+
+	var result embeddingResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode embedding response: %w", err)
+	}
+	return &result, nil
+}
+
+// collect orders the returned vectors by input index and checks their size
+func (p *openAIProvider) collect(result *embeddingResponse, count int) ([][]float32, error) {
+	if len(result.Data) != count {
+		return nil, fmt.Errorf("embedding response has %d vectors for %d inputs", len(result.Data), count)
+	}
+
+	vectors := make([][]float32, count)
+	for _, item := range result.Data {
+		if item.Index < 0 || item.Index >= count || vectors[item.Index] != nil {
+			return nil, fmt.Errorf("embedding response has an unexpected index %d", item.Index)
+		}
+		if len(item.Embedding) != p.dimensions {
+			return nil, fmt.Errorf("model %s returned %d dimensions, expected %d (set EMBEDDING_DIMENSIONS)", p.model, len(item.Embedding), p.dimensions)
+		}
+		vectors[item.Index] = item.Embedding
+	}
+	return vectors, nil
+}
+
+type statusError struct {
+	status     int
+	message    string
+	retryAfter string
+}
+
+func (e *statusError) Error() string {
+	if e.message != "" {
+		return fmt.Sprintf("embedding API returned %d: %s", e.status, e.message)
+	}
+	return fmt.Sprintf("embedding API returned %d", e.status)
+}
+
+func (e *statusError) retryable() bool {
+	return e.status == http.StatusTooManyRequests || e.status >= 500
+}
+
+// retryDelay backs off exponentially from half a second, or waits as long as
+// the server asked to in Retry-After
+func retryDelay(err error, attempt int) time.Duration {
+	delay := 500 * time.Millisecond << (attempt - 1)
+
+	var statusErr *statusError
+	if errors.As(err, &statusErr) && statusErr.retryAfter != "" {
+		if seconds, convErr := strconv.Atoi(statusErr.retryAfter); convErr == nil && seconds >= 0 {
+			delay = time.Duration(seconds) * time.Second
+		}
+	}
+	return min(delay, maxRetryDelay)
+}
@@ -0,0 +1,62 @@
+package llm
+
+import (
+	"ai-matching/src/domain/interface/external"
+	"context"
+	"unicode"
+)
+
+const EchoModel = "local-echo"
+
+type echoProvider struct{}
+
+// NewEchoProvider replies with the last user message, one word at a time. It
+// stands in for a real model in development so the prompt and the streaming
+// path can be checked without an API key.
+func NewEchoProvider() external.LLMProvider {
+	return &echoProvider{}
+}
+
+func (p *echoProvider) Model() string {
+	return EchoModel
+}
+
+func (p *echoProvider) Stream(ctx context.Context, messages []external.ChatMessage, onToken func(string) error) error {
+	var prompt string
+	for i := len(messages) - 1; i >= 0; i-- {
+		if messages[i].Role == external.RoleUser {
+			prompt = messages[i].Content
+			break
+		}
+	}
+
+	for _, token := range splitWords(prompt) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := onToken(token); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitWords cuts text after each run of whitespace so that the tokens joined
+// together give back text unchanged
+func splitWords(text string) []string {
+	var tokens []string
+	start := 0
+	inSpace := false
+	for i, r := range text {
+		space := unicode.IsSpace(r)
+		if inSpace && !space {
+			tokens = append(tokens, text[start:i])
+			start = i
+		}
+		inSpace = space
+	}
+	if start < len(text) {
+		tokens = append(tokens, text[start:])
+	}
+	return tokens
+}
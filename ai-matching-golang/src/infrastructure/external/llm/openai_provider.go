@@ -0,0 +1,241 @@
+package llm
+
+import (
+	"ai-matching/src/domain/interface/external"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultOpenAIBaseURL   = "https://api.openai.com/v1"
+	defaultOpenAIModel     = "gpt-4o-mini"
+	defaultOpenAIMaxTokens = 1024
+
+	openAIAttempts = 3
+	maxRetryDelay  = 10 * time.Second
+	// maxEventBytes bounds one line of the event stream
+	maxEventBytes = 1 << 20
+)
+
+type openAIProvider struct {
+	baseURL   string
+	apiKey    string
+	model     string
+	maxTokens int
+	client    *http.Client
+}
+
+// NewOpenAIProvider calls the streaming /chat/completions endpoint of the
+// OpenAI API or any server compatible with it. It is configured by
+// LLM_API_URL, LLM_API_KEY, LLM_MODEL and LLM_MAX_TOKENS.
+func NewOpenAIProvider() external.LLMProvider {
+	p := &openAIProvider{
+		baseURL:   strings.TrimRight(os.Getenv("LLM_API_URL"), "/"),
+		apiKey:    os.Getenv("LLM_API_KEY"),
+		model:     os.Getenv("LLM_MODEL"),
+		maxTokens: defaultOpenAIMaxTokens,
+		// No overall timeout: answers stream for as long as the model writes.
+		// The request context bounds the call instead.
+		client: &http.Client{},
+	}
+	if p.baseURL == "" {
+		p.baseURL = defaultOpenAIBaseURL
+	}
+	if p.model == "" {
+		p.model = defaultOpenAIModel
+	}
+	if v := os.Getenv("LLM_MAX_TOKENS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			p.maxTokens = n
+		} else {
+			slog.Warn("ignoring invalid LLM_MAX_TOKENS", "value", v)
+		}
+	}
+	return p
+}
+
+func (p *openAIProvider) Model() string {
+	return p.model
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatRequest struct {
+	Model     string        `json:"model"`
+	Messages  []chatMessage `json:"messages"`
+	MaxTokens int           `json:"max_tokens,omitempty"`
+	Stream    bool          `json:"stream"`
+}
+
+type chatChunk struct {
+	Choices []struct {
+		Delta struct {
+			Content string `json:"content"`
+		} `json:"delta"`
+	} `json:"choices"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+type apiErrorResponse struct {
+	Error struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Stream retries only until the server accepts the request; once tokens have
+// been passed on, a failure is returned as is.
+func (p *openAIProvider) Stream(ctx context.Context, messages []external.ChatMessage, onToken func(string) error) error {
+	body := chatRequest{
+		Model:     p.model,
+		Messages:  make([]chatMessage, len(messages)),
+		MaxTokens: p.maxTokens,
+		Stream:    true,
+	}
+	for i, m := range messages {
+		body.Messages[i] = chatMessage{Role: m.Role, Content: m.Content}
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode chat request: %w", err)
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < openAIAttempts; attempt++ {
+		if attempt > 0 {
+			delay := retryDelay(lastErr, attempt)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+
+		var stream io.ReadCloser
+		stream, lastErr = p.post(ctx, payload)
+		if lastErr == nil {
+			defer stream.Close()
+			return p.read(stream, onToken)
+		}
+
+		var statusErr *statusError
+		if errors.As(lastErr, &statusErr) && !statusErr.retryable() {
+			break
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+	return fmt.Errorf("failed to generate reply: %w", lastErr)
+}
+
+func (p *openAIProvider) post(ctx context.Context, payload []byte) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/chat/completions", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "text/event-stream")
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		statusErr := &statusError{status: resp.StatusCode, retryAfter: resp.Header.Get("Retry-After")}
+		var apiErr apiErrorResponse
+		raw, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+		if json.Unmarshal(raw, &apiErr) == nil && apiErr.Error.Message != "" {
+			statusErr.message = apiErr.Error.Message
+		}
+		return nil, statusErr
+	}
+	return resp.Body, nil
+}
+
+// read passes on the content deltas of the event stream until [DONE]
+func (p *openAIProvider) read(stream io.Reader, onToken func(string) error) error {
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 0, 64<<10), maxEventBytes)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data:")
+		if !ok {
+			continue
+		}
+		data = strings.TrimSpace(data)
+		if data == "[DONE]" {
+			return nil
+		}
+
+		var chunk chatChunk
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return fmt.Errorf("failed to decode chat stream: %w", err)
+		}
+		if chunk.Error != nil {
+			return fmt.Errorf("chat API failed mid-stream: %s", chunk.Error.Message)
+		}
+		for _, choice := range chunk.Choices {
+			if choice.Delta.Content == "" {
+				continue
+			}
+			if err := onToken(choice.Delta.Content); err != nil {
+				return err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read chat stream: %w", err)
+	}
+	return errors.New("chat stream ended without [DONE]")
+}
+
+type statusError struct {
+	status     int
+	message    string
+	retryAfter string
+}
+
+func (e *statusError) Error() string {
+	if e.message != "" {
+		return fmt.Sprintf("chat API returned %d: %s", e.status, e.message)
+	}
+	return fmt.Sprintf("chat API returned %d", e.status)
+}
+
+func (e *statusError) retryable() bool {
+	return e.status == http.StatusTooManyRequests || e.status >= 500
+}
+
+// retryDelay backs off exponentially from half a second, or waits as long as
+// the server asked to in Retry-After
+func retryDelay(err error, attempt int) time.Duration {
+	delay := 500 * time.Millisecond << (attempt - 1)
+
+	var statusErr *statusError
+	if errors.As(err, &statusErr) && statusErr.retryAfter != "" {
+		if seconds, convErr := strconv.Atoi(statusErr.retryAfter); convErr == nil && seconds >= 0 {
+			delay = time.Duration(seconds) * time.Second
+		}
+	}
+	return min(delay, maxRetryDelay)
+}
@@ -0,0 +1,171 @@
+package featureflag
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// snapshotTTL bounds how long other replicas keep serving a flag after it was
+// changed; the replica that handled the change reloads immediately.
+const snapshotTTL = 30 * time.Second
+
+type overrideKey struct {
+	flag string
+	id   uuid.UUID
+}
+
+type snapshot struct {
+	flags           map[string]db.FeatureFlag
+	orgOverrides    map[overrideKey]bool
+	tenantOverrides map[overrideKey]bool
+	loadedAt        time.Time
+}
+
+// Evaluator answers whether a feature is on for an organization and tenant.
+// A tenant override wins over an organization override, which wins over the
+// global flag. A globally enabled flag is on for the tenants whose bucket
+// falls inside its rollout percentage. Unknown flags are off.
+type Evaluator struct {
+	repo repository.FeatureFlagRepository
+	now  func() time.Time
+
+	mu      sync.Mutex
+	current *snapshot
+}
+
+func NewEvaluator(repo repository.FeatureFlagRepository) *Evaluator {
+	return &Evaluator{
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+// Enabled reports whether key is on. Either ID may be uuid.Nil when unknown.
+// Lookup failures are logged and treated as off.
+func (e *Evaluator) Enabled(ctx context.Context, key string, organizationID, tenantID uuid.UUID) bool {
+	snap, err := e.snapshot(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to load feature flags", "flag", key, "error", err)
+		return false
+	}
+	return snap.evaluate(key, organizationID, tenantID)
+}
+
+// All evaluates every defined flag.
+func (e *Evaluator) All(ctx context.Context, organizationID, tenantID uuid.UUID) (map[string]bool, error) {
+	snap, err := e.snapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]bool, len(snap.flags))
+	for key := range snap.flags {
+		result[key] = snap.evaluate(key, organizationID, tenantID)
+	}
+	return result, nil
+}
+
+// Invalidate forces the next evaluation to reload flags from the database.
+func (e *Evaluator) Invalidate() {
+	e.mu.Lock()
+	e.current = nil
+	e.mu.Unlock()
+}
+
+func (e *Evaluator) snapshot(ctx context.Context) (*snapshot, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.current != nil && e.now().Sub(e.current.loadedAt) < snapshotTTL {
+		return e.current, nil
+	}
+
+	snap, err := e.load(ctx)
+	if err != nil {
+		if e.current != nil {
+			// Keep serving the last known flags rather than switching
+			// everything off during a database hiccup.
+			slog.WarnContext(ctx, "failed to refresh feature flags, using cached values", "error", err)
+			return e.current, nil
+		}
+		return nil, err
+	}
+	e.current = snap
+	return snap, nil
+}
+
+func (e *Evaluator) load(ctx context.Context) (*snapshot, error) {
+	flags, err := e.repo.ListFeatureFlags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list feature flags: %w", err)
+	}
+	overrides, err := e.repo.ListFeatureFlagOverrides(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list feature flag overrides: %w", err)
+	}
+
+	snap := &snapshot{
+		flags:           make(map[string]db.FeatureFlag, len(flags)),
+		orgOverrides:    make(map[overrideKey]bool),
+		tenantOverrides: make(map[overrideKey]bool),
+		loadedAt:        e.now(),
+	}
+	for _, flag := range flags {
+		snap.flags[flag.Key] = flag
+	}
+	for _, o := range overrides {
+		if o.TenantID.Valid {
+			snap.tenantOverrides[overrideKey{o.FlagKey, o.TenantID.UUID}] = o.Enabled
+		} else if o.OrganizationID.Valid {
+			snap.orgOverrides[overrideKey{o.FlagKey, o.OrganizationID.UUID}] = o.Enabled
+		}
+	}
+	return snap, nil
+}
+
+func (s *snapshot) evaluate(key string, organizationID, tenantID uuid.UUID) bool {
+	flag, ok := s.flags[key]
+	if !ok {
+		return false
+	}
+	if tenantID != uuid.Nil {
+		if enabled, ok := s.tenantOverrides[overrideKey{key, tenantID}]; ok {
+			return enabled
+		}
+	}
+	if organizationID != uuid.Nil {
+		if enabled, ok := s.orgOverrides[overrideKey{key, organizationID}]; ok {
+			return enabled
+		}
+	}
+	if !flag.Enabled {
+		return false
+	}
+	return InRollout(key, tenantID, flag.RolloutPercentage)
+}
+
+// InRollout buckets tenantID into 0-99 per flag so each flag rolls out to a
+// different, stable subset of tenants. Without a tenant only a full rollout
+// counts as on.
+func InRollout(key string, tenantID uuid.UUID, percentage int32) bool {
+	if percentage >= 100 {
+		return true
+	}
+	if percentage <= 0 || tenantID == uuid.Nil {
+		return false
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	h.Write([]byte{':'})
+	h.Write(tenantID[:])
+	return int32(h.Sum32()%100) < percentage
+}
@@ -0,0 +1,22 @@
+package health
+
+import (
+	"ai-matching/src/domain/interface/external"
+	"context"
+)
+
+type cognitoChecker struct {
+	client external.CognitoClient
+}
+
+func NewCognitoChecker(client external.CognitoClient) Checker {
+	return &cognitoChecker{client: client}
+}
+
+func (c *cognitoChecker) Name() string {
+	return "cognito"
+}
+
+func (c *cognitoChecker) Check(ctx context.Context) error {
+	return c.client.Ping(ctx)
+}
@@ -0,0 +1,46 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type httpChecker struct {
+	name   string
+	url    string
+	client *http.Client
+}
+
+// NewHTTPChecker reports the dependency as up when a GET to url answers with a
+// 2xx status.
+func NewHTTPChecker(name, url string) Checker {
+	return &httpChecker{
+		name:   name,
+		url:    url,
+		client: http.DefaultClient,
+	}
+}
+
+func (c *httpChecker) Name() string {
+	return c.name
+}
+
+func (c *httpChecker) Check(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	return nil
+}
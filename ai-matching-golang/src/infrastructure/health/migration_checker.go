@@ -0,0 +1,46 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+type migrationChecker struct {
+	db              *sql.DB
+	expectedVersion uint
+}
+
+// NewMigrationChecker compares the version recorded by golang-migrate in
+// schema_migrations with the latest migration embedded in the binary.
+func NewMigrationChecker(db *sql.DB, expectedVersion uint) Checker {
+	return &migrationChecker{
+		db:              db,
+		expectedVersion: expectedVersion,
+	}
+}
+
+func (c *migrationChecker) Name() string {
+	return "migrations"
+}
+
+func (c *migrationChecker) Check(ctx context.Context) error {
+	var version uint
+	var dirty bool
+	err := c.db.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("no migrations applied: expected version %d", c.expectedVersion)
+		}
+		return fmt.Errorf("failed to read migration version: %w", err)
+	}
+
+	if dirty {
+		return fmt.Errorf("migration version %d is dirty", version)
+	}
+	if version != c.expectedVersion {
+		return fmt.Errorf("migration version mismatch: expected %d, got %d", c.expectedVersion, version)
+	}
+
+	return nil
+}
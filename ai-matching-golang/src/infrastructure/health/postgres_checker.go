@@ -0,0 +1,22 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+)
+
+type postgresChecker struct {
+	db *sql.DB
+}
+
+func NewPostgresChecker(db *sql.DB) Checker {
+	return &postgresChecker{db: db}
+}
+
+func (c *postgresChecker) Name() string {
+	return "postgres"
+}
+
+func (c *postgresChecker) Check(ctx context.Context) error {
+	return c.db.PingContext(ctx)
+}
@@ -0,0 +1,98 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+// Checker reports whether a single dependency of the service is usable.
+type Checker interface {
+	Name() string
+	Check(ctx context.Context) error
+}
+
+type ComponentResult struct {
+	Name    string
+	Status  string
+	Latency time.Duration
+	Error   string
+}
+
+type Report struct {
+	Healthy    bool
+	Components []ComponentResult
+}
+
+type Registry struct {
+	mu       sync.RWMutex
+	checkers []Checker
+	timeout  time.Duration
+}
+
+func NewRegistry(timeout time.Duration, checkers ...Checker) *Registry {
+	return &Registry{
+		checkers: checkers,
+		timeout:  timeout,
+	}
+}
+
+func (r *Registry) Register(checker Checker) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checkers = append(r.checkers, checker)
+}
+
+// Run executes every registered checker concurrently and collects the results
+// in registration order.
+func (r *Registry) Run(ctx context.Context) Report {
+	r.mu.RLock()
+	checkers := make([]Checker, len(r.checkers))
+	copy(checkers, r.checkers)
+	r.mu.RUnlock()
+
+	results := make([]ComponentResult, len(checkers))
+
+	var wg sync.WaitGroup
+	for i, checker := range checkers {
+		wg.Add(1)
+		go func(i int, checker Checker) {
+			defer wg.Done()
+			results[i] = r.runChecker(ctx, checker)
+		}(i, checker)
+	}
+	wg.Wait()
+
+	report := Report{Healthy: true, Components: results}
+	for _, result := range results {
+		if result.Status != StatusUp {
+			report.Healthy = false
+		}
+	}
+
+	return report
+}
+
+func (r *Registry) runChecker(ctx context.Context, checker Checker) ComponentResult {
+	checkCtx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := checker.Check(checkCtx)
+	result := ComponentResult{
+		Name:    checker.Name(),
+		Status:  StatusUp,
+		Latency: time.Since(start),
+	}
+	if err != nil {
+		result.Status = StatusDown
+		result.Error = err.Error()
+	}
+
+	return result
+}
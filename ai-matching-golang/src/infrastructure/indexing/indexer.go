@@ -0,0 +1,169 @@
+package indexing
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/external"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/chunking"
+	"ai-matching/src/infrastructure/textextract"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// Index statuses of a document version
+const (
+	StatusPending  = "pending"
+	StatusIndexing = "indexing"
+	StatusIndexed  = "indexed"
+	StatusFailed   = "failed"
+	StatusSkipped  = "skipped"
+)
+
+// JobKind is the job that indexes a document version
+const JobKind = "document.index"
+
+// Job is the payload of an indexing job
+type Job struct {
+	VersionID uuid.UUID `json:"versionId"`
+}
+
+// embedBatchSize bounds how many chunks are held in memory between the
+// embedding provider and the database
+const embedBatchSize = 64
+
+// Indexer splits the extracted text of document versions into chunks and
+// stores them with their embeddings. Chunk windows are configured by
+// CHUNK_UNIT (tokens or characters), CHUNK_SIZE and CHUNK_OVERLAP.
+type Indexer struct {
+	chunkRepo repository.DocumentChunkRepository
+	chunker   *chunking.Chunker
+	embedder  external.EmbeddingProvider
+}
+
+func NewIndexer(chunkRepo repository.DocumentChunkRepository, embedder external.EmbeddingProvider) *Indexer {
+	opts := chunking.DefaultOptions()
+	if v := os.Getenv("CHUNK_UNIT"); v != "" {
+		if v == chunking.UnitTokens || v == chunking.UnitCharacters {
+			opts.Unit = v
+			if v == chunking.UnitCharacters {
+				opts.Size, opts.Overlap = opts.Size*4, opts.Overlap*4
+			}
+		} else {
+			slog.Warn("ignoring invalid CHUNK_UNIT", "value", v)
+		}
+	}
+	opts.Size = intFromEnv("CHUNK_SIZE", opts.Size)
+	opts.Overlap = intFromEnv("CHUNK_OVERLAP", opts.Overlap)
+
+	return &Indexer{
+		chunkRepo: chunkRepo,
+		chunker:   chunking.NewChunker(opts),
+		embedder:  embedder,
+	}
+}
+
+// Model returns the embedding model chunks are indexed with
+func (x *Indexer) Model() string {
+	return x.embedder.Model()
+}
+
+// Run chunks and embeds a pending or failed version. Failures are recorded
+// on the version and returned so the job is retried.
+func (x *Indexer) Run(ctx context.Context, versionID uuid.UUID) error {
+	started, err := x.chunkRepo.StartIndexing(ctx, versionID)
+	if err != nil {
+		return fmt.Errorf("failed to start document indexing: %w", err)
+	}
+	if !started {
+		return nil
+	}
+
+	if err := x.index(ctx, versionID); err != nil {
+		if err := x.chunkRepo.SetIndexStatus(ctx, versionID, StatusFailed, err.Error()); err != nil {
+			slog.ErrorContext(ctx, "failed to record document indexing failure", slog.String("version_id", versionID.String()), slog.Any("error", err))
+		}
+		return err
+	}
+	return nil
+}
+
+func (x *Indexer) index(ctx context.Context, versionID uuid.UUID) error {
+	version, err := x.chunkRepo.GetVersionForIndexing(ctx, versionID)
+	if err != nil {
+		return fmt.Errorf("failed to get document version: %w", err)
+	}
+
+	// Only the current version of a document is searchable
+	switch {
+	case version.Version < version.CurrentVersion:
+		return x.chunkRepo.SetIndexStatus(ctx, versionID, StatusSkipped, "superseded by a newer version")
+	case version.ExtractionStatus != "extracted":
+		return x.chunkRepo.SetIndexStatus(ctx, versionID, StatusSkipped, "no text was extracted")
+	}
+
+	chunks := x.chunker.Split(version.ExtractedText, version.Format == textextract.FormatMarkdown)
+	model := x.embedder.Model()
+
+	for start := 0; start < len(chunks); start += embedBatchSize {
+		batch := chunks[start:min(start+embedBatchSize, len(chunks))]
+
+		texts := make([]string, len(batch))
+		for i, chunk := range batch {
+			texts[i] = chunk.EmbeddingText()
+		}
+		vectors, err := x.embedder.Embed(ctx, texts)
+		if err != nil {
+			return fmt.Errorf("failed to embed chunks: %w", err)
+		}
+
+		for i, chunk := range batch {
+			if err := x.chunkRepo.CreateChunk(ctx, db.CreateDocumentChunkParams{
+				TenantID:       version.TenantID,
+				DocumentID:     version.DocumentID,
+				Version:        version.Version,
+				ChunkIndex:     int32(chunk.Index),
+				Heading:        chunk.Heading,
+				Content:        chunk.Content,
+				StartOffset:    int32(chunk.Start),
+				EndOffset:      int32(chunk.End),
+				TokenCount:     int32(chunk.Tokens),
+				Embedding:      vectors[i],
+				EmbeddingModel: model,
+			}); err != nil {
+				return fmt.Errorf("failed to store chunk: %w", err)
+			}
+		}
+	}
+
+	// Drop what an earlier, longer run of this version left behind
+	if err := x.chunkRepo.DeleteChunksFrom(ctx, version.DocumentID, version.Version, int32(len(chunks))); err != nil {
+		return fmt.Errorf("failed to remove stale chunks: %w", err)
+	}
+
+	if err := x.chunkRepo.CompleteIndexing(ctx, versionID, int32(len(chunks)), model); err != nil {
+		return fmt.Errorf("failed to complete indexing: %w", err)
+	}
+
+	if _, err := x.chunkRepo.DeleteSupersededChunks(ctx, version.DocumentID, version.Version); err != nil {
+		slog.WarnContext(ctx, "failed to remove chunks of older versions", slog.String("document_id", version.DocumentID.String()), slog.Any("error", err))
+	}
+	return nil
+}
+
+func intFromEnv(name string, fallback int) int {
+	v := os.Getenv(name)
+	if v == "" {
+		return fallback
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		slog.Warn("ignoring invalid "+name, "value", v)
+		return fallback
+	}
+	return n
+}
@@ -0,0 +1,133 @@
+package jobs
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Job statuses
+const (
+	StatusQueued    = "queued"
+	StatusRunning   = "running"
+	StatusSucceeded = "succeeded"
+	StatusDead      = "dead"
+)
+
+const defaultMaxAttempts = 5
+
+var ErrUnknownKind = errors.New("no handler is registered for the job kind")
+
+// Handler runs one attempt of a job. A returned error is retried with
+// backoff until the job runs out of attempts, unless it is Permanent.
+type Handler func(ctx context.Context, job db.Job) error
+
+// Options control when and how often a job runs and who it belongs to
+type Options struct {
+	// RunAt delays the first attempt; the zero value runs it right away
+	RunAt time.Time
+	// MaxAttempts defaults to JOB_MAX_ATTEMPTS
+	MaxAttempts int
+	// OrganizationID and TenantID let organization admins see the job
+	OrganizationID uuid.UUID
+	TenantID       uuid.UUID
+}
+
+// Queue stores jobs in Postgres for workers to claim. Handlers are
+// registered once at startup, in every process that enqueues or runs jobs.
+type Queue struct {
+	jobRepo     repository.JobRepository
+	handlers    map[string]Handler
+	maxAttempts int
+}
+
+func NewQueue(jobRepo repository.JobRepository) *Queue {
+	return &Queue{
+		jobRepo:     jobRepo,
+		handlers:    make(map[string]Handler),
+		maxAttempts: intFromEnv("JOB_MAX_ATTEMPTS", defaultMaxAttempts),
+	}
+}
+
+// Register sets the handler of a job kind
+func (q *Queue) Register(kind string, handler Handler) {
+	if _, ok := q.handlers[kind]; ok {
+		panic("jobs: handler already registered for " + kind)
+	}
+	q.handlers[kind] = handler
+}
+
+// Handle registers a handler that receives the payload decoded as T. A
+// payload that does not decode fails the job permanently.
+func Handle[T any](q *Queue, kind string, handle func(ctx context.Context, payload T) error) {
+	q.Register(kind, func(ctx context.Context, job db.Job) error {
+		var payload T
+		if err := json.Unmarshal(job.Payload, &payload); err != nil {
+			return Permanent(fmt.Errorf("failed to decode payload: %w", err))
+		}
+		return handle(ctx, payload)
+	})
+}
+
+// Enqueue stores a job with its payload encoded as JSON
+func (q *Queue) Enqueue(ctx context.Context, kind string, payload any, opts Options) (db.Job, error) {
+	if _, ok := q.handlers[kind]; !ok {
+		return db.Job{}, fmt.Errorf("%w: %s", ErrUnknownKind, kind)
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return db.Job{}, fmt.Errorf("failed to encode job payload: %w", err)
+	}
+	maxAttempts := opts.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = q.maxAttempts
+	}
+
+	job, err := q.jobRepo.CreateJob(ctx, db.CreateJobParams{
+		Kind:           kind,
+		Payload:        data,
+		MaxAttempts:    int32(maxAttempts),
+		RunAt:          sql.NullTime{Time: opts.RunAt, Valid: !opts.RunAt.IsZero()},
+		OrganizationID: uuid.NullUUID{UUID: opts.OrganizationID, Valid: opts.OrganizationID != uuid.Nil},
+		TenantID:       uuid.NullUUID{UUID: opts.TenantID, Valid: opts.TenantID != uuid.Nil},
+	})
+	if err != nil {
+		return db.Job{}, fmt.Errorf("failed to enqueue %s job: %w", kind, err)
+	}
+	return job, nil
+}
+
+func (q *Queue) handler(kind string) (Handler, bool) {
+	handler, ok := q.handlers[kind]
+	return handler, ok
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks an error that retrying cannot fix, so the job goes
+// straight to dead
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether err was marked with Permanent
+func IsPermanent(err error) bool {
+	var permanent *permanentError
+	return errors.As(err, &permanent)
+}
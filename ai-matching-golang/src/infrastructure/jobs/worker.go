@@ -0,0 +1,247 @@
+package jobs
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultConcurrency     = 4
+	defaultPollInterval    = time.Second
+	defaultLockTimeout     = 30 * time.Minute
+	defaultRetryBase       = 10 * time.Second
+	defaultRetryMax        = time.Hour
+	defaultShutdownTimeout = 30 * time.Second
+
+	// maxErrorLength keeps stored errors readable in the job status endpoints
+	maxErrorLength = 2000
+)
+
+// Worker claims due jobs and runs them with their registered handlers.
+// WORKER_CONCURRENCY sets how many jobs run at once and WORKER_POLL_INTERVAL
+// how often the queue is checked while idle. A job still running after
+// JOB_LOCK_TIMEOUT is assumed lost with its worker and claimed again. Failed
+// attempts wait JOB_RETRY_BASE, doubling per attempt up to JOB_RETRY_MAX.
+type Worker struct {
+	queue           *Queue
+	jobRepo         repository.JobRepository
+	id              string
+	concurrency     int
+	pollInterval    time.Duration
+	lockTimeout     time.Duration
+	retryBase       time.Duration
+	retryMax        time.Duration
+	shutdownTimeout time.Duration
+	now             func() time.Time
+}
+
+func NewWorker(queue *Queue) *Worker {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "worker"
+	}
+
+	return &Worker{
+		queue:           queue,
+		jobRepo:         queue.jobRepo,
+		id:              fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+		concurrency:     intFromEnv("WORKER_CONCURRENCY", defaultConcurrency),
+		pollInterval:    durationFromEnv("WORKER_POLL_INTERVAL", defaultPollInterval),
+		lockTimeout:     durationFromEnv("JOB_LOCK_TIMEOUT", defaultLockTimeout),
+		retryBase:       durationFromEnv("JOB_RETRY_BASE", defaultRetryBase),
+		retryMax:        durationFromEnv("JOB_RETRY_MAX", defaultRetryMax),
+		shutdownTimeout: durationFromEnv("WORKER_SHUTDOWN_TIMEOUT", defaultShutdownTimeout),
+		now:             time.Now,
+	}
+}
+
+// Run processes jobs until ctx is cancelled. Running jobs are then given
+// WORKER_SHUTDOWN_TIMEOUT to finish before they are cancelled and retried.
+func (w *Worker) Run(ctx context.Context) error {
+	// Jobs outlive ctx so they can finish during shutdown
+	jobCtx, cancelJobs := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelJobs()
+
+	slots := make(chan struct{}, w.concurrency)
+	done := make(chan struct{}, w.concurrency)
+	var running sync.WaitGroup
+
+	slog.InfoContext(ctx, "job worker started", slog.String("worker_id", w.id), slog.Int("concurrency", w.concurrency))
+
+	for ctx.Err() == nil {
+		free := w.concurrency - len(slots)
+		claimed := 0
+		if free > 0 {
+			jobs, err := w.claim(ctx, free)
+			if err != nil && ctx.Err() == nil {
+				slog.ErrorContext(ctx, "failed to claim jobs", slog.Any("error", err))
+			}
+			for _, job := range jobs {
+				slots <- struct{}{}
+				running.Add(1)
+				go func() {
+					defer running.Done()
+					w.execute(jobCtx, job)
+					<-slots
+					// Wake the loop to claim another job, unless it is already awake
+					select {
+					case done <- struct{}{}:
+					default:
+					}
+				}()
+			}
+			claimed = len(jobs)
+		}
+
+		// Keep claiming while the queue has more work than free slots
+		if claimed > 0 && claimed == free {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+		case <-done:
+		case <-time.After(w.pollInterval):
+		}
+	}
+
+	finished := make(chan struct{})
+	go func() {
+		running.Wait()
+		close(finished)
+	}()
+	select {
+	case <-finished:
+	case <-time.After(w.shutdownTimeout):
+		slog.WarnContext(ctx, "cancelling running jobs at shutdown", slog.String("worker_id", w.id))
+		cancelJobs()
+		<-finished
+	}
+
+	slog.InfoContext(ctx, "job worker stopped", slog.String("worker_id", w.id))
+	return nil
+}
+
+func (w *Worker) claim(ctx context.Context, limit int) ([]db.Job, error) {
+	staleBefore := w.now().Add(-w.lockTimeout)
+	if n, err := w.jobRepo.BuryStaleJobs(ctx, staleBefore); err != nil {
+		return nil, fmt.Errorf("failed to bury stale jobs: %w", err)
+	} else if n > 0 {
+		slog.WarnContext(ctx, "stale jobs ran out of attempts", slog.Int64("count", n))
+	}
+	return w.jobRepo.ClaimJobs(ctx, w.id, staleBefore, int32(limit))
+}
+
+// execute runs one attempt of a job and records the outcome
+func (w *Worker) execute(ctx context.Context, job db.Job) {
+	logger := slog.With(
+		slog.String("job_id", job.ID.String()),
+		slog.String("kind", job.Kind),
+		slog.Int("attempt", int(job.Attempts)),
+	)
+
+	started := w.now()
+	err := w.run(ctx, job)
+	elapsed := time.Since(started)
+
+	// Record the outcome even when the job was cancelled at shutdown
+	ctx = context.WithoutCancel(ctx)
+
+	var held bool
+	var recordErr error
+	switch {
+	case err == nil:
+		logger.InfoContext(ctx, "job succeeded", slog.Duration("duration", elapsed))
+		held, recordErr = w.jobRepo.CompleteJob(ctx, job.ID, w.id)
+	case IsPermanent(err) || job.Attempts >= job.MaxAttempts:
+		logger.ErrorContext(ctx, "job failed for good", slog.Duration("duration", elapsed), slog.Any("error", err))
+		held, recordErr = w.jobRepo.BuryJob(ctx, job.ID, w.id, truncate(err.Error()))
+	default:
+		delay := w.backoff(int(job.Attempts))
+		logger.WarnContext(ctx, "job failed, will retry", slog.Duration("duration", elapsed), slog.Duration("retry_in", delay), slog.Any("error", err))
+		held, recordErr = w.jobRepo.RetryJob(ctx, job.ID, w.id, delay, truncate(err.Error()))
+	}
+
+	if recordErr != nil {
+		logger.ErrorContext(ctx, "failed to record job outcome", slog.Any("error", recordErr))
+	} else if !held {
+		logger.WarnContext(ctx, "job was claimed by another worker before it finished")
+	}
+}
+
+func (w *Worker) run(ctx context.Context, job db.Job) (err error) {
+	handler, ok := w.queue.handler(job.Kind)
+	if !ok {
+		return Permanent(fmt.Errorf("%w: %s", ErrUnknownKind, job.Kind))
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job handler panicked: %v", r)
+		}
+	}()
+	return handler(context.WithValue(ctx, lastAttemptKey{}, job.Attempts >= job.MaxAttempts), job)
+}
+
+type lastAttemptKey struct{}
+
+// LastAttempt reports whether the job being handled fails for good when
+// this attempt fails, for handlers that record their own final outcome
+func LastAttempt(ctx context.Context) bool {
+	last, _ := ctx.Value(lastAttemptKey{}).(bool)
+	return last
+}
+
+// backoff doubles the retry delay per attempt, with up to 20% jitter so
+// jobs that failed together do not retry together
+func (w *Worker) backoff(attempt int) time.Duration {
+	delay := w.retryMax
+	if attempt-1 < 32 {
+		delay = min(w.retryBase<<(attempt-1), w.retryMax)
+	}
+	if delay <= 0 {
+		delay = w.retryMax
+	}
+	return delay + rand.N(delay/5+1)
+}
+
+func truncate(message string) string {
+	if len(message) <= maxErrorLength {
+		return message
+	}
+	return strings.ToValidUTF8(message[:maxErrorLength], "")
+}
+
+func intFromEnv(name string, fallback int) int {
+	v := os.Getenv(name)
+	if v == "" {
+		return fallback
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n <= 0 {
+		slog.Warn("ignoring invalid "+name, "value", v)
+		return fallback
+	}
+	return n
+}
+
+func durationFromEnv(name string, fallback time.Duration) time.Duration {
+	v := os.Getenv(name)
+	if v == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		slog.Warn("ignoring invalid "+name, "value", v)
+		return fallback
+	}
+	return d
+}
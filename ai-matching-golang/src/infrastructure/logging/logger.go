@@ -0,0 +1,50 @@
+package logging
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// NewLogger builds the application logger. LOG_LEVEL selects the minimum level
+// (debug, info, warn, error; default info) and LOG_FORMAT selects json (default)
+// or text output. Sensitive attributes are redacted and request-scoped
+// attributes stored with WithAttrs are added to every record.
+func NewLogger() *slog.Logger {
+	return NewLoggerTo(os.Stdout)
+}
+
+// NewLoggerTo builds the application logger writing to w
+func NewLoggerTo(w io.Writer) *slog.Logger {
+	return newLogger(w, os.Getenv("LOG_LEVEL"), os.Getenv("LOG_FORMAT"))
+}
+
+func newLogger(w io.Writer, level, format string) *slog.Logger {
+	opts := &slog.HandlerOptions{
+		Level:       parseLevel(level),
+		ReplaceAttr: redact,
+	}
+
+	var handler slog.Handler
+	if strings.EqualFold(format, "text") {
+		handler = slog.NewTextHandler(w, opts)
+	} else {
+		handler = slog.NewJSONHandler(w, opts)
+	}
+
+	return slog.New(&contextHandler{next: handler})
+}
+
+func parseLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
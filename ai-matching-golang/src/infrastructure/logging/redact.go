@@ -0,0 +1,82 @@
+package logging
+
+import (
+	"log/slog"
+	"strings"
+	"unicode"
+)
+
+const RedactedValue = "[REDACTED]"
+
+var sensitiveKeys = []string{
+	"password",
+	"token",
+	"secret",
+	"authorization",
+	"cookie",
+	"confirmationcode",
+}
+
+// IsSensitiveKey reports whether an attribute or field name is expected to
+// hold a credential. Keys are compared word by word (snake, kebab, dotted or
+// camel case), so "refresh_token" and "clientSecret" match while
+// "tokens_used" does not.
+func IsSensitiveKey(key string) bool {
+	words := keyWords(key)
+	for i, word := range words {
+		if isSensitiveWord(word) {
+			return true
+		}
+		if i > 0 && isSensitiveWord(words[i-1]+word) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSensitiveWord also matches words run together, like "accesstoken"
+func isSensitiveWord(word string) bool {
+	for _, sensitive := range sensitiveKeys {
+		if strings.HasSuffix(word, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+// keyWords splits a key into lower case words
+func keyWords(key string) []string {
+	var words []string
+	var word []rune
+	runes := []rune(key)
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+	}
+	for i, r := range runes {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+			continue
+		case unicode.IsUpper(r) && i > 0:
+			prev := runes[i-1]
+			// fooBar and the Token of APIToken start a new word
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) ||
+				(unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				flush()
+			}
+		}
+		word = append(word, r)
+	}
+	flush()
+	return words
+}
+
+func redact(groups []string, attr slog.Attr) slog.Attr {
+	if attr.Value.Kind() != slog.KindGroup && IsSensitiveKey(attr.Key) {
+		return slog.String(attr.Key, RedactedValue)
+	}
+	return attr
+}
@@ -0,0 +1,138 @@
+package maintenance
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/external"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+)
+
+const userPageSize = 100
+
+// CognitoReconciler copies profile changes made in Cognito, such as through
+// the hosted UI, onto the local users and reports accounts that exist on
+// only one side. It never creates or deletes users.
+type CognitoReconciler struct {
+	cognitoClient external.CognitoClient
+	userRepo      repository.UserRepository
+}
+
+func NewCognitoReconciler(cognitoClient external.CognitoClient, userRepo repository.UserRepository) *CognitoReconciler {
+	return &CognitoReconciler{
+		cognitoClient: cognitoClient,
+		userRepo:      userRepo,
+	}
+}
+
+// Reconcile compares every pool user with the local user of the same sub
+func (r *CognitoReconciler) Reconcile(ctx context.Context) (string, error) {
+	seen := make(map[string]struct{})
+	var checked, updated, unlinked, failed int
+
+	token := ""
+	for {
+		page, err := r.cognitoClient.ListUsers(ctx, token)
+		if err != nil {
+			return "", fmt.Errorf("failed to list cognito users: %w", err)
+		}
+
+		for _, identity := range page.Users {
+			attrs := attributes(identity.Attributes)
+			sub := attrs["sub"]
+			if sub == "" {
+				continue
+			}
+			seen[sub] = struct{}{}
+			checked++
+
+			user, err := r.userRepo.GetUserByCognitoID(ctx, sub)
+			if errors.Is(err, sql.ErrNoRows) {
+				unlinked++
+				slog.WarnContext(ctx, "cognito user has no local user", slog.String("cognito_id", sub))
+				continue
+			}
+			if err != nil {
+				return "", fmt.Errorf("failed to get user for cognito id %s: %w", sub, err)
+			}
+
+			params, changed := profileUpdate(user, attrs)
+			if !changed {
+				continue
+			}
+			// One conflicting email should not hold up the rest of the pool
+			if _, err := r.userRepo.UpdateUser(ctx, params); err != nil {
+				failed++
+				slog.ErrorContext(ctx, "failed to update user from cognito", slog.String("user_id", user.ID.String()), slog.Any("error", err))
+				continue
+			}
+			updated++
+		}
+
+		token = aws.ToString(page.PaginationToken)
+		if token == "" {
+			break
+		}
+	}
+
+	orphaned := 0
+	for offset := int32(0); ; offset += userPageSize {
+		users, err := r.userRepo.ListUsers(ctx, userPageSize, offset)
+		if err != nil {
+			return "", fmt.Errorf("failed to list users: %w", err)
+		}
+		for _, user := range users {
+			if _, ok := seen[user.CognitoID]; !ok {
+				orphaned++
+				slog.WarnContext(ctx, "user has no cognito identity", slog.String("user_id", user.ID.String()))
+			}
+		}
+		if len(users) < userPageSize {
+			break
+		}
+	}
+
+	summary := fmt.Sprintf("checked %d identities, updated %d users, %d identities without a user, %d users without an identity",
+		checked, updated, unlinked, orphaned)
+	if failed > 0 {
+		return summary, fmt.Errorf("failed to update %d users", failed)
+	}
+	return summary, nil
+}
+
+// profileUpdate applies the Cognito email and names to a user. Names missing
+// in Cognito are left alone, as users created by sign-in have none.
+func profileUpdate(user db.User, attrs map[string]string) (db.UpdateUserParams, bool) {
+	params := db.UpdateUserParams{
+		ID:        user.ID,
+		Email:     user.Email,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+	}
+	if email := attrs["email"]; email != "" {
+		params.Email = email
+	}
+	if name := attrs["given_name"]; name != "" {
+		params.FirstName = sql.NullString{String: name, Valid: true}
+	}
+	if name := attrs["family_name"]; name != "" {
+		params.LastName = sql.NullString{String: name, Valid: true}
+	}
+
+	changed := params.Email != user.Email || params.FirstName != user.FirstName || params.LastName != user.LastName
+	return params, changed
+}
+
+func attributes(attrs []types.AttributeType) map[string]string {
+	m := make(map[string]string, len(attrs))
+	for _, attr := range attrs {
+		m[aws.ToString(attr.Name)] = aws.ToString(attr.Value)
+	}
+	return m
+}
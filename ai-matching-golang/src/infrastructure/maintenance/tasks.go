@@ -0,0 +1,178 @@
+package maintenance
+
+import (
+	"ai-matching/src/domain/interface/external"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/dataexport"
+	"ai-matching/src/infrastructure/metering"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+)
+
+const (
+	defaultDocumentPurgeAfter = 30 * 24 * time.Hour
+	defaultJobRetention       = 7 * 24 * time.Hour
+	defaultDeliveryRetention  = 30 * 24 * time.Hour
+
+	purgeBatchSize = 100
+)
+
+// Tasks are the housekeeping jobs run by the scheduler. Each returns a short
+// summary for the run history. Soft-deleted documents are purged after
+// DOCUMENT_PURGE_AFTER (default 30 days), succeeded jobs are removed after
+// JOB_RETENTION (default 7 days) and finished webhook deliveries after
+// WEBHOOK_DELIVERY_RETENTION (default 30 days).
+type Tasks struct {
+	documentRepo       repository.DocumentRepository
+	memberRepo         repository.OrganizationMemberRepository
+	tenantRepo         repository.TenantRepository
+	rateLimitRepo      repository.RateLimitRepository
+	jobRepo            repository.JobRepository
+	webhookRepo        repository.WebhookRepository
+	storage            external.FileStorage
+	exporter           *dataexport.Exporter
+	meter              *metering.Meter
+	documentPurgeAfter time.Duration
+	jobRetention       time.Duration
+	deliveryRetention  time.Duration
+	now                func() time.Time
+}
+
+func NewTasks(documentRepo repository.DocumentRepository, memberRepo repository.OrganizationMemberRepository, tenantRepo repository.TenantRepository, rateLimitRepo repository.RateLimitRepository, jobRepo repository.JobRepository, webhookRepo repository.WebhookRepository, storage external.FileStorage, exporter *dataexport.Exporter, meter *metering.Meter) *Tasks {
+	return &Tasks{
+		documentRepo:       documentRepo,
+		memberRepo:         memberRepo,
+		tenantRepo:         tenantRepo,
+		rateLimitRepo:      rateLimitRepo,
+		jobRepo:            jobRepo,
+		webhookRepo:        webhookRepo,
+		storage:            storage,
+		exporter:           exporter,
+		meter:              meter,
+		documentPurgeAfter: durationFromEnv("DOCUMENT_PURGE_AFTER", defaultDocumentPurgeAfter),
+		jobRetention:       durationFromEnv("JOB_RETENTION", defaultJobRetention),
+		deliveryRetention:  durationFromEnv("WEBHOOK_DELIVERY_RETENTION", defaultDeliveryRetention),
+		now:                time.Now,
+	}
+}
+
+// PurgeDocuments permanently deletes documents soft-deleted longer than the
+// purge delay, along with their stored files, versions and chunks
+func (t *Tasks) PurgeDocuments(ctx context.Context) (string, error) {
+	deletedBefore := t.now().Add(-t.documentPurgeAfter)
+
+	purged := 0
+	for {
+		ids, err := t.documentRepo.ListPurgeableDocuments(ctx, deletedBefore, purgeBatchSize)
+		if err != nil {
+			return "", fmt.Errorf("failed to list purgeable documents: %w", err)
+		}
+
+		for _, id := range ids {
+			keys, err := t.documentRepo.ListVersionStorageKeys(ctx, id)
+			if err != nil {
+				return "", fmt.Errorf("failed to list files of document %s: %w", id, err)
+			}
+			// Delete the files first so a failure leaves the document to retry
+			for _, key := range keys {
+				if err := t.storage.Delete(ctx, key); err != nil && !errors.Is(err, external.ErrFileNotFound) {
+					return "", fmt.Errorf("failed to delete file of document %s: %w", id, err)
+				}
+			}
+			if _, err := t.documentRepo.PurgeDocument(ctx, id); err != nil {
+				return "", fmt.Errorf("failed to purge document %s: %w", id, err)
+			}
+			purged++
+		}
+
+		if len(ids) < purgeBatchSize {
+			return fmt.Sprintf("purged %d documents", purged), nil
+		}
+	}
+}
+
+// ExpireOwnershipTransfers closes pending ownership transfers that were not
+// accepted in time
+func (t *Tasks) ExpireOwnershipTransfers(ctx context.Context) (string, error) {
+	n, err := t.memberRepo.ExpireOwnershipTransfers(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to expire ownership transfers: %w", err)
+	}
+	return fmt.Sprintf("expired %d ownership transfers", n), nil
+}
+
+// SnapshotUsage writes yesterday's usage records. Snapshots are idempotent,
+// so the run also picks up activity recorded after midnight.
+func (t *Tasks) SnapshotUsage(ctx context.Context) (string, error) {
+	day := metering.Day(t.now()).AddDate(0, 0, -1)
+	n, err := t.meter.Snapshot(ctx, day)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("wrote %d usage records for %s", n, day.Format(time.DateOnly)), nil
+}
+
+// DeleteExpiredExports removes export archives past their retention
+func (t *Tasks) DeleteExpiredExports(ctx context.Context) (string, error) {
+	n, err := t.exporter.DeleteExpired(ctx)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("deleted %d export archives", n), nil
+}
+
+// DeleteExpiredRateLimits removes rate limit counters whose window ended
+func (t *Tasks) DeleteExpiredRateLimits(ctx context.Context) (string, error) {
+	n, err := t.rateLimitRepo.DeleteExpired(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to delete expired rate limits: %w", err)
+	}
+	return fmt.Sprintf("deleted %d rate limit counters", n), nil
+}
+
+// DeleteExpiredSubdomainAliases releases renamed subdomains after their
+// redirect period
+func (t *Tasks) DeleteExpiredSubdomainAliases(ctx context.Context) (string, error) {
+	n, err := t.tenantRepo.DeleteExpiredSubdomainAliases(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to delete expired subdomain aliases: %w", err)
+	}
+	return fmt.Sprintf("deleted %d subdomain aliases", n), nil
+}
+
+// DeleteSucceededJobs removes finished jobs past their retention. Dead jobs
+// are kept so admins can inspect and retry them.
+func (t *Tasks) DeleteSucceededJobs(ctx context.Context) (string, error) {
+	n, err := t.jobRepo.DeleteSucceededJobs(ctx, t.now().Add(-t.jobRetention))
+	if err != nil {
+		return "", fmt.Errorf("failed to delete succeeded jobs: %w", err)
+	}
+	return fmt.Sprintf("deleted %d succeeded jobs", n), nil
+}
+
+// DeleteWebhookDeliveries removes succeeded and failed webhook deliveries
+// past their retention. Pending ones are left for the worker.
+func (t *Tasks) DeleteWebhookDeliveries(ctx context.Context) (string, error) {
+	n, err := t.webhookRepo.DeleteDeliveriesBefore(ctx, t.now().Add(-t.deliveryRetention))
+	if err != nil {
+		return "", fmt.Errorf("failed to delete webhook deliveries: %w", err)
+	}
+	return fmt.Sprintf("deleted %d webhook deliveries", n), nil
+}
+
+func durationFromEnv(name string, fallback time.Duration) time.Duration {
+	v := os.Getenv(name)
+	if v == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		slog.Warn("ignoring invalid "+name, "value", v)
+		return fallback
+	}
+	return d
+}
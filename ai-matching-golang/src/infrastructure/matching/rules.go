@@ -0,0 +1,177 @@
+package matching
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Rule operators
+const (
+	OpEquals    = "eq"
+	OpNotEquals = "neq"
+	OpIn        = "in"
+	OpContains  = "contains"
+	OpAtLeast   = "gte"
+	OpAtMost    = "lte"
+	OpExists    = "exists"
+)
+
+const MaxRuleWeight = 100
+
+// Rule is a requirement an opening places on candidate attributes.
+// Attribute is a key of the attributes object, with dots reaching into
+// nested objects, such as "license.type".
+type Rule struct {
+	Attribute string  `json:"attribute"`
+	Operator  string  `json:"operator"`
+	Value     any     `json:"value,omitempty"`
+	Weight    float64 `json:"weight"`
+	// Required rules exclude the candidates that fail them
+	Required bool `json:"required,omitempty"`
+}
+
+// Validate checks that the rule can be evaluated
+func (r Rule) Validate() error {
+	if strings.TrimSpace(r.Attribute) == "" {
+		return errors.New("attribute must not be blank")
+	}
+	if r.Weight < 0 || r.Weight > MaxRuleWeight {
+		return fmt.Errorf("weight must be between 0 and %d", MaxRuleWeight)
+	}
+
+	switch r.Operator {
+	case OpEquals, OpNotEquals, OpContains:
+		if !isScalar(r.Value) {
+			return fmt.Errorf("%s needs a string, number or boolean value", r.Operator)
+		}
+	case OpIn:
+		values, ok := r.Value.([]any)
+		if !ok || len(values) == 0 {
+			return errors.New("in needs a non-empty list of values")
+		}
+		for _, v := range values {
+			if !isScalar(v) {
+				return errors.New("in needs a list of strings, numbers or booleans")
+			}
+		}
+	case OpAtLeast, OpAtMost:
+		if _, ok := r.Value.(float64); !ok {
+			return fmt.Errorf("%s needs a number", r.Operator)
+		}
+	case OpExists:
+		if r.Value != nil {
+			return errors.New("exists takes no value")
+		}
+	default:
+		return fmt.Errorf("unknown operator %q", r.Operator)
+	}
+	return nil
+}
+
+// Evaluate reports whether attributes satisfy the rule, with the attribute
+// value it was judged on. Attributes holding a list satisfy eq, in and
+// contains when any element does.
+func (r Rule) Evaluate(attributes map[string]any) (bool, any) {
+	actual, found := lookup(attributes, r.Attribute)
+	if !found || actual == nil {
+		// A missing attribute does not equal anything, so neq holds
+		return r.Operator == OpNotEquals, nil
+	}
+
+	switch r.Operator {
+	case OpEquals:
+		return anyElement(actual, func(v any) bool { return equal(v, r.Value) }), actual
+	case OpNotEquals:
+		return !anyElement(actual, func(v any) bool { return equal(v, r.Value) }), actual
+	case OpIn:
+		values, _ := r.Value.([]any)
+		return anyElement(actual, func(v any) bool {
+			for _, want := range values {
+				if equal(v, want) {
+					return true
+				}
+			}
+			return false
+		}), actual
+	case OpContains:
+		return anyElement(actual, func(v any) bool {
+			s, ok := v.(string)
+			want, wantString := r.Value.(string)
+			if ok && wantString {
+				return strings.Contains(strings.ToLower(s), strings.ToLower(want))
+			}
+			return equal(v, r.Value)
+		}), actual
+	case OpAtLeast, OpAtMost:
+		n, ok := actual.(float64)
+		want, _ := r.Value.(float64)
+		if !ok {
+			return false, actual
+		}
+		if r.Operator == OpAtLeast {
+			return n >= want, actual
+		}
+		return n <= want, actual
+	case OpExists:
+		if s, ok := actual.(string); ok {
+			return strings.TrimSpace(s) != "", actual
+		}
+		if list, ok := actual.([]any); ok {
+			return len(list) > 0, actual
+		}
+		return true, actual
+	}
+	return false, actual
+}
+
+func lookup(attributes map[string]any, path string) (any, bool) {
+	var current any = attributes
+	for _, key := range strings.Split(path, ".") {
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current, ok = object[key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func anyElement(v any, match func(any) bool) bool {
+	if list, ok := v.([]any); ok {
+		for _, item := range list {
+			if match(item) {
+				return true
+			}
+		}
+		return false
+	}
+	return match(v)
+}
+
+// equal compares JSON scalars; strings compare case-insensitively
+func equal(a, b any) bool {
+	switch a := a.(type) {
+	case string:
+		b, ok := b.(string)
+		return ok && strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+	case float64:
+		b, ok := b.(float64)
+		return ok && a == b
+	case bool:
+		b, ok := b.(bool)
+		return ok && a == b
+	}
+	return false
+}
+
+func isScalar(v any) bool {
+	switch v.(type) {
+	case string, float64, bool:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package matching
+
+import (
+	"ai-matching/src/infrastructure/retrieval"
+)
+
+// Factor kinds
+const (
+	FactorRule       = "rule"
+	FactorSimilarity = "similarity"
+)
+
+// Factor is one component of a match score
+type Factor struct {
+	Kind string
+	// Rule is set for rule factors
+	Rule   *Rule
+	Actual any
+	// Score is 1 or 0 for a rule and the clamped cosine similarity of the
+	// two descriptions for similarity
+	Score  float64
+	Weight float64
+	// Contribution is the share of the total score from this factor
+	Contribution float64
+}
+
+// Score is how well a candidate fits an opening
+type Score struct {
+	// Total is the weighted mean of the factor scores, between 0 and 1
+	Total float64
+	// Eligible is false when a required rule failed
+	Eligible bool
+	Factors  []Factor
+}
+
+// Evaluate scores candidate attributes against the rules of an opening and
+// the similarity of their description embeddings. Either embedding may be
+// nil, for instance when a description is empty, which leaves similarity
+// out of the score rather than counting it as zero.
+func Evaluate(rules []Rule, attributes map[string]any, candidateEmbedding, openingEmbedding []float32, similarityWeight float64) Score {
+	score := Score{Eligible: true, Factors: make([]Factor, 0, len(rules)+1)}
+
+	var totalWeight float64
+	for i := range rules {
+		rule := &rules[i]
+		matched, actual := rule.Evaluate(attributes)
+		factor := Factor{Kind: FactorRule, Rule: rule, Actual: actual, Weight: rule.Weight}
+		if matched {
+			factor.Score = 1
+		} else if rule.Required {
+			score.Eligible = false
+		}
+		totalWeight += rule.Weight
+		score.Factors = append(score.Factors, factor)
+	}
+
+	if similarityWeight > 0 && len(candidateEmbedding) > 0 && len(candidateEmbedding) == len(openingEmbedding) {
+		similarity := max(retrieval.Cosine(candidateEmbedding, openingEmbedding), 0)
+		score.Factors = append(score.Factors, Factor{Kind: FactorSimilarity, Score: similarity, Weight: similarityWeight})
+		totalWeight += similarityWeight
+	}
+
+	if totalWeight == 0 {
+		return score
+	}
+	for i := range score.Factors {
+		factor := &score.Factors[i]
+		factor.Contribution = factor.Weight * factor.Score / totalWeight
+		score.Total += factor.Contribution
+	}
+	return score
+}
@@ -0,0 +1,79 @@
+package metering
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+const commandUsage = `usage:
+  ai-matching usage snapshot [-date YYYY-MM-DD]
+  ai-matching usage export [-period YYYY-MM] [-format csv|json] [-out FILE]`
+
+// RunCommand runs the "usage" subcommand. snapshot defaults to yesterday and
+// export to the previous billing period, written to stdout.
+func RunCommand(ctx context.Context, meter *Meter, args []string, stdout io.Writer) error {
+	if len(args) == 0 {
+		return errors.New(commandUsage)
+	}
+
+	switch args[0] {
+	case "snapshot":
+		fs := flag.NewFlagSet("usage snapshot", flag.ContinueOnError)
+		date := fs.String("date", Day(meter.now()).AddDate(0, 0, -1).Format(time.DateOnly), "day to snapshot (UTC)")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+
+		day, err := time.Parse(time.DateOnly, *date)
+		if err != nil {
+			return fmt.Errorf("invalid date %q, expected YYYY-MM-DD", *date)
+		}
+		n, err := meter.Snapshot(ctx, day)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(stdout, "wrote %d usage records for %s\n", n, day.Format(time.DateOnly))
+		return err
+
+	case "export":
+		fs := flag.NewFlagSet("usage export", flag.ContinueOnError)
+		periodFlag := fs.String("period", PeriodOf(meter.now()).Previous().String(), "billing period")
+		format := fs.String("format", FormatCSV, "output format (csv or json)")
+		out := fs.String("out", "", "output file (defaults to stdout)")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+
+		if !ValidFormat(*format) {
+			return fmt.Errorf("unsupported export format %q", *format)
+		}
+		period, err := ParsePeriod(*periodFlag)
+		if err != nil {
+			return err
+		}
+		report, err := meter.Report(ctx, period)
+		if err != nil {
+			return err
+		}
+
+		if *out == "" {
+			return report.Write(stdout, *format)
+		}
+		f, err := os.Create(*out)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", *out, err)
+		}
+		if err := report.Write(f, *format); err != nil {
+			f.Close()
+			return err
+		}
+		return f.Close()
+	}
+
+	return fmt.Errorf("unknown usage command %q\n%s", args[0], commandUsage)
+}
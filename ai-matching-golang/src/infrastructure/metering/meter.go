@@ -0,0 +1,108 @@
+package metering
+
+import (
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/quota"
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Metrics stored in usage_records. Rows with a tenant hold per-tenant values,
+// rows without one hold organization totals.
+const (
+	// MetricActiveUsers is the number of distinct users with a request that day.
+	MetricActiveUsers = "active_users"
+	// MetricSeats is the number of tenant members at snapshot time. The
+	// organization total counts users that belong to several tenants once.
+	MetricSeats = "seats"
+	// MetricAPICalls is the month-to-date API call count of the organization.
+	MetricAPICalls = "api_calls"
+)
+
+// Meter records user activity and snapshots daily usage for billing.
+type Meter struct {
+	usageRepo repository.UsageRepository
+	quotas    *quota.Enforcer
+	now       func() time.Time
+
+	mu       sync.Mutex
+	day      time.Time
+	recorded map[activityKey]struct{}
+}
+
+type activityKey struct {
+	tenantID uuid.UUID
+	userID   uuid.UUID
+}
+
+func NewMeter(usageRepo repository.UsageRepository, quotas *quota.Enforcer) *Meter {
+	return &Meter{
+		usageRepo: usageRepo,
+		quotas:    quotas,
+		now:       time.Now,
+		recorded:  make(map[activityKey]struct{}),
+	}
+}
+
+// RecordActivity marks the user as active in the tenant today. Each
+// user/tenant pair is written at most once per day per process.
+func (m *Meter) RecordActivity(ctx context.Context, organizationID, tenantID, userID uuid.UUID) error {
+	today := Day(m.now())
+	key := activityKey{tenantID: tenantID, userID: userID}
+
+	m.mu.Lock()
+	if !m.day.Equal(today) {
+		m.day = today
+		m.recorded = make(map[activityKey]struct{})
+	}
+	_, seen := m.recorded[key]
+	m.mu.Unlock()
+	if seen {
+		return nil
+	}
+
+	if err := m.usageRepo.RecordUserActivity(ctx, today, organizationID, tenantID, userID); err != nil {
+		return fmt.Errorf("failed to record user activity: %w", err)
+	}
+
+	m.mu.Lock()
+	if m.day.Equal(today) {
+		m.recorded[key] = struct{}{}
+	}
+	m.mu.Unlock()
+	return nil
+}
+
+// Snapshot writes the usage records for date. It is idempotent, so a day can
+// be snapshotted again to pick up late activity.
+func (m *Meter) Snapshot(ctx context.Context, date time.Time) (int64, error) {
+	date = Day(date)
+
+	steps := []struct {
+		name string
+		run  func(context.Context, time.Time) (int64, error)
+	}{
+		{"tenant active users", m.usageRepo.SnapshotTenantActiveUsers},
+		{"organization active users", m.usageRepo.SnapshotOrganizationActiveUsers},
+		{"tenant seats", m.usageRepo.SnapshotTenantSeats},
+		{"organization seats", m.usageRepo.SnapshotOrganizationSeats},
+		{"organization api calls", m.usageRepo.SnapshotOrganizationAPICalls},
+	}
+
+	var total int64
+	for _, step := range steps {
+		n, err := step.run(ctx, date)
+		if err != nil {
+			return total, fmt.Errorf("failed to snapshot %s: %w", step.name, err)
+		}
+		total += n
+	}
+
+	slog.InfoContext(ctx, "usage snapshot written", "date", date.Format(time.DateOnly), "records", total)
+	return total, nil
+}
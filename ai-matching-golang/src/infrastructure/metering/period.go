@@ -0,0 +1,60 @@
+package metering
+
+import (
+	"fmt"
+	"time"
+)
+
+const periodLayout = "2006-01"
+
+// Period is a calendar-month billing period in UTC. It lines up with the
+// monthly API quota period so API call totals can be read straight from it.
+type Period struct {
+	Start time.Time
+	End   time.Time
+}
+
+// ParsePeriod parses a period written as YYYY-MM.
+func ParsePeriod(value string) (Period, error) {
+	start, err := time.Parse(periodLayout, value)
+	if err != nil {
+		return Period{}, fmt.Errorf("invalid billing period %q, expected YYYY-MM", value)
+	}
+	return PeriodOf(start), nil
+}
+
+// PeriodOf returns the period containing t.
+func PeriodOf(t time.Time) Period {
+	t = t.UTC()
+	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return Period{Start: start, End: start.AddDate(0, 1, 0)}
+}
+
+// Previous returns the period before p.
+func (p Period) Previous() Period {
+	return PeriodOf(p.Start.AddDate(0, -1, 0))
+}
+
+func (p Period) String() string {
+	return p.Start.Format(periodLayout)
+}
+
+// elapsedDays counts the days of p up to and including the day before now, so
+// averages over the current period only cover days that have been snapshotted.
+func (p Period) elapsedDays(now time.Time) int {
+	end := p.End
+	if today := Day(now); today.Before(end) {
+		end = today
+	}
+	days := int(end.Sub(p.Start).Hours() / 24)
+	if days < 1 {
+		return 1
+	}
+	return days
+}
+
+// Day truncates t to midnight UTC.
+func Day(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
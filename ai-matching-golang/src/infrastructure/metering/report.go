@@ -0,0 +1,229 @@
+package metering
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+)
+
+// ValidFormat reports whether format is a supported export format.
+func ValidFormat(format string) bool {
+	return format == FormatCSV || format == FormatJSON
+}
+
+// Report is the invoicing input for one billing period.
+type Report struct {
+	Period        string              `json:"period"`
+	PeriodStart   time.Time           `json:"periodStart"`
+	PeriodEnd     time.Time           `json:"periodEnd"`
+	GeneratedAt   time.Time           `json:"generatedAt"`
+	Organizations []OrganizationUsage `json:"organizations"`
+}
+
+type OrganizationUsage struct {
+	OrganizationID          uuid.UUID     `json:"organizationId"`
+	Name                    string        `json:"name"`
+	Plan                    string        `json:"plan"`
+	PeakSeats               int64         `json:"peakSeats"`
+	PeakDailyActiveUsers    int64         `json:"peakDailyActiveUsers"`
+	AverageDailyActiveUsers float64       `json:"averageDailyActiveUsers"`
+	MonthlyActiveUsers      int64         `json:"monthlyActiveUsers"`
+	APICalls                int64         `json:"apiCalls"`
+	Tenants                 []TenantUsage `json:"tenants"`
+}
+
+type TenantUsage struct {
+	TenantID                uuid.UUID `json:"tenantId"`
+	Name                    string    `json:"name"`
+	PeakSeats               int64     `json:"peakSeats"`
+	PeakDailyActiveUsers    int64     `json:"peakDailyActiveUsers"`
+	AverageDailyActiveUsers float64   `json:"averageDailyActiveUsers"`
+	MonthlyActiveUsers      int64     `json:"monthlyActiveUsers"`
+}
+
+// usageTotals accumulates the daily records of one organization or tenant.
+type usageTotals struct {
+	peakSeats       int64
+	peakActiveUsers int64
+	activeUserDays  int64
+}
+
+func (t *usageTotals) add(metric string, quantity int64) {
+	switch metric {
+	case MetricSeats:
+		t.peakSeats = max(t.peakSeats, quantity)
+	case MetricActiveUsers:
+		t.peakActiveUsers = max(t.peakActiveUsers, quantity)
+		t.activeUserDays += quantity
+	}
+}
+
+// Report aggregates the usage records of period. The plan is the one in effect
+// when the report is generated.
+func (m *Meter) Report(ctx context.Context, period Period) (*Report, error) {
+	records, err := m.usageRepo.ListUsageRecords(ctx, period.Start, period.End)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list usage records: %w", err)
+	}
+	orgMAU, err := m.usageRepo.CountOrganizationMonthlyActiveUsers(ctx, period.Start, period.End)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count monthly active users: %w", err)
+	}
+	tenantMAU, err := m.usageRepo.CountTenantMonthlyActiveUsers(ctx, period.Start, period.End)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count monthly active users: %w", err)
+	}
+
+	orgActive := make(map[uuid.UUID]int64, len(orgMAU))
+	for _, row := range orgMAU {
+		orgActive[row.OrganizationID] = row.ActiveUsers
+	}
+	tenantActive := make(map[uuid.UUID]int64, len(tenantMAU))
+	for _, row := range tenantMAU {
+		tenantActive[row.TenantID] = row.ActiveUsers
+	}
+
+	// Records are ordered by organization, then tenant, so entries can be
+	// appended as they first appear.
+	var orgs []*OrganizationUsage
+	orgIndex := make(map[uuid.UUID]int)
+	orgTotals := make(map[uuid.UUID]*usageTotals)
+	tenantIndex := make(map[uuid.UUID]int)
+	tenantTotals := make(map[uuid.UUID]*usageTotals)
+
+	for _, rec := range records {
+		i, ok := orgIndex[rec.OrganizationID]
+		if !ok {
+			i = len(orgs)
+			orgIndex[rec.OrganizationID] = i
+			orgTotals[rec.OrganizationID] = &usageTotals{}
+			orgs = append(orgs, &OrganizationUsage{
+				OrganizationID:     rec.OrganizationID,
+				Name:               rec.OrganizationName,
+				MonthlyActiveUsers: orgActive[rec.OrganizationID],
+				Tenants:            []TenantUsage{},
+			})
+		}
+		org := orgs[i]
+
+		if !rec.TenantID.Valid {
+			if rec.Metric == MetricAPICalls {
+				// Month-to-date counter, so the latest value is the total
+				org.APICalls = max(org.APICalls, rec.Quantity)
+			}
+			orgTotals[rec.OrganizationID].add(rec.Metric, rec.Quantity)
+			continue
+		}
+
+		tenantID := rec.TenantID.UUID
+		if _, ok := tenantIndex[tenantID]; !ok {
+			tenantIndex[tenantID] = len(org.Tenants)
+			tenantTotals[tenantID] = &usageTotals{}
+			org.Tenants = append(org.Tenants, TenantUsage{
+				TenantID:           tenantID,
+				Name:               rec.TenantName.String,
+				MonthlyActiveUsers: tenantActive[tenantID],
+			})
+		}
+		tenantTotals[tenantID].add(rec.Metric, rec.Quantity)
+	}
+
+	now := m.now()
+	days := float64(period.elapsedDays(now))
+
+	report := &Report{
+		Period:        period.String(),
+		PeriodStart:   period.Start,
+		PeriodEnd:     period.End,
+		GeneratedAt:   now.UTC(),
+		Organizations: make([]OrganizationUsage, len(orgs)),
+	}
+	for i, org := range orgs {
+		plan, err := m.quotas.Plan(ctx, org.OrganizationID)
+		if err != nil {
+			return nil, err
+		}
+		org.Plan = plan.Code
+
+		totals := orgTotals[org.OrganizationID]
+		org.PeakSeats = totals.peakSeats
+		org.PeakDailyActiveUsers = totals.peakActiveUsers
+		org.AverageDailyActiveUsers = roundAverage(totals.activeUserDays, days)
+
+		for j := range org.Tenants {
+			tenant := &org.Tenants[j]
+			totals := tenantTotals[tenant.TenantID]
+			tenant.PeakSeats = totals.peakSeats
+			tenant.PeakDailyActiveUsers = totals.peakActiveUsers
+			tenant.AverageDailyActiveUsers = roundAverage(totals.activeUserDays, days)
+		}
+		report.Organizations[i] = *org
+	}
+	return report, nil
+}
+
+func roundAverage(total int64, days float64) float64 {
+	return math.Round(float64(total)/days*100) / 100
+}
+
+// Write encodes the report as CSV or JSON.
+func (r *Report) Write(w io.Writer, format string) error {
+	switch format {
+	case FormatCSV:
+		return r.writeCSV(w)
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(r)
+	}
+	return fmt.Errorf("unsupported export format %q", format)
+}
+
+// writeCSV writes one row per organization followed by one row per tenant.
+// Tenant rows leave api_calls empty since API calls are metered per
+// organization.
+func (r *Report) writeCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{
+		"period", "organization_id", "organization_name", "plan", "tenant_id", "tenant_name",
+		"peak_seats", "peak_daily_active_users", "average_daily_active_users", "monthly_active_users", "api_calls",
+	})
+
+	for _, org := range r.Organizations {
+		_ = cw.Write([]string{
+			r.Period, org.OrganizationID.String(), org.Name, org.Plan, "", "",
+			formatInt(org.PeakSeats), formatInt(org.PeakDailyActiveUsers), formatFloat(org.AverageDailyActiveUsers),
+			formatInt(org.MonthlyActiveUsers), formatInt(org.APICalls),
+		})
+		for _, tenant := range org.Tenants {
+			_ = cw.Write([]string{
+				r.Period, org.OrganizationID.String(), org.Name, org.Plan, tenant.TenantID.String(), tenant.Name,
+				formatInt(tenant.PeakSeats), formatInt(tenant.PeakDailyActiveUsers), formatFloat(tenant.AverageDailyActiveUsers),
+				formatInt(tenant.MonthlyActiveUsers), "",
+			})
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func formatInt(v int64) string {
+	return strconv.FormatInt(v, 10)
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
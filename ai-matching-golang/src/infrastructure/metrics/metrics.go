@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"database/sql"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+const namespace = "ai_matching"
+
+var (
+	HTTPRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "Number of handled API requests by huma operation, method and status code.",
+	}, []string{"operation", "method", "status"})
+
+	HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Latency of API requests by huma operation and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation", "method"})
+
+	HTTPRequestsInFlight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "http_requests_in_flight",
+		Help:      "Number of API requests currently being served.",
+	})
+
+	JWKSCacheTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "jwks_cache_total",
+		Help:      "JWKS lookups by cache result (hit or miss).",
+	}, []string{"result"})
+
+	JWKSFetchErrorsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "jwks_fetch_errors_total",
+		Help:      "Number of failed JWKS fetches.",
+	})
+
+	CognitoRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cognito_requests_total",
+		Help:      "Cognito API calls by operation and outcome (success or the AWS error code).",
+	}, []string{"operation", "outcome"})
+)
+
+// NewRegistry returns a registry holding the application metrics together with
+// the Go runtime, process and database pool collectors.
+func NewRegistry(db *sql.DB) *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		collectors.NewDBStatsCollector(db, "postgres"),
+		HTTPRequestsTotal,
+		HTTPRequestDuration,
+		HTTPRequestsInFlight,
+		JWKSCacheTotal,
+		JWKSFetchErrorsTotal,
+		CognitoRequestsTotal,
+	)
+	return registry
+}
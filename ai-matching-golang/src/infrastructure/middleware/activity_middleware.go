@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"ai-matching/src/infrastructure/metering"
+	"log/slog"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+// ActivityMiddleware records the authenticated user as active in their tenant
+// for usage metering. It must run after the auth middleware and never blocks
+// the request.
+func ActivityMiddleware(meter *metering.Meter) func(huma.Context, func(huma.Context)) {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		user, err := GetUserFromContext(ctx.Context())
+		if err == nil && user.OrganizationID != uuid.Nil && user.Tenant.ID != uuid.Nil {
+			if err := meter.RecordActivity(ctx.Context(), user.OrganizationID, user.Tenant.ID, user.UserID); err != nil {
+				slog.WarnContext(ctx.Context(), "failed to record user activity", "user_id", user.UserID, "error", err)
+			}
+		}
+
+		next(ctx)
+	}
+}
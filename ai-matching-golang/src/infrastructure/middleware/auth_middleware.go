@@ -4,12 +4,13 @@ import (
 	"context"
 	"fmt"
 	"github.com/google/uuid"
+	"net/http"
 	"regexp"
 	"strings"
 
 	"ai-matching/src/domain/interface/repository"
 	"ai-matching/src/infrastructure/external/cognito"
-	"github.com/gofiber/fiber/v2"
+	"github.com/danielgtaylor/huma/v2"
 )
 
 type AuthMiddleware struct {
@@ -24,95 +25,91 @@ func NewAuthMiddleware(userRepo repository.UserRepository, tenantRepo repository
 	}
 }
 
-func (m *AuthMiddleware) FiberMiddleware() fiber.Handler {
-	return func(c *fiber.Ctx) error {
-		authHeader := c.Get("Authorization")
+// HumaMiddleware authenticates operations that declare bearer security and
+// stores the caller in the request context for GetUserFromContext. Public
+// operations pass through untouched.
+func (m *AuthMiddleware) HumaMiddleware(api huma.API) func(huma.Context, func(huma.Context)) {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		if op := ctx.Operation(); op == nil || len(op.Security) == 0 {
+			next(ctx)
+			return
+		}
+
+		unauthorized := func(message string) {
+			_ = huma.WriteErr(api, ctx, http.StatusUnauthorized, message)
+		}
+
+		authHeader := ctx.Header("Authorization")
 		if authHeader == "" {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"error": "Missing authorization header",
-			})
+			unauthorized("Missing authorization header")
+			return
 		}
 
 		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
 		if tokenString == authHeader {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"error": "Invalid authorization header format",
-			})
+			unauthorized("Invalid authorization header format")
+			return
 		}
 
-		token, err := m.jwtValidator.ValidateToken(tokenString)
+		token, err := m.jwtValidator.ValidateToken(ctx.Context(), tokenString)
 		if err != nil {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"error": "Invalid token: " + err.Error(),
-			})
+			unauthorized("Invalid token: " + err.Error())
+			return
 		}
 
 		userInfo, err := m.jwtValidator.GetUserInfoFromToken(token)
 		if err != nil {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"error": "Failed to extract user info: " + err.Error(),
-			})
+			unauthorized("Failed to extract user info: " + err.Error())
+			return
 		}
 
-		// 型変換を行ってからLocalsに保存
+		// 型変換を行ってからcontextに保存
+		var userID, orgID, tenantID uuid.UUID
 		if userIDStr, ok := userInfo["user_id"].(string); ok {
-			if userID, err := uuid.Parse(userIDStr); err == nil {
-				c.Locals("user_id", userID)
+			if id, err := uuid.Parse(userIDStr); err == nil {
+				userID = id
+				ctx = huma.WithValue(ctx, "user_id", id)
 			}
 		}
-		c.Locals("token", tokenString)
+		ctx = huma.WithValue(ctx, "token", tokenString)
 
 		// Set organization_id and tenant_id if available with proper type conversion
 		if orgIDStr, ok := userInfo["organization_id"].(string); ok {
-			if orgID, err := uuid.Parse(orgIDStr); err == nil {
-				c.Locals("organization_id", orgID)
+			if id, err := uuid.Parse(orgIDStr); err == nil {
+				orgID = id
+				ctx = huma.WithValue(ctx, "organization_id", id)
 			}
 		}
 		if tenantIDStr, ok := userInfo["tenant_id"].(string); ok {
-			if tenantID, err := uuid.Parse(tenantIDStr); err == nil {
-				c.Locals("tenant_id", tenantID)
+			if id, err := uuid.Parse(tenantIDStr); err == nil {
+				tenantID = id
+				ctx = huma.WithValue(ctx, "tenant_id", id)
 			}
 		}
-		if tenantName, ok := userInfo["tenant_name"]; ok {
-			c.Locals("tenant_name", tenantName)
-		}
-		if tenantSubdomain, ok := userInfo["tenant_subdomain"]; ok {
-			c.Locals("tenant_subdomain", tenantSubdomain)
-		}
-		if tenantIsActive, ok := userInfo["tenant_is_active"]; ok {
-			c.Locals("tenant_is_active", tenantIsActive)
+		for _, key := range []string{"tenant_name", "tenant_subdomain", "tenant_is_active"} {
+			if value, ok := userInfo[key]; ok {
+				ctx = huma.WithValue(ctx, key, value)
+			}
 		}
 
 		// URLパスから organizationId を抽出
-		path := c.Path()
+		path := ctx.URL().Path
 		organizationId := extractOrganizationIdFromPath(path)
-
-		if organizationId != "" {
-			if orgID, ok := c.Locals("organization_id").(uuid.UUID); ok {
-				if organizationId != orgID.String() {
-					return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-						"error": "Invalid organization ID",
-					})
-				}
-			}
+		if organizationId != "" && orgID != uuid.Nil && organizationId != orgID.String() {
+			unauthorized("Invalid organization ID")
+			return
 		}
 
 		// URLパスから tenantId を抽出
 		tenantId := extractTenantIdFromPath(path)
-		if tenantId != "" {
-			if tenantID, ok := c.Locals("tenant_id").(uuid.UUID); ok {
-				if userID, ok := c.Locals("user_id").(uuid.UUID); ok {
-					_, err := m.tenantUserRepo.GetTenantUser(context.Background(), tenantID, userID)
-					if err != nil {
-						return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-							"error": "User not authorized for this tenant",
-						})
-					}
-				}
+		if tenantId != "" && tenantID != uuid.Nil && userID != uuid.Nil {
+			if _, err := m.tenantUserRepo.GetTenantUser(ctx.Context(), tenantID, userID); err != nil {
+				unauthorized("User not authorized for this tenant")
+				return
 			}
 		}
 
-		return c.Next()
+		next(ctx)
 	}
 }
 
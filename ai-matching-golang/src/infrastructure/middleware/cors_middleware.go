@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"ai-matching/src/infrastructure/cors"
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+	fiberCors "github.com/gofiber/fiber/v2/middleware/cors"
+)
+
+type CORSMiddleware struct {
+	resolver *cors.Resolver
+}
+
+func NewCORSMiddleware(resolver *cors.Resolver) *CORSMiddleware {
+	return &CORSMiddleware{
+		resolver: resolver,
+	}
+}
+
+// FiberMiddleware evaluates the Origin header per request against the
+// resolver, so credentialed requests are only answered for known frontends.
+func (m *CORSMiddleware) FiberMiddleware() fiber.Handler {
+	return fiberCors.New(fiberCors.Config{
+		AllowOriginsFunc: func(origin string) bool {
+			return m.resolver.Allowed(context.Background(), origin)
+		},
+		AllowCredentials: true,
+		AllowHeaders:     "Origin, Content-Type, Accept, Authorization, X-COMPANY-ID, X-SYSTEM-ADMIN-ID, X-Request-ID",
+		ExposeHeaders:    "X-Request-ID",
+		AllowMethods:     "GET, HEAD, PUT, PATCH, POST, DELETE",
+		MaxAge:           600,
+	})
+}
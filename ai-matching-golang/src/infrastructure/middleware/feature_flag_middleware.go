@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"ai-matching/src/infrastructure/featureflag"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+// RequireFeature returns an operation middleware that answers 404 when key is
+// off for the caller, so disabled features look like they do not exist. The
+// organization and tenant come from the organizationId/tenantId path
+// parameters when present, otherwise from the authenticated user.
+func RequireFeature(api huma.API, flags *featureflag.Evaluator, key string) func(huma.Context, func(huma.Context)) {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		var organizationID, tenantID uuid.UUID
+		if user, err := GetUserFromContext(ctx.Context()); err == nil {
+			organizationID = user.OrganizationID
+			tenantID = user.Tenant.ID
+		}
+		if id, err := uuid.Parse(ctx.Param("organizationId")); err == nil {
+			organizationID = id
+		}
+		if id, err := uuid.Parse(ctx.Param("tenantId")); err == nil {
+			tenantID = id
+		}
+
+		if !flags.Enabled(ctx.Context(), key, organizationID, tenantID) {
+			_ = huma.WriteErr(api, ctx, http.StatusNotFound, "Not Found")
+			return
+		}
+
+		next(ctx)
+	}
+}
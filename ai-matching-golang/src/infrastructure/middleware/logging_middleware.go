@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"ai-matching/src/infrastructure/logging"
+	"log/slog"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/adapters/humafiber"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type RequestLogger struct {
+	logger *slog.Logger
+}
+
+func NewRequestLogger(logger *slog.Logger) *RequestLogger {
+	return &RequestLogger{
+		logger: logger,
+	}
+}
+
+// FiberMiddleware writes one access log record per request once the response
+// status is known.
+func (m *RequestLogger) FiberMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+		err := c.Next()
+		if err != nil {
+			// Let the app error handler set the final status before logging.
+			if handlerErr := c.App().ErrorHandler(c, err); handlerErr != nil {
+				c.Status(fiber.StatusInternalServerError)
+			}
+		}
+
+		status := c.Response().StatusCode()
+		attrs := []slog.Attr{
+			slog.String("method", c.Method()),
+			slog.String("path", c.Path()),
+			slog.Int("status", status),
+			slog.Duration("latency", time.Since(start)),
+			slog.String("ip", c.IP()),
+		}
+
+		level := slog.LevelInfo
+		switch {
+		case status >= fiber.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= fiber.StatusBadRequest:
+			level = slog.LevelWarn
+		}
+		m.logger.LogAttrs(c.UserContext(), level, "request completed", attrs...)
+
+		return nil
+	}
+}
+
+// LoggingMiddleware adds the operation ID and, for authenticated requests, the
+// user, organization and tenant IDs from UserContext to the request context so
+// that the access log and any logs written by controllers and usecases carry
+// them. It must run after the auth middleware, which stores the caller.
+func LoggingMiddleware(ctx huma.Context, next func(huma.Context)) {
+	attrs := []slog.Attr{slog.String("operation_id", ctx.Operation().OperationID)}
+	if user, err := GetUserFromContext(ctx.Context()); err == nil {
+		attrs = append(attrs, slog.String("user_id", user.UserID.String()))
+		if user.OrganizationID != uuid.Nil {
+			attrs = append(attrs, slog.String("organization_id", user.OrganizationID.String()))
+		}
+		if user.Tenant != nil && user.Tenant.ID != uuid.Nil {
+			attrs = append(attrs, slog.String("tenant_id", user.Tenant.ID.String()))
+		}
+	}
+
+	fiberCtx := humafiber.Unwrap(ctx)
+	fiberCtx.SetUserContext(logging.WithAttrs(fiberCtx.UserContext(), attrs...))
+
+	next(huma.WithContext(ctx, logging.WithAttrs(ctx.Context(), attrs...)))
+}
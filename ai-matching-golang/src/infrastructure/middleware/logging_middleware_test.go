@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"ai-matching/src/infrastructure/logging"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/adapters/humafiber"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// lockedBuffer lets the handler goroutine and the test share the log output
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) records(t *testing.T) []map[string]any {
+	t.Helper()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var records []map[string]any
+	scanner := bufio.NewScanner(bytes.NewReader(b.buf.Bytes()))
+	for scanner.Scan() {
+		var record map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("log line is not JSON: %s", scanner.Text())
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestLoggingMiddlewareAuthenticatedRequest(t *testing.T) {
+	t.Setenv("LOG_FORMAT", "json")
+	t.Setenv("LOG_LEVEL", "info")
+
+	out := &lockedBuffer{}
+	logger := logging.NewLoggerTo(out)
+
+	userID, orgID, tenantID := uuid.New(), uuid.New(), uuid.New()
+	// Stands in for AuthMiddleware.HumaMiddleware, which stores the same values
+	auth := func(ctx huma.Context, next func(huma.Context)) {
+		ctx = huma.WithValue(ctx, "user_id", userID)
+		ctx = huma.WithValue(ctx, "token", "token")
+		ctx = huma.WithValue(ctx, "organization_id", orgID)
+		ctx = huma.WithValue(ctx, "tenant_id", tenantID)
+		next(ctx)
+	}
+
+	app := fiber.New()
+	app.Use(NewRequestLogger(logger).FiberMiddleware())
+	api := humafiber.New(app, huma.DefaultConfig("test", "1.0.0"))
+	// Same order as di.SetupRouter
+	api.UseMiddleware(auth, LoggingMiddleware)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "ping",
+		Method:      "GET",
+		Path:        "/ping",
+		Security:    []map[string][]string{{"bearer": {}}},
+	}, func(ctx context.Context, input *struct{}) (*struct{}, error) {
+		logger.InfoContext(ctx, "handled")
+		return nil, nil
+	})
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/ping", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	want := map[string]string{
+		"operation_id":    "ping",
+		"user_id":         userID.String(),
+		"organization_id": orgID.String(),
+		"tenant_id":       tenantID.String(),
+	}
+	found := map[string]bool{}
+	for _, record := range out.records(t) {
+		msg, _ := record["msg"].(string)
+		if msg != "handled" && msg != "request completed" {
+			continue
+		}
+		found[msg] = true
+		for key, value := range want {
+			if got, _ := record[key].(string); got != value {
+				t.Errorf("%q record: %s = %q, want %q", msg, key, got, value)
+			}
+		}
+	}
+	for _, msg := range []string{"handled", "request completed"} {
+		if !found[msg] {
+			t.Errorf("no %q record logged", msg)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"ai-matching/src/infrastructure/metrics"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// MetricsMiddleware records request count, latency and in-flight requests per
+// huma OperationID.
+func MetricsMiddleware(ctx huma.Context, next func(huma.Context)) {
+	operationID := ctx.Operation().OperationID
+	method := ctx.Method()
+
+	metrics.HTTPRequestsInFlight.Inc()
+	defer metrics.HTTPRequestsInFlight.Dec()
+
+	start := time.Now()
+	next(ctx)
+
+	status := ctx.Status()
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	metrics.HTTPRequestDuration.WithLabelValues(operationID, method).Observe(time.Since(start).Seconds())
+	metrics.HTTPRequestsTotal.WithLabelValues(operationID, method, strconv.Itoa(status)).Inc()
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"ai-matching/src/infrastructure/quota"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+// APIQuotaMiddleware counts authenticated requests against the organization's
+// monthly API quota and answers 429 once it is used up. It must run after the
+// auth middleware. Storage failures let the request through.
+func APIQuotaMiddleware(api huma.API, enforcer *quota.Enforcer) func(huma.Context, func(huma.Context)) {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		user, err := GetUserFromContext(ctx.Context())
+		if err != nil || user.OrganizationID == uuid.Nil {
+			next(ctx)
+			return
+		}
+
+		err = enforcer.RecordAPICall(ctx.Context(), user.OrganizationID)
+		if err != nil {
+			var exceeded *quota.ExceededError
+			if errors.As(err, &exceeded) {
+				_ = huma.WriteErr(api, ctx, http.StatusTooManyRequests, exceeded.Error())
+				return
+			}
+			slog.WarnContext(ctx.Context(), "api quota check failed", "organization_id", user.OrganizationID, "error", err)
+		}
+
+		next(ctx)
+	}
+}
+
+// QuotaError converts a *quota.ExceededError returned by a usecase into a 403
+// response. Other errors pass through.
+func QuotaError(err error) error {
+	var exceeded *quota.ExceededError
+	if !errors.As(err, &exceeded) {
+		return err
+	}
+	return huma.Error403Forbidden(exceeded.Error())
+}
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"ai-matching/src/infrastructure/ratelimit"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/adapters/humafiber"
+)
+
+// RateLimitByIP returns an operation middleware that applies rule to the
+// client IP and answers 429 with Retry-After once it is exceeded.
+func RateLimitByIP(api huma.API, limiter *ratelimit.Limiter, rule ratelimit.Rule) func(huma.Context, func(huma.Context)) {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		ip := humafiber.Unwrap(ctx).IP()
+
+		err := limiter.Allow(ctx.Context(), rule, ip)
+		if err != nil {
+			var limitErr *ratelimit.LimitExceededError
+			if errors.As(err, &limitErr) {
+				ctx.SetHeader("Retry-After", strconv.Itoa(limitErr.RetryAfterSeconds()))
+				_ = huma.WriteErr(api, ctx, http.StatusTooManyRequests, "Too many requests")
+				return
+			}
+			_ = huma.WriteErr(api, ctx, http.StatusInternalServerError, "Rate limit check failed", err)
+			return
+		}
+
+		next(ctx)
+	}
+}
+
+// RateLimitError converts a *ratelimit.LimitExceededError returned by a
+// usecase into a 429 response with Retry-After. Other errors pass through.
+func RateLimitError(err error) error {
+	var limitErr *ratelimit.LimitExceededError
+	if !errors.As(err, &limitErr) {
+		return err
+	}
+
+	return huma.ErrorWithHeaders(
+		huma.Error429TooManyRequests(limitErr.Reason),
+		http.Header{"Retry-After": []string{strconv.Itoa(limitErr.RetryAfterSeconds())}},
+	)
+}
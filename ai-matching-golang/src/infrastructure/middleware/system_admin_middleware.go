@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"ai-matching/src/domain/interface/repository"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// RequireSystemAdmin returns an operation middleware that only lets users
+// flagged is_system_admin through.
+func RequireSystemAdmin(api huma.API, userRepo repository.UserRepository) func(huma.Context, func(huma.Context)) {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		userCtx, err := GetUserFromContext(ctx.Context())
+		if err != nil {
+			_ = huma.WriteErr(api, ctx, http.StatusUnauthorized, "Authentication required")
+			return
+		}
+
+		user, err := userRepo.GetUser(ctx.Context(), userCtx.UserID)
+		if err != nil || !user.IsSystemAdmin {
+			_ = huma.WriteErr(api, ctx, http.StatusForbidden, "System administrator privileges required")
+			return
+		}
+
+		next(ctx)
+	}
+}
@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+)
+
+// RequireTenant returns the caller when tenantID is the tenant resolved for
+// them by the auth middleware. Tenant-owned content is only served for that
+// tenant, even to users who belong to several.
+func RequireTenant(ctx context.Context, tenantID uuid.UUID) (*UserContext, error) {
+	userCtx, err := GetUserFromContext(ctx)
+	if err != nil {
+		return nil, huma.Error401Unauthorized("Authentication required")
+	}
+	if userCtx.Tenant == nil || userCtx.Tenant.ID == uuid.Nil || userCtx.Tenant.ID != tenantID {
+		return nil, huma.Error403Forbidden("Tenant is outside the scope of the current session")
+	}
+	return userCtx, nil
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"ai-matching/src/infrastructure/tracing"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracingMiddleware starts a server span per huma operation, continuing the
+// trace from an incoming traceparent header when present.
+func TracingMiddleware(ctx huma.Context, next func(huma.Context)) {
+	carrier := propagation.HeaderCarrier{}
+	ctx.EachHeader(func(name, value string) {
+		carrier.Set(name, value)
+	})
+	parent := otel.GetTextMapPropagator().Extract(ctx.Context(), carrier)
+
+	op := ctx.Operation()
+	spanCtx, span := tracing.Tracer().Start(parent, op.OperationID,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(ctx.Method()),
+			semconv.HTTPRouteKey.String(op.Path),
+		),
+	)
+	defer span.End()
+
+	next(huma.WithContext(ctx, spanCtx))
+
+	status := ctx.Status()
+	if status == 0 {
+		status = http.StatusOK
+	}
+	span.SetAttributes(semconv.HTTPResponseStatusCodeKey.Int(status))
+	if status >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, http.StatusText(status))
+	}
+}
@@ -0,0 +1,146 @@
+package quota
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultPlan applies to organizations without an active subscription.
+const DefaultPlan = "free"
+
+const (
+	StatusActive   = "active"
+	StatusCanceled = "canceled"
+)
+
+const (
+	ResourceTenants  = "tenants"
+	ResourceSeats    = "seats"
+	ResourceAPICalls = "api calls"
+)
+
+// ExceededError is returned when an action would go over a plan quota.
+type ExceededError struct {
+	Resource string
+	Plan     string
+	Limit    int64
+}
+
+func (e *ExceededError) Error() string {
+	return fmt.Sprintf("%s quota exceeded: the %s plan allows %d", e.Resource, e.Plan, e.Limit)
+}
+
+// Enforcer checks organization usage against the limits of its plan.
+type Enforcer struct {
+	subscriptionRepo repository.SubscriptionRepository
+	tenantRepo       repository.TenantRepository
+	tenantUserRepo   repository.TenantUserRepository
+	now              func() time.Time
+}
+
+func NewEnforcer(subscriptionRepo repository.SubscriptionRepository, tenantRepo repository.TenantRepository, tenantUserRepo repository.TenantUserRepository) *Enforcer {
+	return &Enforcer{
+		subscriptionRepo: subscriptionRepo,
+		tenantRepo:       tenantRepo,
+		tenantUserRepo:   tenantUserRepo,
+		now:              time.Now,
+	}
+}
+
+// Plan returns the plan in effect for an organization, falling back to
+// DefaultPlan when it has no active subscription.
+func (e *Enforcer) Plan(ctx context.Context, organizationID uuid.UUID) (db.Plan, error) {
+	code := DefaultPlan
+	sub, err := e.subscriptionRepo.GetOrganizationSubscription(ctx, organizationID)
+	switch {
+	case err == nil:
+		if sub.Status == StatusActive {
+			code = sub.PlanCode
+		}
+	case !errors.Is(err, sql.ErrNoRows):
+		return db.Plan{}, fmt.Errorf("failed to get subscription: %w", err)
+	}
+
+	plan, err := e.subscriptionRepo.GetPlan(ctx, code)
+	if err != nil {
+		return db.Plan{}, fmt.Errorf("failed to get plan %q: %w", code, err)
+	}
+	return plan, nil
+}
+
+// CheckTenant returns an *ExceededError when the organization cannot create
+// another tenant.
+func (e *Enforcer) CheckTenant(ctx context.Context, organizationID uuid.UUID) error {
+	plan, err := e.Plan(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+	if !plan.MaxTenants.Valid {
+		return nil
+	}
+
+	count, err := e.tenantRepo.CountTenantsByOrganization(ctx, organizationID)
+	if err != nil {
+		return fmt.Errorf("failed to count tenants: %w", err)
+	}
+	if count >= int64(plan.MaxTenants.Int32) {
+		return &ExceededError{Resource: ResourceTenants, Plan: plan.Code, Limit: int64(plan.MaxTenants.Int32)}
+	}
+	return nil
+}
+
+// CheckSeat returns an *ExceededError when the tenant cannot take another user.
+func (e *Enforcer) CheckSeat(ctx context.Context, tenantID uuid.UUID) error {
+	tenant, err := e.tenantRepo.GetTenant(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	plan, err := e.Plan(ctx, tenant.OrganizationID)
+	if err != nil {
+		return err
+	}
+	if !plan.MaxSeatsPerTenant.Valid {
+		return nil
+	}
+
+	count, err := e.tenantUserRepo.CountTenantUsers(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to count tenant users: %w", err)
+	}
+	if count >= int64(plan.MaxSeatsPerTenant.Int32) {
+		return &ExceededError{Resource: ResourceSeats, Plan: plan.Code, Limit: int64(plan.MaxSeatsPerTenant.Int32)}
+	}
+	return nil
+}
+
+// RecordAPICall counts a request against the organization's monthly API
+// quota and returns an *ExceededError once it has been used up.
+func (e *Enforcer) RecordAPICall(ctx context.Context, organizationID uuid.UUID) error {
+	count, err := e.subscriptionRepo.IncrementAPIUsage(ctx, organizationID, e.PeriodStart())
+	if err != nil {
+		return fmt.Errorf("failed to record api usage: %w", err)
+	}
+
+	plan, err := e.Plan(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+	if plan.MaxApiCallsPerMonth.Valid && count > plan.MaxApiCallsPerMonth.Int64 {
+		return &ExceededError{Resource: ResourceAPICalls, Plan: plan.Code, Limit: plan.MaxApiCallsPerMonth.Int64}
+	}
+	return nil
+}
+
+// PeriodStart returns the first day (UTC) of the current monthly quota period.
+func (e *Enforcer) PeriodStart() time.Time {
+	now := e.now().UTC()
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,187 @@
+package rag
+
+import (
+	"ai-matching/src/domain/interface/external"
+	"ai-matching/src/infrastructure/chunking"
+	"ai-matching/src/infrastructure/retrieval"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// defaultContextTokens bounds the source text put into a prompt, leaving
+// room for the question and the answer in small context windows
+const defaultContextTokens = 3000
+
+// NoSourcesAnswer is given without calling the model when retrieval finds
+// nothing, since an answer could only be made up
+const NoSourcesAnswer = "I could not find anything about this in the documents."
+
+const systemPrompt = `You answer questions using only the numbered sources given with each question, which are excerpts from the organization's documents.
+Cite the source of every statement with its number in square brackets, such as [1] or [2][3].
+If the sources do not contain the answer, say that you could not find it instead of guessing.
+Answer in the language of the question.`
+
+var citationPattern = regexp.MustCompile(`\[(\d+(?:\s*,\s*\d+)*)\]`)
+
+// Question is what to answer and how to retrieve its sources
+type Question struct {
+	Text string
+	// Query is searched for instead of Text when set, such as a follow-up
+	// question rewritten to stand on its own
+	Query string
+	// History holds the earlier turns of a conversation, oldest first
+	History []external.ChatMessage
+	Filter  retrieval.Filter
+	TopK    int
+	Mode    string
+	Weights retrieval.Weights
+}
+
+// Source is a retrieved chunk as numbered in the prompt
+type Source struct {
+	Number int
+	retrieval.HybridResult
+}
+
+// Answerer answers questions from the chunks of a tenant's documents.
+// RAG_CONTEXT_TOKENS sets how much source text a prompt may hold and
+// RAG_HISTORY_TOKENS how much of the earlier conversation.
+type Answerer struct {
+	searcher      *retrieval.HybridSearcher
+	llm           external.LLMProvider
+	contextTokens int
+	historyTokens int
+}
+
+func NewAnswerer(searcher *retrieval.HybridSearcher, llm external.LLMProvider) *Answerer {
+	return &Answerer{
+		searcher:      searcher,
+		llm:           llm,
+		contextTokens: intFromEnv("RAG_CONTEXT_TOKENS", defaultContextTokens),
+		historyTokens: intFromEnv("RAG_HISTORY_TOKENS", defaultHistoryTokens),
+	}
+}
+
+// Model returns the model answers are generated with
+func (a *Answerer) Model() string {
+	return a.llm.Model()
+}
+
+// Answer is a question with its sources retrieved, ready to be generated
+type Answer struct {
+	Sources  []Source
+	messages []external.ChatMessage
+	llm      external.LLMProvider
+}
+
+// Prepare retrieves the sources of a question and builds its prompt. Sources
+// past the token budget are left out, best first.
+func (a *Answerer) Prepare(ctx context.Context, tenantID uuid.UUID, q Question) (*Answer, error) {
+	query := q.Query
+	if query == "" {
+		query = q.Text
+	}
+	results, _, err := a.searcher.Search(ctx, tenantID, query, q.Filter, q.TopK, q.Mode, q.Weights)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make([]Source, 0, len(results))
+	budget := a.contextTokens
+	for _, result := range results {
+		tokens := chunking.CountTokens(result.Chunk.Content)
+		if tokens > budget && len(sources) > 0 {
+			break
+		}
+		budget -= tokens
+		sources = append(sources, Source{Number: len(sources) + 1, HybridResult: result})
+	}
+
+	messages := make([]external.ChatMessage, 0, len(q.History)+2)
+	messages = append(messages, external.ChatMessage{Role: external.RoleSystem, Content: systemPrompt})
+	for _, message := range a.TrimHistory(q.History) {
+		// Citations of earlier answers number other sources
+		if message.Role == external.RoleAssistant {
+			message.Content = citationPattern.ReplaceAllString(message.Content, "")
+		}
+		messages = append(messages, message)
+	}
+	messages = append(messages, external.ChatMessage{Role: external.RoleUser, Content: buildPrompt(q.Text, sources)})
+
+	return &Answer{
+		Sources:  sources,
+		messages: messages,
+		llm:      a.llm,
+	}, nil
+}
+
+// Stream generates the answer, passing each token to onToken, and returns
+// the whole text
+func (a *Answer) Stream(ctx context.Context, onToken func(string) error) (string, error) {
+	if len(a.Sources) == 0 {
+		return NoSourcesAnswer, onToken(NoSourcesAnswer)
+	}
+
+	var text strings.Builder
+	err := a.llm.Stream(ctx, a.messages, func(token string) error {
+		text.WriteString(token)
+		return onToken(token)
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to generate answer: %w", err)
+	}
+	return text.String(), nil
+}
+
+// Cited returns the sources text refers to, in order of first citation.
+// Numbers that match no source are ignored.
+func (a *Answer) Cited(text string) []Source {
+	cited := []Source{}
+	seen := make(map[int]bool)
+	for _, match := range citationPattern.FindAllStringSubmatch(text, -1) {
+		for _, part := range strings.Split(match[1], ",") {
+			n, err := strconv.Atoi(strings.TrimSpace(part))
+			if err != nil || n < 1 || n > len(a.Sources) || seen[n] {
+				continue
+			}
+			seen[n] = true
+			cited = append(cited, a.Sources[n-1])
+		}
+	}
+	return cited
+}
+
+func buildPrompt(question string, sources []Source) string {
+	var b strings.Builder
+	b.WriteString("Sources:\n")
+	for _, source := range sources {
+		chunk := source.Chunk
+		fmt.Fprintf(&b, "\n[%d] %s", source.Number, chunk.DocumentTitle)
+		if chunk.Heading != "" {
+			b.WriteString(" > " + chunk.Heading)
+		}
+		b.WriteString("\n" + strings.TrimSpace(chunk.Content) + "\n")
+	}
+	b.WriteString("\nQuestion: " + question)
+	return b.String()
+}
+
+func intFromEnv(name string, fallback int) int {
+	v := os.Getenv(name)
+	if v == "" {
+		return fallback
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n <= 0 {
+		slog.Warn("ignoring invalid "+name, "value", v)
+		return fallback
+	}
+	return n
+}
@@ -0,0 +1,78 @@
+package rag
+
+import (
+	"ai-matching/src/domain/interface/external"
+	"ai-matching/src/infrastructure/chunking"
+	"context"
+	"fmt"
+	"strings"
+)
+
+// defaultHistoryTokens bounds the earlier turns sent along with a question
+const defaultHistoryTokens = 1500
+
+// maxQueryRunes caps a rewritten query at the length a question may have
+const maxQueryRunes = 2000
+
+// The conversation goes in the system message and the question alone in the
+// user message, so a model that ignores the instructions still returns
+// something searchable.
+const rewritePrompt = `Rewrite the user's latest question as a standalone search query, using the conversation below to resolve references such as "it" or "that drug".
+Reply with the query only, in the language of the question. If the question already stands on its own, repeat it unchanged.
+
+Conversation:
+%s`
+
+// TrimHistory keeps the latest turns of history that fit the token budget.
+// Older turns are dropped whole, and the kept part starts with a question.
+func (a *Answerer) TrimHistory(history []external.ChatMessage) []external.ChatMessage {
+	budget := a.historyTokens
+	start := len(history)
+	for start > 0 {
+		tokens := chunking.CountTokens(history[start-1].Content)
+		if tokens > budget {
+			break
+		}
+		budget -= tokens
+		start--
+	}
+	for start < len(history) && history[start].Role != external.RoleUser {
+		start++
+	}
+	return history[start:]
+}
+
+// Rewrite turns a follow-up question into a query that can be searched for
+// without the conversation. Without history the question is returned as is.
+func (a *Answerer) Rewrite(ctx context.Context, history []external.ChatMessage, question string) (string, error) {
+	history = a.TrimHistory(history)
+	if len(history) == 0 {
+		return question, nil
+	}
+
+	var transcript strings.Builder
+	for _, message := range history {
+		fmt.Fprintf(&transcript, "%s: %s\n", message.Role, strings.TrimSpace(message.Content))
+	}
+
+	var query strings.Builder
+	err := a.llm.Stream(ctx, []external.ChatMessage{
+		{Role: external.RoleSystem, Content: fmt.Sprintf(rewritePrompt, transcript.String())},
+		{Role: external.RoleUser, Content: question},
+	}, func(token string) error {
+		query.WriteString(token)
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to rewrite question: %w", err)
+	}
+
+	rewritten := strings.TrimSpace(query.String())
+	if rewritten == "" {
+		return question, nil
+	}
+	if runes := []rune(rewritten); len(runes) > maxQueryRunes {
+		rewritten = string(runes[:maxQueryRunes])
+	}
+	return rewritten, nil
+}
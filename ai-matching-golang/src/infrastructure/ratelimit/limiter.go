@@ -0,0 +1,90 @@
+package ratelimit
+
+import (
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// Rule allows Limit requests per Window for each subject (an IP address or an
+// email). Name namespaces the counters so rules do not share buckets.
+type Rule struct {
+	Name   string
+	Limit  int64
+	Window time.Duration
+}
+
+var (
+	LoginByIP             = Rule{Name: "login:ip", Limit: 30, Window: time.Minute}
+	LoginByEmail          = Rule{Name: "login:email", Limit: 10, Window: 15 * time.Minute}
+	RegisterByIP          = Rule{Name: "register:ip", Limit: 10, Window: time.Hour}
+	RegisterByEmail       = Rule{Name: "register:email", Limit: 3, Window: time.Hour}
+	ForgotPasswordByIP    = Rule{Name: "forgot-password:ip", Limit: 10, Window: time.Hour}
+	ForgotPasswordByEmail = Rule{Name: "forgot-password:email", Limit: 3, Window: time.Hour}
+	ResetPasswordByIP     = Rule{Name: "reset-password:ip", Limit: 20, Window: time.Hour}
+	ResetPasswordByEmail  = Rule{Name: "reset-password:email", Limit: 5, Window: time.Hour}
+	ConfirmByIP           = Rule{Name: "confirm:ip", Limit: 20, Window: time.Hour}
+	ConfirmByEmail        = Rule{Name: "confirm:email", Limit: 5, Window: time.Hour}
+	SubdomainCheckByIP    = Rule{Name: "subdomain-check:ip", Limit: 60, Window: time.Minute}
+)
+
+// LimitExceededError is returned when a rule or a login lockout rejects a
+// request. RetryAfter tells the client when it may try again.
+type LimitExceededError struct {
+	Reason     string
+	RetryAfter time.Duration
+}
+
+func (e *LimitExceededError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", e.Reason, e.RetryAfter)
+}
+
+// RetryAfterSeconds rounds up so clients never retry too early.
+func (e *LimitExceededError) RetryAfterSeconds() int {
+	return int(math.Ceil(e.RetryAfter.Seconds()))
+}
+
+type Limiter struct {
+	repo repository.RateLimitRepository
+	now  func() time.Time
+}
+
+func NewLimiter(repo repository.RateLimitRepository) *Limiter {
+	return &Limiter{
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+// Allow counts a request from subject against rule and returns a
+// *LimitExceededError once the limit for the current window is exceeded.
+func (l *Limiter) Allow(ctx context.Context, rule Rule, subject string) error {
+	count, resetAt, err := l.repo.Increment(ctx, Key(rule.Name, subject), rule.Window)
+	if err != nil {
+		return fmt.Errorf("rate limit check failed: %w", err)
+	}
+
+	if count > rule.Limit {
+		return &LimitExceededError{
+			Reason:     "too many requests",
+			RetryAfter: retryAfter(resetAt, l.now()),
+		}
+	}
+	return nil
+}
+
+// Key builds the storage key for a rule and subject. Subjects are normalized so
+// that "User@Example.com" and "user@example.com " share a bucket.
+func Key(name, subject string) string {
+	return name + ":" + strings.ToLower(strings.TrimSpace(subject))
+}
+
+func retryAfter(resetAt, now time.Time) time.Duration {
+	if d := resetAt.Sub(now); d > time.Second {
+		return d
+	}
+	return time.Second
+}
@@ -0,0 +1,99 @@
+package ratelimit
+
+import (
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	failureKey = "login:failures"
+	lockoutKey = "login:lockout"
+)
+
+// LoginLockout locks an account out after repeated failed logins. Once
+// Threshold failures have accumulated within FailureWindow, every further
+// failure locks the account for BaseLockout doubled per extra failure, capped
+// at MaxLockout. A successful login clears the failures.
+type LoginLockout struct {
+	repo          repository.RateLimitRepository
+	Threshold     int64
+	FailureWindow time.Duration
+	BaseLockout   time.Duration
+	MaxLockout    time.Duration
+	now           func() time.Time
+}
+
+func NewLoginLockout(repo repository.RateLimitRepository) *LoginLockout {
+	return &LoginLockout{
+		repo:          repo,
+		Threshold:     5,
+		FailureWindow: 24 * time.Hour,
+		BaseLockout:   time.Minute,
+		MaxLockout:    time.Hour,
+		now:           time.Now,
+	}
+}
+
+// Check returns a *LimitExceededError while the account is locked.
+func (l *LoginLockout) Check(ctx context.Context, email string) error {
+	count, lockedUntil, err := l.repo.Get(ctx, Key(lockoutKey, email))
+	if err != nil {
+		return fmt.Errorf("lockout check failed: %w", err)
+	}
+
+	if count > 0 {
+		return &LimitExceededError{
+			Reason:     "account temporarily locked after repeated failed logins",
+			RetryAfter: retryAfter(lockedUntil, l.now()),
+		}
+	}
+	return nil
+}
+
+// RecordFailure registers a failed login and returns a *LimitExceededError
+// when it triggers a lockout.
+func (l *LoginLockout) RecordFailure(ctx context.Context, email string) error {
+	failures, _, err := l.repo.Increment(ctx, Key(failureKey, email), l.FailureWindow)
+	if err != nil {
+		return fmt.Errorf("failed to record login failure: %w", err)
+	}
+
+	if failures < l.Threshold {
+		return nil
+	}
+
+	// Start a fresh lock window so the stored expiry matches duration even if a
+	// concurrent attempt slipped past Check while an earlier lock was active.
+	duration := l.lockoutDuration(failures)
+	if err := l.repo.Reset(ctx, Key(lockoutKey, email)); err != nil {
+		return fmt.Errorf("failed to lock account: %w", err)
+	}
+	if _, _, err := l.repo.Increment(ctx, Key(lockoutKey, email), duration); err != nil {
+		return fmt.Errorf("failed to lock account: %w", err)
+	}
+
+	return &LimitExceededError{
+		Reason:     "account temporarily locked after repeated failed logins",
+		RetryAfter: duration,
+	}
+}
+
+func (l *LoginLockout) RecordSuccess(ctx context.Context, email string) error {
+	if err := l.repo.Reset(ctx, Key(failureKey, email)); err != nil {
+		return err
+	}
+	return l.repo.Reset(ctx, Key(lockoutKey, email))
+}
+
+func (l *LoginLockout) lockoutDuration(failures int64) time.Duration {
+	duration := l.BaseLockout
+	for i := l.Threshold; i < failures; i++ {
+		duration *= 2
+		if duration >= l.MaxLockout {
+			return l.MaxLockout
+		}
+	}
+	return duration
+}
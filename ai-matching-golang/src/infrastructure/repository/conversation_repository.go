@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type conversationRepository struct {
+	queries db.Querier
+}
+
+func NewConversationRepository(queries db.Querier) repository.ConversationRepository {
+	return &conversationRepository{
+		queries: queries,
+	}
+}
+
+func (r *conversationRepository) CreateConversation(ctx context.Context, params db.CreateConversationParams) (db.Conversation, error) {
+	return r.queries.CreateConversation(ctx, params)
+}
+
+func (r *conversationRepository) GetConversation(ctx context.Context, tenantID, userID, id uuid.UUID) (db.Conversation, error) {
+	return r.queries.GetConversation(ctx, db.GetConversationParams{
+		ID:       id,
+		TenantID: tenantID,
+		UserID:   userID,
+	})
+}
+
+func (r *conversationRepository) ListConversations(ctx context.Context, tenantID, userID uuid.UUID, limit, offset int32) ([]db.Conversation, error) {
+	return r.queries.ListConversations(ctx, db.ListConversationsParams{
+		TenantID:  tenantID,
+		UserID:    userID,
+		RowLimit:  limit,
+		RowOffset: offset,
+	})
+}
+
+func (r *conversationRepository) CountConversations(ctx context.Context, tenantID, userID uuid.UUID) (int64, error) {
+	return r.queries.CountConversations(ctx, db.CountConversationsParams{
+		TenantID: tenantID,
+		UserID:   userID,
+	})
+}
+
+func (r *conversationRepository) TouchConversation(ctx context.Context, id uuid.UUID, title string) error {
+	return r.queries.TouchConversation(ctx, db.TouchConversationParams{
+		ID:    id,
+		Title: title,
+	})
+}
+
+func (r *conversationRepository) DeleteConversation(ctx context.Context, tenantID, userID, id uuid.UUID) (bool, error) {
+	rows, err := r.queries.DeleteConversation(ctx, db.DeleteConversationParams{
+		ID:       id,
+		TenantID: tenantID,
+		UserID:   userID,
+	})
+	return rows > 0, err
+}
+
+// Message methods
+
+func (r *conversationRepository) CreateMessage(ctx context.Context, params db.CreateMessageParams) (db.Message, error) {
+	return r.queries.CreateMessage(ctx, params)
+}
+
+func (r *conversationRepository) ListMessages(ctx context.Context, conversationID uuid.UUID) ([]db.Message, error) {
+	return r.queries.ListMessages(ctx, conversationID)
+}
+
+func (r *conversationRepository) ListRecentMessages(ctx context.Context, conversationID uuid.UUID, limit int32) ([]db.Message, error) {
+	return r.queries.ListRecentMessages(ctx, db.ListRecentMessagesParams{
+		ConversationID: conversationID,
+		RowLimit:       limit,
+	})
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// HNSW candidate list bounds; pgvector rejects ef_search above 1000
+const (
+	minHNSWEfSearch = 40
+	maxHNSWEfSearch = 1000
+)
+
+type documentChunkRepository struct {
+	queries db.Querier
+	tx      *Transactor
+}
+
+func NewDocumentChunkRepository(queries db.Querier, tx *Transactor) repository.DocumentChunkRepository {
+	return &documentChunkRepository{
+		queries: queries,
+		tx:      tx,
+	}
+}
+
+func (r *documentChunkRepository) GetVersionForIndexing(ctx context.Context, versionID uuid.UUID) (db.GetDocumentVersionForIndexingRow, error) {
+	return r.queries.GetDocumentVersionForIndexing(ctx, versionID)
+}
+
+func (r *documentChunkRepository) StartIndexing(ctx context.Context, versionID uuid.UUID) (bool, error) {
+	rows, err := r.queries.StartDocumentVersionIndexing(ctx, versionID)
+	return rows > 0, err
+}
+
+func (r *documentChunkRepository) CompleteIndexing(ctx context.Context, versionID uuid.UUID, chunkCount int32, embeddingModel string) error {
+	return r.queries.CompleteDocumentVersionIndexing(ctx, db.CompleteDocumentVersionIndexingParams{
+		ChunkCount:     chunkCount,
+		EmbeddingModel: sql.NullString{String: embeddingModel, Valid: embeddingModel != ""},
+		ID:             versionID,
+	})
+}
+
+func (r *documentChunkRepository) SetIndexStatus(ctx context.Context, versionID uuid.UUID, status, message string) error {
+	return r.queries.SetDocumentVersionIndexStatus(ctx, db.SetDocumentVersionIndexStatusParams{
+		IndexStatus: status,
+		IndexError:  sql.NullString{String: message, Valid: message != ""},
+		ID:          versionID,
+	})
+}
+
+func (r *documentChunkRepository) CreateChunk(ctx context.Context, params db.CreateDocumentChunkParams) error {
+	return r.queries.CreateDocumentChunk(ctx, params)
+}
+
+func (r *documentChunkRepository) DeleteChunksFrom(ctx context.Context, documentID uuid.UUID, version, firstIndex int32) error {
+	return r.queries.DeleteDocumentChunksFrom(ctx, db.DeleteDocumentChunksFromParams{
+		DocumentID: documentID,
+		Version:    version,
+		FirstIndex: firstIndex,
+	})
+}
+
+func (r *documentChunkRepository) DeleteSupersededChunks(ctx context.Context, documentID uuid.UUID, version int32) (int64, error) {
+	return r.queries.DeleteSupersededDocumentChunks(ctx, db.DeleteSupersededDocumentChunksParams{
+		DocumentID: documentID,
+		Version:    version,
+	})
+}
+
+func (r *documentChunkRepository) ListChunks(ctx context.Context, tenantID, documentID uuid.UUID, version int32) ([]db.ListDocumentChunksRow, error) {
+	return r.queries.ListDocumentChunks(ctx, db.ListDocumentChunksParams{
+		DocumentID: documentID,
+		Version:    version,
+		TenantID:   tenantID,
+	})
+}
+
+func (r *documentChunkRepository) VectorExtensionInstalled(ctx context.Context) (bool, error) {
+	return r.queries.GetVectorExtensionInstalled(ctx)
+}
+
+// SearchByVector uses the query matching the HNSW index for the embedding
+// size when there is one. The index scan still gives up after
+// hnsw.max_scan_tuples (or after a single pass before pgvector 0.8), so a
+// tenant holding a small share of the chunks can come back short; the exact
+// search then answers, which is cheap for such a tenant.
+func (r *documentChunkRepository) SearchByVector(ctx context.Context, params db.SearchDocumentChunksByVectorParams) ([]db.SearchDocumentChunksByVectorRow, error) {
+	params.Metadata, params.DocumentIds = searchFilter(params.Metadata, params.DocumentIds)
+
+	size := len(params.QueryEmbedding)
+	if size != 256 && size != 1536 {
+		return r.queries.SearchDocumentChunksByVector(ctx, params)
+	}
+
+	var rows []db.SearchDocumentChunksByVectorRow
+	err := r.tx.InTx(ctx, func(q db.Querier) error {
+		efSearch := min(max(int(params.RowLimit), minHNSWEfSearch), maxHNSWEfSearch)
+		if err := q.SetHNSWSearchScope(ctx, strconv.Itoa(efSearch)); err != nil {
+			return err
+		}
+
+		if size == 256 {
+			indexed, err := q.SearchDocumentChunksByVector256(ctx, db.SearchDocumentChunksByVector256Params(params))
+			if err != nil {
+				return err
+			}
+			for _, row := range indexed {
+				rows = append(rows, db.SearchDocumentChunksByVectorRow(row))
+			}
+			return nil
+		}
+
+		indexed, err := q.SearchDocumentChunksByVector1536(ctx, db.SearchDocumentChunksByVector1536Params(params))
+		if err != nil {
+			return err
+		}
+		for _, row := range indexed {
+			rows = append(rows, db.SearchDocumentChunksByVectorRow(row))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) < int(params.RowLimit) {
+		return r.queries.SearchDocumentChunksByVector(ctx, params)
+	}
+	return rows, nil
+}
+
+func (r *documentChunkRepository) ListEmbeddings(ctx context.Context, params db.ListDocumentChunkEmbeddingsParams) ([]db.ListDocumentChunkEmbeddingsRow, error) {
+	params.Metadata, params.DocumentIds = searchFilter(params.Metadata, params.DocumentIds)
+	return r.queries.ListDocumentChunkEmbeddings(ctx, params)
+}
+
+func (r *documentChunkRepository) SearchByKeyword(ctx context.Context, params db.SearchDocumentChunksByKeywordParams) ([]db.SearchDocumentChunksByKeywordRow, error) {
+	params.Metadata, params.DocumentIds = searchFilter(params.Metadata, params.DocumentIds)
+	return r.queries.SearchDocumentChunksByKeyword(ctx, params)
+}
+
+func (r *documentChunkRepository) GetChunksByIDs(ctx context.Context, tenantID uuid.UUID, ids []uuid.UUID) ([]db.GetDocumentChunksByIDsRow, error) {
+	return r.queries.GetDocumentChunksByIDs(ctx, db.GetDocumentChunksByIDsParams{
+		Ids:      ids,
+		TenantID: tenantID,
+	})
+}
+
+// searchFilter replaces missing filters with ones that match everything; a
+// NULL metadata or document list would match nothing.
+func searchFilter(metadata json.RawMessage, documentIDs []uuid.UUID) (json.RawMessage, []uuid.UUID) {
+	if len(metadata) == 0 {
+		metadata = json.RawMessage("{}")
+	}
+	if documentIDs == nil {
+		documentIDs = []uuid.UUID{}
+	}
+	return metadata, documentIDs
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type documentRepository struct {
+	queries db.Querier
+}
+
+func NewDocumentRepository(queries db.Querier) repository.DocumentRepository {
+	return &documentRepository{
+		queries: queries,
+	}
+}
+
+func (r *documentRepository) CreateDocument(ctx context.Context, params db.CreateDocumentParams) (db.Document, error) {
+	return r.queries.CreateDocument(ctx, params)
+}
+
+func (r *documentRepository) GetDocument(ctx context.Context, tenantID, id uuid.UUID) (db.Document, error) {
+	return r.queries.GetDocument(ctx, db.GetDocumentParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+}
+
+func (r *documentRepository) ListDocuments(ctx context.Context, tenantID uuid.UUID, limit, offset int32) ([]db.Document, error) {
+	return r.queries.ListDocuments(ctx, db.ListDocumentsParams{
+		TenantID:  tenantID,
+		RowLimit:  limit,
+		RowOffset: offset,
+	})
+}
+
+func (r *documentRepository) CountDocuments(ctx context.Context, tenantID uuid.UUID) (int64, error) {
+	return r.queries.CountDocuments(ctx, tenantID)
+}
+
+func (r *documentRepository) UpdateDocument(ctx context.Context, params db.UpdateDocumentParams) (db.Document, error) {
+	return r.queries.UpdateDocument(ctx, params)
+}
+
+func (r *documentRepository) SoftDeleteDocument(ctx context.Context, tenantID, id uuid.UUID) (bool, error) {
+	rows, err := r.queries.SoftDeleteDocument(ctx, db.SoftDeleteDocumentParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+	return rows > 0, err
+}
+
+// Version methods
+
+func (r *documentRepository) CreateDocumentVersion(ctx context.Context, params db.CreateDocumentVersionParams) (db.DocumentVersion, error) {
+	return r.queries.CreateDocumentVersion(ctx, params)
+}
+
+func (r *documentRepository) GetDocumentVersion(ctx context.Context, tenantID, documentID uuid.UUID, version int32) (db.DocumentVersion, error) {
+	return r.queries.GetDocumentVersion(ctx, db.GetDocumentVersionParams{
+		DocumentID: documentID,
+		Version:    version,
+		TenantID:   tenantID,
+	})
+}
+
+func (r *documentRepository) ListDocumentVersions(ctx context.Context, tenantID, documentID uuid.UUID) ([]db.ListDocumentVersionsRow, error) {
+	return r.queries.ListDocumentVersions(ctx, db.ListDocumentVersionsParams{
+		DocumentID: documentID,
+		TenantID:   tenantID,
+	})
+}
+
+func (r *documentRepository) ListPurgeableDocuments(ctx context.Context, deletedBefore time.Time, limit int32) ([]uuid.UUID, error) {
+	return r.queries.ListPurgeableDocuments(ctx, db.ListPurgeableDocumentsParams{
+		DeletedBefore: deletedBefore,
+		RowLimit:      limit,
+	})
+}
+
+func (r *documentRepository) ListVersionStorageKeys(ctx context.Context, documentID uuid.UUID) ([]string, error) {
+	return r.queries.ListDocumentVersionStorageKeys(ctx, documentID)
+}
+
+func (r *documentRepository) PurgeDocument(ctx context.Context, id uuid.UUID) (bool, error) {
+	rows, err := r.queries.PurgeDocument(ctx, id)
+	return rows > 0, err
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"ai-matching/src/domain/interface/repository"
+	"errors"
+
+	"github.com/lib/pq"
+)
+
+// translateError maps driver errors that callers need to branch on to the
+// domain errors declared next to the repository interfaces.
+func translateError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return repository.ErrAlreadyExists
+	}
+	return err
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type featureFlagRepository struct {
+	queries db.Querier
+}
+
+func NewFeatureFlagRepository(queries db.Querier) repository.FeatureFlagRepository {
+	return &featureFlagRepository{
+		queries: queries,
+	}
+}
+
+func (r *featureFlagRepository) ListFeatureFlags(ctx context.Context) ([]db.FeatureFlag, error) {
+	return r.queries.ListFeatureFlags(ctx)
+}
+
+func (r *featureFlagRepository) GetFeatureFlag(ctx context.Context, key string) (db.FeatureFlag, error) {
+	return r.queries.GetFeatureFlag(ctx, key)
+}
+
+func (r *featureFlagRepository) UpsertFeatureFlag(ctx context.Context, params db.UpsertFeatureFlagParams) (db.FeatureFlag, error) {
+	return r.queries.UpsertFeatureFlag(ctx, params)
+}
+
+func (r *featureFlagRepository) DeleteFeatureFlag(ctx context.Context, key string) error {
+	return r.queries.DeleteFeatureFlag(ctx, key)
+}
+
+// Override methods
+
+func (r *featureFlagRepository) ListFeatureFlagOverrides(ctx context.Context) ([]db.FeatureFlagOverride, error) {
+	return r.queries.ListFeatureFlagOverrides(ctx)
+}
+
+func (r *featureFlagRepository) UpsertOrganizationOverride(ctx context.Context, key string, organizationID uuid.UUID, enabled bool) (db.FeatureFlagOverride, error) {
+	return r.queries.UpsertOrganizationFeatureFlagOverride(ctx, db.UpsertOrganizationFeatureFlagOverrideParams{
+		FlagKey:        key,
+		OrganizationID: organizationID,
+		Enabled:        enabled,
+	})
+}
+
+func (r *featureFlagRepository) UpsertTenantOverride(ctx context.Context, key string, tenantID uuid.UUID, enabled bool) (db.FeatureFlagOverride, error) {
+	return r.queries.UpsertTenantFeatureFlagOverride(ctx, db.UpsertTenantFeatureFlagOverrideParams{
+		FlagKey:  key,
+		TenantID: tenantID,
+		Enabled:  enabled,
+	})
+}
+
+func (r *featureFlagRepository) DeleteOrganizationOverride(ctx context.Context, key string, organizationID uuid.UUID) error {
+	return r.queries.DeleteOrganizationFeatureFlagOverride(ctx, db.DeleteOrganizationFeatureFlagOverrideParams{
+		FlagKey:        key,
+		OrganizationID: organizationID,
+	})
+}
+
+func (r *featureFlagRepository) DeleteTenantOverride(ctx context.Context, key string, tenantID uuid.UUID) error {
+	return r.queries.DeleteTenantFeatureFlagOverride(ctx, db.DeleteTenantFeatureFlagOverrideParams{
+		FlagKey:  key,
+		TenantID: tenantID,
+	})
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type jobRepository struct {
+	queries db.Querier
+}
+
+func NewJobRepository(queries db.Querier) repository.JobRepository {
+	return &jobRepository{
+		queries: queries,
+	}
+}
+
+func (r *jobRepository) CreateJob(ctx context.Context, params db.CreateJobParams) (db.Job, error) {
+	return r.queries.CreateJob(ctx, params)
+}
+
+func (r *jobRepository) GetOrganizationJob(ctx context.Context, organizationID, id uuid.UUID) (db.Job, error) {
+	return r.queries.GetOrganizationJob(ctx, db.GetOrganizationJobParams{
+		ID:             id,
+		OrganizationID: organizationID,
+	})
+}
+
+func (r *jobRepository) ListOrganizationJobs(ctx context.Context, params db.ListOrganizationJobsParams) ([]db.Job, error) {
+	return r.queries.ListOrganizationJobs(ctx, params)
+}
+
+func (r *jobRepository) CountOrganizationJobs(ctx context.Context, params db.CountOrganizationJobsParams) (int64, error) {
+	return r.queries.CountOrganizationJobs(ctx, params)
+}
+
+func (r *jobRepository) RequeueJob(ctx context.Context, organizationID, id uuid.UUID) (db.Job, error) {
+	return r.queries.RequeueJob(ctx, db.RequeueJobParams{
+		ID:             id,
+		OrganizationID: organizationID,
+	})
+}
+
+func (r *jobRepository) DeleteSucceededJobs(ctx context.Context, finishedBefore time.Time) (int64, error) {
+	return r.queries.DeleteSucceededJobs(ctx, finishedBefore)
+}
+
+func (r *jobRepository) ClaimJobs(ctx context.Context, workerID string, staleBefore time.Time, limit int32) ([]db.Job, error) {
+	return r.queries.ClaimJobs(ctx, db.ClaimJobsParams{
+		WorkerID:    workerID,
+		StaleBefore: staleBefore,
+		RowLimit:    limit,
+	})
+}
+
+func (r *jobRepository) BuryStaleJobs(ctx context.Context, staleBefore time.Time) (int64, error) {
+	return r.queries.BuryStaleJobs(ctx, staleBefore)
+}
+
+func (r *jobRepository) CompleteJob(ctx context.Context, id uuid.UUID, workerID string) (bool, error) {
+	rows, err := r.queries.CompleteJob(ctx, db.CompleteJobParams{
+		ID:       id,
+		WorkerID: workerID,
+	})
+	return rows > 0, err
+}
+
+func (r *jobRepository) RetryJob(ctx context.Context, id uuid.UUID, workerID string, delay time.Duration, lastError string) (bool, error) {
+	rows, err := r.queries.RetryJob(ctx, db.RetryJobParams{
+		DelaySeconds: delay.Seconds(),
+		LastError:    lastError,
+		ID:           id,
+		WorkerID:     workerID,
+	})
+	return rows > 0, err
+}
+
+func (r *jobRepository) BuryJob(ctx context.Context, id uuid.UUID, workerID string, lastError string) (bool, error) {
+	rows, err := r.queries.BuryJob(ctx, db.BuryJobParams{
+		LastError: lastError,
+		ID:        id,
+		WorkerID:  workerID,
+	})
+	return rows > 0, err
+}
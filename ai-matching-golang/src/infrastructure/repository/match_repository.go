@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type matchRepository struct {
+	queries db.Querier
+}
+
+func NewMatchRepository(queries db.Querier) repository.MatchRepository {
+	return &matchRepository{
+		queries: queries,
+	}
+}
+
+func (r *matchRepository) CreateCandidate(ctx context.Context, params db.CreateMatchCandidateParams) (db.MatchCandidate, error) {
+	return r.queries.CreateMatchCandidate(ctx, params)
+}
+
+func (r *matchRepository) GetCandidate(ctx context.Context, tenantID, id uuid.UUID) (db.MatchCandidate, error) {
+	return r.queries.GetMatchCandidate(ctx, db.GetMatchCandidateParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+}
+
+func (r *matchRepository) ListCandidates(ctx context.Context, tenantID uuid.UUID, limit, offset int32) ([]db.MatchCandidate, error) {
+	return r.queries.ListMatchCandidates(ctx, db.ListMatchCandidatesParams{
+		TenantID:  tenantID,
+		RowLimit:  limit,
+		RowOffset: offset,
+	})
+}
+
+func (r *matchRepository) CountCandidates(ctx context.Context, tenantID uuid.UUID) (int64, error) {
+	return r.queries.CountMatchCandidates(ctx, tenantID)
+}
+
+func (r *matchRepository) UpdateCandidate(ctx context.Context, params db.UpdateMatchCandidateParams) (db.MatchCandidate, error) {
+	return r.queries.UpdateMatchCandidate(ctx, params)
+}
+
+func (r *matchRepository) SetCandidateEmbedding(ctx context.Context, params db.SetMatchCandidateEmbeddingParams) error {
+	return r.queries.SetMatchCandidateEmbedding(ctx, params)
+}
+
+func (r *matchRepository) DeleteCandidate(ctx context.Context, tenantID, id uuid.UUID) (bool, error) {
+	rows, err := r.queries.DeleteMatchCandidate(ctx, db.DeleteMatchCandidateParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+	return rows > 0, err
+}
+
+func (r *matchRepository) ListCandidatesAfter(ctx context.Context, tenantID, afterID uuid.UUID, ids []uuid.UUID, limit int32) ([]db.MatchCandidate, error) {
+	if ids == nil {
+		ids = []uuid.UUID{}
+	}
+	return r.queries.ListMatchCandidatesAfter(ctx, db.ListMatchCandidatesAfterParams{
+		TenantID:     tenantID,
+		AfterID:      afterID,
+		CandidateIds: ids,
+		RowLimit:     limit,
+	})
+}
+
+// Opening methods
+
+func (r *matchRepository) CreateOpening(ctx context.Context, params db.CreateMatchOpeningParams) (db.MatchOpening, error) {
+	return r.queries.CreateMatchOpening(ctx, params)
+}
+
+func (r *matchRepository) GetOpening(ctx context.Context, tenantID, id uuid.UUID) (db.MatchOpening, error) {
+	return r.queries.GetMatchOpening(ctx, db.GetMatchOpeningParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+}
+
+func (r *matchRepository) ListOpenings(ctx context.Context, tenantID uuid.UUID, limit, offset int32) ([]db.MatchOpening, error) {
+	return r.queries.ListMatchOpenings(ctx, db.ListMatchOpeningsParams{
+		TenantID:  tenantID,
+		RowLimit:  limit,
+		RowOffset: offset,
+	})
+}
+
+func (r *matchRepository) CountOpenings(ctx context.Context, tenantID uuid.UUID) (int64, error) {
+	return r.queries.CountMatchOpenings(ctx, tenantID)
+}
+
+func (r *matchRepository) UpdateOpening(ctx context.Context, params db.UpdateMatchOpeningParams) (db.MatchOpening, error) {
+	return r.queries.UpdateMatchOpening(ctx, params)
+}
+
+func (r *matchRepository) SetOpeningEmbedding(ctx context.Context, params db.SetMatchOpeningEmbeddingParams) error {
+	return r.queries.SetMatchOpeningEmbedding(ctx, params)
+}
+
+func (r *matchRepository) DeleteOpening(ctx context.Context, tenantID, id uuid.UUID) (bool, error) {
+	rows, err := r.queries.DeleteMatchOpening(ctx, db.DeleteMatchOpeningParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+	return rows > 0, err
+}
+
+func (r *matchRepository) ListOpenOpeningsAfter(ctx context.Context, tenantID, afterID uuid.UUID, ids []uuid.UUID, limit int32) ([]db.MatchOpening, error) {
+	if ids == nil {
+		ids = []uuid.UUID{}
+	}
+	return r.queries.ListOpenMatchOpeningsAfter(ctx, db.ListOpenMatchOpeningsAfterParams{
+		TenantID:   tenantID,
+		AfterID:    afterID,
+		OpeningIds: ids,
+		RowLimit:   limit,
+	})
+}
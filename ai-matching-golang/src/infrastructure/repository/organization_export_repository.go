@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type organizationExportRepository struct {
+	queries db.Querier
+}
+
+func NewOrganizationExportRepository(queries db.Querier) repository.OrganizationExportRepository {
+	return &organizationExportRepository{
+		queries: queries,
+	}
+}
+
+func (r *organizationExportRepository) CreateOrganizationExport(ctx context.Context, organizationID uuid.UUID, requestedBy uuid.NullUUID) (db.OrganizationExport, error) {
+	return r.queries.CreateOrganizationExport(ctx, db.CreateOrganizationExportParams{
+		OrganizationID: organizationID,
+		RequestedBy:    requestedBy,
+	})
+}
+
+func (r *organizationExportRepository) GetOrganizationExport(ctx context.Context, organizationID, id uuid.UUID) (db.OrganizationExport, error) {
+	return r.queries.GetOrganizationExport(ctx, db.GetOrganizationExportParams{
+		ID:             id,
+		OrganizationID: organizationID,
+	})
+}
+
+func (r *organizationExportRepository) GetOrganizationExportByID(ctx context.Context, id uuid.UUID) (db.OrganizationExport, error) {
+	return r.queries.GetOrganizationExportByID(ctx, id)
+}
+
+func (r *organizationExportRepository) ListOrganizationExports(ctx context.Context, organizationID uuid.UUID, limit int32) ([]db.OrganizationExport, error) {
+	return r.queries.ListOrganizationExports(ctx, db.ListOrganizationExportsParams{
+		OrganizationID: organizationID,
+		RowLimit:       limit,
+	})
+}
+
+func (r *organizationExportRepository) StartOrganizationExport(ctx context.Context, id uuid.UUID) (bool, error) {
+	rows, err := r.queries.StartOrganizationExport(ctx, id)
+	return rows > 0, err
+}
+
+func (r *organizationExportRepository) CompleteOrganizationExport(ctx context.Context, id uuid.UUID, storageKey string, sizeBytes int64, expiresAt time.Time) error {
+	return r.queries.CompleteOrganizationExport(ctx, db.CompleteOrganizationExportParams{
+		StorageKey: sql.NullString{String: storageKey, Valid: true},
+		SizeBytes:  sql.NullInt64{Int64: sizeBytes, Valid: true},
+		ExpiresAt:  sql.NullTime{Time: expiresAt, Valid: true},
+		ID:         id,
+	})
+}
+
+func (r *organizationExportRepository) FailOrganizationExport(ctx context.Context, id uuid.UUID, message string) error {
+	return r.queries.FailOrganizationExport(ctx, db.FailOrganizationExportParams{
+		ErrorMessage: sql.NullString{String: message, Valid: true},
+		ID:           id,
+	})
+}
+
+// Export data methods
+
+func (r *organizationExportRepository) ListExpiredOrganizationExports(ctx context.Context, limit int32) ([]db.OrganizationExport, error) {
+	return r.queries.ListExpiredOrganizationExports(ctx, limit)
+}
+
+func (r *organizationExportRepository) ClearOrganizationExportStorage(ctx context.Context, id uuid.UUID) error {
+	return r.queries.ClearOrganizationExportStorage(ctx, id)
+}
+
+func (r *organizationExportRepository) ListTenants(ctx context.Context, organizationID uuid.UUID) ([]db.Tenant, error) {
+	return r.queries.ListOrganizationExportTenants(ctx, organizationID)
+}
+
+func (r *organizationExportRepository) ListUsers(ctx context.Context, organizationID uuid.UUID) ([]db.User, error) {
+	return r.queries.ListOrganizationExportUsers(ctx, organizationID)
+}
+
+func (r *organizationExportRepository) ListTenantMemberships(ctx context.Context, organizationID uuid.UUID) ([]db.ListOrganizationExportTenantMembershipsRow, error) {
+	return r.queries.ListOrganizationExportTenantMemberships(ctx, organizationID)
+}
+
+func (r *organizationExportRepository) ListAuditEvents(ctx context.Context, organizationID uuid.UUID) ([]db.ListOrganizationAuditEventsRow, error) {
+	return r.queries.ListOrganizationAuditEvents(ctx, organizationID)
+}
@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// errTransferMemberGone rolls back an acceptance when either party has left
+// the organization
+var errTransferMemberGone = errors.New("ownership transfer member not found")
+
+type organizationMemberRepository struct {
+	queries db.Querier
+	tx      *Transactor
+}
+
+func NewOrganizationMemberRepository(queries db.Querier, tx *Transactor) repository.OrganizationMemberRepository {
+	return &organizationMemberRepository{
+		queries: queries,
+		tx:      tx,
+	}
+}
+
+func (r *organizationMemberRepository) GetOrganizationMember(ctx context.Context, organizationID, userID uuid.UUID) (db.OrganizationMember, error) {
+	return r.queries.GetOrganizationMember(ctx, db.GetOrganizationMemberParams{
+		OrganizationID: organizationID,
+		UserID:         userID,
+	})
+}
+
+func (r *organizationMemberRepository) ListOrganizationMembers(ctx context.Context, organizationID uuid.UUID) ([]db.ListOrganizationMembersRow, error) {
+	return r.queries.ListOrganizationMembers(ctx, organizationID)
+}
+
+func (r *organizationMemberRepository) AddOrganizationMember(ctx context.Context, organizationID, userID uuid.UUID, role string) (db.OrganizationMember, error) {
+	member, err := r.queries.AddOrganizationMember(ctx, db.AddOrganizationMemberParams{
+		OrganizationID: organizationID,
+		UserID:         userID,
+		Role:           role,
+	})
+	return member, translateError(err)
+}
+
+// UpdateOrganizationMemberRole locks the owner rows first; the last-owner
+// check in the update alone would let two concurrent demotions both pass
+func (r *organizationMemberRepository) UpdateOrganizationMemberRole(ctx context.Context, organizationID, userID uuid.UUID, role string) (bool, error) {
+	var updated bool
+	err := r.tx.InTx(ctx, func(q db.Querier) error {
+		if err := q.LockOrganizationOwners(ctx, organizationID); err != nil {
+			return err
+		}
+
+		rows, err := q.UpdateOrganizationMemberRole(ctx, db.UpdateOrganizationMemberRoleParams{
+			Role:           role,
+			OrganizationID: organizationID,
+			UserID:         userID,
+		})
+		updated = rows > 0
+		return err
+	})
+	return updated, err
+}
+
+// DeleteOrganizationMember also cancels pending ownership transfers from or
+// to the member, so a removed recipient cannot accept one later. Like a role
+// change it locks the owner rows before the last-owner check.
+func (r *organizationMemberRepository) DeleteOrganizationMember(ctx context.Context, organizationID, userID uuid.UUID) (bool, error) {
+	var deleted bool
+	err := r.tx.InTx(ctx, func(q db.Querier) error {
+		if err := q.LockOrganizationOwners(ctx, organizationID); err != nil {
+			return err
+		}
+
+		rows, err := q.DeleteOrganizationMember(ctx, db.DeleteOrganizationMemberParams{
+			OrganizationID: organizationID,
+			UserID:         userID,
+		})
+		if err != nil || rows == 0 {
+			return err
+		}
+		deleted = true
+
+		_, err = q.CancelMemberOwnershipTransfers(ctx, db.CancelMemberOwnershipTransfersParams{
+			OrganizationID: organizationID,
+			UserID:         userID,
+		})
+		return err
+	})
+	return deleted, err
+}
+
+// Ownership transfer methods
+
+func (r *organizationMemberRepository) GetOwnershipTransfer(ctx context.Context, organizationID, id uuid.UUID) (db.OrganizationOwnershipTransfer, error) {
+	return r.queries.GetOwnershipTransfer(ctx, db.GetOwnershipTransferParams{
+		ID:             id,
+		OrganizationID: organizationID,
+	})
+}
+
+func (r *organizationMemberRepository) GetPendingOwnershipTransfer(ctx context.Context, organizationID uuid.UUID) (db.OrganizationOwnershipTransfer, error) {
+	return r.queries.GetPendingOwnershipTransfer(ctx, organizationID)
+}
+
+// CreateOwnershipTransfer replaces any pending transfer of the organization
+func (r *organizationMemberRepository) CreateOwnershipTransfer(ctx context.Context, organizationID, fromUserID, toUserID uuid.UUID, expiresAt time.Time) (db.OrganizationOwnershipTransfer, error) {
+	var transfer db.OrganizationOwnershipTransfer
+	err := r.tx.InTx(ctx, func(q db.Querier) error {
+		if _, err := q.CancelPendingOwnershipTransfers(ctx, organizationID); err != nil {
+			return err
+		}
+
+		var err error
+		transfer, err = q.CreateOwnershipTransfer(ctx, db.CreateOwnershipTransferParams{
+			OrganizationID: organizationID,
+			FromUserID:     fromUserID,
+			ToUserID:       toUserID,
+			ExpiresAt:      expiresAt,
+		})
+		return err
+	})
+	return transfer, translateError(err)
+}
+
+func (r *organizationMemberRepository) CancelOwnershipTransfer(ctx context.Context, organizationID, id uuid.UUID) (bool, error) {
+	rows, err := r.queries.CancelOwnershipTransfer(ctx, db.CancelOwnershipTransferParams{
+		ID:             id,
+		OrganizationID: organizationID,
+	})
+	return rows > 0, err
+}
+
+// AcceptOwnershipTransfer marks the transfer accepted and swaps the roles of
+// both members. Nothing changes unless both are still members.
+func (r *organizationMemberRepository) AcceptOwnershipTransfer(ctx context.Context, organizationID, id uuid.UUID) (bool, error) {
+	err := r.tx.InTx(ctx, func(q db.Querier) error {
+		transfer, err := q.AcceptOwnershipTransfer(ctx, db.AcceptOwnershipTransferParams{
+			ID:             id,
+			OrganizationID: organizationID,
+		})
+		if err != nil {
+			return err
+		}
+
+		rows, err := q.SwapOwnerRoles(ctx, db.SwapOwnerRolesParams{
+			OrganizationID: organizationID,
+			FromUserID:     transfer.FromUserID,
+			ToUserID:       transfer.ToUserID,
+		})
+		if err != nil {
+			return err
+		}
+		if rows != 2 {
+			return errTransferMemberGone
+		}
+		return nil
+	})
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, errTransferMemberGone) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (r *organizationMemberRepository) ExpireOwnershipTransfers(ctx context.Context) (int64, error) {
+	return r.queries.ExpireOwnershipTransfers(ctx)
+}
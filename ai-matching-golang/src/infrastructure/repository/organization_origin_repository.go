@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type organizationOriginRepository struct {
+	queries db.Querier
+}
+
+func NewOrganizationOriginRepository(queries db.Querier) repository.OrganizationOriginRepository {
+	return &organizationOriginRepository{
+		queries: queries,
+	}
+}
+
+func (r *organizationOriginRepository) GetOrganizationOrigin(ctx context.Context, organizationID, id uuid.UUID) (db.OrganizationOrigin, error) {
+	return r.queries.GetOrganizationOrigin(ctx, db.GetOrganizationOriginParams{
+		ID:             id,
+		OrganizationID: organizationID,
+	})
+}
+
+func (r *organizationOriginRepository) ListOrganizationOrigins(ctx context.Context, organizationID uuid.UUID) ([]db.OrganizationOrigin, error) {
+	return r.queries.ListOrganizationOrigins(ctx, organizationID)
+}
+
+func (r *organizationOriginRepository) CreateOrganizationOrigin(ctx context.Context, organizationID uuid.UUID, origin string) (db.OrganizationOrigin, error) {
+	row, err := r.queries.CreateOrganizationOrigin(ctx, db.CreateOrganizationOriginParams{
+		OrganizationID: organizationID,
+		Origin:         origin,
+	})
+	return row, translateError(err)
+}
+
+func (r *organizationOriginRepository) DeleteOrganizationOrigin(ctx context.Context, organizationID, id uuid.UUID) error {
+	return r.queries.DeleteOrganizationOrigin(ctx, db.DeleteOrganizationOriginParams{
+		ID:             id,
+		OrganizationID: organizationID,
+	})
+}
+
+func (r *organizationOriginRepository) IsOriginRegistered(ctx context.Context, origin string) (bool, error) {
+	return r.queries.IsOriginRegistered(ctx, origin)
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"sync"
+	"time"
+)
+
+type rateLimitCounter struct {
+	count     int64
+	expiresAt time.Time
+}
+
+const memorySweepInterval = time.Minute
+
+// memoryRateLimitRepository keeps counters in process memory. It is only
+// suitable for a single replica or local development.
+type memoryRateLimitRepository struct {
+	mu        sync.Mutex
+	counters  map[string]rateLimitCounter
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewMemoryRateLimitRepository() repository.RateLimitRepository {
+	return &memoryRateLimitRepository{
+		counters: make(map[string]rateLimitCounter),
+		now:      time.Now,
+	}
+}
+
+func (r *memoryRateLimitRepository) Increment(ctx context.Context, key string, window time.Duration) (int64, time.Time, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	if now.Sub(r.lastSweep) > memorySweepInterval {
+		r.sweep(now)
+	}
+
+	counter, ok := r.counters[key]
+	if !ok || !counter.expiresAt.After(now) {
+		counter = rateLimitCounter{expiresAt: now.Add(window)}
+	}
+	counter.count++
+	r.counters[key] = counter
+
+	return counter.count, counter.expiresAt, nil
+}
+
+func (r *memoryRateLimitRepository) Get(ctx context.Context, key string) (int64, time.Time, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	counter, ok := r.counters[key]
+	if !ok || !counter.expiresAt.After(r.now()) {
+		return 0, time.Time{}, nil
+	}
+	return counter.count, counter.expiresAt, nil
+}
+
+func (r *memoryRateLimitRepository) Reset(ctx context.Context, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.counters, key)
+	return nil
+}
+
+func (r *memoryRateLimitRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.sweep(r.now()), nil
+}
+
+func (r *memoryRateLimitRepository) sweep(now time.Time) int64 {
+	var deleted int64
+	for key, counter := range r.counters {
+		if !counter.expiresAt.After(now) {
+			delete(r.counters, key)
+			deleted++
+		}
+	}
+	r.lastSweep = now
+	return deleted
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+type rateLimitRepository struct {
+	queries db.Querier
+}
+
+func NewRateLimitRepository(queries db.Querier) repository.RateLimitRepository {
+	return &rateLimitRepository{
+		queries: queries,
+	}
+}
+
+func (r *rateLimitRepository) Increment(ctx context.Context, key string, window time.Duration) (int64, time.Time, error) {
+	row, err := r.queries.IncrementRateLimitCounter(ctx, db.IncrementRateLimitCounterParams{
+		Key:           key,
+		WindowSeconds: window.Seconds(),
+	})
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return row.Count, row.ExpiresAt, nil
+}
+
+func (r *rateLimitRepository) Get(ctx context.Context, key string) (int64, time.Time, error) {
+	row, err := r.queries.GetRateLimitCounter(ctx, key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, time.Time{}, nil
+		}
+		return 0, time.Time{}, err
+	}
+	return row.Count, row.ExpiresAt, nil
+}
+
+func (r *rateLimitRepository) Reset(ctx context.Context, key string) error {
+	return r.queries.DeleteRateLimitCounter(ctx, key)
+}
+
+func (r *rateLimitRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	return r.queries.DeleteExpiredRateLimitCounters(ctx)
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+type settingsRepository struct {
+	queries db.Querier
+	tx      *Transactor
+}
+
+func NewSettingsRepository(queries db.Querier, tx *Transactor) repository.SettingsRepository {
+	return &settingsRepository{
+		queries: queries,
+		tx:      tx,
+	}
+}
+
+// Organization settings
+
+func (r *settingsRepository) ListOrganizationSettings(ctx context.Context, organizationID uuid.UUID) ([]db.OrganizationSetting, error) {
+	return r.queries.ListOrganizationSettings(ctx, organizationID)
+}
+
+func (r *settingsRepository) UpdateOrganizationSettings(ctx context.Context, organizationID uuid.UUID, values map[string]json.RawMessage, updatedBy uuid.NullUUID) error {
+	return r.tx.InTx(ctx, func(q db.Querier) error {
+		for _, key := range slices.Sorted(maps.Keys(values)) {
+			if values[key] == nil {
+				if err := q.DeleteOrganizationSetting(ctx, db.DeleteOrganizationSettingParams{
+					OrganizationID: organizationID,
+					Key:            key,
+				}); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if _, err := q.UpsertOrganizationSetting(ctx, db.UpsertOrganizationSettingParams{
+				OrganizationID: organizationID,
+				Key:            key,
+				Value:          values[key],
+				UpdatedBy:      updatedBy,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Tenant settings
+
+func (r *settingsRepository) ListTenantSettings(ctx context.Context, tenantID uuid.UUID) ([]db.TenantSetting, error) {
+	return r.queries.ListTenantSettings(ctx, tenantID)
+}
+
+func (r *settingsRepository) UpdateTenantSettings(ctx context.Context, tenantID uuid.UUID, values map[string]json.RawMessage, updatedBy uuid.NullUUID) error {
+	return r.tx.InTx(ctx, func(q db.Querier) error {
+		for _, key := range slices.Sorted(maps.Keys(values)) {
+			if values[key] == nil {
+				if err := q.DeleteTenantSetting(ctx, db.DeleteTenantSettingParams{
+					TenantID: tenantID,
+					Key:      key,
+				}); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if _, err := q.UpsertTenantSetting(ctx, db.UpsertTenantSettingParams{
+				TenantID:  tenantID,
+				Key:       key,
+				Value:     values[key],
+				UpdatedBy: updatedBy,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type subscriptionRepository struct {
+	queries db.Querier
+}
+
+func NewSubscriptionRepository(queries db.Querier) repository.SubscriptionRepository {
+	return &subscriptionRepository{
+		queries: queries,
+	}
+}
+
+func (r *subscriptionRepository) ListPlans(ctx context.Context) ([]db.Plan, error) {
+	return r.queries.ListPlans(ctx)
+}
+
+func (r *subscriptionRepository) GetPlan(ctx context.Context, code string) (db.Plan, error) {
+	return r.queries.GetPlan(ctx, code)
+}
+
+// Subscription methods
+
+func (r *subscriptionRepository) GetOrganizationSubscription(ctx context.Context, organizationID uuid.UUID) (db.OrganizationSubscription, error) {
+	return r.queries.GetOrganizationSubscription(ctx, organizationID)
+}
+
+func (r *subscriptionRepository) UpsertOrganizationSubscription(ctx context.Context, organizationID uuid.UUID, planCode, status string) (db.OrganizationSubscription, error) {
+	return r.queries.UpsertOrganizationSubscription(ctx, db.UpsertOrganizationSubscriptionParams{
+		OrganizationID: organizationID,
+		PlanCode:       planCode,
+		Status:         status,
+	})
+}
+
+// Usage methods
+
+func (r *subscriptionRepository) IncrementAPIUsage(ctx context.Context, organizationID uuid.UUID, periodStart time.Time) (int64, error) {
+	return r.queries.IncrementOrganizationApiUsage(ctx, db.IncrementOrganizationApiUsageParams{
+		OrganizationID: organizationID,
+		PeriodStart:    periodStart,
+	})
+}
+
+func (r *subscriptionRepository) GetAPIUsage(ctx context.Context, organizationID uuid.UUID, periodStart time.Time) (int64, error) {
+	return r.queries.GetOrganizationApiUsage(ctx, db.GetOrganizationApiUsageParams{
+		OrganizationID: organizationID,
+		PeriodStart:    periodStart,
+	})
+}
+
+func (r *subscriptionRepository) ListTenantSeatCounts(ctx context.Context, organizationID uuid.UUID) ([]db.ListTenantSeatCountsRow, error) {
+	return r.queries.ListTenantSeatCounts(ctx, organizationID)
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type taskRunRepository struct {
+	queries db.Querier
+}
+
+func NewTaskRunRepository(queries db.Querier) repository.TaskRunRepository {
+	return &taskRunRepository{
+		queries: queries,
+	}
+}
+
+func (r *taskRunRepository) StartScheduledRun(ctx context.Context, taskName string, scheduledFor time.Time, workerID string) (db.TaskRun, bool, error) {
+	run, err := r.queries.StartScheduledTaskRun(ctx, db.StartScheduledTaskRunParams{
+		TaskName:     taskName,
+		ScheduledFor: scheduledFor,
+		WorkerID:     workerID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return db.TaskRun{}, false, nil
+	}
+	return run, err == nil, err
+}
+
+func (r *taskRunRepository) StartManualRun(ctx context.Context, taskName string, triggeredBy uuid.NullUUID, workerID string) (db.TaskRun, error) {
+	return r.queries.StartManualTaskRun(ctx, db.StartManualTaskRunParams{
+		TaskName:    taskName,
+		TriggeredBy: triggeredBy,
+		WorkerID:    workerID,
+	})
+}
+
+func (r *taskRunRepository) FinishRun(ctx context.Context, id uuid.UUID, status, summary, errorMessage string) error {
+	return r.queries.FinishTaskRun(ctx, db.FinishTaskRunParams{
+		Status:       status,
+		Summary:      sql.NullString{String: summary, Valid: summary != ""},
+		ErrorMessage: sql.NullString{String: errorMessage, Valid: errorMessage != ""},
+		ID:           id,
+	})
+}
+
+func (r *taskRunRepository) ListLatestRuns(ctx context.Context) ([]db.TaskRun, error) {
+	return r.queries.ListLatestTaskRuns(ctx)
+}
+
+func (r *taskRunRepository) ListRuns(ctx context.Context, taskName string, limit, offset int32) ([]db.TaskRun, error) {
+	return r.queries.ListTaskRuns(ctx, db.ListTaskRunsParams{
+		TaskName:  taskName,
+		RowOffset: offset,
+		RowLimit:  limit,
+	})
+}
+
+func (r *taskRunRepository) CountRuns(ctx context.Context, taskName string) (int64, error) {
+	return r.queries.CountTaskRuns(ctx, taskName)
+}
+
+func (r *taskRunRepository) DeleteRunsBefore(ctx context.Context, before time.Time) (int64, error) {
+	return r.queries.DeleteTaskRunsBefore(ctx, before)
+}
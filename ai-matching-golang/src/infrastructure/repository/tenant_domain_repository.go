@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type tenantDomainRepository struct {
+	queries db.Querier
+}
+
+func NewTenantDomainRepository(queries db.Querier) repository.TenantDomainRepository {
+	return &tenantDomainRepository{
+		queries: queries,
+	}
+}
+
+func (r *tenantDomainRepository) GetTenantDomain(ctx context.Context, tenantID, id uuid.UUID) (db.TenantDomain, error) {
+	return r.queries.GetTenantDomain(ctx, db.GetTenantDomainParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+}
+
+func (r *tenantDomainRepository) ListTenantDomains(ctx context.Context, tenantID uuid.UUID) ([]db.TenantDomain, error) {
+	return r.queries.ListTenantDomains(ctx, tenantID)
+}
+
+func (r *tenantDomainRepository) CreateTenantDomain(ctx context.Context, params db.CreateTenantDomainParams) (db.TenantDomain, error) {
+	domain, err := r.queries.CreateTenantDomain(ctx, params)
+	return domain, translateError(err)
+}
+
+func (r *tenantDomainRepository) MarkTenantDomainVerified(ctx context.Context, tenantID, id uuid.UUID) (db.TenantDomain, error) {
+	domain, err := r.queries.MarkTenantDomainVerified(ctx, db.MarkTenantDomainVerifiedParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+	return domain, translateError(err)
+}
+
+func (r *tenantDomainRepository) SetPrimaryTenantDomain(ctx context.Context, tenantID, id uuid.UUID) error {
+	return r.queries.SetPrimaryTenantDomain(ctx, db.SetPrimaryTenantDomainParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+}
+
+func (r *tenantDomainRepository) DeleteTenantDomain(ctx context.Context, tenantID, id uuid.UUID) error {
+	return r.queries.DeleteTenantDomain(ctx, db.DeleteTenantDomainParams{
+		ID:       id,
+		TenantID: tenantID,
+	})
+}
+
+func (r *tenantDomainRepository) GetTenantByVerifiedDomain(ctx context.Context, domain string) (db.Tenant, error) {
+	return r.queries.GetTenantByVerifiedDomain(ctx, domain)
+}
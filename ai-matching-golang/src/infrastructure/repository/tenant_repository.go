@@ -4,6 +4,8 @@ import (
 	"ai-matching/db/sqlc"
 	"ai-matching/src/domain/interface/repository"
 	"context"
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -35,11 +37,13 @@ func (r *tenantRepository) ListTenantsByOrganization(ctx context.Context, organi
 }
 
 func (r *tenantRepository) CreateTenant(ctx context.Context, params db.CreateTenantParams) (db.Tenant, error) {
-	return r.queries.CreateTenant(ctx, params)
+	tenant, err := r.queries.CreateTenant(ctx, params)
+	return tenant, translateError(err)
 }
 
 func (r *tenantRepository) UpdateTenant(ctx context.Context, params db.UpdateTenantParams) (db.Tenant, error) {
-	return r.queries.UpdateTenant(ctx, params)
+	tenant, err := r.queries.UpdateTenant(ctx, params)
+	return tenant, translateError(err)
 }
 
 func (r *tenantRepository) DeleteTenant(ctx context.Context, id uuid.UUID) error {
@@ -72,3 +76,32 @@ func (r *tenantRepository) CheckUserBelongsToTenant(ctx context.Context, tenantI
 func (r *tenantRepository) CountTenantsByOrganization(ctx context.Context, organizationID uuid.UUID) (int64, error) {
 	return r.queries.CountTenantsByOrganization(ctx, organizationID)
 }
+
+// Subdomain alias methods
+
+func (r *tenantRepository) ListTakenSubdomains(ctx context.Context, subdomains []string, excludeTenantID uuid.UUID) ([]string, error) {
+	return r.queries.ListTakenSubdomains(ctx, db.ListTakenSubdomainsParams{
+		Subdomains: subdomains,
+		TenantID:   excludeTenantID,
+	})
+}
+
+func (r *tenantRepository) UpsertSubdomainAlias(ctx context.Context, subdomain string, tenantID uuid.UUID, expiresAt time.Time) error {
+	return r.queries.UpsertTenantSubdomainAlias(ctx, db.UpsertTenantSubdomainAliasParams{
+		Subdomain: subdomain,
+		TenantID:  tenantID,
+		ExpiresAt: expiresAt,
+	})
+}
+
+func (r *tenantRepository) GetTenantBySubdomainAlias(ctx context.Context, subdomain string) (db.Tenant, error) {
+	return r.queries.GetTenantBySubdomainAlias(ctx, subdomain)
+}
+
+func (r *tenantRepository) DeleteSubdomainAlias(ctx context.Context, subdomain string) error {
+	return r.queries.DeleteTenantSubdomainAlias(ctx, subdomain)
+}
+
+func (r *tenantRepository) DeleteExpiredSubdomainAliases(ctx context.Context) (int64, error) {
+	return r.queries.DeleteExpiredTenantSubdomainAliases(ctx)
+}
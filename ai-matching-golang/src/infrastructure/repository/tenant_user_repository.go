@@ -56,4 +56,8 @@ func (r *tenantUserRepository) UpdateUserRoleInTenant(ctx context.Context, tenan
 
 func (r *tenantUserRepository) ListTenantUsers(ctx context.Context, tenantID uuid.UUID) ([]db.ListTenantUsersRow, error) {
 	return r.queries.ListTenantUsers(ctx, tenantID)
+}
+
+func (r *tenantUserRepository) CountTenantUsers(ctx context.Context, tenantID uuid.UUID) (int64, error) {
+	return r.queries.CountTenantUsers(ctx, tenantID)
 }
\ No newline at end of file
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// Transactor runs a group of queries in one database transaction for
+// repositories whose writes must land together. The querier handed to the
+// callback goes through wrap (the tracing decorator) like every other query.
+type Transactor struct {
+	db   *sql.DB
+	wrap func(db.Querier) db.Querier
+}
+
+func NewTransactor(sqlDB *sql.DB, wrap func(db.Querier) db.Querier) *Transactor {
+	return &Transactor{
+		db:   sqlDB,
+		wrap: wrap,
+	}
+}
+
+// InTx commits when fn returns nil and rolls back otherwise
+func (t *Transactor) InTx(ctx context.Context, fn func(q db.Querier) error) error {
+	tx, err := t.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if err := fn(t.wrap(db.New(tx))); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			return errors.Join(err, fmt.Errorf("failed to roll back transaction: %w", rbErr))
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type usageRepository struct {
+	queries db.Querier
+}
+
+func NewUsageRepository(queries db.Querier) repository.UsageRepository {
+	return &usageRepository{
+		queries: queries,
+	}
+}
+
+func (r *usageRepository) RecordUserActivity(ctx context.Context, date time.Time, organizationID, tenantID, userID uuid.UUID) error {
+	return r.queries.RecordUserActivity(ctx, db.RecordUserActivityParams{
+		ActivityDate:   date,
+		TenantID:       tenantID,
+		UserID:         userID,
+		OrganizationID: organizationID,
+	})
+}
+
+// Snapshot methods
+
+func (r *usageRepository) SnapshotTenantActiveUsers(ctx context.Context, date time.Time) (int64, error) {
+	return r.queries.SnapshotTenantActiveUsers(ctx, date)
+}
+
+func (r *usageRepository) SnapshotOrganizationActiveUsers(ctx context.Context, date time.Time) (int64, error) {
+	return r.queries.SnapshotOrganizationActiveUsers(ctx, date)
+}
+
+func (r *usageRepository) SnapshotTenantSeats(ctx context.Context, date time.Time) (int64, error) {
+	return r.queries.SnapshotTenantSeats(ctx, date)
+}
+
+func (r *usageRepository) SnapshotOrganizationSeats(ctx context.Context, date time.Time) (int64, error) {
+	return r.queries.SnapshotOrganizationSeats(ctx, date)
+}
+
+func (r *usageRepository) SnapshotOrganizationAPICalls(ctx context.Context, date time.Time) (int64, error) {
+	return r.queries.SnapshotOrganizationApiCalls(ctx, date)
+}
+
+// Period methods
+
+func (r *usageRepository) ListUsageRecords(ctx context.Context, start, end time.Time) ([]db.ListUsageRecordsByPeriodRow, error) {
+	return r.queries.ListUsageRecordsByPeriod(ctx, db.ListUsageRecordsByPeriodParams{
+		PeriodStart: start,
+		PeriodEnd:   end,
+	})
+}
+
+func (r *usageRepository) CountTenantMonthlyActiveUsers(ctx context.Context, start, end time.Time) ([]db.CountTenantMonthlyActiveUsersRow, error) {
+	return r.queries.CountTenantMonthlyActiveUsers(ctx, db.CountTenantMonthlyActiveUsersParams{
+		PeriodStart: start,
+		PeriodEnd:   end,
+	})
+}
+
+func (r *usageRepository) CountOrganizationMonthlyActiveUsers(ctx context.Context, start, end time.Time) ([]db.CountOrganizationMonthlyActiveUsersRow, error) {
+	return r.queries.CountOrganizationMonthlyActiveUsers(ctx, db.CountOrganizationMonthlyActiveUsersParams{
+		PeriodStart: start,
+		PeriodEnd:   end,
+	})
+}
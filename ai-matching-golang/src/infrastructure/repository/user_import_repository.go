@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type userImportRepository struct {
+	queries db.Querier
+}
+
+func NewUserImportRepository(queries db.Querier) repository.UserImportRepository {
+	return &userImportRepository{
+		queries: queries,
+	}
+}
+
+func (r *userImportRepository) CreateUserImport(ctx context.Context, params db.CreateUserImportParams) (db.UserImport, error) {
+	return r.queries.CreateUserImport(ctx, params)
+}
+
+func (r *userImportRepository) GetUserImport(ctx context.Context, organizationID, id uuid.UUID) (db.UserImport, error) {
+	return r.queries.GetUserImport(ctx, db.GetUserImportParams{
+		ID:             id,
+		OrganizationID: organizationID,
+	})
+}
+
+func (r *userImportRepository) ListUserImports(ctx context.Context, organizationID uuid.UUID, limit int32) ([]db.UserImport, error) {
+	return r.queries.ListUserImports(ctx, db.ListUserImportsParams{
+		OrganizationID: organizationID,
+		RowLimit:       limit,
+	})
+}
+
+func (r *userImportRepository) StartUserImport(ctx context.Context, id uuid.UUID) (bool, error) {
+	rows, err := r.queries.StartUserImport(ctx, id)
+	return rows > 0, err
+}
+
+func (r *userImportRepository) FinishUserImport(ctx context.Context, id uuid.UUID, status string) error {
+	return r.queries.FinishUserImport(ctx, db.FinishUserImportParams{
+		Status: status,
+		ID:     id,
+	})
+}
+
+// Row methods
+
+func (r *userImportRepository) CreateUserImportRow(ctx context.Context, params db.CreateUserImportRowParams) error {
+	return r.queries.CreateUserImportRow(ctx, params)
+}
+
+func (r *userImportRepository) ListUserImportRows(ctx context.Context, importID uuid.UUID) ([]db.ListUserImportRowsRow, error) {
+	return r.queries.ListUserImportRows(ctx, importID)
+}
+
+func (r *userImportRepository) UpdateUserImportRow(ctx context.Context, params db.UpdateUserImportRowParams) error {
+	return r.queries.UpdateUserImportRow(ctx, params)
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type webhookRepository struct {
+	queries db.Querier
+}
+
+func NewWebhookRepository(queries db.Querier) repository.WebhookRepository {
+	return &webhookRepository{
+		queries: queries,
+	}
+}
+
+func (r *webhookRepository) CreateEndpoint(ctx context.Context, params db.CreateWebhookEndpointParams) (db.WebhookEndpoint, error) {
+	return r.queries.CreateWebhookEndpoint(ctx, params)
+}
+
+func (r *webhookRepository) GetEndpoint(ctx context.Context, organizationID, id uuid.UUID) (db.WebhookEndpoint, error) {
+	return r.queries.GetWebhookEndpoint(ctx, db.GetWebhookEndpointParams{
+		ID:             id,
+		OrganizationID: organizationID,
+	})
+}
+
+func (r *webhookRepository) ListEndpoints(ctx context.Context, organizationID uuid.UUID) ([]db.WebhookEndpoint, error) {
+	return r.queries.ListWebhookEndpoints(ctx, organizationID)
+}
+
+func (r *webhookRepository) UpdateEndpoint(ctx context.Context, params db.UpdateWebhookEndpointParams) (db.WebhookEndpoint, error) {
+	return r.queries.UpdateWebhookEndpoint(ctx, params)
+}
+
+func (r *webhookRepository) DeleteEndpoint(ctx context.Context, organizationID, id uuid.UUID) (bool, error) {
+	rows, err := r.queries.DeleteWebhookEndpoint(ctx, db.DeleteWebhookEndpointParams{
+		ID:             id,
+		OrganizationID: organizationID,
+	})
+	return rows > 0, err
+}
+
+func (r *webhookRepository) RotateEndpointSecret(ctx context.Context, organizationID, id uuid.UUID, secret string, previousExpiresAt time.Time) (db.WebhookEndpoint, error) {
+	return r.queries.RotateWebhookEndpointSecret(ctx, db.RotateWebhookEndpointSecretParams{
+		PreviousSecretExpiresAt: previousExpiresAt,
+		Secret:                  secret,
+		ID:                      id,
+		OrganizationID:          organizationID,
+	})
+}
+
+func (r *webhookRepository) ListSubscribedEndpoints(ctx context.Context, organizationID uuid.UUID, eventType string) ([]db.WebhookEndpoint, error) {
+	return r.queries.ListSubscribedWebhookEndpoints(ctx, db.ListSubscribedWebhookEndpointsParams{
+		OrganizationID: organizationID,
+		EventType:      eventType,
+	})
+}
+
+// Delivery methods
+
+func (r *webhookRepository) CreateDelivery(ctx context.Context, params db.CreateWebhookDeliveryParams) (db.WebhookDelivery, error) {
+	return r.queries.CreateWebhookDelivery(ctx, params)
+}
+
+func (r *webhookRepository) GetDelivery(ctx context.Context, endpointID, id uuid.UUID) (db.WebhookDelivery, error) {
+	return r.queries.GetWebhookDelivery(ctx, db.GetWebhookDeliveryParams{
+		ID:         id,
+		EndpointID: endpointID,
+	})
+}
+
+func (r *webhookRepository) ListDeliveries(ctx context.Context, params db.ListWebhookDeliveriesParams) ([]db.WebhookDelivery, error) {
+	return r.queries.ListWebhookDeliveries(ctx, params)
+}
+
+func (r *webhookRepository) CountDeliveries(ctx context.Context, params db.CountWebhookDeliveriesParams) (int64, error) {
+	return r.queries.CountWebhookDeliveries(ctx, params)
+}
+
+func (r *webhookRepository) DeleteDeliveriesBefore(ctx context.Context, before time.Time) (int64, error) {
+	return r.queries.DeleteWebhookDeliveriesBefore(ctx, before)
+}
+
+func (r *webhookRepository) GetDeliveryTarget(ctx context.Context, id uuid.UUID) (db.GetWebhookDeliveryTargetRow, error) {
+	return r.queries.GetWebhookDeliveryTarget(ctx, id)
+}
+
+func (r *webhookRepository) RecordDeliveryAttempt(ctx context.Context, params db.RecordWebhookDeliveryAttemptParams) error {
+	return r.queries.RecordWebhookDeliveryAttempt(ctx, params)
+}
+
+func (r *webhookRepository) FailDelivery(ctx context.Context, id uuid.UUID, errorMessage string) error {
+	return r.queries.FailWebhookDelivery(ctx, db.FailWebhookDeliveryParams{
+		ErrorMessage: errorMessage,
+		ID:           id,
+	})
+}
@@ -0,0 +1,234 @@
+package retrieval
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// Search modes
+const (
+	ModeVector  = "vector"
+	ModeKeyword = "keyword"
+	ModeHybrid  = "hybrid"
+)
+
+const (
+	// DefaultRRFK is the rank constant from the original reciprocal rank
+	// fusion paper
+	DefaultRRFK = 60
+
+	// Each ranking contributes a few times more candidates than requested so
+	// that chunks ranked moderately by both can still make the cut
+	candidateFactor = 4
+	minCandidates   = 20
+	maxCandidates   = 100
+)
+
+// Weights tune reciprocal rank fusion: a chunk scores the sum over both
+// rankings of weight / (K + rank).
+type Weights struct {
+	Vector  float64
+	Keyword float64
+	K       int
+}
+
+// Explanation breaks a search score down into its components. Ranks start
+// at 1; zero means the chunk was not in that ranking.
+type Explanation struct {
+	VectorRank          int
+	VectorScore         float64
+	KeywordRank         int
+	KeywordScore        float64
+	VectorContribution  float64
+	KeywordContribution float64
+}
+
+// HybridResult is a chunk returned by a hybrid search
+type HybridResult struct {
+	Result
+	Explanation Explanation
+}
+
+// HybridSearcher combines vector similarity with Postgres full-text search,
+// which catches exact terms such as drug names and codes that embeddings
+// tend to blur.
+type HybridSearcher struct {
+	vector    *VectorSearcher
+	chunkRepo repository.DocumentChunkRepository
+}
+
+func NewHybridSearcher(vector *VectorSearcher, chunkRepo repository.DocumentChunkRepository) *HybridSearcher {
+	return &HybridSearcher{
+		vector:    vector,
+		chunkRepo: chunkRepo,
+	}
+}
+
+// Model returns the embedding model of the vector ranking
+func (h *HybridSearcher) Model() string {
+	return h.vector.Model()
+}
+
+// Search returns the k best chunks of the tenant for query in the given
+// mode, with the vector backend used (empty in keyword mode). In vector mode
+// the score is the cosine similarity, in keyword mode the full-text rank and
+// in hybrid mode the fused score.
+func (h *HybridSearcher) Search(ctx context.Context, tenantID uuid.UUID, query string, filter Filter, k int, mode string, weights Weights) ([]HybridResult, string, error) {
+	if query == "" {
+		return nil, "", ErrEmptyQuery
+	}
+
+	depth := k
+	if mode == ModeHybrid {
+		depth = min(max(k*candidateFactor, minCandidates), maxCandidates)
+	}
+
+	var (
+		vectorHits, keywordHits []Hit
+		backend                 string
+		err                     error
+	)
+	if mode != ModeKeyword {
+		vector, err := h.vector.Embed(ctx, query)
+		if err != nil {
+			return nil, "", err
+		}
+		vectorHits, backend, err = h.vector.Nearest(ctx, tenantID, vector, filter, depth)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	if mode != ModeVector {
+		keywordHits, err = h.keyword(ctx, tenantID, query, filter, depth)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	var fused []fusedHit
+	switch mode {
+	case ModeVector:
+		fused = single(vectorHits, func(e *Explanation, rank int, score float64) {
+			e.VectorRank, e.VectorScore = rank, score
+		})
+	case ModeKeyword:
+		fused = single(keywordHits, func(e *Explanation, rank int, score float64) {
+			e.KeywordRank, e.KeywordScore = rank, score
+		})
+	default:
+		fused = fuse(vectorHits, keywordHits, weights)
+	}
+	if len(fused) > k {
+		fused = fused[:k]
+	}
+
+	hits := make([]Hit, len(fused))
+	for i, f := range fused {
+		hits[i] = f.Hit
+	}
+	results, err := h.vector.Load(ctx, tenantID, hits)
+	if err != nil {
+		return nil, "", err
+	}
+
+	explanations := make(map[uuid.UUID]Explanation, len(fused))
+	for _, f := range fused {
+		explanations[f.ChunkID] = f.explanation
+	}
+	out := make([]HybridResult, len(results))
+	for i, result := range results {
+		out[i] = HybridResult{Result: result, Explanation: explanations[result.Chunk.ID]}
+	}
+	return out, backend, nil
+}
+
+func (h *HybridSearcher) keyword(ctx context.Context, tenantID uuid.UUID, query string, filter Filter, k int) ([]Hit, error) {
+	metadata, err := filterMetadata(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := h.chunkRepo.SearchByKeyword(ctx, db.SearchDocumentChunksByKeywordParams{
+		Query:       query,
+		TenantID:    tenantID,
+		Metadata:    metadata,
+		DocumentIds: filter.DocumentIDs,
+		RowLimit:    int32(k),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search chunks by keyword: %w", err)
+	}
+
+	hits := make([]Hit, len(rows))
+	for i, row := range rows {
+		hits[i] = Hit{ChunkID: row.ID, Score: row.Rank}
+	}
+	return hits, nil
+}
+
+type fusedHit struct {
+	Hit
+	explanation Explanation
+}
+
+// single keeps the order and scores of one ranking
+func single(hits []Hit, explain func(e *Explanation, rank int, score float64)) []fusedHit {
+	out := make([]fusedHit, len(hits))
+	for i, hit := range hits {
+		out[i].Hit = hit
+		explain(&out[i].explanation, i+1, hit.Score)
+	}
+	return out
+}
+
+// fuse merges two rankings with weighted reciprocal rank fusion. Only ranks
+// matter, so cosine similarities and full-text ranks need no normalization.
+func fuse(vectorHits, keywordHits []Hit, weights Weights) []fusedHit {
+	if weights.K <= 0 {
+		weights.K = DefaultRRFK
+	}
+	if weights.Vector <= 0 && weights.Keyword <= 0 {
+		weights.Vector, weights.Keyword = 1, 1
+	}
+
+	byID := make(map[uuid.UUID]*fusedHit, len(vectorHits)+len(keywordHits))
+	get := func(id uuid.UUID) *fusedHit {
+		f, ok := byID[id]
+		if !ok {
+			f = &fusedHit{Hit: Hit{ChunkID: id}}
+			byID[id] = f
+		}
+		return f
+	}
+
+	for i, hit := range vectorHits {
+		f := get(hit.ChunkID)
+		f.explanation.VectorRank = i + 1
+		f.explanation.VectorScore = hit.Score
+		f.explanation.VectorContribution = weights.Vector / float64(weights.K+i+1)
+	}
+	for i, hit := range keywordHits {
+		f := get(hit.ChunkID)
+		f.explanation.KeywordRank = i + 1
+		f.explanation.KeywordScore = hit.Score
+		f.explanation.KeywordContribution = weights.Keyword / float64(weights.K+i+1)
+	}
+
+	out := make([]fusedHit, 0, len(byID))
+	for _, f := range byID {
+		f.Score = f.explanation.VectorContribution + f.explanation.KeywordContribution
+		out = append(out, *f)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Score != out[j].Score {
+			return out[i].Score > out[j].Score
+		}
+		return out[i].ChunkID.String() < out[j].ChunkID.String()
+	})
+	return out
+}
@@ -0,0 +1,298 @@
+package retrieval
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/external"
+	"ai-matching/src/domain/interface/repository"
+	"container/heap"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"os"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// Vector search backends
+const (
+	BackendPGVector   = "pgvector"
+	BackendBruteForce = "bruteforce"
+)
+
+// bruteForcePageSize is how many embeddings the fallback reads per query
+const bruteForcePageSize = 1000
+
+var ErrEmptyQuery = errors.New("query is empty")
+
+// Filter narrows a search. Metadata matches documents whose metadata
+// contains every given key with the given value.
+type Filter struct {
+	Metadata    map[string]any
+	DocumentIDs []uuid.UUID
+}
+
+// Hit is a chunk found by a search with its similarity to the query
+type Hit struct {
+	ChunkID uuid.UUID
+	Score   float64
+}
+
+// Result is a chunk returned by a search
+type Result struct {
+	Chunk db.GetDocumentChunksByIDsRow
+	Score float64
+}
+
+// VectorSearcher finds the chunks nearest to a query embedding within one
+// tenant. It uses pgvector when the extension is installed and otherwise
+// compares embeddings in process. VECTOR_SEARCH=pgvector or bruteforce
+// forces a backend.
+type VectorSearcher struct {
+	chunkRepo repository.DocumentChunkRepository
+	embedder  external.EmbeddingProvider
+	mode      string
+
+	mu      sync.Mutex
+	backend string
+}
+
+func NewVectorSearcher(chunkRepo repository.DocumentChunkRepository, embedder external.EmbeddingProvider) *VectorSearcher {
+	mode := os.Getenv("VECTOR_SEARCH")
+	switch mode {
+	case "", "auto":
+		mode = ""
+	case BackendPGVector, BackendBruteForce:
+	default:
+		slog.Warn("ignoring invalid VECTOR_SEARCH", "value", mode)
+		mode = ""
+	}
+
+	return &VectorSearcher{
+		chunkRepo: chunkRepo,
+		embedder:  embedder,
+		mode:      mode,
+	}
+}
+
+// Model returns the embedding model queries and chunks are compared with
+func (s *VectorSearcher) Model() string {
+	return s.embedder.Model()
+}
+
+// Backend reports which backend searches use. Detection runs on first use
+// and is remembered once it succeeds.
+func (s *VectorSearcher) Backend(ctx context.Context) (string, error) {
+	if s.mode != "" {
+		return s.mode, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.backend != "" {
+		return s.backend, nil
+	}
+
+	installed, err := s.chunkRepo.VectorExtensionInstalled(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to detect pgvector: %w", err)
+	}
+	s.backend = BackendBruteForce
+	if installed {
+		s.backend = BackendPGVector
+	}
+	slog.InfoContext(ctx, "vector search backend selected", "backend", s.backend)
+	return s.backend, nil
+}
+
+// Embed returns the embedding of a query
+func (s *VectorSearcher) Embed(ctx context.Context, query string) ([]float32, error) {
+	if query == "" {
+		return nil, ErrEmptyQuery
+	}
+	vectors, err := s.embedder.Embed(ctx, []string{query})
+	if err != nil {
+		return nil, fmt.Errorf("failed to embed query: %w", err)
+	}
+	return vectors[0], nil
+}
+
+// Nearest returns up to k chunks of the tenant closest to vector, most
+// similar first, and the backend that found them
+func (s *VectorSearcher) Nearest(ctx context.Context, tenantID uuid.UUID, vector []float32, filter Filter, k int) ([]Hit, string, error) {
+	backend, err := s.Backend(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	metadata, err := filterMetadata(filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var hits []Hit
+	if backend == BackendPGVector {
+		hits, err = s.nearestPGVector(ctx, tenantID, vector, metadata, filter.DocumentIDs, k)
+	} else {
+		hits, err = s.nearestBruteForce(ctx, tenantID, vector, metadata, filter.DocumentIDs, k)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return hits, backend, nil
+}
+
+// Load returns the chunks of hits in the order of hits. Chunks removed since
+// the hits were found are left out.
+func (s *VectorSearcher) Load(ctx context.Context, tenantID uuid.UUID, hits []Hit) ([]Result, error) {
+	if len(hits) == 0 {
+		return []Result{}, nil
+	}
+
+	ids := make([]uuid.UUID, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.ChunkID
+	}
+	chunks, err := s.chunkRepo.GetChunksByIDs(ctx, tenantID, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chunks: %w", err)
+	}
+
+	byID := make(map[uuid.UUID]db.GetDocumentChunksByIDsRow, len(chunks))
+	for _, chunk := range chunks {
+		byID[chunk.ID] = chunk
+	}
+
+	results := make([]Result, 0, len(hits))
+	for _, hit := range hits {
+		if chunk, ok := byID[hit.ChunkID]; ok {
+			results = append(results, Result{Chunk: chunk, Score: hit.Score})
+		}
+	}
+	return results, nil
+}
+
+// Search embeds query and returns the k most similar chunks of the tenant
+func (s *VectorSearcher) Search(ctx context.Context, tenantID uuid.UUID, query string, filter Filter, k int) ([]Result, string, error) {
+	vector, err := s.Embed(ctx, query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	hits, backend, err := s.Nearest(ctx, tenantID, vector, filter, k)
+	if err != nil {
+		return nil, "", err
+	}
+
+	results, err := s.Load(ctx, tenantID, hits)
+	if err != nil {
+		return nil, "", err
+	}
+	return results, backend, nil
+}
+
+func (s *VectorSearcher) nearestPGVector(ctx context.Context, tenantID uuid.UUID, vector []float32, metadata json.RawMessage, documentIDs []uuid.UUID, k int) ([]Hit, error) {
+	rows, err := s.chunkRepo.SearchByVector(ctx, db.SearchDocumentChunksByVectorParams{
+		QueryEmbedding: vector,
+		TenantID:       tenantID,
+		EmbeddingModel: s.embedder.Model(),
+		Metadata:       metadata,
+		DocumentIds:    documentIDs,
+		RowLimit:       int32(k),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search chunks: %w", err)
+	}
+
+	hits := make([]Hit, len(rows))
+	for i, row := range rows {
+		hits[i] = Hit{ChunkID: row.ID, Score: 1 - row.Distance}
+	}
+	return hits, nil
+}
+
+// nearestBruteForce pages through every candidate embedding and keeps the k
+// best in a heap, so memory stays bounded however many chunks there are.
+func (s *VectorSearcher) nearestBruteForce(ctx context.Context, tenantID uuid.UUID, vector []float32, metadata json.RawMessage, documentIDs []uuid.UUID, k int) ([]Hit, error) {
+	best := &hitHeap{}
+	after := uuid.Nil
+	for {
+		rows, err := s.chunkRepo.ListEmbeddings(ctx, db.ListDocumentChunkEmbeddingsParams{
+			TenantID:       tenantID,
+			AfterID:        after,
+			EmbeddingModel: s.embedder.Model(),
+			Metadata:       metadata,
+			DocumentIds:    documentIDs,
+			RowLimit:       bruteForcePageSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list chunk embeddings: %w", err)
+		}
+
+		for _, row := range rows {
+			if len(row.Embedding) != len(vector) {
+				continue
+			}
+			hit := Hit{ChunkID: row.ID, Score: Cosine(vector, row.Embedding)}
+			if best.Len() < k {
+				heap.Push(best, hit)
+			} else if hit.Score > (*best)[0].Score {
+				(*best)[0] = hit
+				heap.Fix(best, 0)
+			}
+		}
+
+		if len(rows) < bruteForcePageSize {
+			break
+		}
+		after = rows[len(rows)-1].ID
+	}
+
+	hits := make([]Hit, best.Len())
+	for i := len(hits) - 1; i >= 0; i-- {
+		hits[i] = heap.Pop(best).(Hit)
+	}
+	return hits, nil
+}
+
+// Cosine returns the cosine similarity of two vectors of the same length
+func Cosine(a, b []float32) float64 {
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+func filterMetadata(filter Filter) (json.RawMessage, error) {
+	if len(filter.Metadata) == 0 {
+		return json.RawMessage("{}"), nil
+	}
+	metadata, err := json.Marshal(filter.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode metadata filter: %w", err)
+	}
+	return metadata, nil
+}
+
+// hitHeap is a min-heap on score, so the weakest of the best hits is on top
+type hitHeap []Hit
+
+func (h hitHeap) Len() int           { return len(h) }
+func (h hitHeap) Less(i, j int) bool { return h[i].Score < h[j].Score }
+func (h hitHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *hitHeap) Push(x any)        { *h = append(*h, x.(Hit)) }
+func (h *hitHeap) Pop() any {
+	old := *h
+	hit := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return hit
+}
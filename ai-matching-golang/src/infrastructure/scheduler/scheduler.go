@@ -0,0 +1,345 @@
+package scheduler
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/jobs"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Run statuses
+const (
+	StatusRunning   = "running"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+// Run triggers
+const (
+	TriggerSchedule = "schedule"
+	TriggerManual   = "manual"
+)
+
+// JobKind runs a task on request, on whichever worker claims the job
+const JobKind = "scheduler.run_task"
+
+const (
+	defaultRunRetention = 30 * 24 * time.Hour
+
+	// manualAttempts covers waiting out a run that is already in progress
+	manualAttempts = 3
+
+	// maxErrorLength keeps stored errors readable in the run history
+	maxErrorLength = 2000
+)
+
+var (
+	ErrTaskNotFound = errors.New("task not found")
+	ErrTaskRunning  = errors.New("task is already running")
+
+	errTaskFailed = errors.New("task failed")
+)
+
+// Job is the payload of a manually triggered run
+type Job struct {
+	Task        string    `json:"task"`
+	TriggeredBy uuid.UUID `json:"triggeredBy"`
+}
+
+// TaskFunc runs a task once and returns a short summary for the run history
+type TaskFunc func(ctx context.Context) (string, error)
+
+type Task struct {
+	Name        string
+	Description string
+	Schedule    *Schedule
+	run         TaskFunc
+}
+
+// Scheduler runs registered tasks on their cron schedules. Every replica
+// runs the scheduler; a Postgres advisory lock per task makes sure only one
+// of them executes a task at a time, and the run history records each slot
+// once. Runs older than TASK_RUN_RETENTION are deleted.
+type Scheduler struct {
+	db           *sql.DB
+	runRepo      repository.TaskRunRepository
+	queue        *jobs.Queue
+	tasks        []*Task
+	byName       map[string]*Task
+	workerID     string
+	runRetention time.Duration
+	now          func() time.Time
+}
+
+func NewScheduler(sqlDB *sql.DB, runRepo repository.TaskRunRepository, queue *jobs.Queue) *Scheduler {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "scheduler"
+	}
+
+	s := &Scheduler{
+		db:           sqlDB,
+		runRepo:      runRepo,
+		queue:        queue,
+		byName:       make(map[string]*Task),
+		workerID:     fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+		runRetention: durationFromEnv("TASK_RUN_RETENTION", defaultRunRetention),
+		now:          time.Now,
+	}
+	return s
+}
+
+// Register adds a task that runs on the cron expression spec. It panics on
+// an invalid expression or a duplicate name, as tasks are set up at startup.
+func (s *Scheduler) Register(name, spec, description string, run TaskFunc) {
+	schedule, err := ParseSchedule(spec)
+	if err != nil {
+		panic(fmt.Sprintf("scheduler: task %s: %v", name, err))
+	}
+	if _, ok := s.byName[name]; ok {
+		panic("scheduler: task registered twice: " + name)
+	}
+
+	task := &Task{Name: name, Description: description, Schedule: schedule, run: run}
+	s.tasks = append(s.tasks, task)
+	s.byName[name] = task
+}
+
+// Tasks returns the registered tasks in registration order
+func (s *Scheduler) Tasks() []Task {
+	tasks := make([]Task, len(s.tasks))
+	for i, task := range s.tasks {
+		tasks[i] = *task
+	}
+	return tasks
+}
+
+// Task returns a registered task by name
+func (s *Scheduler) Task(name string) (Task, bool) {
+	task, ok := s.byName[name]
+	if !ok {
+		return Task{}, false
+	}
+	return *task, true
+}
+
+// Trigger queues a run of the task outside its schedule
+func (s *Scheduler) Trigger(ctx context.Context, name string, triggeredBy uuid.UUID) (db.Job, error) {
+	if _, ok := s.byName[name]; !ok {
+		return db.Job{}, ErrTaskNotFound
+	}
+	return s.queue.Enqueue(ctx, JobKind, Job{Task: name, TriggeredBy: triggeredBy}, jobs.Options{
+		MaxAttempts: manualAttempts,
+	})
+}
+
+// Run executes tasks as they come due until ctx is cancelled, then waits
+// for running tasks to stop. Slots missed while no scheduler was running
+// are not caught up.
+func (s *Scheduler) Run(ctx context.Context) error {
+	var running sync.WaitGroup
+
+	next := make([]time.Time, len(s.tasks))
+	for i, task := range s.tasks {
+		next[i] = task.Schedule.Next(s.now())
+	}
+
+	slog.InfoContext(ctx, "scheduler started", slog.String("worker_id", s.workerID), slog.Int("tasks", len(s.tasks)))
+
+	for ctx.Err() == nil {
+		var due time.Time
+		for _, t := range next {
+			if !t.IsZero() && (due.IsZero() || t.Before(due)) {
+				due = t
+			}
+		}
+		if due.IsZero() {
+			<-ctx.Done()
+			break
+		}
+
+		timer := time.NewTimer(due.Sub(s.now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			continue
+		case <-timer.C:
+		}
+
+		now := s.now()
+		for i, task := range s.tasks {
+			if next[i].IsZero() || next[i].After(now) {
+				continue
+			}
+			slot := next[i]
+			next[i] = task.Schedule.Next(now)
+
+			running.Add(1)
+			go func() {
+				defer running.Done()
+				s.runScheduled(ctx, task, slot)
+			}()
+		}
+	}
+
+	running.Wait()
+	slog.InfoContext(ctx, "scheduler stopped", slog.String("worker_id", s.workerID))
+	return nil
+}
+
+func (s *Scheduler) runScheduled(ctx context.Context, task *Task, slot time.Time) {
+	logger := slog.With(slog.String("task", task.Name), slog.Time("scheduled_for", slot))
+
+	err := s.execute(ctx, task, func(ctx context.Context) (db.TaskRun, bool, error) {
+		return s.runRepo.StartScheduledRun(ctx, task.Name, slot, s.workerID)
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrTaskRunning):
+		logger.InfoContext(ctx, "skipping scheduled task, it is already running")
+	case errors.Is(err, errTaskFailed):
+		// Already recorded in the run history
+	default:
+		logger.ErrorContext(ctx, "failed to run scheduled task", slog.Any("error", err))
+	}
+}
+
+// HandleJob is the job handler that runs a manually triggered task
+func (s *Scheduler) HandleJob(ctx context.Context, job Job) error {
+	task, ok := s.byName[job.Task]
+	if !ok {
+		return jobs.Permanent(fmt.Errorf("%w: %s", ErrTaskNotFound, job.Task))
+	}
+
+	triggeredBy := uuid.NullUUID{UUID: job.TriggeredBy, Valid: job.TriggeredBy != uuid.Nil}
+	err := s.execute(ctx, task, func(ctx context.Context) (db.TaskRun, bool, error) {
+		run, err := s.runRepo.StartManualRun(ctx, task.Name, triggeredBy, s.workerID)
+		return run, err == nil, err
+	})
+	// The failed run is in the history; running it again is up to an admin
+	if errors.Is(err, errTaskFailed) {
+		return jobs.Permanent(err)
+	}
+	return err
+}
+
+// execute runs a task while holding its advisory lock. start records the
+// run and returns false when the run should be skipped.
+func (s *Scheduler) execute(ctx context.Context, task *Task, start func(ctx context.Context) (db.TaskRun, bool, error)) error {
+	unlock, err := s.lock(ctx, task.Name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	run, ok, err := start(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record task run: %w", err)
+	}
+	if !ok {
+		return nil
+	}
+
+	logger := slog.With(slog.String("task", task.Name), slog.String("run_id", run.ID.String()))
+	logger.InfoContext(ctx, "task started", slog.String("trigger", run.Trigger))
+
+	started := s.now()
+	summary, taskErr := s.call(ctx, task)
+	elapsed := time.Since(started)
+
+	// Record the outcome even when the task was cancelled at shutdown
+	ctx = context.WithoutCancel(ctx)
+
+	status, errorMessage := StatusSucceeded, ""
+	if taskErr != nil {
+		status, errorMessage = StatusFailed, truncate(taskErr.Error())
+		logger.ErrorContext(ctx, "task failed", slog.Duration("duration", elapsed), slog.Any("error", taskErr))
+	} else {
+		logger.InfoContext(ctx, "task succeeded", slog.Duration("duration", elapsed), slog.String("summary", summary))
+	}
+
+	if err := s.runRepo.FinishRun(ctx, run.ID, status, truncate(summary), errorMessage); err != nil {
+		logger.ErrorContext(ctx, "failed to record task outcome", slog.Any("error", err))
+	}
+	if n, err := s.runRepo.DeleteRunsBefore(ctx, s.now().Add(-s.runRetention)); err != nil {
+		logger.WarnContext(ctx, "failed to delete old task runs", slog.Any("error", err))
+	} else if n > 0 {
+		logger.DebugContext(ctx, "deleted old task runs", slog.Int64("count", n))
+	}
+
+	if taskErr != nil {
+		return fmt.Errorf("%w: %w", errTaskFailed, taskErr)
+	}
+	return nil
+}
+
+func (s *Scheduler) call(ctx context.Context, task *Task) (summary string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("task panicked: %v", r)
+		}
+	}()
+	return task.run(ctx)
+}
+
+// lock takes the session-level advisory lock of a task on a dedicated
+// connection, so the lock is released if this process dies mid-run
+func (s *Scheduler) lock(ctx context.Context, name string) (func(), error) {
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connection for task lock: %w", err)
+	}
+
+	key := "task:" + name
+	queries := db.New(conn)
+	locked, err := queries.TryTaskLock(ctx, key)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to take task lock: %w", err)
+	}
+	if !locked {
+		conn.Close()
+		return nil, ErrTaskRunning
+	}
+
+	return func() {
+		unlockCtx := context.WithoutCancel(ctx)
+		if err := queries.ReleaseTaskLock(unlockCtx, key); err != nil {
+			slog.WarnContext(unlockCtx, "failed to release task lock", slog.String("task", name), slog.Any("error", err))
+			// Drop the connection rather than pool it with the lock held
+			conn.Raw(func(any) error { return driver.ErrBadConn })
+		}
+		conn.Close()
+	}, nil
+}
+
+func truncate(message string) string {
+	if len(message) <= maxErrorLength {
+		return message
+	}
+	return strings.ToValidUTF8(message[:maxErrorLength], "")
+}
+
+func durationFromEnv(name string, fallback time.Duration) time.Duration {
+	v := os.Getenv(name)
+	if v == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		slog.Warn("ignoring invalid "+name, "value", v)
+		return fallback
+	}
+	return d
+}
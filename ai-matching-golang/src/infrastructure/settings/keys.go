@@ -0,0 +1,97 @@
+package settings
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"time"
+
+	// The runtime image ships without a zoneinfo database.
+	_ "time/tzdata"
+)
+
+// Registered settings. Add new keys here so they are validated on write and
+// listed by the definitions endpoint.
+var (
+	Locale = Define("general.locale", "Language used for the UI and notifications",
+		"ja", oneOf("ja", "en"), ScopeOrganization, ScopeTenant)
+
+	Timezone = Define("general.timezone", "IANA time zone used to display and schedule times",
+		"Asia/Tokyo", validTimezone, ScopeOrganization, ScopeTenant)
+
+	BrandingPrimaryColor = Define("branding.primaryColor", "Primary brand color as #RRGGBB",
+		"#2563eb", hexColor, ScopeOrganization, ScopeTenant)
+
+	BrandingLogoURL = Define("branding.logoUrl", "HTTPS URL of the logo shown in the frontend (empty for none)",
+		"", httpsURLOrEmpty, ScopeOrganization, ScopeTenant)
+
+	SessionTimeoutMinutes = Define("security.sessionTimeoutMinutes", "Idle minutes before the frontend signs users out",
+		60, intRange(5, 1440), ScopeOrganization)
+
+	SearchVectorWeight = Define("search.vectorWeight", "Weight of the vector similarity ranking in hybrid document search",
+		1.0, floatRange(0, 10), ScopeOrganization, ScopeTenant)
+
+	SearchKeywordWeight = Define("search.keywordWeight", "Weight of the keyword ranking in hybrid document search",
+		1.0, floatRange(0, 10), ScopeOrganization, ScopeTenant)
+
+	SearchRRFK = Define("search.rrfK", "Rank constant of reciprocal rank fusion; larger values flatten the gap between top ranks",
+		60, intRange(1, 1000), ScopeOrganization, ScopeTenant)
+
+	MatchingSimilarityWeight = Define("matching.similarityWeight", "Weight of description similarity against the rule weights when scoring matches",
+		1.0, floatRange(0, 100), ScopeOrganization, ScopeTenant)
+)
+
+func oneOf(values ...string) func(string) error {
+	return func(v string) error {
+		if !slices.Contains(values, v) {
+			return fmt.Errorf("must be one of %v", values)
+		}
+		return nil
+	}
+}
+
+func intRange(min, max int) func(int) error {
+	return func(v int) error {
+		if v < min || v > max {
+			return fmt.Errorf("must be between %d and %d", min, max)
+		}
+		return nil
+	}
+}
+
+func floatRange(min, max float64) func(float64) error {
+	return func(v float64) error {
+		if v < min || v > max {
+			return fmt.Errorf("must be between %g and %g", min, max)
+		}
+		return nil
+	}
+}
+
+func validTimezone(v string) error {
+	if _, err := time.LoadLocation(v); err != nil || v == "" || v == "Local" {
+		return fmt.Errorf("must be an IANA time zone such as Asia/Tokyo")
+	}
+	return nil
+}
+
+var hexColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+func hexColor(v string) error {
+	if !hexColorPattern.MatchString(v) {
+		return fmt.Errorf("must be a color in #RRGGBB form")
+	}
+	return nil
+}
+
+func httpsURLOrEmpty(v string) error {
+	if v == "" {
+		return nil
+	}
+	u, err := url.Parse(v)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("must be an https URL")
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package settings
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+)
+
+// Scope is the level a setting value is stored at. Tenant values override
+// organization values, which override the registered default.
+type Scope string
+
+const (
+	ScopeOrganization Scope = "organization"
+	ScopeTenant       Scope = "tenant"
+
+	// SourceDefault marks an effective value that comes from the definition.
+	SourceDefault = "default"
+)
+
+var (
+	ErrUnknownKey      = errors.New("unknown setting key")
+	ErrScopeNotAllowed = errors.New("setting cannot be set at this level")
+	ErrInvalidValue    = errors.New("invalid setting value")
+)
+
+// Definition is the registered schema of one setting key.
+type Definition struct {
+	Key         string
+	Description string
+	Type        string
+	Scopes      []Scope
+	Default     json.RawMessage
+	validate    func(json.RawMessage) error
+}
+
+// AllowedAt reports whether the key may be stored at scope.
+func (d *Definition) AllowedAt(scope Scope) bool {
+	return slices.Contains(d.Scopes, scope)
+}
+
+// Validate checks raw against the key's type and constraints.
+func (d *Definition) Validate(raw json.RawMessage) error {
+	if err := d.validate(raw); err != nil {
+		return fmt.Errorf("%w for %s: %v", ErrInvalidValue, d.Key, err)
+	}
+	return nil
+}
+
+// Key is a typed handle on a registered setting, used with Get.
+type Key[T any] struct {
+	def *Definition
+}
+
+func (k Key[T]) Name() string {
+	return k.def.Key
+}
+
+var registry = map[string]*Definition{}
+
+// Define registers a setting key with its default, an optional validator and
+// the scopes it may be stored at. It panics on programmer errors such as a
+// duplicate key or an invalid default, so it is meant for package-level vars.
+func Define[T any](key, description string, def T, validate func(T) error, scopes ...Scope) Key[T] {
+	if _, exists := registry[key]; exists {
+		panic("settings: duplicate key " + key)
+	}
+	if len(scopes) == 0 {
+		panic("settings: no scopes for key " + key)
+	}
+	if validate != nil {
+		if err := validate(def); err != nil {
+			panic(fmt.Sprintf("settings: invalid default for %s: %v", key, err))
+		}
+	}
+
+	raw, err := json.Marshal(def)
+	if err != nil {
+		panic(fmt.Sprintf("settings: cannot encode default for %s: %v", key, err))
+	}
+
+	d := &Definition{
+		Key:         key,
+		Description: description,
+		Type:        typeName(reflect.TypeOf(def)),
+		Scopes:      scopes,
+		Default:     raw,
+		validate: func(raw json.RawMessage) error {
+			var v T
+			if err := json.Unmarshal(raw, &v); err != nil {
+				return fmt.Errorf("expected %s", typeName(reflect.TypeOf(def)))
+			}
+			if validate != nil {
+				return validate(v)
+			}
+			return nil
+		},
+	}
+	registry[key] = d
+	return Key[T]{def: d}
+}
+
+// Lookup returns the definition of key.
+func Lookup(key string) (*Definition, error) {
+	d, ok := registry[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, key)
+	}
+	return d, nil
+}
+
+// Definitions returns every registered definition ordered by key.
+func Definitions() []*Definition {
+	defs := make([]*Definition, 0, len(registry))
+	for _, d := range registry {
+		defs = append(defs, d)
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Key < defs[j].Key })
+	return defs
+}
+
+func typeName(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	default:
+		return "object"
+	}
+}
@@ -0,0 +1,103 @@
+package settings
+
+import (
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Value is the effective value of a key and where it came from.
+type Value struct {
+	Key       string
+	Raw       json.RawMessage
+	Source    string
+	Version   int32
+	UpdatedAt *time.Time
+}
+
+// Values maps every registered key to its effective value.
+type Values map[string]Value
+
+// Get decodes the effective value of key. Stored values that no longer match
+// the registered type fall back to the default.
+func Get[T any](values Values, key Key[T]) T {
+	var v T
+	if value, ok := values[key.Name()]; ok {
+		if err := json.Unmarshal(value.Raw, &v); err == nil {
+			return v
+		}
+	}
+	_ = json.Unmarshal(key.def.Default, &v)
+	return v
+}
+
+// Resolver computes effective settings by layering tenant values over
+// organization values over registered defaults.
+type Resolver struct {
+	settingsRepo repository.SettingsRepository
+	tenantRepo   repository.TenantRepository
+}
+
+func NewResolver(settingsRepo repository.SettingsRepository, tenantRepo repository.TenantRepository) *Resolver {
+	return &Resolver{
+		settingsRepo: settingsRepo,
+		tenantRepo:   tenantRepo,
+	}
+}
+
+// ForOrganization returns the effective values of an organization.
+func (r *Resolver) ForOrganization(ctx context.Context, organizationID uuid.UUID) (Values, error) {
+	values := defaults()
+
+	rows, err := r.settingsRepo.ListOrganizationSettings(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization settings: %w", err)
+	}
+	for _, row := range rows {
+		values.set(row.Key, row.Value, string(ScopeOrganization), row.Version, row.UpdatedAt)
+	}
+	return values, nil
+}
+
+// ForTenant returns the effective values of a tenant, inheriting from its
+// organization.
+func (r *Resolver) ForTenant(ctx context.Context, tenantID uuid.UUID) (Values, error) {
+	tenant, err := r.tenantRepo.GetTenant(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	values, err := r.ForOrganization(ctx, tenant.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.settingsRepo.ListTenantSettings(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tenant settings: %w", err)
+	}
+	for _, row := range rows {
+		values.set(row.Key, row.Value, string(ScopeTenant), row.Version, row.UpdatedAt)
+	}
+	return values, nil
+}
+
+func defaults() Values {
+	values := make(Values, len(registry))
+	for key, def := range registry {
+		values[key] = Value{Key: key, Raw: def.Default, Source: SourceDefault}
+	}
+	return values
+}
+
+// set ignores rows for keys that are no longer registered.
+func (v Values) set(key string, raw json.RawMessage, source string, version int32, updatedAt time.Time) {
+	if _, ok := registry[key]; !ok {
+		return
+	}
+	v[key] = Value{Key: key, Raw: raw, Source: source, Version: version, UpdatedAt: &updatedAt}
+}
@@ -0,0 +1,77 @@
+package tenancy
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"os"
+	"strings"
+)
+
+const (
+	// VerificationRecordPrefix is prepended to a custom domain to form the
+	// name of the TXT record that proves ownership.
+	VerificationRecordPrefix = "_ai-matching-verification"
+	verificationValuePrefix  = "ai-matching-verification="
+)
+
+var ErrInvalidDomain = errors.New("domain must be a fully qualified hostname")
+
+// BaseDomain is the parent domain under which tenant subdomains are served.
+func BaseDomain() string {
+	return strings.Trim(strings.ToLower(os.Getenv("TENANT_BASE_DOMAIN")), ".")
+}
+
+// NormalizeDomain lowercases a hostname, strips a trailing dot and validates
+// it label by label.
+func NormalizeDomain(domain string) (string, error) {
+	domain = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+	if len(domain) == 0 || len(domain) > 253 {
+		return "", ErrInvalidDomain
+	}
+
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return "", ErrInvalidDomain
+	}
+	for _, label := range labels {
+		if !validLabel(label) {
+			return "", ErrInvalidDomain
+		}
+	}
+	return domain, nil
+}
+
+func validLabel(label string) bool {
+	if len(label) == 0 || len(label) > 63 {
+		return false
+	}
+	if label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+	for _, c := range label {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// NewVerificationToken returns a random token for the TXT challenge.
+func NewVerificationToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// VerificationRecordName is the TXT record name customers must create.
+func VerificationRecordName(domain string) string {
+	return VerificationRecordPrefix + "." + domain
+}
+
+// VerificationRecordValue is the TXT record value customers must publish.
+func VerificationRecordValue(token string) string {
+	return verificationValuePrefix + token
+}
@@ -0,0 +1,53 @@
+package tenancy
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"database/sql"
+	"errors"
+	"net"
+	"strings"
+)
+
+// HostResolver maps a request hostname to the tenant it addresses, either
+// <subdomain>.<TENANT_BASE_DOMAIN> (including subdomain aliases kept after a
+// rename) or a verified custom domain.
+type HostResolver struct {
+	baseDomain string
+	tenantRepo repository.TenantRepository
+	domainRepo repository.TenantDomainRepository
+}
+
+func NewHostResolver(tenantRepo repository.TenantRepository, domainRepo repository.TenantDomainRepository) *HostResolver {
+	return &HostResolver{
+		baseDomain: BaseDomain(),
+		tenantRepo: tenantRepo,
+		domainRepo: domainRepo,
+	}
+}
+
+// Resolve returns sql.ErrNoRows when no active tenant owns host.
+func (r *HostResolver) Resolve(ctx context.Context, host string) (db.Tenant, error) {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+
+	if r.baseDomain != "" {
+		if label, ok := strings.CutSuffix(host, "."+r.baseDomain); ok {
+			if label == "" || strings.Contains(label, ".") {
+				return db.Tenant{}, sql.ErrNoRows
+			}
+			tenant, err := r.tenantRepo.GetTenantBySubdomain(ctx, label)
+			if errors.Is(err, sql.ErrNoRows) {
+				// Renamed tenants stay reachable on their previous subdomain
+				// during the alias grace period.
+				return r.tenantRepo.GetTenantBySubdomainAlias(ctx, label)
+			}
+			return tenant, err
+		}
+	}
+
+	return r.domainRepo.GetTenantByVerifiedDomain(ctx, host)
+}
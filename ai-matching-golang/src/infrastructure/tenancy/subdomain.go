@@ -0,0 +1,221 @@
+package tenancy
+
+import (
+	"ai-matching/src/domain/interface/repository"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	minSubdomainLength      = 3
+	maxSubdomainLength      = 63
+	maxSuggestions          = 5
+	defaultAliasGracePeriod = 30 * 24 * time.Hour
+)
+
+var (
+	ErrInvalidSubdomain  = errors.New("subdomain must be 3-63 lowercase letters, digits or hyphens and cannot start or end with a hyphen")
+	ErrReservedSubdomain = errors.New("subdomain is reserved")
+	ErrSubdomainTaken    = errors.New("subdomain is already taken")
+)
+
+// reservedSubdomains are labels the platform serves itself or that would be
+// confusing to hand to a tenant.
+var reservedSubdomains = map[string]struct{}{
+	"www": {}, "api": {}, "admin": {}, "app": {}, "auth": {}, "login": {}, "logout": {},
+	"signup": {}, "register": {}, "account": {}, "accounts": {}, "billing": {},
+	"dashboard": {}, "console": {}, "portal": {}, "docs": {}, "help": {}, "support": {},
+	"status": {}, "blog": {}, "mail": {}, "smtp": {}, "imap": {}, "pop": {}, "ftp": {},
+	"ns1": {}, "ns2": {}, "cdn": {}, "static": {}, "assets": {}, "media": {}, "files": {},
+	"metrics": {}, "health": {}, "system": {}, "root": {}, "dev": {}, "staging": {},
+	"test": {}, "demo": {}, "localhost": {},
+}
+
+// NormalizeSubdomain lowercases name and checks it is a DNS label the
+// platform can serve.
+func NormalizeSubdomain(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if len(name) < minSubdomainLength || len(name) > maxSubdomainLength || !validLabel(name) {
+		return "", ErrInvalidSubdomain
+	}
+	return name, nil
+}
+
+// IsReservedSubdomain reports whether name is on the reserved-word list.
+func IsReservedSubdomain(name string) bool {
+	_, ok := reservedSubdomains[name]
+	return ok
+}
+
+// SubdomainAvailability is the answer to an availability check. Reason is
+// "invalid", "reserved" or "taken" when Available is false.
+type SubdomainAvailability struct {
+	Name        string
+	Available   bool
+	Reason      string
+	Suggestions []string
+}
+
+// SubdomainRegistry validates subdomain claims and keeps renamed subdomains
+// as redirect aliases for SUBDOMAIN_ALIAS_GRACE_PERIOD (default 30 days).
+type SubdomainRegistry struct {
+	tenantRepo  repository.TenantRepository
+	gracePeriod time.Duration
+	now         func() time.Time
+}
+
+func NewSubdomainRegistry(tenantRepo repository.TenantRepository) *SubdomainRegistry {
+	gracePeriod := defaultAliasGracePeriod
+	if v := os.Getenv("SUBDOMAIN_ALIAS_GRACE_PERIOD"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			gracePeriod = d
+		} else {
+			slog.Warn("ignoring invalid SUBDOMAIN_ALIAS_GRACE_PERIOD", "value", v)
+		}
+	}
+
+	return &SubdomainRegistry{
+		tenantRepo:  tenantRepo,
+		gracePeriod: gracePeriod,
+		now:         time.Now,
+	}
+}
+
+// Claim validates name for tenantID (uuid.Nil for a new tenant) and returns
+// the normalized subdomain. Aliases the tenant itself holds do not block it.
+func (r *SubdomainRegistry) Claim(ctx context.Context, name string, tenantID uuid.UUID) (string, error) {
+	subdomain, err := NormalizeSubdomain(name)
+	if err != nil {
+		return "", err
+	}
+	if IsReservedSubdomain(subdomain) {
+		return "", ErrReservedSubdomain
+	}
+
+	taken, err := r.tenantRepo.ListTakenSubdomains(ctx, []string{subdomain}, tenantID)
+	if err != nil {
+		return "", fmt.Errorf("failed to check subdomain: %w", err)
+	}
+	if len(taken) > 0 {
+		return "", ErrSubdomainTaken
+	}
+	return subdomain, nil
+}
+
+// Availability checks name and, when it cannot be used, suggests free
+// alternatives derived from it.
+func (r *SubdomainRegistry) Availability(ctx context.Context, name string) (*SubdomainAvailability, error) {
+	result := &SubdomainAvailability{Name: strings.ToLower(strings.TrimSpace(name)), Suggestions: []string{}}
+
+	_, err := r.Claim(ctx, name, uuid.Nil)
+	switch {
+	case err == nil:
+		result.Available = true
+		return result, nil
+	case errors.Is(err, ErrInvalidSubdomain):
+		result.Reason = "invalid"
+	case errors.Is(err, ErrReservedSubdomain):
+		result.Reason = "reserved"
+	case errors.Is(err, ErrSubdomainTaken):
+		result.Reason = "taken"
+	default:
+		return nil, err
+	}
+
+	suggestions, err := r.suggest(ctx, result.Name)
+	if err != nil {
+		return nil, err
+	}
+	result.Suggestions = suggestions
+	return result, nil
+}
+
+// Rename records oldSubdomain as an alias of tenantID after the tenant moved
+// to newSubdomain, and drops any alias the tenant held on newSubdomain.
+func (r *SubdomainRegistry) Rename(ctx context.Context, tenantID uuid.UUID, oldSubdomain, newSubdomain string) error {
+	if oldSubdomain == newSubdomain {
+		return nil
+	}
+	if err := r.tenantRepo.DeleteSubdomainAlias(ctx, newSubdomain); err != nil {
+		return fmt.Errorf("failed to release subdomain alias: %w", err)
+	}
+	if r.gracePeriod == 0 {
+		return nil
+	}
+	if err := r.tenantRepo.UpsertSubdomainAlias(ctx, oldSubdomain, tenantID, r.now().Add(r.gracePeriod)); err != nil {
+		return fmt.Errorf("failed to keep previous subdomain as alias: %w", err)
+	}
+	return nil
+}
+
+func (r *SubdomainRegistry) suggest(ctx context.Context, name string) ([]string, error) {
+	base := slugify(name)
+	if len(base) < minSubdomainLength {
+		return []string{}, nil
+	}
+
+	var candidates []string
+	if base != name && len(base) <= maxSubdomainLength && !IsReservedSubdomain(base) {
+		candidates = append(candidates, base)
+	}
+	for _, suffix := range []string{"-clinic", "-jp", "-2", "-3", "-4", "-5", "-6", "-7", "-8", "-9"} {
+		stem := base
+		if len(stem)+len(suffix) > maxSubdomainLength {
+			stem = strings.TrimRight(stem[:maxSubdomainLength-len(suffix)], "-")
+		}
+		candidate := stem + suffix
+		if _, err := NormalizeSubdomain(candidate); err != nil || IsReservedSubdomain(candidate) {
+			continue
+		}
+		candidates = append(candidates, candidate)
+	}
+	if len(candidates) == 0 {
+		return []string{}, nil
+	}
+
+	taken, err := r.tenantRepo.ListTakenSubdomains(ctx, candidates, uuid.Nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check suggestions: %w", err)
+	}
+	takenSet := make(map[string]struct{}, len(taken))
+	for _, t := range taken {
+		takenSet[t] = struct{}{}
+	}
+
+	suggestions := []string{}
+	for _, candidate := range candidates {
+		if _, ok := takenSet[candidate]; ok {
+			continue
+		}
+		suggestions = append(suggestions, candidate)
+		if len(suggestions) == maxSuggestions {
+			break
+		}
+	}
+	return suggestions, nil
+}
+
+// slugify turns arbitrary input into a hyphen-separated DNS label stem.
+func slugify(name string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, c := range strings.ToLower(name) {
+		if (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') {
+			b.WriteRune(c)
+			hyphen = false
+			continue
+		}
+		if !hyphen && b.Len() > 0 {
+			b.WriteByte('-')
+			hyphen = true
+		}
+	}
+	return strings.Trim(b.String(), "-")
+}
@@ -0,0 +1,117 @@
+package textextract
+
+import (
+	"errors"
+	"mime"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Formats recognized by Extract
+const (
+	FormatText     = "text"
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+	FormatPDF      = "pdf"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported document format")
+	ErrNoText            = errors.New("no extractable text found")
+)
+
+// Detect returns the format of a file from its declared content type,
+// falling back to the file extension.
+func Detect(contentType, filename string) (string, error) {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "text/markdown", "text/x-markdown":
+		return FormatMarkdown, nil
+	case "text/html", "application/xhtml+xml":
+		return FormatHTML, nil
+	case "application/pdf":
+		return FormatPDF, nil
+	}
+
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".txt", ".text":
+		return FormatText, nil
+	case ".md", ".markdown":
+		return FormatMarkdown, nil
+	case ".html", ".htm", ".xhtml":
+		return FormatHTML, nil
+	case ".pdf":
+		return FormatPDF, nil
+	}
+
+	if mediaType == "text/plain" {
+		return FormatText, nil
+	}
+	return "", ErrUnsupportedFormat
+}
+
+// Extract returns the plain text of a document. Markdown is kept as is so
+// that its headings can still be used when the text is split up.
+func Extract(format string, data []byte) (string, error) {
+	var text string
+	switch format {
+	case FormatText, FormatMarkdown:
+		text = decodeText(data)
+	case FormatHTML:
+		var err error
+		if text, err = extractHTML(data); err != nil {
+			return "", err
+		}
+	case FormatPDF:
+		var err error
+		if text, err = extractPDF(data); err != nil {
+			return "", err
+		}
+	default:
+		return "", ErrUnsupportedFormat
+	}
+
+	text = normalize(text)
+	if text == "" {
+		return "", ErrNoText
+	}
+	return text, nil
+}
+
+// decodeText strips a UTF-8 byte order mark and replaces invalid sequences.
+func decodeText(data []byte) string {
+	s := strings.TrimPrefix(string(data), "\ufeff")
+	if !utf8.ValidString(s) {
+		s = strings.ToValidUTF8(s, "\uFFFD")
+	}
+	return s
+}
+
+var (
+	spaceRun   = regexp.MustCompile(`[ \t\f\v\x{00A0}]+`)
+	newlineRun = regexp.MustCompile(`\n{3,}`)
+)
+
+// normalize unifies line endings, drops control characters, trims trailing
+// spaces and collapses runs of blank lines.
+func normalize(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.ReplaceAll(s, "\r", "\n")
+	s = strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\t' || !unicode.IsControl(r) {
+			return r
+		}
+		return -1
+	}, s)
+
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRightFunc(spaceRun.ReplaceAllString(line, " "), unicode.IsSpace)
+	}
+	s = strings.Join(lines, "\n")
+	s = newlineRun.ReplaceAllString(s, "\n\n")
+	return strings.TrimSpace(s)
+}
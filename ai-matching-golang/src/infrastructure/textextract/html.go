@@ -0,0 +1,112 @@
+package textextract
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// skippedElements never contain readable text.
+var skippedElements = map[atom.Atom]bool{
+	atom.Script:   true,
+	atom.Style:    true,
+	atom.Noscript: true,
+	atom.Template: true,
+	atom.Svg:      true,
+	atom.Iframe:   true,
+}
+
+// blockElements start a new line so paragraphs, list items and table rows
+// stay apart.
+var blockElements = map[atom.Atom]bool{
+	atom.P: true, atom.Div: true, atom.Br: true, atom.Hr: true,
+	atom.H1: true, atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true, atom.H6: true,
+	atom.Li: true, atom.Ul: true, atom.Ol: true, atom.Dl: true, atom.Dt: true, atom.Dd: true,
+	atom.Tr: true, atom.Table: true, atom.Blockquote: true, atom.Pre: true,
+	atom.Section: true, atom.Article: true, atom.Header: true, atom.Footer: true,
+	atom.Main: true, atom.Nav: true, atom.Aside: true, atom.Figure: true, atom.Figcaption: true,
+	atom.Title: true,
+}
+
+func extractHTML(data []byte) (string, error) {
+	z := html.NewTokenizer(bytes.NewReader(data))
+
+	var b strings.Builder
+	var title string
+	skipDepth := 0
+	inTitle := false
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			if errors.Is(z.Err(), io.EOF) {
+				lines := strings.Split(b.String(), "\n")
+				for i, line := range lines {
+					lines[i] = strings.TrimSpace(line)
+				}
+				text := strings.Join(lines, "\n")
+				// The title is kept apart so it always leads the text
+				if title != "" {
+					text = title + "\n\n" + text
+				}
+				return text, nil
+			}
+			return "", z.Err()
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, _ := z.TagName()
+			a := atom.Lookup(name)
+			if a == atom.Title {
+				inTitle = true
+			}
+			if skippedElements[a] {
+				if z.Token().Type == html.StartTagToken {
+					skipDepth++
+				}
+				continue
+			}
+			if blockElements[a] {
+				b.WriteString("\n")
+			}
+			if a == atom.Td || a == atom.Th {
+				b.WriteString("\t")
+			}
+
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			a := atom.Lookup(name)
+			if a == atom.Title {
+				inTitle = false
+			}
+			if skippedElements[a] {
+				if skipDepth > 0 {
+					skipDepth--
+				}
+				continue
+			}
+			if blockElements[a] {
+				b.WriteString("\n")
+			}
+
+		case html.TextToken:
+			text := string(z.Text())
+			if inTitle {
+				title = strings.TrimSpace(text)
+				continue
+			}
+			if skipDepth > 0 {
+				continue
+			}
+			if strings.HasPrefix(text, " ") || strings.HasPrefix(text, "\n") {
+				b.WriteString(" ")
+			}
+			b.WriteString(strings.Join(strings.Fields(text), " "))
+			if strings.HasSuffix(text, " ") || strings.HasSuffix(text, "\n") {
+				b.WriteString(" ")
+			}
+		}
+	}
+}
@@ -0,0 +1,471 @@
+package textextract
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// maxInflatedStream bounds a single decompressed stream so a small crafted
+// file cannot expand without limit.
+const maxInflatedStream = 32 << 20
+
+// maxInflatedTotal bounds the decompressed data of the whole document, since
+// many streams that each stay under maxInflatedStream still add up.
+const maxInflatedTotal = 128 << 20
+
+// maxArrayDepth bounds nested arrays in a content stream. Real text arrays
+// are flat; deep nesting only comes from files built to exhaust the stack.
+const maxArrayDepth = 64
+
+var (
+	ErrEncryptedPDF = errors.New("encrypted PDFs are not supported")
+	errPDFNesting   = errors.New("PDF content stream nests arrays too deeply")
+	errPDFInflated  = errors.New("PDF streams decompress to more data than allowed")
+)
+
+// extractPDF pulls the text drawn by the text operators of every page content
+// stream. It handles uncompressed and FlateDecode streams and simple font
+// encodings; scanned pages and fonts with custom CMaps yield no text.
+func extractPDF(data []byte) (string, error) {
+	if !bytes.HasPrefix(bytes.TrimLeft(data, "\x00\t\n\r "), []byte("%PDF-")) {
+		return "", errors.New("not a PDF file")
+	}
+	if bytes.Contains(data, []byte("/Encrypt")) {
+		return "", ErrEncryptedPDF
+	}
+
+	var b strings.Builder
+	err := eachPDFStream(data, func(content []byte) error {
+		if !bytes.Contains(content, []byte("BT")) {
+			return nil
+		}
+		text, err := pdfContentText(content)
+		if err != nil {
+			return err
+		}
+		if strings.TrimSpace(text) == "" {
+			return nil
+		}
+		b.WriteString(text)
+		b.WriteString("\n\n")
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// eachPDFStream passes the decoded data of every stream that may hold page
+// content to fn, one at a time, skipping images, fonts and cross-reference
+// data. It fails once the inflated streams exceed maxInflatedTotal.
+func eachPDFStream(data []byte, fn func(content []byte) error) error {
+	budget := int64(maxInflatedTotal)
+	offset := 0
+	for {
+		i := bytes.Index(data[offset:], []byte("stream"))
+		if i < 0 {
+			return nil
+		}
+		keyword := offset + i
+		start := keyword + len("stream")
+		offset = start
+
+		// Skip "endstream" and the keyword inside other tokens
+		if keyword >= 3 && string(data[keyword-3:keyword]) == "end" {
+			continue
+		}
+		switch {
+		case bytes.HasPrefix(data[start:], []byte("\r\n")):
+			start += 2
+		case bytes.HasPrefix(data[start:], []byte("\n")), bytes.HasPrefix(data[start:], []byte("\r")):
+			start++
+		default:
+			continue
+		}
+
+		end := bytes.Index(data[start:], []byte("endstream"))
+		if end < 0 {
+			return nil
+		}
+		raw := bytes.TrimRight(data[start:start+end], "\r\n")
+		offset = start + end + len("endstream")
+
+		dict := streamDict(data[:keyword])
+		if skipStream(dict) {
+			continue
+		}
+
+		if bytes.Contains(dict, []byte("/FlateDecode")) {
+			inflated, ok := inflate(raw, budget)
+			if int64(len(inflated)) > budget {
+				return errPDFInflated
+			}
+			budget -= int64(len(inflated))
+			if !ok {
+				continue
+			}
+			raw = inflated
+		} else if bytes.Contains(dict, []byte("/Filter")) {
+			// Other filters are used for images and are not worth decoding
+			continue
+		}
+		if err := fn(raw); err != nil {
+			return err
+		}
+	}
+}
+
+// streamDict returns the dictionary that precedes a stream keyword.
+func streamDict(before []byte) []byte {
+	if i := bytes.LastIndex(before, []byte("obj")); i >= 0 {
+		return before[i:]
+	}
+	return nil
+}
+
+func skipStream(dict []byte) bool {
+	for _, marker := range []string{"/Image", "/XRef", "/ObjStm", "/Metadata", "/Length1", "/Length2", "/Length3", "/FontFile", "/ICCBased", "/EmbeddedFile"} {
+		if bytes.Contains(dict, []byte(marker)) {
+			return true
+		}
+	}
+	return false
+}
+
+// inflate decompresses a FlateDecode stream, keeping whatever was decoded
+// before a truncated or corrupt tail. It reads one byte past budget so the
+// caller can tell when the document's budget has run out.
+func inflate(raw []byte, budget int64) ([]byte, bool) {
+	r, err := zlib.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, false
+	}
+	defer r.Close()
+
+	out, _ := io.ReadAll(io.LimitReader(r, min(maxInflatedStream, budget+1)))
+	return out, len(out) > 0
+}
+
+// pdfContentText interprets the text operators of a content stream.
+func pdfContentText(content []byte) (string, error) {
+	var b strings.Builder
+	var operands []pdfOperand
+	lex := &pdfLexer{data: content}
+
+	newline := func() {
+		if b.Len() > 0 && !strings.HasSuffix(b.String(), "\n") {
+			b.WriteString("\n")
+		}
+	}
+
+	for {
+		tok, ok := lex.next()
+		if lex.err != nil {
+			return "", lex.err
+		}
+		if !ok {
+			break
+		}
+		if tok.op == "" {
+			operands = append(operands, tok)
+			continue
+		}
+
+		switch tok.op {
+		case "Tj":
+			if s, ok := lastString(operands); ok {
+				b.WriteString(s)
+			}
+		case "'", "\"":
+			newline()
+			if s, ok := lastString(operands); ok {
+				b.WriteString(s)
+			}
+		case "TJ":
+			if len(operands) > 0 {
+				for _, item := range operands[len(operands)-1].array {
+					if item.isString {
+						b.WriteString(item.str)
+					} else if item.num < -200 {
+						// A large negative adjustment is how many PDFs space words
+						b.WriteString(" ")
+					}
+				}
+			}
+		case "Td", "TD":
+			if len(operands) >= 2 && operands[len(operands)-1].num != 0 {
+				newline()
+			} else {
+				b.WriteString(" ")
+			}
+		case "T*", "ET":
+			newline()
+		case "Tm":
+			newline()
+		}
+		operands = operands[:0]
+	}
+	return b.String(), nil
+}
+
+func lastString(operands []pdfOperand) (string, bool) {
+	if len(operands) == 0 || !operands[len(operands)-1].isString {
+		return "", false
+	}
+	return operands[len(operands)-1].str, true
+}
+
+// pdfOperand is a lexed content stream token. op is set for operators.
+type pdfOperand struct {
+	op       string
+	isString bool
+	str      string
+	num      float64
+	array    []pdfOperand
+}
+
+type pdfLexer struct {
+	data  []byte
+	pos   int
+	depth int
+	err   error
+}
+
+func (l *pdfLexer) next() (pdfOperand, bool) {
+	l.skipSpace()
+	if l.pos >= len(l.data) {
+		return pdfOperand{}, false
+	}
+
+	c := l.data[l.pos]
+	switch {
+	case c == '(':
+		return pdfOperand{isString: true, str: decodePDFString(l.literal())}, true
+	case c == '<' && l.peek(1) == '<':
+		l.pos += 2
+		l.skipDict()
+		return pdfOperand{}, true
+	case c == '<':
+		return pdfOperand{isString: true, str: decodePDFString(l.hex())}, true
+	case c == '[':
+		if l.depth >= maxArrayDepth {
+			l.err = errPDFNesting
+			return pdfOperand{}, false
+		}
+		l.depth++
+		defer func() { l.depth-- }()
+
+		l.pos++
+		var items []pdfOperand
+		for {
+			l.skipSpace()
+			if l.pos >= len(l.data) {
+				break
+			}
+			if l.data[l.pos] == ']' {
+				l.pos++
+				break
+			}
+			item, ok := l.next()
+			if l.err != nil {
+				return pdfOperand{}, false
+			}
+			if !ok {
+				break
+			}
+			items = append(items, item)
+		}
+		return pdfOperand{array: items}, true
+	case c == '/':
+		l.pos++
+		l.word()
+		return pdfOperand{}, true
+	case c == ']' || c == '>' || c == ')' || c == '{' || c == '}':
+		l.pos++
+		return pdfOperand{}, true
+	}
+
+	w := l.word()
+	if w == "" {
+		l.pos++
+		return pdfOperand{}, true
+	}
+	if n, err := strconv.ParseFloat(w, 64); err == nil {
+		return pdfOperand{num: n}, true
+	}
+	if w == "BI" {
+		// Inline image data is binary; skip to its end marker
+		if i := bytes.Index(l.data[l.pos:], []byte("EI")); i >= 0 {
+			l.pos += i + 2
+		} else {
+			l.pos = len(l.data)
+		}
+		return pdfOperand{}, true
+	}
+	return pdfOperand{op: w}, true
+}
+
+func (l *pdfLexer) peek(n int) byte {
+	if l.pos+n < len(l.data) {
+		return l.data[l.pos+n]
+	}
+	return 0
+}
+
+func (l *pdfLexer) skipSpace() {
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		if c == '%' {
+			for l.pos < len(l.data) && l.data[l.pos] != '\n' && l.data[l.pos] != '\r' {
+				l.pos++
+			}
+			continue
+		}
+		if !isPDFSpace(c) {
+			return
+		}
+		l.pos++
+	}
+}
+
+func (l *pdfLexer) skipDict() {
+	depth := 1
+	for l.pos < len(l.data) && depth > 0 {
+		switch {
+		case l.data[l.pos] == '<' && l.peek(1) == '<':
+			depth++
+			l.pos += 2
+		case l.data[l.pos] == '>' && l.peek(1) == '>':
+			depth--
+			l.pos += 2
+		case l.data[l.pos] == '(':
+			l.literal()
+		default:
+			l.pos++
+		}
+	}
+}
+
+func (l *pdfLexer) word() string {
+	start := l.pos
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		if isPDFSpace(c) || strings.IndexByte("()<>[]{}/%", c) >= 0 {
+			break
+		}
+		l.pos++
+	}
+	return string(l.data[start:l.pos])
+}
+
+// literal reads a (...) string, resolving escapes and balanced parentheses.
+func (l *pdfLexer) literal() []byte {
+	l.pos++ // (
+	var out []byte
+	depth := 1
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		l.pos++
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return out
+			}
+		case '\\':
+			if l.pos >= len(l.data) {
+				return out
+			}
+			e := l.data[l.pos]
+			l.pos++
+			switch e {
+			case 'n':
+				out = append(out, '\n')
+			case 'r':
+				out = append(out, '\r')
+			case 't':
+				out = append(out, '\t')
+			case 'b':
+				out = append(out, '\b')
+			case 'f':
+				out = append(out, '\f')
+			case '\r':
+				if l.pos < len(l.data) && l.data[l.pos] == '\n' {
+					l.pos++
+				}
+			case '\n':
+			default:
+				if e >= '0' && e <= '7' {
+					v := int(e - '0')
+					for i := 0; i < 2 && l.pos < len(l.data) && l.data[l.pos] >= '0' && l.data[l.pos] <= '7'; i++ {
+						v = v*8 + int(l.data[l.pos]-'0')
+						l.pos++
+					}
+					out = append(out, byte(v))
+				} else {
+					out = append(out, e)
+				}
+			}
+			continue
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+// hex reads a <...> string.
+func (l *pdfLexer) hex() []byte {
+	l.pos++ // <
+	var digits []byte
+	for l.pos < len(l.data) && l.data[l.pos] != '>' {
+		if c := l.data[l.pos]; !isPDFSpace(c) {
+			digits = append(digits, c)
+		}
+		l.pos++
+	}
+	l.pos++ // >
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+
+	out := make([]byte, 0, len(digits)/2)
+	for i := 0; i < len(digits); i += 2 {
+		v, err := strconv.ParseUint(string(digits[i:i+2]), 16, 8)
+		if err != nil {
+			return out
+		}
+		out = append(out, byte(v))
+	}
+	return out
+}
+
+// decodePDFString decodes UTF-16BE strings (with a byte order mark) and
+// treats everything else as Latin-1, which matches PDFDocEncoding for
+// printable characters.
+func decodePDFString(s []byte) string {
+	if len(s) >= 2 && s[0] == 0xFE && s[1] == 0xFF {
+		units := make([]uint16, 0, len(s)/2)
+		for i := 2; i+1 < len(s); i += 2 {
+			units = append(units, uint16(s[i])<<8|uint16(s[i+1]))
+		}
+		return string(utf16.Decode(units))
+	}
+
+	runes := make([]rune, len(s))
+	for i, c := range s {
+		runes[i] = rune(c)
+	}
+	return string(runes)
+}
+
+func isPDFSpace(c byte) bool {
+	return c == ' ' || c == '\n' || c == '\r' || c == '\t' || c == '\f' || c == 0
+}
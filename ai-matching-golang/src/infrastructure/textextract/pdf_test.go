@@ -0,0 +1,76 @@
+package textextract
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// buildPDF wraps each stream in a FlateDecode stream object
+func buildPDF(t *testing.T, streams ...[]byte) []byte {
+	t.Helper()
+
+	var b bytes.Buffer
+	b.WriteString("%PDF-1.4\n")
+	for i, stream := range streams {
+		var compressed bytes.Buffer
+		w, err := zlib.NewWriterLevel(&compressed, zlib.BestCompression)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write(stream); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		fmt.Fprintf(&b, "%d 0 obj\n<< /Length %d /Filter /FlateDecode >>\nstream\n", i+1, compressed.Len())
+		b.Write(compressed.Bytes())
+		b.WriteString("\nendstream\nendobj\n")
+	}
+	b.WriteString("%%EOF\n")
+	return b.Bytes()
+}
+
+func TestExtractPDF(t *testing.T) {
+	data := buildPDF(t, []byte("BT /F1 12 Tf (Hello) Tj ET"), []byte("BT [(Wor) -20 (ld)] TJ ET"))
+
+	text, err := extractPDF(data)
+	if err != nil {
+		t.Fatalf("extractPDF() error = %v", err)
+	}
+	if !strings.Contains(text, "Hello") || !strings.Contains(text, "World") {
+		t.Errorf("extractPDF() = %q, want Hello and World", text)
+	}
+}
+
+func TestExtractPDFInflateBudget(t *testing.T) {
+	// Each stream stays under maxInflatedStream and compresses to a few KiB,
+	// but together they inflate past maxInflatedTotal
+	stream := bytes.Repeat([]byte{' '}, 8<<20)
+	streams := make([][]byte, maxInflatedTotal/len(stream)+1)
+	for i := range streams {
+		streams[i] = stream
+	}
+	data := buildPDF(t, streams...)
+	if len(data) > 1<<20 {
+		t.Fatalf("test PDF is %d bytes, want a small upload", len(data))
+	}
+
+	if _, err := extractPDF(data); !errors.Is(err, errPDFInflated) {
+		t.Errorf("extractPDF() error = %v, want %v", err, errPDFInflated)
+	}
+}
+
+func TestExtractPDFNesting(t *testing.T) {
+	content := "BT " + strings.Repeat("[", maxArrayDepth+1) + " ET"
+	data := buildPDF(t, []byte(content))
+
+	if _, err := extractPDF(data); !errors.Is(err, errPDFNesting) {
+		t.Errorf("extractPDF() error = %v, want %v", err, errPDFNesting)
+	}
+}
@@ -0,0 +1,121 @@
+// Command querygen generates the tracing decorator for the sqlc Querier
+// interface. Run it through go generate after `sqlc generate`.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+)
+
+func main() {
+	in := flag.String("in", "", "path to the sqlc querier.go")
+	out := flag.String("out", "", "output file")
+	pkg := flag.String("pkg", "tracing", "package name of the generated file")
+	dbImport := flag.String("db", "ai-matching/db/sqlc", "import path of the sqlc package")
+	flag.Parse()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, *in, nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	querier := findQuerier(file)
+	if querier == nil {
+		log.Fatal("Querier interface not found")
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by querygen. DO NOT EDIT.\n\npackage %s\n\nimport (\n", *pkg)
+	fmt.Fprintf(&buf, "\tdb %q\n", *dbImport)
+	for _, imp := range file.Imports {
+		buf.WriteString("\t" + imp.Path.Value + "\n")
+	}
+	buf.WriteString(")\n\n")
+
+	for _, method := range querier.Methods.List {
+		fn := method.Type.(*ast.FuncType)
+		qualify(fn)
+		writeMethod(&buf, fset, method.Names[0].Name, fn)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("format generated code: %v\n%s", err, buf.String())
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func findQuerier(file *ast.File) *ast.InterfaceType {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if ok && ts.Name.Name == "Querier" {
+				return ts.Type.(*ast.InterfaceType)
+			}
+		}
+	}
+	return nil
+}
+
+// qualify prefixes types declared in the sqlc package with "db.".
+func qualify(fn *ast.FuncType) {
+	ast.Inspect(fn, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.SelectorExpr:
+			return false
+		case *ast.Ident:
+			if ast.IsExported(node.Name) {
+				node.Name = "db." + node.Name
+			}
+		}
+		return true
+	})
+}
+
+func writeMethod(buf *bytes.Buffer, fset *token.FileSet, name string, fn *ast.FuncType) {
+	var params, args []string
+	for _, field := range fn.Params.List {
+		typ := render(fset, field.Type)
+		for _, n := range field.Names {
+			params = append(params, n.Name+" "+typ)
+			args = append(args, n.Name)
+		}
+	}
+
+	var results []string
+	for _, field := range fn.Results.List {
+		results = append(results, render(fset, field.Type))
+	}
+
+	fmt.Fprintf(buf, "func (q *tracedQuerier) %s(%s) (%s) {\n", name, strings.Join(params, ", "), strings.Join(results, ", "))
+	fmt.Fprintf(buf, "\tctx, span := startQuerySpan(ctx, %q)\n", name)
+	if len(results) == 1 {
+		fmt.Fprintf(buf, "\terr := q.next.%s(%s)\n\tendQuerySpan(span, err)\n\treturn err\n}\n\n", name, strings.Join(args, ", "))
+		return
+	}
+	fmt.Fprintf(buf, "\tresult, err := q.next.%s(%s)\n\tendQuerySpan(span, err)\n\treturn result, err\n}\n\n", name, strings.Join(args, ", "))
+}
+
+func render(fset *token.FileSet, expr ast.Expr) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, expr); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
@@ -0,0 +1,43 @@
+package tracing
+
+import (
+	db "ai-matching/db/sqlc"
+	"context"
+	"database/sql"
+	"errors"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+//go:generate go run ./internal/querygen -in ../../../db/sqlc/querier.go -out querier_gen.go
+
+// tracedQuerier decorates db.Querier with one client span per query. The
+// methods live in querier_gen.go and are regenerated whenever sqlc output
+// changes.
+type tracedQuerier struct {
+	next db.Querier
+}
+
+func NewTracedQuerier(next db.Querier) db.Querier {
+	return &tracedQuerier{next: next}
+}
+
+func startQuerySpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, "db."+name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBOperationNameKey.String(name),
+		),
+	)
+}
+
+// endQuerySpan does not flag sql.ErrNoRows as a failure; lookups that find
+// nothing are an expected outcome for many callers.
+func endQuerySpan(span trace.Span, err error) {
+	if errors.Is(err, sql.ErrNoRows) {
+		err = nil
+	}
+	End(span, err)
+}
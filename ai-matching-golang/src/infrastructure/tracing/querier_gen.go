@@ -0,0 +1,1508 @@
+// Code generated by querygen. DO NOT EDIT.
+
+package tracing
+
+import (
+	db "ai-matching/db/sqlc"
+	"context"
+	"github.com/google/uuid"
+	"time"
+)
+
+func (q *tracedQuerier) AcceptOwnershipTransfer(ctx context.Context, arg db.AcceptOwnershipTransferParams) (db.OrganizationOwnershipTransfer, error) {
+	ctx, span := startQuerySpan(ctx, "AcceptOwnershipTransfer")
+	result, err := q.next.AcceptOwnershipTransfer(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) AddOrganizationMember(ctx context.Context, arg db.AddOrganizationMemberParams) (db.OrganizationMember, error) {
+	ctx, span := startQuerySpan(ctx, "AddOrganizationMember")
+	result, err := q.next.AddOrganizationMember(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) AddUserToTenant(ctx context.Context, arg db.AddUserToTenantParams) (db.TenantUser, error) {
+	ctx, span := startQuerySpan(ctx, "AddUserToTenant")
+	result, err := q.next.AddUserToTenant(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) BuryJob(ctx context.Context, arg db.BuryJobParams) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "BuryJob")
+	result, err := q.next.BuryJob(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) BuryStaleJobs(ctx context.Context, staleBefore time.Time) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "BuryStaleJobs")
+	result, err := q.next.BuryStaleJobs(ctx, staleBefore)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CancelMemberOwnershipTransfers(ctx context.Context, arg db.CancelMemberOwnershipTransfersParams) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "CancelMemberOwnershipTransfers")
+	result, err := q.next.CancelMemberOwnershipTransfers(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CancelOwnershipTransfer(ctx context.Context, arg db.CancelOwnershipTransferParams) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "CancelOwnershipTransfer")
+	result, err := q.next.CancelOwnershipTransfer(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CancelPendingOwnershipTransfers(ctx context.Context, organizationID uuid.UUID) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "CancelPendingOwnershipTransfers")
+	result, err := q.next.CancelPendingOwnershipTransfers(ctx, organizationID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CheckUserBelongsToTenant(ctx context.Context, arg db.CheckUserBelongsToTenantParams) (bool, error) {
+	ctx, span := startQuerySpan(ctx, "CheckUserBelongsToTenant")
+	result, err := q.next.CheckUserBelongsToTenant(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ClaimJobs(ctx context.Context, arg db.ClaimJobsParams) ([]db.Job, error) {
+	ctx, span := startQuerySpan(ctx, "ClaimJobs")
+	result, err := q.next.ClaimJobs(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ClearOrganizationExportStorage(ctx context.Context, id uuid.UUID) error {
+	ctx, span := startQuerySpan(ctx, "ClearOrganizationExportStorage")
+	err := q.next.ClearOrganizationExportStorage(ctx, id)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) CompleteDocumentVersionIndexing(ctx context.Context, arg db.CompleteDocumentVersionIndexingParams) error {
+	ctx, span := startQuerySpan(ctx, "CompleteDocumentVersionIndexing")
+	err := q.next.CompleteDocumentVersionIndexing(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) CompleteJob(ctx context.Context, arg db.CompleteJobParams) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "CompleteJob")
+	result, err := q.next.CompleteJob(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CompleteOrganizationExport(ctx context.Context, arg db.CompleteOrganizationExportParams) error {
+	ctx, span := startQuerySpan(ctx, "CompleteOrganizationExport")
+	err := q.next.CompleteOrganizationExport(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) CountConversations(ctx context.Context, arg db.CountConversationsParams) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "CountConversations")
+	result, err := q.next.CountConversations(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CountDocuments(ctx context.Context, tenantID uuid.UUID) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "CountDocuments")
+	result, err := q.next.CountDocuments(ctx, tenantID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CountMatchCandidates(ctx context.Context, tenantID uuid.UUID) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "CountMatchCandidates")
+	result, err := q.next.CountMatchCandidates(ctx, tenantID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CountMatchOpenings(ctx context.Context, tenantID uuid.UUID) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "CountMatchOpenings")
+	result, err := q.next.CountMatchOpenings(ctx, tenantID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CountOrganizationJobs(ctx context.Context, arg db.CountOrganizationJobsParams) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "CountOrganizationJobs")
+	result, err := q.next.CountOrganizationJobs(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CountOrganizationMonthlyActiveUsers(ctx context.Context, arg db.CountOrganizationMonthlyActiveUsersParams) ([]db.CountOrganizationMonthlyActiveUsersRow, error) {
+	ctx, span := startQuerySpan(ctx, "CountOrganizationMonthlyActiveUsers")
+	result, err := q.next.CountOrganizationMonthlyActiveUsers(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CountOrganizations(ctx context.Context) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "CountOrganizations")
+	result, err := q.next.CountOrganizations(ctx)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CountTaskRuns(ctx context.Context, taskName string) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "CountTaskRuns")
+	result, err := q.next.CountTaskRuns(ctx, taskName)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CountTenantMonthlyActiveUsers(ctx context.Context, arg db.CountTenantMonthlyActiveUsersParams) ([]db.CountTenantMonthlyActiveUsersRow, error) {
+	ctx, span := startQuerySpan(ctx, "CountTenantMonthlyActiveUsers")
+	result, err := q.next.CountTenantMonthlyActiveUsers(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CountTenantUsers(ctx context.Context, tenantID uuid.UUID) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "CountTenantUsers")
+	result, err := q.next.CountTenantUsers(ctx, tenantID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CountTenantsByOrganization(ctx context.Context, organizationID uuid.UUID) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "CountTenantsByOrganization")
+	result, err := q.next.CountTenantsByOrganization(ctx, organizationID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CountUsers(ctx context.Context) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "CountUsers")
+	result, err := q.next.CountUsers(ctx)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CountUsersNotInTenant(ctx context.Context, tenantID uuid.UUID) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "CountUsersNotInTenant")
+	result, err := q.next.CountUsersNotInTenant(ctx, tenantID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CountWebhookDeliveries(ctx context.Context, arg db.CountWebhookDeliveriesParams) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "CountWebhookDeliveries")
+	result, err := q.next.CountWebhookDeliveries(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CreateConversation(ctx context.Context, arg db.CreateConversationParams) (db.Conversation, error) {
+	ctx, span := startQuerySpan(ctx, "CreateConversation")
+	result, err := q.next.CreateConversation(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CreateDocument(ctx context.Context, arg db.CreateDocumentParams) (db.Document, error) {
+	ctx, span := startQuerySpan(ctx, "CreateDocument")
+	result, err := q.next.CreateDocument(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CreateDocumentChunk(ctx context.Context, arg db.CreateDocumentChunkParams) error {
+	ctx, span := startQuerySpan(ctx, "CreateDocumentChunk")
+	err := q.next.CreateDocumentChunk(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) CreateDocumentVersion(ctx context.Context, arg db.CreateDocumentVersionParams) (db.DocumentVersion, error) {
+	ctx, span := startQuerySpan(ctx, "CreateDocumentVersion")
+	result, err := q.next.CreateDocumentVersion(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CreateJob(ctx context.Context, arg db.CreateJobParams) (db.Job, error) {
+	ctx, span := startQuerySpan(ctx, "CreateJob")
+	result, err := q.next.CreateJob(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CreateMatchCandidate(ctx context.Context, arg db.CreateMatchCandidateParams) (db.MatchCandidate, error) {
+	ctx, span := startQuerySpan(ctx, "CreateMatchCandidate")
+	result, err := q.next.CreateMatchCandidate(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CreateMatchOpening(ctx context.Context, arg db.CreateMatchOpeningParams) (db.MatchOpening, error) {
+	ctx, span := startQuerySpan(ctx, "CreateMatchOpening")
+	result, err := q.next.CreateMatchOpening(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CreateMessage(ctx context.Context, arg db.CreateMessageParams) (db.Message, error) {
+	ctx, span := startQuerySpan(ctx, "CreateMessage")
+	result, err := q.next.CreateMessage(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CreateOrganization(ctx context.Context, arg db.CreateOrganizationParams) (db.Organization, error) {
+	ctx, span := startQuerySpan(ctx, "CreateOrganization")
+	result, err := q.next.CreateOrganization(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CreateOrganizationExport(ctx context.Context, arg db.CreateOrganizationExportParams) (db.OrganizationExport, error) {
+	ctx, span := startQuerySpan(ctx, "CreateOrganizationExport")
+	result, err := q.next.CreateOrganizationExport(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CreateOrganizationOrigin(ctx context.Context, arg db.CreateOrganizationOriginParams) (db.OrganizationOrigin, error) {
+	ctx, span := startQuerySpan(ctx, "CreateOrganizationOrigin")
+	result, err := q.next.CreateOrganizationOrigin(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CreateOwnershipTransfer(ctx context.Context, arg db.CreateOwnershipTransferParams) (db.OrganizationOwnershipTransfer, error) {
+	ctx, span := startQuerySpan(ctx, "CreateOwnershipTransfer")
+	result, err := q.next.CreateOwnershipTransfer(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CreateTenant(ctx context.Context, arg db.CreateTenantParams) (db.Tenant, error) {
+	ctx, span := startQuerySpan(ctx, "CreateTenant")
+	result, err := q.next.CreateTenant(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CreateTenantDomain(ctx context.Context, arg db.CreateTenantDomainParams) (db.TenantDomain, error) {
+	ctx, span := startQuerySpan(ctx, "CreateTenantDomain")
+	result, err := q.next.CreateTenantDomain(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+	ctx, span := startQuerySpan(ctx, "CreateUser")
+	result, err := q.next.CreateUser(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CreateUserImport(ctx context.Context, arg db.CreateUserImportParams) (db.UserImport, error) {
+	ctx, span := startQuerySpan(ctx, "CreateUserImport")
+	result, err := q.next.CreateUserImport(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CreateUserImportRow(ctx context.Context, arg db.CreateUserImportRowParams) error {
+	ctx, span := startQuerySpan(ctx, "CreateUserImportRow")
+	err := q.next.CreateUserImportRow(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) CreateWebhookDelivery(ctx context.Context, arg db.CreateWebhookDeliveryParams) (db.WebhookDelivery, error) {
+	ctx, span := startQuerySpan(ctx, "CreateWebhookDelivery")
+	result, err := q.next.CreateWebhookDelivery(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) CreateWebhookEndpoint(ctx context.Context, arg db.CreateWebhookEndpointParams) (db.WebhookEndpoint, error) {
+	ctx, span := startQuerySpan(ctx, "CreateWebhookEndpoint")
+	result, err := q.next.CreateWebhookEndpoint(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) DeleteConversation(ctx context.Context, arg db.DeleteConversationParams) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "DeleteConversation")
+	result, err := q.next.DeleteConversation(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) DeleteDocumentChunksFrom(ctx context.Context, arg db.DeleteDocumentChunksFromParams) error {
+	ctx, span := startQuerySpan(ctx, "DeleteDocumentChunksFrom")
+	err := q.next.DeleteDocumentChunksFrom(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) DeleteExpiredRateLimitCounters(ctx context.Context) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "DeleteExpiredRateLimitCounters")
+	result, err := q.next.DeleteExpiredRateLimitCounters(ctx)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) DeleteExpiredTenantSubdomainAliases(ctx context.Context) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "DeleteExpiredTenantSubdomainAliases")
+	result, err := q.next.DeleteExpiredTenantSubdomainAliases(ctx)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) DeleteFeatureFlag(ctx context.Context, key string) error {
+	ctx, span := startQuerySpan(ctx, "DeleteFeatureFlag")
+	err := q.next.DeleteFeatureFlag(ctx, key)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) DeleteMatchCandidate(ctx context.Context, arg db.DeleteMatchCandidateParams) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "DeleteMatchCandidate")
+	result, err := q.next.DeleteMatchCandidate(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) DeleteMatchOpening(ctx context.Context, arg db.DeleteMatchOpeningParams) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "DeleteMatchOpening")
+	result, err := q.next.DeleteMatchOpening(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) DeleteOrganization(ctx context.Context, id uuid.UUID) error {
+	ctx, span := startQuerySpan(ctx, "DeleteOrganization")
+	err := q.next.DeleteOrganization(ctx, id)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) DeleteOrganizationFeatureFlagOverride(ctx context.Context, arg db.DeleteOrganizationFeatureFlagOverrideParams) error {
+	ctx, span := startQuerySpan(ctx, "DeleteOrganizationFeatureFlagOverride")
+	err := q.next.DeleteOrganizationFeatureFlagOverride(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) DeleteOrganizationMember(ctx context.Context, arg db.DeleteOrganizationMemberParams) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "DeleteOrganizationMember")
+	result, err := q.next.DeleteOrganizationMember(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) DeleteOrganizationOrigin(ctx context.Context, arg db.DeleteOrganizationOriginParams) error {
+	ctx, span := startQuerySpan(ctx, "DeleteOrganizationOrigin")
+	err := q.next.DeleteOrganizationOrigin(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) DeleteOrganizationSetting(ctx context.Context, arg db.DeleteOrganizationSettingParams) error {
+	ctx, span := startQuerySpan(ctx, "DeleteOrganizationSetting")
+	err := q.next.DeleteOrganizationSetting(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) DeleteRateLimitCounter(ctx context.Context, key string) error {
+	ctx, span := startQuerySpan(ctx, "DeleteRateLimitCounter")
+	err := q.next.DeleteRateLimitCounter(ctx, key)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) DeleteSucceededJobs(ctx context.Context, finishedBefore time.Time) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "DeleteSucceededJobs")
+	result, err := q.next.DeleteSucceededJobs(ctx, finishedBefore)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) DeleteSupersededDocumentChunks(ctx context.Context, arg db.DeleteSupersededDocumentChunksParams) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "DeleteSupersededDocumentChunks")
+	result, err := q.next.DeleteSupersededDocumentChunks(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) DeleteTaskRunsBefore(ctx context.Context, before time.Time) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "DeleteTaskRunsBefore")
+	result, err := q.next.DeleteTaskRunsBefore(ctx, before)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) DeleteTenant(ctx context.Context, id uuid.UUID) error {
+	ctx, span := startQuerySpan(ctx, "DeleteTenant")
+	err := q.next.DeleteTenant(ctx, id)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) DeleteTenantDomain(ctx context.Context, arg db.DeleteTenantDomainParams) error {
+	ctx, span := startQuerySpan(ctx, "DeleteTenantDomain")
+	err := q.next.DeleteTenantDomain(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) DeleteTenantFeatureFlagOverride(ctx context.Context, arg db.DeleteTenantFeatureFlagOverrideParams) error {
+	ctx, span := startQuerySpan(ctx, "DeleteTenantFeatureFlagOverride")
+	err := q.next.DeleteTenantFeatureFlagOverride(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) DeleteTenantSetting(ctx context.Context, arg db.DeleteTenantSettingParams) error {
+	ctx, span := startQuerySpan(ctx, "DeleteTenantSetting")
+	err := q.next.DeleteTenantSetting(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) DeleteTenantSubdomainAlias(ctx context.Context, subdomain string) error {
+	ctx, span := startQuerySpan(ctx, "DeleteTenantSubdomainAlias")
+	err := q.next.DeleteTenantSubdomainAlias(ctx, subdomain)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	ctx, span := startQuerySpan(ctx, "DeleteUser")
+	err := q.next.DeleteUser(ctx, id)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) DeleteWebhookDeliveriesBefore(ctx context.Context, before time.Time) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "DeleteWebhookDeliveriesBefore")
+	result, err := q.next.DeleteWebhookDeliveriesBefore(ctx, before)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) DeleteWebhookEndpoint(ctx context.Context, arg db.DeleteWebhookEndpointParams) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "DeleteWebhookEndpoint")
+	result, err := q.next.DeleteWebhookEndpoint(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ExpireOwnershipTransfers(ctx context.Context) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "ExpireOwnershipTransfers")
+	result, err := q.next.ExpireOwnershipTransfers(ctx)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) FailOrganizationExport(ctx context.Context, arg db.FailOrganizationExportParams) error {
+	ctx, span := startQuerySpan(ctx, "FailOrganizationExport")
+	err := q.next.FailOrganizationExport(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) FailWebhookDelivery(ctx context.Context, arg db.FailWebhookDeliveryParams) error {
+	ctx, span := startQuerySpan(ctx, "FailWebhookDelivery")
+	err := q.next.FailWebhookDelivery(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) FinishTaskRun(ctx context.Context, arg db.FinishTaskRunParams) error {
+	ctx, span := startQuerySpan(ctx, "FinishTaskRun")
+	err := q.next.FinishTaskRun(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) FinishUserImport(ctx context.Context, arg db.FinishUserImportParams) error {
+	ctx, span := startQuerySpan(ctx, "FinishUserImport")
+	err := q.next.FinishUserImport(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) GetConversation(ctx context.Context, arg db.GetConversationParams) (db.Conversation, error) {
+	ctx, span := startQuerySpan(ctx, "GetConversation")
+	result, err := q.next.GetConversation(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetDocument(ctx context.Context, arg db.GetDocumentParams) (db.Document, error) {
+	ctx, span := startQuerySpan(ctx, "GetDocument")
+	result, err := q.next.GetDocument(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetDocumentChunksByIDs(ctx context.Context, arg db.GetDocumentChunksByIDsParams) ([]db.GetDocumentChunksByIDsRow, error) {
+	ctx, span := startQuerySpan(ctx, "GetDocumentChunksByIDs")
+	result, err := q.next.GetDocumentChunksByIDs(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetDocumentVersion(ctx context.Context, arg db.GetDocumentVersionParams) (db.DocumentVersion, error) {
+	ctx, span := startQuerySpan(ctx, "GetDocumentVersion")
+	result, err := q.next.GetDocumentVersion(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetDocumentVersionForIndexing(ctx context.Context, id uuid.UUID) (db.GetDocumentVersionForIndexingRow, error) {
+	ctx, span := startQuerySpan(ctx, "GetDocumentVersionForIndexing")
+	result, err := q.next.GetDocumentVersionForIndexing(ctx, id)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetFeatureFlag(ctx context.Context, key string) (db.FeatureFlag, error) {
+	ctx, span := startQuerySpan(ctx, "GetFeatureFlag")
+	result, err := q.next.GetFeatureFlag(ctx, key)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetMatchCandidate(ctx context.Context, arg db.GetMatchCandidateParams) (db.MatchCandidate, error) {
+	ctx, span := startQuerySpan(ctx, "GetMatchCandidate")
+	result, err := q.next.GetMatchCandidate(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetMatchOpening(ctx context.Context, arg db.GetMatchOpeningParams) (db.MatchOpening, error) {
+	ctx, span := startQuerySpan(ctx, "GetMatchOpening")
+	result, err := q.next.GetMatchOpening(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetOrganization(ctx context.Context, id uuid.UUID) (db.Organization, error) {
+	ctx, span := startQuerySpan(ctx, "GetOrganization")
+	result, err := q.next.GetOrganization(ctx, id)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetOrganizationApiUsage(ctx context.Context, arg db.GetOrganizationApiUsageParams) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "GetOrganizationApiUsage")
+	result, err := q.next.GetOrganizationApiUsage(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetOrganizationByTenant(ctx context.Context, tenantID uuid.UUID) (db.Organization, error) {
+	ctx, span := startQuerySpan(ctx, "GetOrganizationByTenant")
+	result, err := q.next.GetOrganizationByTenant(ctx, tenantID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetOrganizationExport(ctx context.Context, arg db.GetOrganizationExportParams) (db.OrganizationExport, error) {
+	ctx, span := startQuerySpan(ctx, "GetOrganizationExport")
+	result, err := q.next.GetOrganizationExport(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetOrganizationExportByID(ctx context.Context, id uuid.UUID) (db.OrganizationExport, error) {
+	ctx, span := startQuerySpan(ctx, "GetOrganizationExportByID")
+	result, err := q.next.GetOrganizationExportByID(ctx, id)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetOrganizationJob(ctx context.Context, arg db.GetOrganizationJobParams) (db.Job, error) {
+	ctx, span := startQuerySpan(ctx, "GetOrganizationJob")
+	result, err := q.next.GetOrganizationJob(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetOrganizationMember(ctx context.Context, arg db.GetOrganizationMemberParams) (db.OrganizationMember, error) {
+	ctx, span := startQuerySpan(ctx, "GetOrganizationMember")
+	result, err := q.next.GetOrganizationMember(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetOrganizationOrigin(ctx context.Context, arg db.GetOrganizationOriginParams) (db.OrganizationOrigin, error) {
+	ctx, span := startQuerySpan(ctx, "GetOrganizationOrigin")
+	result, err := q.next.GetOrganizationOrigin(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetOrganizationSubscription(ctx context.Context, organizationID uuid.UUID) (db.OrganizationSubscription, error) {
+	ctx, span := startQuerySpan(ctx, "GetOrganizationSubscription")
+	result, err := q.next.GetOrganizationSubscription(ctx, organizationID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetOrganizationWithTenants(ctx context.Context, id uuid.UUID) (db.GetOrganizationWithTenantsRow, error) {
+	ctx, span := startQuerySpan(ctx, "GetOrganizationWithTenants")
+	result, err := q.next.GetOrganizationWithTenants(ctx, id)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetOwnershipTransfer(ctx context.Context, arg db.GetOwnershipTransferParams) (db.OrganizationOwnershipTransfer, error) {
+	ctx, span := startQuerySpan(ctx, "GetOwnershipTransfer")
+	result, err := q.next.GetOwnershipTransfer(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetPendingOwnershipTransfer(ctx context.Context, organizationID uuid.UUID) (db.OrganizationOwnershipTransfer, error) {
+	ctx, span := startQuerySpan(ctx, "GetPendingOwnershipTransfer")
+	result, err := q.next.GetPendingOwnershipTransfer(ctx, organizationID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetPlan(ctx context.Context, code string) (db.Plan, error) {
+	ctx, span := startQuerySpan(ctx, "GetPlan")
+	result, err := q.next.GetPlan(ctx, code)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetRateLimitCounter(ctx context.Context, key string) (db.GetRateLimitCounterRow, error) {
+	ctx, span := startQuerySpan(ctx, "GetRateLimitCounter")
+	result, err := q.next.GetRateLimitCounter(ctx, key)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetTenant(ctx context.Context, id uuid.UUID) (db.Tenant, error) {
+	ctx, span := startQuerySpan(ctx, "GetTenant")
+	result, err := q.next.GetTenant(ctx, id)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetTenantBySubdomain(ctx context.Context, subdomain string) (db.Tenant, error) {
+	ctx, span := startQuerySpan(ctx, "GetTenantBySubdomain")
+	result, err := q.next.GetTenantBySubdomain(ctx, subdomain)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetTenantBySubdomainAlias(ctx context.Context, subdomain string) (db.Tenant, error) {
+	ctx, span := startQuerySpan(ctx, "GetTenantBySubdomainAlias")
+	result, err := q.next.GetTenantBySubdomainAlias(ctx, subdomain)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetTenantByVerifiedDomain(ctx context.Context, domain string) (db.Tenant, error) {
+	ctx, span := startQuerySpan(ctx, "GetTenantByVerifiedDomain")
+	result, err := q.next.GetTenantByVerifiedDomain(ctx, domain)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetTenantDomain(ctx context.Context, arg db.GetTenantDomainParams) (db.TenantDomain, error) {
+	ctx, span := startQuerySpan(ctx, "GetTenantDomain")
+	result, err := q.next.GetTenantDomain(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetTenantUser(ctx context.Context, arg db.GetTenantUserParams) (db.TenantUser, error) {
+	ctx, span := startQuerySpan(ctx, "GetTenantUser")
+	result, err := q.next.GetTenantUser(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetTenantWithUserCount(ctx context.Context, id uuid.UUID) (db.GetTenantWithUserCountRow, error) {
+	ctx, span := startQuerySpan(ctx, "GetTenantWithUserCount")
+	result, err := q.next.GetTenantWithUserCount(ctx, id)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetTenantsByOrganization(ctx context.Context, organizationID uuid.UUID) ([]db.Tenant, error) {
+	ctx, span := startQuerySpan(ctx, "GetTenantsByOrganization")
+	result, err := q.next.GetTenantsByOrganization(ctx, organizationID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetTenantsByUser(ctx context.Context, userID uuid.UUID) ([]db.Tenant, error) {
+	ctx, span := startQuerySpan(ctx, "GetTenantsByUser")
+	result, err := q.next.GetTenantsByUser(ctx, userID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetTenantsByUserID(ctx context.Context, userID uuid.UUID) ([]db.GetTenantsByUserIDRow, error) {
+	ctx, span := startQuerySpan(ctx, "GetTenantsByUserID")
+	result, err := q.next.GetTenantsByUserID(ctx, userID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetUser(ctx context.Context, id uuid.UUID) (db.User, error) {
+	ctx, span := startQuerySpan(ctx, "GetUser")
+	result, err := q.next.GetUser(ctx, id)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetUserByCognitoID(ctx context.Context, cognitoID string) (db.User, error) {
+	ctx, span := startQuerySpan(ctx, "GetUserByCognitoID")
+	result, err := q.next.GetUserByCognitoID(ctx, cognitoID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetUserByEmail(ctx context.Context, email string) (db.User, error) {
+	ctx, span := startQuerySpan(ctx, "GetUserByEmail")
+	result, err := q.next.GetUserByEmail(ctx, email)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetUserImport(ctx context.Context, arg db.GetUserImportParams) (db.UserImport, error) {
+	ctx, span := startQuerySpan(ctx, "GetUserImport")
+	result, err := q.next.GetUserImport(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetUserWithTenants(ctx context.Context, id uuid.UUID) (db.GetUserWithTenantsRow, error) {
+	ctx, span := startQuerySpan(ctx, "GetUserWithTenants")
+	result, err := q.next.GetUserWithTenants(ctx, id)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetUsersByTenant(ctx context.Context, tenantID uuid.UUID) ([]db.User, error) {
+	ctx, span := startQuerySpan(ctx, "GetUsersByTenant")
+	result, err := q.next.GetUsersByTenant(ctx, tenantID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetUsersNotInTenant(ctx context.Context, arg db.GetUsersNotInTenantParams) ([]db.User, error) {
+	ctx, span := startQuerySpan(ctx, "GetUsersNotInTenant")
+	result, err := q.next.GetUsersNotInTenant(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetVectorExtensionInstalled(ctx context.Context) (bool, error) {
+	ctx, span := startQuerySpan(ctx, "GetVectorExtensionInstalled")
+	result, err := q.next.GetVectorExtensionInstalled(ctx)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetWebhookDelivery(ctx context.Context, arg db.GetWebhookDeliveryParams) (db.WebhookDelivery, error) {
+	ctx, span := startQuerySpan(ctx, "GetWebhookDelivery")
+	result, err := q.next.GetWebhookDelivery(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetWebhookDeliveryTarget(ctx context.Context, id uuid.UUID) (db.GetWebhookDeliveryTargetRow, error) {
+	ctx, span := startQuerySpan(ctx, "GetWebhookDeliveryTarget")
+	result, err := q.next.GetWebhookDeliveryTarget(ctx, id)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) GetWebhookEndpoint(ctx context.Context, arg db.GetWebhookEndpointParams) (db.WebhookEndpoint, error) {
+	ctx, span := startQuerySpan(ctx, "GetWebhookEndpoint")
+	result, err := q.next.GetWebhookEndpoint(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) IncrementOrganizationApiUsage(ctx context.Context, arg db.IncrementOrganizationApiUsageParams) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "IncrementOrganizationApiUsage")
+	result, err := q.next.IncrementOrganizationApiUsage(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) IncrementRateLimitCounter(ctx context.Context, arg db.IncrementRateLimitCounterParams) (db.IncrementRateLimitCounterRow, error) {
+	ctx, span := startQuerySpan(ctx, "IncrementRateLimitCounter")
+	result, err := q.next.IncrementRateLimitCounter(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) IsOriginRegistered(ctx context.Context, origin string) (bool, error) {
+	ctx, span := startQuerySpan(ctx, "IsOriginRegistered")
+	result, err := q.next.IsOriginRegistered(ctx, origin)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListConversations(ctx context.Context, arg db.ListConversationsParams) ([]db.Conversation, error) {
+	ctx, span := startQuerySpan(ctx, "ListConversations")
+	result, err := q.next.ListConversations(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListDocumentChunkEmbeddings(ctx context.Context, arg db.ListDocumentChunkEmbeddingsParams) ([]db.ListDocumentChunkEmbeddingsRow, error) {
+	ctx, span := startQuerySpan(ctx, "ListDocumentChunkEmbeddings")
+	result, err := q.next.ListDocumentChunkEmbeddings(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListDocumentChunks(ctx context.Context, arg db.ListDocumentChunksParams) ([]db.ListDocumentChunksRow, error) {
+	ctx, span := startQuerySpan(ctx, "ListDocumentChunks")
+	result, err := q.next.ListDocumentChunks(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListDocumentVersionStorageKeys(ctx context.Context, documentID uuid.UUID) ([]string, error) {
+	ctx, span := startQuerySpan(ctx, "ListDocumentVersionStorageKeys")
+	result, err := q.next.ListDocumentVersionStorageKeys(ctx, documentID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListDocumentVersions(ctx context.Context, arg db.ListDocumentVersionsParams) ([]db.ListDocumentVersionsRow, error) {
+	ctx, span := startQuerySpan(ctx, "ListDocumentVersions")
+	result, err := q.next.ListDocumentVersions(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListDocuments(ctx context.Context, arg db.ListDocumentsParams) ([]db.Document, error) {
+	ctx, span := startQuerySpan(ctx, "ListDocuments")
+	result, err := q.next.ListDocuments(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListExpiredOrganizationExports(ctx context.Context, rowLimit int32) ([]db.OrganizationExport, error) {
+	ctx, span := startQuerySpan(ctx, "ListExpiredOrganizationExports")
+	result, err := q.next.ListExpiredOrganizationExports(ctx, rowLimit)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListFeatureFlagOverrides(ctx context.Context) ([]db.FeatureFlagOverride, error) {
+	ctx, span := startQuerySpan(ctx, "ListFeatureFlagOverrides")
+	result, err := q.next.ListFeatureFlagOverrides(ctx)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListFeatureFlags(ctx context.Context) ([]db.FeatureFlag, error) {
+	ctx, span := startQuerySpan(ctx, "ListFeatureFlags")
+	result, err := q.next.ListFeatureFlags(ctx)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListLatestTaskRuns(ctx context.Context) ([]db.TaskRun, error) {
+	ctx, span := startQuerySpan(ctx, "ListLatestTaskRuns")
+	result, err := q.next.ListLatestTaskRuns(ctx)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListMatchCandidates(ctx context.Context, arg db.ListMatchCandidatesParams) ([]db.MatchCandidate, error) {
+	ctx, span := startQuerySpan(ctx, "ListMatchCandidates")
+	result, err := q.next.ListMatchCandidates(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListMatchCandidatesAfter(ctx context.Context, arg db.ListMatchCandidatesAfterParams) ([]db.MatchCandidate, error) {
+	ctx, span := startQuerySpan(ctx, "ListMatchCandidatesAfter")
+	result, err := q.next.ListMatchCandidatesAfter(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListMatchOpenings(ctx context.Context, arg db.ListMatchOpeningsParams) ([]db.MatchOpening, error) {
+	ctx, span := startQuerySpan(ctx, "ListMatchOpenings")
+	result, err := q.next.ListMatchOpenings(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListMessages(ctx context.Context, conversationID uuid.UUID) ([]db.Message, error) {
+	ctx, span := startQuerySpan(ctx, "ListMessages")
+	result, err := q.next.ListMessages(ctx, conversationID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListOpenMatchOpeningsAfter(ctx context.Context, arg db.ListOpenMatchOpeningsAfterParams) ([]db.MatchOpening, error) {
+	ctx, span := startQuerySpan(ctx, "ListOpenMatchOpeningsAfter")
+	result, err := q.next.ListOpenMatchOpeningsAfter(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListOrganizationAuditEvents(ctx context.Context, organizationID uuid.UUID) ([]db.ListOrganizationAuditEventsRow, error) {
+	ctx, span := startQuerySpan(ctx, "ListOrganizationAuditEvents")
+	result, err := q.next.ListOrganizationAuditEvents(ctx, organizationID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListOrganizationExportTenantMemberships(ctx context.Context, organizationID uuid.UUID) ([]db.ListOrganizationExportTenantMembershipsRow, error) {
+	ctx, span := startQuerySpan(ctx, "ListOrganizationExportTenantMemberships")
+	result, err := q.next.ListOrganizationExportTenantMemberships(ctx, organizationID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListOrganizationExportTenants(ctx context.Context, organizationID uuid.UUID) ([]db.Tenant, error) {
+	ctx, span := startQuerySpan(ctx, "ListOrganizationExportTenants")
+	result, err := q.next.ListOrganizationExportTenants(ctx, organizationID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListOrganizationExportUsers(ctx context.Context, organizationID uuid.UUID) ([]db.User, error) {
+	ctx, span := startQuerySpan(ctx, "ListOrganizationExportUsers")
+	result, err := q.next.ListOrganizationExportUsers(ctx, organizationID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListOrganizationExports(ctx context.Context, arg db.ListOrganizationExportsParams) ([]db.OrganizationExport, error) {
+	ctx, span := startQuerySpan(ctx, "ListOrganizationExports")
+	result, err := q.next.ListOrganizationExports(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListOrganizationJobs(ctx context.Context, arg db.ListOrganizationJobsParams) ([]db.Job, error) {
+	ctx, span := startQuerySpan(ctx, "ListOrganizationJobs")
+	result, err := q.next.ListOrganizationJobs(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListOrganizationMembers(ctx context.Context, organizationID uuid.UUID) ([]db.ListOrganizationMembersRow, error) {
+	ctx, span := startQuerySpan(ctx, "ListOrganizationMembers")
+	result, err := q.next.ListOrganizationMembers(ctx, organizationID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListOrganizationOrigins(ctx context.Context, organizationID uuid.UUID) ([]db.OrganizationOrigin, error) {
+	ctx, span := startQuerySpan(ctx, "ListOrganizationOrigins")
+	result, err := q.next.ListOrganizationOrigins(ctx, organizationID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListOrganizationSettings(ctx context.Context, organizationID uuid.UUID) ([]db.OrganizationSetting, error) {
+	ctx, span := startQuerySpan(ctx, "ListOrganizationSettings")
+	result, err := q.next.ListOrganizationSettings(ctx, organizationID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListOrganizations(ctx context.Context, arg db.ListOrganizationsParams) ([]db.Organization, error) {
+	ctx, span := startQuerySpan(ctx, "ListOrganizations")
+	result, err := q.next.ListOrganizations(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListPlans(ctx context.Context) ([]db.Plan, error) {
+	ctx, span := startQuerySpan(ctx, "ListPlans")
+	result, err := q.next.ListPlans(ctx)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListPurgeableDocuments(ctx context.Context, arg db.ListPurgeableDocumentsParams) ([]uuid.UUID, error) {
+	ctx, span := startQuerySpan(ctx, "ListPurgeableDocuments")
+	result, err := q.next.ListPurgeableDocuments(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListRecentMessages(ctx context.Context, arg db.ListRecentMessagesParams) ([]db.Message, error) {
+	ctx, span := startQuerySpan(ctx, "ListRecentMessages")
+	result, err := q.next.ListRecentMessages(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListSubscribedWebhookEndpoints(ctx context.Context, arg db.ListSubscribedWebhookEndpointsParams) ([]db.WebhookEndpoint, error) {
+	ctx, span := startQuerySpan(ctx, "ListSubscribedWebhookEndpoints")
+	result, err := q.next.ListSubscribedWebhookEndpoints(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListTakenSubdomains(ctx context.Context, arg db.ListTakenSubdomainsParams) ([]string, error) {
+	ctx, span := startQuerySpan(ctx, "ListTakenSubdomains")
+	result, err := q.next.ListTakenSubdomains(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListTaskRuns(ctx context.Context, arg db.ListTaskRunsParams) ([]db.TaskRun, error) {
+	ctx, span := startQuerySpan(ctx, "ListTaskRuns")
+	result, err := q.next.ListTaskRuns(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListTenantDomains(ctx context.Context, tenantID uuid.UUID) ([]db.TenantDomain, error) {
+	ctx, span := startQuerySpan(ctx, "ListTenantDomains")
+	result, err := q.next.ListTenantDomains(ctx, tenantID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListTenantSeatCounts(ctx context.Context, organizationID uuid.UUID) ([]db.ListTenantSeatCountsRow, error) {
+	ctx, span := startQuerySpan(ctx, "ListTenantSeatCounts")
+	result, err := q.next.ListTenantSeatCounts(ctx, organizationID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListTenantSettings(ctx context.Context, tenantID uuid.UUID) ([]db.TenantSetting, error) {
+	ctx, span := startQuerySpan(ctx, "ListTenantSettings")
+	result, err := q.next.ListTenantSettings(ctx, tenantID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListTenantUsers(ctx context.Context, tenantID uuid.UUID) ([]db.ListTenantUsersRow, error) {
+	ctx, span := startQuerySpan(ctx, "ListTenantUsers")
+	result, err := q.next.ListTenantUsers(ctx, tenantID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListTenantsByOrganization(ctx context.Context, arg db.ListTenantsByOrganizationParams) ([]db.Tenant, error) {
+	ctx, span := startQuerySpan(ctx, "ListTenantsByOrganization")
+	result, err := q.next.ListTenantsByOrganization(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListUsageRecordsByPeriod(ctx context.Context, arg db.ListUsageRecordsByPeriodParams) ([]db.ListUsageRecordsByPeriodRow, error) {
+	ctx, span := startQuerySpan(ctx, "ListUsageRecordsByPeriod")
+	result, err := q.next.ListUsageRecordsByPeriod(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListUserImportRows(ctx context.Context, importID uuid.UUID) ([]db.ListUserImportRowsRow, error) {
+	ctx, span := startQuerySpan(ctx, "ListUserImportRows")
+	result, err := q.next.ListUserImportRows(ctx, importID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListUserImports(ctx context.Context, arg db.ListUserImportsParams) ([]db.UserImport, error) {
+	ctx, span := startQuerySpan(ctx, "ListUserImports")
+	result, err := q.next.ListUserImports(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListUsers(ctx context.Context, arg db.ListUsersParams) ([]db.User, error) {
+	ctx, span := startQuerySpan(ctx, "ListUsers")
+	result, err := q.next.ListUsers(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListWebhookDeliveries(ctx context.Context, arg db.ListWebhookDeliveriesParams) ([]db.WebhookDelivery, error) {
+	ctx, span := startQuerySpan(ctx, "ListWebhookDeliveries")
+	result, err := q.next.ListWebhookDeliveries(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) ListWebhookEndpoints(ctx context.Context, organizationID uuid.UUID) ([]db.WebhookEndpoint, error) {
+	ctx, span := startQuerySpan(ctx, "ListWebhookEndpoints")
+	result, err := q.next.ListWebhookEndpoints(ctx, organizationID)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) LockOrganizationOwners(ctx context.Context, organizationID uuid.UUID) error {
+	ctx, span := startQuerySpan(ctx, "LockOrganizationOwners")
+	err := q.next.LockOrganizationOwners(ctx, organizationID)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) MarkTenantDomainVerified(ctx context.Context, arg db.MarkTenantDomainVerifiedParams) (db.TenantDomain, error) {
+	ctx, span := startQuerySpan(ctx, "MarkTenantDomainVerified")
+	result, err := q.next.MarkTenantDomainVerified(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) PurgeDocument(ctx context.Context, id uuid.UUID) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "PurgeDocument")
+	result, err := q.next.PurgeDocument(ctx, id)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) RecordUserActivity(ctx context.Context, arg db.RecordUserActivityParams) error {
+	ctx, span := startQuerySpan(ctx, "RecordUserActivity")
+	err := q.next.RecordUserActivity(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) RecordWebhookDeliveryAttempt(ctx context.Context, arg db.RecordWebhookDeliveryAttemptParams) error {
+	ctx, span := startQuerySpan(ctx, "RecordWebhookDeliveryAttempt")
+	err := q.next.RecordWebhookDeliveryAttempt(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) ReleaseTaskLock(ctx context.Context, lockKey string) error {
+	ctx, span := startQuerySpan(ctx, "ReleaseTaskLock")
+	err := q.next.ReleaseTaskLock(ctx, lockKey)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) RemoveUserFromTenant(ctx context.Context, arg db.RemoveUserFromTenantParams) error {
+	ctx, span := startQuerySpan(ctx, "RemoveUserFromTenant")
+	err := q.next.RemoveUserFromTenant(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) RequeueJob(ctx context.Context, arg db.RequeueJobParams) (db.Job, error) {
+	ctx, span := startQuerySpan(ctx, "RequeueJob")
+	result, err := q.next.RequeueJob(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) RetryJob(ctx context.Context, arg db.RetryJobParams) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "RetryJob")
+	result, err := q.next.RetryJob(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) RotateWebhookEndpointSecret(ctx context.Context, arg db.RotateWebhookEndpointSecretParams) (db.WebhookEndpoint, error) {
+	ctx, span := startQuerySpan(ctx, "RotateWebhookEndpointSecret")
+	result, err := q.next.RotateWebhookEndpointSecret(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) SearchDocumentChunksByKeyword(ctx context.Context, arg db.SearchDocumentChunksByKeywordParams) ([]db.SearchDocumentChunksByKeywordRow, error) {
+	ctx, span := startQuerySpan(ctx, "SearchDocumentChunksByKeyword")
+	result, err := q.next.SearchDocumentChunksByKeyword(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) SearchDocumentChunksByVector(ctx context.Context, arg db.SearchDocumentChunksByVectorParams) ([]db.SearchDocumentChunksByVectorRow, error) {
+	ctx, span := startQuerySpan(ctx, "SearchDocumentChunksByVector")
+	result, err := q.next.SearchDocumentChunksByVector(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) SearchDocumentChunksByVector1536(ctx context.Context, arg db.SearchDocumentChunksByVector1536Params) ([]db.SearchDocumentChunksByVector1536Row, error) {
+	ctx, span := startQuerySpan(ctx, "SearchDocumentChunksByVector1536")
+	result, err := q.next.SearchDocumentChunksByVector1536(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) SearchDocumentChunksByVector256(ctx context.Context, arg db.SearchDocumentChunksByVector256Params) ([]db.SearchDocumentChunksByVector256Row, error) {
+	ctx, span := startQuerySpan(ctx, "SearchDocumentChunksByVector256")
+	result, err := q.next.SearchDocumentChunksByVector256(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) SetDocumentVersionIndexStatus(ctx context.Context, arg db.SetDocumentVersionIndexStatusParams) error {
+	ctx, span := startQuerySpan(ctx, "SetDocumentVersionIndexStatus")
+	err := q.next.SetDocumentVersionIndexStatus(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) SetHNSWSearchScope(ctx context.Context, efSearch string) error {
+	ctx, span := startQuerySpan(ctx, "SetHNSWSearchScope")
+	err := q.next.SetHNSWSearchScope(ctx, efSearch)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) SetMatchCandidateEmbedding(ctx context.Context, arg db.SetMatchCandidateEmbeddingParams) error {
+	ctx, span := startQuerySpan(ctx, "SetMatchCandidateEmbedding")
+	err := q.next.SetMatchCandidateEmbedding(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) SetMatchOpeningEmbedding(ctx context.Context, arg db.SetMatchOpeningEmbeddingParams) error {
+	ctx, span := startQuerySpan(ctx, "SetMatchOpeningEmbedding")
+	err := q.next.SetMatchOpeningEmbedding(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) SetPrimaryTenantDomain(ctx context.Context, arg db.SetPrimaryTenantDomainParams) error {
+	ctx, span := startQuerySpan(ctx, "SetPrimaryTenantDomain")
+	err := q.next.SetPrimaryTenantDomain(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) SnapshotOrganizationActiveUsers(ctx context.Context, usageDate time.Time) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "SnapshotOrganizationActiveUsers")
+	result, err := q.next.SnapshotOrganizationActiveUsers(ctx, usageDate)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) SnapshotOrganizationApiCalls(ctx context.Context, usageDate time.Time) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "SnapshotOrganizationApiCalls")
+	result, err := q.next.SnapshotOrganizationApiCalls(ctx, usageDate)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) SnapshotOrganizationSeats(ctx context.Context, usageDate time.Time) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "SnapshotOrganizationSeats")
+	result, err := q.next.SnapshotOrganizationSeats(ctx, usageDate)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) SnapshotTenantActiveUsers(ctx context.Context, usageDate time.Time) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "SnapshotTenantActiveUsers")
+	result, err := q.next.SnapshotTenantActiveUsers(ctx, usageDate)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) SnapshotTenantSeats(ctx context.Context, usageDate time.Time) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "SnapshotTenantSeats")
+	result, err := q.next.SnapshotTenantSeats(ctx, usageDate)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) SoftDeleteDocument(ctx context.Context, arg db.SoftDeleteDocumentParams) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "SoftDeleteDocument")
+	result, err := q.next.SoftDeleteDocument(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) StartDocumentVersionIndexing(ctx context.Context, id uuid.UUID) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "StartDocumentVersionIndexing")
+	result, err := q.next.StartDocumentVersionIndexing(ctx, id)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) StartManualTaskRun(ctx context.Context, arg db.StartManualTaskRunParams) (db.TaskRun, error) {
+	ctx, span := startQuerySpan(ctx, "StartManualTaskRun")
+	result, err := q.next.StartManualTaskRun(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) StartOrganizationExport(ctx context.Context, id uuid.UUID) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "StartOrganizationExport")
+	result, err := q.next.StartOrganizationExport(ctx, id)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) StartScheduledTaskRun(ctx context.Context, arg db.StartScheduledTaskRunParams) (db.TaskRun, error) {
+	ctx, span := startQuerySpan(ctx, "StartScheduledTaskRun")
+	result, err := q.next.StartScheduledTaskRun(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) StartUserImport(ctx context.Context, id uuid.UUID) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "StartUserImport")
+	result, err := q.next.StartUserImport(ctx, id)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) SwapOwnerRoles(ctx context.Context, arg db.SwapOwnerRolesParams) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "SwapOwnerRoles")
+	result, err := q.next.SwapOwnerRoles(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) TouchConversation(ctx context.Context, arg db.TouchConversationParams) error {
+	ctx, span := startQuerySpan(ctx, "TouchConversation")
+	err := q.next.TouchConversation(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) TryTaskLock(ctx context.Context, lockKey string) (bool, error) {
+	ctx, span := startQuerySpan(ctx, "TryTaskLock")
+	result, err := q.next.TryTaskLock(ctx, lockKey)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) UpdateDocument(ctx context.Context, arg db.UpdateDocumentParams) (db.Document, error) {
+	ctx, span := startQuerySpan(ctx, "UpdateDocument")
+	result, err := q.next.UpdateDocument(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) UpdateMatchCandidate(ctx context.Context, arg db.UpdateMatchCandidateParams) (db.MatchCandidate, error) {
+	ctx, span := startQuerySpan(ctx, "UpdateMatchCandidate")
+	result, err := q.next.UpdateMatchCandidate(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) UpdateMatchOpening(ctx context.Context, arg db.UpdateMatchOpeningParams) (db.MatchOpening, error) {
+	ctx, span := startQuerySpan(ctx, "UpdateMatchOpening")
+	result, err := q.next.UpdateMatchOpening(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) UpdateOrganization(ctx context.Context, arg db.UpdateOrganizationParams) (db.Organization, error) {
+	ctx, span := startQuerySpan(ctx, "UpdateOrganization")
+	result, err := q.next.UpdateOrganization(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) UpdateOrganizationMemberRole(ctx context.Context, arg db.UpdateOrganizationMemberRoleParams) (int64, error) {
+	ctx, span := startQuerySpan(ctx, "UpdateOrganizationMemberRole")
+	result, err := q.next.UpdateOrganizationMemberRole(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) UpdateTenant(ctx context.Context, arg db.UpdateTenantParams) (db.Tenant, error) {
+	ctx, span := startQuerySpan(ctx, "UpdateTenant")
+	result, err := q.next.UpdateTenant(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) UpdateUser(ctx context.Context, arg db.UpdateUserParams) (db.User, error) {
+	ctx, span := startQuerySpan(ctx, "UpdateUser")
+	result, err := q.next.UpdateUser(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) UpdateUserImportRow(ctx context.Context, arg db.UpdateUserImportRowParams) error {
+	ctx, span := startQuerySpan(ctx, "UpdateUserImportRow")
+	err := q.next.UpdateUserImportRow(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
+
+func (q *tracedQuerier) UpdateUserRoleInTenant(ctx context.Context, arg db.UpdateUserRoleInTenantParams) (db.TenantUser, error) {
+	ctx, span := startQuerySpan(ctx, "UpdateUserRoleInTenant")
+	result, err := q.next.UpdateUserRoleInTenant(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) UpdateWebhookEndpoint(ctx context.Context, arg db.UpdateWebhookEndpointParams) (db.WebhookEndpoint, error) {
+	ctx, span := startQuerySpan(ctx, "UpdateWebhookEndpoint")
+	result, err := q.next.UpdateWebhookEndpoint(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) UpsertFeatureFlag(ctx context.Context, arg db.UpsertFeatureFlagParams) (db.FeatureFlag, error) {
+	ctx, span := startQuerySpan(ctx, "UpsertFeatureFlag")
+	result, err := q.next.UpsertFeatureFlag(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) UpsertOrganizationFeatureFlagOverride(ctx context.Context, arg db.UpsertOrganizationFeatureFlagOverrideParams) (db.FeatureFlagOverride, error) {
+	ctx, span := startQuerySpan(ctx, "UpsertOrganizationFeatureFlagOverride")
+	result, err := q.next.UpsertOrganizationFeatureFlagOverride(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) UpsertOrganizationSetting(ctx context.Context, arg db.UpsertOrganizationSettingParams) (db.OrganizationSetting, error) {
+	ctx, span := startQuerySpan(ctx, "UpsertOrganizationSetting")
+	result, err := q.next.UpsertOrganizationSetting(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) UpsertOrganizationSubscription(ctx context.Context, arg db.UpsertOrganizationSubscriptionParams) (db.OrganizationSubscription, error) {
+	ctx, span := startQuerySpan(ctx, "UpsertOrganizationSubscription")
+	result, err := q.next.UpsertOrganizationSubscription(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) UpsertTenantFeatureFlagOverride(ctx context.Context, arg db.UpsertTenantFeatureFlagOverrideParams) (db.FeatureFlagOverride, error) {
+	ctx, span := startQuerySpan(ctx, "UpsertTenantFeatureFlagOverride")
+	result, err := q.next.UpsertTenantFeatureFlagOverride(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) UpsertTenantSetting(ctx context.Context, arg db.UpsertTenantSettingParams) (db.TenantSetting, error) {
+	ctx, span := startQuerySpan(ctx, "UpsertTenantSetting")
+	result, err := q.next.UpsertTenantSetting(ctx, arg)
+	endQuerySpan(span, err)
+	return result, err
+}
+
+func (q *tracedQuerier) UpsertTenantSubdomainAlias(ctx context.Context, arg db.UpsertTenantSubdomainAliasParams) error {
+	ctx, span := startQuerySpan(ctx, "UpsertTenantSubdomainAlias")
+	err := q.next.UpsertTenantSubdomainAlias(ctx, arg)
+	endQuerySpan(span, err)
+	return err
+}
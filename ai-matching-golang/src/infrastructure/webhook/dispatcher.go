@@ -0,0 +1,319 @@
+package webhook
+
+import (
+	"ai-matching/db/sqlc"
+	"ai-matching/src/domain/interface/repository"
+	"ai-matching/src/infrastructure/buildinfo"
+	"ai-matching/src/infrastructure/jobs"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Delivery statuses
+const (
+	StatusPending   = "pending"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+// JobKind is the job that sends one delivery
+const JobKind = "webhook.deliver"
+
+// Job is the payload of a delivery job
+type Job struct {
+	DeliveryID uuid.UUID `json:"deliveryId"`
+}
+
+const (
+	defaultTimeout       = 10 * time.Second
+	defaultMaxAttempts   = 8
+	defaultSecretOverlap = 24 * time.Hour
+
+	// maxResponseBody is how much of a response is kept in the delivery log
+	maxResponseBody = 1024
+	// maxErrorLength keeps stored errors readable in the delivery log
+	maxErrorLength = 2000
+)
+
+var (
+	ErrDeliveryNotFound = errors.New("webhook delivery not found")
+
+	errEndpointDisabled = errors.New("endpoint is disabled")
+)
+
+// Dispatcher records organization events as webhook deliveries and sends
+// them from the job queue. A delivery is tried up to WEBHOOK_MAX_ATTEMPTS
+// times (default 8) with the queue's backoff, each request timing out after
+// WEBHOOK_TIMEOUT (default 10s). After a secret rotation the old secret
+// keeps signing for WEBHOOK_SECRET_OVERLAP (default 24h).
+// WEBHOOK_ALLOW_PRIVATE_NETWORKS=true permits http and private addresses
+// for local development.
+type Dispatcher struct {
+	webhookRepo   repository.WebhookRepository
+	queue         *jobs.Queue
+	client        *http.Client
+	maxAttempts   int
+	secretOverlap time.Duration
+	allowPrivate  bool
+	now           func() time.Time
+}
+
+func NewDispatcher(webhookRepo repository.WebhookRepository, queue *jobs.Queue) *Dispatcher {
+	timeout := durationFromEnv("WEBHOOK_TIMEOUT", defaultTimeout)
+	allowPrivate := os.Getenv("WEBHOOK_ALLOW_PRIVATE_NETWORKS") == "true"
+
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		dialer.Control = checkAddress
+	}
+
+	d := &Dispatcher{
+		webhookRepo: webhookRepo,
+		queue:       queue,
+		client: &http.Client{
+			Timeout: timeout,
+			// No proxy, so the address check sees the real destination
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: timeout,
+				MaxIdleConns:        100,
+				IdleConnTimeout:     90 * time.Second,
+			},
+			// A redirect counts as a failed delivery rather than being followed
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		maxAttempts:   intFromEnv("WEBHOOK_MAX_ATTEMPTS", defaultMaxAttempts),
+		secretOverlap: durationFromEnv("WEBHOOK_SECRET_OVERLAP", defaultSecretOverlap),
+		allowPrivate:  allowPrivate,
+		now:           time.Now,
+	}
+	return d
+}
+
+// Publish queues an event for every active endpoint of the organization
+// subscribed to its type. The change it reports has already happened, so
+// failures are logged rather than returned.
+func (d *Dispatcher) Publish(ctx context.Context, organizationID uuid.UUID, eventType string, data any) {
+	// Finish queueing even if the request that caused the event goes away
+	ctx = context.WithoutCancel(ctx)
+	logger := slog.With(slog.String("organization_id", organizationID.String()), slog.String("event_type", eventType))
+
+	endpoints, err := d.webhookRepo.ListSubscribedEndpoints(ctx, organizationID, eventType)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to list webhook endpoints", slog.Any("error", err))
+		return
+	}
+	if len(endpoints) == 0 {
+		return
+	}
+
+	event := Event{
+		ID:             uuid.New(),
+		Type:           eventType,
+		OrganizationID: organizationID,
+		CreatedAt:      d.now().UTC(),
+		Data:           data,
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to encode webhook event", slog.Any("error", err))
+		return
+	}
+
+	for _, endpoint := range endpoints {
+		delivery, err := d.webhookRepo.CreateDelivery(ctx, db.CreateWebhookDeliveryParams{
+			EndpointID: endpoint.ID,
+			EventID:    event.ID,
+			EventType:  eventType,
+			Payload:    body,
+		})
+		if err != nil {
+			logger.ErrorContext(ctx, "failed to record webhook delivery", slog.String("endpoint_id", endpoint.ID.String()), slog.Any("error", err))
+			continue
+		}
+		if err := d.enqueue(ctx, organizationID, delivery.ID); err != nil {
+			logger.ErrorContext(ctx, "failed to queue webhook delivery", slog.String("delivery_id", delivery.ID.String()), slog.Any("error", err))
+		}
+	}
+}
+
+// Redeliver sends an earlier delivery's event again as a new delivery
+func (d *Dispatcher) Redeliver(ctx context.Context, organizationID uuid.UUID, original db.WebhookDelivery) (db.WebhookDelivery, error) {
+	delivery, err := d.webhookRepo.CreateDelivery(ctx, db.CreateWebhookDeliveryParams{
+		EndpointID:   original.EndpointID,
+		EventID:      original.EventID,
+		EventType:    original.EventType,
+		Payload:      original.Payload,
+		RedeliveryOf: uuid.NullUUID{UUID: original.ID, Valid: true},
+	})
+	if err != nil {
+		return db.WebhookDelivery{}, fmt.Errorf("failed to record webhook delivery: %w", err)
+	}
+	if err := d.enqueue(ctx, organizationID, delivery.ID); err != nil {
+		return db.WebhookDelivery{}, err
+	}
+	return delivery, nil
+}
+
+// RotateSecret gives an endpoint a new signing secret. Deliveries are signed
+// with both secrets until the overlap ends.
+func (d *Dispatcher) RotateSecret(ctx context.Context, organizationID, endpointID uuid.UUID) (db.WebhookEndpoint, error) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		return db.WebhookEndpoint{}, err
+	}
+	return d.webhookRepo.RotateEndpointSecret(ctx, organizationID, endpointID, secret, d.now().Add(d.secretOverlap))
+}
+
+func (d *Dispatcher) enqueue(ctx context.Context, organizationID, deliveryID uuid.UUID) error {
+	_, err := d.queue.Enqueue(ctx, JobKind, Job{DeliveryID: deliveryID}, jobs.Options{
+		MaxAttempts:    d.maxAttempts,
+		OrganizationID: organizationID,
+	})
+	if err != nil {
+		if err := d.webhookRepo.FailDelivery(ctx, deliveryID, "failed to queue delivery"); err != nil {
+			slog.ErrorContext(ctx, "failed to record webhook delivery failure", slog.String("delivery_id", deliveryID.String()), slog.Any("error", err))
+		}
+		return fmt.Errorf("failed to queue webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// Deliver is the job handler that sends one attempt of a delivery. Any
+// response other than 2xx is retried until the job runs out of attempts.
+func (d *Dispatcher) Deliver(ctx context.Context, payload Job) error {
+
+	target, err := d.webhookRepo.GetDeliveryTarget(ctx, payload.DeliveryID)
+	if err != nil {
+		// Deliveries go with their endpoint when it is deleted
+		if errors.Is(err, sql.ErrNoRows) {
+			return jobs.Permanent(ErrDeliveryNotFound)
+		}
+		return fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+	if target.Status != StatusPending {
+		return nil
+	}
+	if !target.IsActive {
+		if err := d.webhookRepo.FailDelivery(ctx, target.ID, errEndpointDisabled.Error()); err != nil {
+			return fmt.Errorf("failed to record webhook delivery failure: %w", err)
+		}
+		return jobs.Permanent(errEndpointDisabled)
+	}
+
+	statusCode, body, elapsed, sendErr := d.send(ctx, target)
+
+	status := StatusSucceeded
+	if sendErr != nil {
+		status = StatusPending
+		if jobs.IsPermanent(sendErr) || jobs.LastAttempt(ctx) {
+			status = StatusFailed
+		}
+	}
+
+	// Record the attempt even when the job was cancelled at shutdown
+	recordCtx := context.WithoutCancel(ctx)
+	params := db.RecordWebhookDeliveryAttemptParams{
+		ID:         target.ID,
+		Status:     status,
+		DurationMs: int32(elapsed.Milliseconds()),
+	}
+	if statusCode != 0 {
+		params.ResponseStatus = sql.NullInt32{Int32: int32(statusCode), Valid: true}
+		params.ResponseBody = sql.NullString{String: body, Valid: true}
+	}
+	if sendErr != nil {
+		params.ErrorMessage = sql.NullString{String: truncate(sendErr.Error()), Valid: true}
+	}
+	if err := d.webhookRepo.RecordDeliveryAttempt(recordCtx, params); err != nil {
+		slog.ErrorContext(recordCtx, "failed to record webhook delivery attempt", slog.String("delivery_id", target.ID.String()), slog.Any("error", err))
+	}
+
+	return sendErr
+}
+
+func (d *Dispatcher) send(ctx context.Context, target db.GetWebhookDeliveryTargetRow) (int, string, time.Duration, error) {
+	timestamp := d.now()
+	secrets := []string{target.Secret}
+	if target.PreviousSecret.Valid && target.PreviousSecretExpiresAt.Valid && timestamp.Before(target.PreviousSecretExpiresAt.Time) {
+		secrets = append(secrets, target.PreviousSecret.String)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.Url, bytes.NewReader(target.Payload))
+	if err != nil {
+		return 0, "", 0, jobs.Permanent(fmt.Errorf("invalid webhook request: %w", err))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "ai-matching-webhooks/"+buildinfo.Version)
+	req.Header.Set("Webhook-Id", target.EventID.String())
+	req.Header.Set("Webhook-Delivery", target.ID.String())
+	req.Header.Set("Webhook-Event", target.EventType)
+	req.Header.Set("Webhook-Timestamp", strconv.FormatInt(timestamp.Unix(), 10))
+	req.Header.Set("Webhook-Signature", Sign(secrets, timestamp, target.Payload))
+
+	started := time.Now()
+	resp, err := d.client.Do(req)
+	elapsed := time.Since(started)
+	if err != nil {
+		return 0, "", elapsed, fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	// Drain a little more so the connection can be reused
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, strings.ToValidUTF8(string(body), ""), elapsed, fmt.Errorf("endpoint responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, strings.ToValidUTF8(string(body), ""), elapsed, nil
+}
+
+func truncate(message string) string {
+	if len(message) <= maxErrorLength {
+		return message
+	}
+	return strings.ToValidUTF8(message[:maxErrorLength], "")
+}
+
+func intFromEnv(name string, fallback int) int {
+	v := os.Getenv(name)
+	if v == "" {
+		return fallback
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n <= 0 {
+		slog.Warn("ignoring invalid "+name, "value", v)
+		return fallback
+	}
+	return n
+}
+
+func durationFromEnv(name string, fallback time.Duration) time.Duration {
+	v := os.Getenv(name)
+	if v == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		slog.Warn("ignoring invalid "+name, "value", v)
+		return fallback
+	}
+	return d
+}
@@ -0,0 +1,70 @@
+package webhook
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Event types an endpoint can subscribe to
+const (
+	EventUserCreated           = "user.created"
+	EventTenantCreated         = "tenant.created"
+	EventTenantDeleted         = "tenant.deleted"
+	EventTenantUserAdded       = "tenant_user.added"
+	EventTenantUserRoleChanged = "tenant_user.role_changed"
+	EventTenantUserRemoved     = "tenant_user.removed"
+)
+
+// EventTypes lists every event type in the order they are documented
+var EventTypes = []string{
+	EventUserCreated,
+	EventTenantCreated,
+	EventTenantDeleted,
+	EventTenantUserAdded,
+	EventTenantUserRoleChanged,
+	EventTenantUserRemoved,
+}
+
+func ValidEventType(eventType string) bool {
+	return slices.Contains(EventTypes, eventType)
+}
+
+// Event is the body posted to endpoints. ID stays the same across retries
+// and redeliveries so receivers can drop duplicates.
+type Event struct {
+	ID             uuid.UUID `json:"id"`
+	Type           string    `json:"type"`
+	OrganizationID uuid.UUID `json:"organizationId"`
+	CreatedAt      time.Time `json:"createdAt"`
+	Data           any       `json:"data"`
+}
+
+// UserData is the data of user.created
+type UserData struct {
+	ID        uuid.UUID `json:"id"`
+	Email     string    `json:"email"`
+	FirstName string    `json:"firstName,omitempty"`
+	LastName  string    `json:"lastName,omitempty"`
+	TenantID  uuid.UUID `json:"tenantId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// TenantData is the data of tenant.created and tenant.deleted
+type TenantData struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Subdomain string    `json:"subdomain"`
+	IsActive  bool      `json:"isActive"`
+}
+
+// TenantUserData is the data of the tenant_user events. PreviousRole is
+// only set on tenant_user.role_changed.
+type TenantUserData struct {
+	TenantID     uuid.UUID `json:"tenantId"`
+	UserID       uuid.UUID `json:"userId"`
+	Email        string    `json:"email,omitempty"`
+	Role         string    `json:"role,omitempty"`
+	PreviousRole string    `json:"previousRole,omitempty"`
+}
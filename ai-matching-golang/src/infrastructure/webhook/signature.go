@@ -0,0 +1,40 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const secretPrefix = "whsec_"
+
+// GenerateSecret returns a new random signing secret
+func GenerateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return secretPrefix + hex.EncodeToString(b), nil
+}
+
+// Sign computes the Webhook-Signature header: one v1= entry per secret, each
+// the hex HMAC-SHA256 of "<unix timestamp>.<body>". Receivers accept the
+// request when any entry matches one of their secrets, which lets a rotated
+// secret overlap with the old one.
+func Sign(secrets []string, timestamp time.Time, body []byte) string {
+	prefix := strconv.FormatInt(timestamp.Unix(), 10) + "."
+
+	signatures := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(prefix))
+		mac.Write(body)
+		signatures = append(signatures, "v1="+hex.EncodeToString(mac.Sum(nil)))
+	}
+	return strings.Join(signatures, ",")
+}
@@ -0,0 +1,68 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+)
+
+var (
+	ErrInvalidURL = errors.New("webhook URL must be an absolute https URL")
+	ErrPrivateURL = errors.New("webhook URL must not point to a private network")
+)
+
+// carrierNAT is shared address space (RFC 6598), reachable only inside
+// the provider's network like the private ranges
+var carrierNAT = netip.MustParsePrefix("100.64.0.0/10")
+
+// ValidateURL checks an endpoint URL and returns it normalized. Unless
+// private networks are allowed, for local development, it must use https
+// and must not name a private address; names that resolve to one are
+// refused when connecting.
+func (d *Dispatcher) ValidateURL(raw string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Host == "" || u.User != nil || u.Fragment != "" {
+		return "", ErrInvalidURL
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	if u.Scheme != "https" && !(d.allowPrivate && u.Scheme == "http") {
+		return "", ErrInvalidURL
+	}
+
+	if !d.allowPrivate {
+		host := strings.ToLower(u.Hostname())
+		if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+			return "", ErrPrivateURL
+		}
+		if addr, err := netip.ParseAddr(host); err == nil && !publicAddr(addr) {
+			return "", ErrPrivateURL
+		}
+	}
+	return u.String(), nil
+}
+
+// checkAddress refuses connections to private addresses. It runs after DNS
+// resolution, so a public name cannot be pointed at an internal service.
+func checkAddress(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid webhook address %q: %w", address, err)
+	}
+	if !publicAddr(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", ErrPrivateURL, addrPort.Addr())
+	}
+	return nil
+}
+
+func publicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	ip := net.IP(addr.AsSlice())
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() ||
+		carrierNAT.Contains(addr))
+}